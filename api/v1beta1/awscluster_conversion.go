@@ -68,7 +68,9 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Bastion.HostID = restored.Status.Bastion.HostID
 		dst.Status.Bastion.CapacityReservationPreference = restored.Status.Bastion.CapacityReservationPreference
 		dst.Status.Bastion.CPUOptions = restored.Status.Bastion.CPUOptions
+		dst.Status.Bastion.HibernationEnabled = restored.Status.Bastion.HibernationEnabled
 		dst.Status.Bastion.IPv6Address = restored.Status.Bastion.IPv6Address
+		restoreVolumes(restored.Status.Bastion.RootVolume, restored.Status.Bastion.NonRootVolumes, dst.Status.Bastion.RootVolume, dst.Status.Bastion.NonRootVolumes)
 		if restored.Status.Bastion.DynamicHostAllocation != nil {
 			dst.Status.Bastion.DynamicHostAllocation = restored.Status.Bastion.DynamicHostAllocation
 		}
@@ -79,6 +81,11 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Network.SecurityGroups[role] = sg
 	}
 	dst.Status.Network.NatGatewaysIPs = restored.Status.Network.NatGatewaysIPs
+	dst.Status.Network.VPCEndpoints = restored.Status.Network.VPCEndpoints
+	dst.Status.Network.TransitGatewayAttachment = restored.Status.Network.TransitGatewayAttachment
+	dst.Status.Network.VPCPeerings = restored.Status.Network.VPCPeerings
+	dst.Status.Network.FlowLog = restored.Status.Network.FlowLog
+	dst.Status.Network.APIServerDNSRecord = restored.Status.Network.APIServerDNSRecord
 
 	if restored.Spec.NetworkSpec.VPC.IPAMPool != nil {
 		if dst.Spec.NetworkSpec.VPC.IPAMPool == nil {
@@ -98,6 +105,9 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.NetworkSpec.AdditionalControlPlaneIngressRules = restored.Spec.NetworkSpec.AdditionalControlPlaneIngressRules
 	dst.Spec.NetworkSpec.AdditionalNodeIngressRules = restored.Spec.NetworkSpec.AdditionalNodeIngressRules
+	dst.Spec.NetworkSpec.AdditionalControlPlaneEgressRules = restored.Spec.NetworkSpec.AdditionalControlPlaneEgressRules
+	dst.Spec.NetworkSpec.AdditionalNodeEgressRules = restored.Spec.NetworkSpec.AdditionalNodeEgressRules
+	dst.Spec.NetworkSpec.SecurityGroupEgressRules = restored.Spec.NetworkSpec.SecurityGroupEgressRules
 	dst.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks = restored.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks
 
 	if restored.Spec.NetworkSpec.VPC.IPAMPool != nil {
@@ -121,6 +131,10 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.NetworkSpec.VPC.CarrierGatewayID = restored.Spec.NetworkSpec.VPC.CarrierGatewayID
 	dst.Spec.NetworkSpec.VPC.SubnetSchema = restored.Spec.NetworkSpec.VPC.SubnetSchema
 	dst.Spec.NetworkSpec.VPC.SecondaryCidrBlocks = restored.Spec.NetworkSpec.VPC.SecondaryCidrBlocks
+	dst.Spec.NetworkSpec.VPC.Endpoints = restored.Spec.NetworkSpec.VPC.Endpoints
+	dst.Spec.NetworkSpec.VPC.TransitGateway = restored.Spec.NetworkSpec.VPC.TransitGateway
+	dst.Spec.NetworkSpec.VPC.FlowLogs = restored.Spec.NetworkSpec.VPC.FlowLogs
+	dst.Spec.NetworkSpec.VPCPeerings = restored.Spec.NetworkSpec.VPCPeerings
 
 	if restored.Spec.NetworkSpec.VPC.ElasticIPPool != nil {
 		if dst.Spec.NetworkSpec.VPC.ElasticIPPool == nil {
@@ -161,6 +175,7 @@ func restoreControlPlaneLoadBalancerStatus(restored, dst *infrav1.LoadBalancer)
 	dst.ARN = restored.ARN
 	dst.LoadBalancerType = restored.LoadBalancerType
 	dst.LoadBalancerIPAddressType = restored.LoadBalancerIPAddressType
+	dst.CanonicalHostedZoneID = restored.CanonicalHostedZoneID
 	dst.ELBAttributes = restored.ELBAttributes
 	dst.ELBListeners = restored.ELBListeners
 	dst.Name = restored.Name
@@ -200,6 +215,10 @@ func restoreControlPlaneLoadBalancer(restored, dst *infrav1.AWSLoadBalancerSpec)
 	dst.Subnets = restored.Subnets
 	dst.TargetGroupIPType = restored.TargetGroupIPType
 	dst.DNSResolutionCheck = restored.DNSResolutionCheck
+	dst.DNS = restored.DNS
+	dst.AccessLogs = restored.AccessLogs
+	dst.ConnectionLogs = restored.ConnectionLogs
+	dst.DeletionProtection = restored.DeletionProtection
 }
 
 // ConvertFrom converts the v1beta1 AWSCluster receiver to a v1beta1 AWSCluster.
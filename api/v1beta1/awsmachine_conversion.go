@@ -50,6 +50,9 @@ func (src *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.NetworkInterfaceType = restored.Spec.NetworkInterfaceType
 	dst.Spec.AssignPrimaryIPv6 = restored.Spec.AssignPrimaryIPv6
 	dst.Spec.CPUOptions = restored.Spec.CPUOptions
+	dst.Spec.HibernationEnabled = restored.Spec.HibernationEnabled
+	dst.Spec.PowerState = restored.Spec.PowerState
+	restoreVolumes(restored.Spec.RootVolume, restored.Spec.NonRootVolumes, dst.Spec.RootVolume, dst.Spec.NonRootVolumes)
 	if restored.Spec.DynamicHostAllocation != nil {
 		dst.Spec.DynamicHostAllocation = restored.Spec.DynamicHostAllocation
 	}
@@ -123,6 +126,9 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Template.Spec.NetworkInterfaceType = restored.Spec.Template.Spec.NetworkInterfaceType
 	dst.Spec.Template.Spec.AssignPrimaryIPv6 = restored.Spec.Template.Spec.AssignPrimaryIPv6
 	dst.Spec.Template.Spec.CPUOptions = restored.Spec.Template.Spec.CPUOptions
+	dst.Spec.Template.Spec.HibernationEnabled = restored.Spec.Template.Spec.HibernationEnabled
+	dst.Spec.Template.Spec.PowerState = restored.Spec.Template.Spec.PowerState
+	restoreVolumes(restored.Spec.Template.Spec.RootVolume, restored.Spec.Template.Spec.NonRootVolumes, dst.Spec.Template.Spec.RootVolume, dst.Spec.Template.Spec.NonRootVolumes)
 	if restored.Spec.Template.Spec.DynamicHostAllocation != nil {
 		dst.Spec.Template.Spec.DynamicHostAllocation = restored.Spec.Template.Spec.DynamicHostAllocation
 	}
@@ -173,3 +179,21 @@ func (dst *AWSMachineTemplateList) ConvertFrom(srcRaw conversion.Hub) error {
 
 	return Convert_v1beta2_AWSMachineTemplateList_To_v1beta1_AWSMachineTemplateList(src, dst, nil)
 }
+
+// restoreVolumes restores the volume fields that don't exist in v1beta1.
+func restoreVolumes(restoredRoot *infrav1.Volume, restoredNonRoot []infrav1.Volume, dstRoot *infrav1.Volume, dstNonRoot []infrav1.Volume) {
+	if restoredRoot != nil && dstRoot != nil {
+		restoreVolume(restoredRoot, dstRoot)
+	}
+	if len(restoredNonRoot) != len(dstNonRoot) {
+		return
+	}
+	for i := range dstNonRoot {
+		restoreVolume(&restoredNonRoot[i], &dstNonRoot[i])
+	}
+}
+
+func restoreVolume(restored, dst *infrav1.Volume) {
+	dst.SnapshotID = restored.SnapshotID
+	dst.VolumeInitializationRate = restored.VolumeInitializationRate
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta2
 
 import (
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
@@ -125,6 +127,12 @@ var (
 
 	// ClusterStaticIdentityKind defines identity reference kind as AWSClusterStaticIdentity.
 	ClusterStaticIdentityKind = AWSIdentityKind("AWSClusterStaticIdentity")
+
+	// ClusterWebIdentityKind defines identity reference kind as AWSClusterWebIdentity.
+	ClusterWebIdentityKind = AWSIdentityKind("AWSClusterWebIdentity")
+
+	// ClusterRolesAnywhereIdentityKind defines identity reference kind as AWSClusterRolesAnywhereIdentity.
+	ClusterRolesAnywhereIdentityKind = AWSIdentityKind("AWSClusterRolesAnywhereIdentity")
 )
 
 // AWSIdentityReference specifies a identity.
@@ -134,7 +142,7 @@ type AWSIdentityReference struct {
 	Name string `json:"name"`
 
 	// Kind of the identity.
-	// +kubebuilder:validation:Enum=AWSClusterControllerIdentity;AWSClusterRoleIdentity;AWSClusterStaticIdentity
+	// +kubebuilder:validation:Enum=AWSClusterControllerIdentity;AWSClusterRoleIdentity;AWSClusterStaticIdentity;AWSClusterWebIdentity;AWSClusterRolesAnywhereIdentity
 	Kind AWSIdentityKind `json:"kind"`
 }
 
@@ -282,6 +290,82 @@ type AWSLoadBalancerSpec struct {
 	// +kubebuilder:validation:Enum=None;Enabled
 	// +optional
 	DNSResolutionCheck *AWSLoadBalancerDNSResolutionCheck `json:"dnsResolutionCheck,omitempty"`
+
+	// DNS configures a Route53 record pointing at the load balancer, used as the control plane endpoint
+	// instead of the load balancer DNS name.
+	// Only supported on the primary control plane load balancer. Once set, the value cannot be changed.
+	// +optional
+	DNS *LoadBalancerDNSSpec `json:"dns,omitempty"`
+
+	// AccessLogs configures the delivery of the load balancer access logs to an S3 bucket.
+	// Only supported on the alb and nlb load balancer types.
+	// +optional
+	AccessLogs *LoadBalancerLogsSpec `json:"accessLogs,omitempty"`
+
+	// ConnectionLogs configures the delivery of the load balancer connection logs to an S3 bucket.
+	// Only supported on the alb load balancer type.
+	// +optional
+	ConnectionLogs *LoadBalancerLogsSpec `json:"connectionLogs,omitempty"`
+
+	// DeletionProtection enables deletion protection on the load balancer, preventing it from being
+	// deleted out of band. CAPA turns it off before deleting a load balancer it owns.
+	// Not supported on the classic load balancer type.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+}
+
+// LoadBalancerLogsSpec defines the S3 destination of load balancer logs.
+type LoadBalancerLogsSpec struct {
+	// Bucket is the name of the S3 bucket the logs are delivered to. Unless CreateBucket is set,
+	// the bucket must already exist and allow the Elastic Load Balancing log delivery to write to it.
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`
+	Bucket string `json:"bucket"`
+
+	// Prefix is the prefix of the log objects in the bucket. When omitted, the logs are stored at the
+	// root of the bucket. It must not start or end with a slash, nor contain AWSLogs.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// CreateBucket makes CAPA create the bucket, along with a bucket policy allowing the Elastic Load
+	// Balancing log delivery to write to it. The bucket and the logs it holds are kept when the cluster
+	// is deleted.
+	// +optional
+	CreateBucket bool `json:"createBucket,omitempty"`
+}
+
+// LoadBalancerDNSSpec defines the Route53 record of a control plane load balancer.
+type LoadBalancerDNSSpec struct {
+	// HostedZoneID is the ID of the public or private Route53 hosted zone the record is created in.
+	// +kubebuilder:validation:MinLength=1
+	HostedZoneID string `json:"hostedZoneID"`
+
+	// RecordName is the fully qualified name of the record, e.g. api.my-cluster.example.com.
+	// It must belong to the domain of the hosted zone.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z0-9]([-a-zA-Z0-9]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}\.?$`
+	RecordName string `json:"recordName"`
+
+	// TTL is the time to live of the record, in seconds.
+	// When omitted, an alias A record pointing at the load balancer is created; alias records follow the TTL of
+	// the load balancer record. When set, a CNAME record with this TTL is created instead, which cannot be used
+	// at the apex of the hosted zone.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2147483647
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+}
+
+// IsAlias returns whether the record is an alias record.
+func (s *LoadBalancerDNSSpec) IsAlias() bool {
+	return s.TTL == nil
+}
+
+// FQDN returns the record name without its trailing dot.
+func (s *LoadBalancerDNSSpec) FQDN() string {
+	return strings.TrimSuffix(s.RecordName, ".")
 }
 
 // AdditionalListenerSpec defines the desired state of an
@@ -293,11 +377,32 @@ type AdditionalListenerSpec struct {
 	Port int64 `json:"port"`
 
 	// Protocol sets the protocol for the additional listener.
-	// Currently only TCP is supported.
-	// +kubebuilder:validation:Enum=TCP
+	// Currently only TCP and TLS are supported. TLS listeners terminate TLS on the load balancer,
+	// using the certificates set in CertificateARNs, and forward TCP traffic to the control plane instances.
+	// TLS is only supported for network load balancers.
+	// +kubebuilder:validation:Enum=TCP;TLS
 	// +kubebuilder:default=TCP
 	Protocol ELBProtocol `json:"protocol,omitempty"`
 
+	// CertificateARNs sets the ARNs of the ACM or IAM certificates of a TLS listener.
+	// The first certificate is the default certificate of the listener, the other ones
+	// are served to the clients requesting them through SNI.
+	// Required when Protocol is TLS.
+	// +kubebuilder:validation:MaxItems=25
+	// +listType=set
+	// +optional
+	CertificateARNs []string `json:"certificateARNs,omitempty"`
+
+	// SSLPolicy sets the security policy defining the protocols and ciphers supported by a TLS listener.
+	// Defaults to the default security policy of Elastic Load Balancing when not set.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+
+	// ALPNPolicy sets the Application-Layer Protocol Negotiation policy of a TLS listener.
+	// +kubebuilder:validation:Enum=HTTP1Only;HTTP2Only;HTTP2Optional;HTTP2Preferred;None
+	// +optional
+	ALPNPolicy *ALPNPolicy `json:"alpnPolicy,omitempty"`
+
 	// HealthCheck sets the optional custom health check configuration to the API target group.
 	// +optional
 	HealthCheck *TargetGroupHealthCheckAdditionalSpec `json:"healthCheck,omitempty"`
@@ -351,6 +456,44 @@ type S3Bucket struct {
 	// BestEffortDeleteObjects defines whether access/permission errors during object deletion should be ignored.
 	// +optional
 	BestEffortDeleteObjects *bool `json:"bestEffortDeleteObjects,omitempty"`
+
+	// KMSKeyARN is the ARN of the customer managed KMS key used for the default SSE-KMS encryption
+	// of the bucket and of the bootstrap data objects. The AWS managed aws/s3 key is used when unset.
+	// When set, the default encryption of the bucket is configured with the key.
+	// The controller and the node IAM roles need kms:Decrypt on the key, the controller also kms:GenerateDataKey.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:kms:[a-z0-9-]+:[0-9]{12}:key/.+$`
+	KMSKeyARN string `json:"kmsKeyARN,omitempty"`
+
+	// BucketKeyEnabled enables an S3 Bucket Key for the SSE-KMS encryption of the bucket,
+	// which reduces the number of requests from S3 to KMS. When this or KMSKeyARN is set, the
+	// default encryption of the bucket is configured with SSE-KMS. Defaults to true in that case.
+	// +optional
+	BucketKeyEnabled *bool `json:"bucketKeyEnabled,omitempty"`
+
+	// BlockPublicAccess enables all four S3 Block Public Access settings on the bucket.
+	// When unset or false, the public access block configuration of the bucket is left untouched.
+	// New buckets block public access by default.
+	// +optional
+	BlockPublicAccess *bool `json:"blockPublicAccess,omitempty"`
+
+	// AccessLogging configures S3 server access logging for the bucket.
+	// +optional
+	AccessLogging *S3BucketAccessLogging `json:"accessLogging,omitempty"`
+}
+
+// S3BucketAccessLogging defines the target of the S3 server access logs of a bucket.
+type S3BucketAccessLogging struct {
+	// TargetBucket is the name of the bucket the access logs are delivered to. It must be in the same
+	// region and account as the logged bucket and allow the logging.s3.amazonaws.com service principal
+	// to put objects. It must not be the logged bucket itself.
+	// +kubebuilder:validation:MinLength:=3
+	// +kubebuilder:validation:MaxLength:=63
+	TargetBucket string `json:"targetBucket"`
+
+	// TargetPrefix is the prefix of the access log object keys. Defaults to the name of the logged bucket followed by a slash.
+	// +optional
+	TargetPrefix string `json:"targetPrefix,omitempty"`
 }
 
 // +kubebuilder:object:root=true
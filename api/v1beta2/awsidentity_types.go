@@ -181,6 +181,111 @@ type AWSClusterControllerIdentitySpec struct {
 	AWSClusterIdentitySpec `json:",inline"`
 }
 
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=awsclusterwebidentities,scope=Cluster,categories=cluster-api,shortName=awswi
+// +kubebuilder:storageversion
+// +k8s:defaulter-gen=true
+
+// AWSClusterWebIdentity is the Schema for the awsclusterwebidentities API
+// It is used to assume a role with a web identity token issued by an OIDC provider trusted by AWS IAM,
+// such as a projected service account token of the management cluster.
+type AWSClusterWebIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec for this AWSClusterWebIdentity.
+	Spec AWSClusterWebIdentitySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:defaulter-gen=true
+
+// AWSClusterWebIdentityList contains a list of AWSClusterWebIdentity.
+type AWSClusterWebIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSClusterWebIdentity `json:"items"`
+}
+
+// AWSClusterWebIdentitySpec defines the specifications for AWSClusterWebIdentity.
+// +kubebuilder:validation:XValidation:rule="has(self.tokenFile) != has(self.tokenSecretRef)",message="exactly one of tokenFile or tokenSecretRef must be set"
+type AWSClusterWebIdentitySpec struct {
+	AWSClusterIdentitySpec `json:",inline"`
+	AWSRoleSpec            `json:",inline"`
+
+	// TokenFile is the path, inside the controller pod, of a file containing the web identity token.
+	// This is typically a projected service account token volume with the sts.amazonaws.com audience,
+	// which is rotated by the kubelet and read again whenever credentials are renewed.
+	// +optional
+	TokenFile string `json:"tokenFile,omitempty"`
+
+	// TokenSecretRef is the name of a secret in the controller namespace containing the web identity token.
+	// The secret should contain the following data key:
+	//  token: eyJhbGciOiJSUzI1NiIsImtpZCI6...
+	// +optional
+	TokenSecretRef string `json:"tokenSecretRef,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=awsclusterrolesanywhereidentities,scope=Cluster,categories=cluster-api,shortName=awsrai
+// +kubebuilder:storageversion
+// +k8s:defaulter-gen=true
+
+// AWSClusterRolesAnywhereIdentity is the Schema for the awsclusterrolesanywhereidentities API
+// It is used to obtain temporary credentials with IAM Roles Anywhere, using an X.509 certificate
+// and private key stored in a secret, for management clusters running outside of AWS.
+type AWSClusterRolesAnywhereIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec for this AWSClusterRolesAnywhereIdentity.
+	Spec AWSClusterRolesAnywhereIdentitySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:defaulter-gen=true
+
+// AWSClusterRolesAnywhereIdentityList contains a list of AWSClusterRolesAnywhereIdentity.
+type AWSClusterRolesAnywhereIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSClusterRolesAnywhereIdentity `json:"items"`
+}
+
+// AWSClusterRolesAnywhereIdentitySpec defines the specifications for AWSClusterRolesAnywhereIdentity.
+type AWSClusterRolesAnywhereIdentitySpec struct {
+	AWSClusterIdentitySpec `json:",inline"`
+
+	// SecretRef is the name of a secret in the controller namespace containing the X.509 certificate
+	// and private key used to sign CreateSession requests. The secret should contain the following data keys:
+	//  tls.crt: PEM encoded end-entity certificate, issued by the trust anchor
+	//  tls.key: PEM encoded RSA or EC private key of the certificate
+	//  ca.crt: Optional, PEM encoded intermediate certificates of the chain
+	SecretRef string `json:"secretRef"`
+
+	// TrustAnchorARN is the Amazon Resource Name (ARN) of the Roles Anywhere trust anchor
+	// that issued the certificate.
+	TrustAnchorARN string `json:"trustAnchorARN"`
+
+	// ProfileARN is the Amazon Resource Name (ARN) of the Roles Anywhere profile
+	// that lists the roles the certificate can assume.
+	ProfileARN string `json:"profileARN"`
+
+	// RoleARN is the Amazon Resource Name (ARN) of the role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// SessionName is an identifier for the role session.
+	// +optional
+	SessionName string `json:"sessionName,omitempty"`
+
+	// DurationSeconds is the duration, in seconds, of the role session before it is renewed.
+	// It is bounded by the duration configured on the profile.
+	// +kubebuilder:validation:Minimum:=900
+	// +kubebuilder:validation:Maximum:=43200
+	// +optional
+	DurationSeconds int32 `json:"durationSeconds,omitempty"`
+}
+
 func init() {
 	SchemeBuilder.Register(
 		&AWSClusterStaticIdentity{},
@@ -189,5 +294,9 @@ func init() {
 		&AWSClusterRoleIdentityList{},
 		&AWSClusterControllerIdentity{},
 		&AWSClusterControllerIdentityList{},
+		&AWSClusterWebIdentity{},
+		&AWSClusterWebIdentityList{},
+		&AWSClusterRolesAnywhereIdentity{},
+		&AWSClusterRolesAnywhereIdentityList{},
 	)
 }
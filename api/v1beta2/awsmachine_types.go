@@ -73,7 +73,23 @@ const (
 	NetworkInterfaceTypeEFAWithENAInterface NetworkInterfaceType = NetworkInterfaceType("efa")
 )
 
+// InstancePowerState is the desired power state of an EC2 instance.
+type InstancePowerState string
+
+const (
+	// InstancePowerStateRunning means the instance should be running.
+	InstancePowerStateRunning = InstancePowerState("Running")
+
+	// InstancePowerStateStopped means the instance should be stopped, keeping its EBS volumes.
+	InstancePowerStateStopped = InstancePowerState("Stopped")
+
+	// InstancePowerStateHibernated means the instance should be hibernated, keeping its EBS volumes
+	// and the content of its memory.
+	InstancePowerStateHibernated = InstancePowerState("Hibernated")
+)
+
 // AWSMachineSpec defines the desired state of an Amazon EC2 instance.
+// +kubebuilder:validation:XValidation:rule="!has(self.powerState) || self.powerState != 'Hibernated' || (has(self.hibernationEnabled) && self.hibernationEnabled)",message="hibernationEnabled must be true when powerState is Hibernated"
 // +kubebuilder:validation:XValidation:rule="!has(self.capacityReservationId) || !has(self.marketType) || self.marketType != 'Spot'",message="capacityReservationId may not be set when marketType is Spot"
 // +kubebuilder:validation:XValidation:rule="!has(self.capacityReservationId) || !has(self.spotMarketOptions)",message="capacityReservationId cannot be set when spotMarketOptions is specified"
 type AWSMachineSpec struct {
@@ -289,6 +305,24 @@ type AWSMachineSpec struct {
 	// +kubebuilder:validation:Enum="";None;CapacityReservationsOnly;Open
 	// +optional
 	CapacityReservationPreference CapacityReservationPreference `json:"capacityReservationPreference,omitempty"`
+
+	// HibernationEnabled configures the instance for hibernation when it is launched, so it can later be
+	// hibernated with PowerState. It cannot be changed once the instance is launched.
+	// Hibernation requires an instance type, AMI and encrypted root volume that support it, see
+	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/hibernating-prerequisites.html
+	// +optional
+	HibernationEnabled bool `json:"hibernationEnabled,omitempty"`
+
+	// PowerState is the desired power state of the instance. Valid values are:
+	// "Running": The instance is started if it was stopped or hibernated.
+	// "Stopped": The instance is stopped, keeping its EBS volumes.
+	// "Hibernated": The instance is hibernated, keeping its EBS volumes and memory. Requires HibernationEnabled.
+	// When omitted, the power state of the instance is not managed, and a stopped instance is reported as failed.
+	// While the instance is stopped or hibernated, the Machine is excluded from MachineHealthCheck remediation.
+	// Not supported for machines of an AWSMachinePool.
+	// +kubebuilder:validation:Enum=Running;Stopped;Hibernated
+	// +optional
+	PowerState InstancePowerState `json:"powerState,omitempty"`
 }
 
 // DynamicHostAllocationSpec defines the configuration for dynamic dedicated host allocation.
@@ -103,6 +103,38 @@ const (
 	VpcEndpointsReconciliationFailedReason = "VpcEndpointsReconciliationFailed"
 )
 
+const (
+	// VpcFlowLogsReadyCondition reports successful reconciliation of the VPC flow logs.
+	VpcFlowLogsReadyCondition clusterv1beta1.ConditionType = "VpcFlowLogsReady"
+	// VpcFlowLogsReconciliationFailedReason used when any errors occur during reconciliation of the VPC flow logs.
+	VpcFlowLogsReconciliationFailedReason = "VpcFlowLogsReconciliationFailed"
+)
+
+const (
+	// TransitGatewayAttachmentReadyCondition reports successful reconciliation of the transit gateway attachment.
+	// Only applicable to managed clusters.
+	TransitGatewayAttachmentReadyCondition clusterv1beta1.ConditionType = "TransitGatewayAttachmentReady"
+	// TransitGatewayAttachmentReconciliationFailedReason used when any errors occur during reconciliation of the transit gateway attachment.
+	TransitGatewayAttachmentReconciliationFailedReason = "TransitGatewayAttachmentReconciliationFailed"
+	// TransitGatewayAttachmentNotAvailableReason used when the transit gateway attachment exists but is not available yet,
+	// e.g. it is pending or waiting to be accepted by the transit gateway owner.
+	TransitGatewayAttachmentNotAvailableReason = "TransitGatewayAttachmentNotAvailable"
+)
+
+const (
+	// VpcPeeringReadyCondition reports successful reconciliation of the VPC peering connections.
+	// Only applicable to managed clusters.
+	VpcPeeringReadyCondition clusterv1beta1.ConditionType = "VpcPeeringReady"
+	// VpcPeeringReconciliationFailedReason used when any errors occur during reconciliation of the VPC peering connections.
+	VpcPeeringReconciliationFailedReason = "VpcPeeringReconciliationFailed"
+	// VpcPeeringNotActiveReason used when a VPC peering connection exists but is not active yet,
+	// e.g. it is waiting to be accepted by the owner of the peer VPC.
+	VpcPeeringNotActiveReason = "VpcPeeringNotActive"
+	// VpcPeeringRouteConflictReason used when a route table of the peer VPC already routes the cluster VPC CIDR blocks
+	// through another target, which is left untouched.
+	VpcPeeringRouteConflictReason = "VpcPeeringRouteConflict"
+)
+
 const (
 	// SecondaryCidrsReadyCondition reports successful reconciliation of secondary CIDR blocks.
 	// Only applicable to managed clusters.
@@ -156,6 +188,12 @@ const (
 	InstanceTerminatedReason = "InstanceTerminated"
 	// InstanceStoppedReason instance is in a stopped state.
 	InstanceStoppedReason = "InstanceStopped"
+	// InstancePoweredOffReason used when the instance is stopped or hibernated as requested by its power state.
+	InstancePoweredOffReason = "InstancePoweredOff"
+	// InstanceStartingReason used when the instance is being started as requested by its power state.
+	InstanceStartingReason = "InstanceStarting"
+	// InstancePowerStateFailedReason used for failures while stopping, hibernating or starting the instance.
+	InstancePowerStateFailedReason = "InstancePowerStateFailed"
 	// InstanceNotReadyReason used when the instance is in a pending state.
 	InstanceNotReadyReason = "InstanceNotReady"
 	// InstanceProvisionStartedReason set when the provisioning of an instance started.
@@ -168,6 +206,20 @@ const (
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
 )
 
+const (
+	// InstanceInterruptedCondition reports on an interruption of the EC2 instance notified by AWS, like a Spot
+	// interruption warning or a scheduled event. The condition has a negative polarity: it is only set, to true,
+	// once an interruption has been notified for the instance.
+	InstanceInterruptedCondition clusterv1beta1.ConditionType = "InstanceInterrupted"
+
+	// SpotInterruptionWarningReason used when the Spot instance is about to be reclaimed by AWS.
+	SpotInterruptionWarningReason = "SpotInterruptionWarning"
+	// RebalanceRecommendationReason used when the Spot instance is at an elevated risk of interruption.
+	RebalanceRecommendationReason = "RebalanceRecommendation"
+	// ScheduledEventReason used when a maintenance or a retirement is scheduled for the instance.
+	ScheduledEventReason = "ScheduledEvent"
+)
+
 const (
 	// SecurityGroupsReadyCondition indicates the security groups are up to date on the AWSMachine.
 	SecurityGroupsReadyCondition clusterv1beta1.ConditionType = "SecurityGroupsReady"
@@ -64,6 +64,39 @@ type NetworkStatus struct {
 
 	// NatGatewaysIPs contains the public IPs of the NAT Gateways
 	NatGatewaysIPs []string `json:"natGatewaysIPs,omitempty"`
+
+	// VPCEndpoints contains the interface VPC endpoints created for the services listed in
+	// the VPC endpoints spec.
+	// +optional
+	VPCEndpoints []VPCEndpoint `json:"vpcEndpoints,omitempty"`
+
+	// TransitGatewayAttachment is the attachment of the VPC to the transit gateway, if any.
+	// +optional
+	TransitGatewayAttachment *TransitGatewayAttachment `json:"transitGatewayAttachment,omitempty"`
+
+	// VPCPeerings are the peering connections of the VPC requested by the controller.
+	// +optional
+	VPCPeerings []VPCPeering `json:"vpcPeerings,omitempty"`
+
+	// FlowLog is the flow log of the VPC created by the controller, if any.
+	// +optional
+	FlowLog *VPCFlowLog `json:"flowLog,omitempty"`
+
+	// APIServerDNSRecord is the Route53 record pointing at the API server load balancer created by the controller, if any.
+	// +optional
+	APIServerDNSRecord *DNSRecord `json:"apiServerDnsRecord,omitempty"`
+}
+
+// DNSRecord describes a Route53 record created by the controller.
+type DNSRecord struct {
+	// HostedZoneID is the ID of the hosted zone of the record.
+	HostedZoneID string `json:"hostedZoneID"`
+
+	// Name is the fully qualified name of the record.
+	Name string `json:"name"`
+
+	// Type is the type of the record, A for alias records or CNAME.
+	Type string `json:"type"`
 }
 
 // ELBScheme defines the scheme of a load balancer.
@@ -114,6 +147,22 @@ var (
 	ELBProtocolUDP = ELBProtocol("UDP")
 )
 
+// ALPNPolicy defines the Application-Layer Protocol Negotiation policy of a TLS listener.
+type ALPNPolicy string
+
+var (
+	// ALPNPolicyHTTP1Only negotiates only HTTP/1.*.
+	ALPNPolicyHTTP1Only = ALPNPolicy("HTTP1Only")
+	// ALPNPolicyHTTP2Only negotiates only HTTP/2.
+	ALPNPolicyHTTP2Only = ALPNPolicy("HTTP2Only")
+	// ALPNPolicyHTTP2Optional prefers HTTP/1.* over HTTP/2.
+	ALPNPolicyHTTP2Optional = ALPNPolicy("HTTP2Optional")
+	// ALPNPolicyHTTP2Preferred prefers HTTP/2 over HTTP/1.*.
+	ALPNPolicyHTTP2Preferred = ALPNPolicy("HTTP2Preferred")
+	// ALPNPolicyNone does not negotiate ALPN.
+	ALPNPolicyNone = ALPNPolicy("None")
+)
+
 // TargetGroupHealthCheck defines health check settings for the target group.
 type TargetGroupHealthCheck struct {
 	Protocol                *string `json:"protocol,omitempty"`
@@ -275,6 +324,20 @@ var (
 	LoadBalancerAttributeIdleTimeTimeoutSeconds = "idle_timeout.timeout_seconds"
 	// LoadBalancerAttributeIdleTimeDefaultTimeoutSecondsInSeconds defines the default idle timeout in seconds.
 	LoadBalancerAttributeIdleTimeDefaultTimeoutSecondsInSeconds = "60"
+	// LoadBalancerAttributeAccessLogsEnabled defines the attribute key for enabling access logs.
+	LoadBalancerAttributeAccessLogsEnabled = "access_logs.s3.enabled"
+	// LoadBalancerAttributeAccessLogsBucket defines the attribute key for the access logs bucket.
+	LoadBalancerAttributeAccessLogsBucket = "access_logs.s3.bucket"
+	// LoadBalancerAttributeAccessLogsPrefix defines the attribute key for the access logs prefix.
+	LoadBalancerAttributeAccessLogsPrefix = "access_logs.s3.prefix"
+	// LoadBalancerAttributeConnectionLogsEnabled defines the attribute key for enabling connection logs.
+	LoadBalancerAttributeConnectionLogsEnabled = "connection_logs.s3.enabled"
+	// LoadBalancerAttributeConnectionLogsBucket defines the attribute key for the connection logs bucket.
+	LoadBalancerAttributeConnectionLogsBucket = "connection_logs.s3.bucket"
+	// LoadBalancerAttributeConnectionLogsPrefix defines the attribute key for the connection logs prefix.
+	LoadBalancerAttributeConnectionLogsPrefix = "connection_logs.s3.prefix"
+	// LoadBalancerAttributeDeletionProtectionEnabled defines the attribute key for enabling deletion protection.
+	LoadBalancerAttributeDeletionProtectionEnabled = "deletion_protection.enabled"
 )
 
 // TargetGroupSpec specifies target group settings for a given listener.
@@ -299,6 +362,12 @@ type Listener struct {
 	Protocol    ELBProtocol     `json:"protocol"`
 	Port        int64           `json:"port"`
 	TargetGroup TargetGroupSpec `json:"targetGroup"`
+	// CertificateARNs are the certificates of a TLS listener, the first one being the default certificate.
+	CertificateARNs []string `json:"certificateARNs,omitempty"`
+	// SSLPolicy is the security policy of a TLS listener.
+	SSLPolicy string `json:"sslPolicy,omitempty"`
+	// ALPNPolicy is the Application-Layer Protocol Negotiation policy of a TLS listener.
+	ALPNPolicy ALPNPolicy `json:"alpnPolicy,omitempty"`
 }
 
 // LoadBalancer defines an AWS load balancer.
@@ -314,6 +383,11 @@ type LoadBalancer struct {
 	// DNSName is the dns name of the load balancer.
 	DNSName string `json:"dnsName,omitempty"`
 
+	// CanonicalHostedZoneID is the ID of the Route53 hosted zone of the load balancer DNS name,
+	// used as the target of alias records.
+	// +optional
+	CanonicalHostedZoneID string `json:"canonicalHostedZoneId,omitempty"`
+
 	// Scheme is the load balancer scheme, either internet-facing or private.
 	Scheme ELBScheme `json:"scheme,omitempty"`
 
@@ -418,10 +492,37 @@ type NetworkSpec struct {
 	// +optional
 	AdditionalNodeIngressRules []IngressRule `json:"additionalNodeIngressRules,omitempty"`
 
+	// AdditionalControlPlaneEgressRules is an optional set of egress rules for the control plane.
+	// When egress rules are declared, they replace the default rule allowing all outbound traffic.
+	// +optional
+	AdditionalControlPlaneEgressRules []EgressRule `json:"additionalControlPlaneEgressRules,omitempty"`
+
+	// AdditionalNodeEgressRules is an optional set of egress rules for every node.
+	// When egress rules are declared, they replace the default rule allowing all outbound traffic.
+	// +optional
+	AdditionalNodeEgressRules []EgressRule `json:"additionalNodeEgressRules,omitempty"`
+
+	// SecurityGroupEgressRules is an optional set of egress rules for the security group with the given role.
+	// For the control plane and node roles, the rules are combined with the additional egress rules above.
+	// When a role is listed, even with no rules, its egress rules are reconciled and the default rule
+	// allowing all outbound traffic is removed. Roles that are not listed keep their existing egress rules.
+	// +optional
+	SecurityGroupEgressRules map[SecurityGroupRole]EgressRules `json:"securityGroupEgressRules,omitempty"`
+
 	// NodePortIngressRuleCidrBlocks is an optional set of CIDR blocks to allow traffic to nodes' NodePort services.
 	// If none are specified here, all IPs are allowed to connect.
 	// +optional
 	NodePortIngressRuleCidrBlocks CidrBlocks `json:"nodePortIngressRuleCidrBlocks,omitempty"`
+
+	// VPCPeerings are the peering connections to establish between the cluster VPC and other VPCs,
+	// together with the routes sent through them.
+	//
+	// NOTE: This only applies when the VPC is managed by the Cluster API AWS controller.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=peerVpcId
+	VPCPeerings []VPCPeeringSpec `json:"vpcPeerings,omitempty"`
 }
 
 // CidrBlocks defines a set of CIDR blocks.
@@ -571,6 +672,29 @@ type VPCSpec struct {
 	// +optional
 	ElasticIPPool *ElasticIPPool `json:"elasticIpPool,omitempty"`
 
+	// Endpoints configures the interface VPC endpoints (AWS PrivateLink) to create in the VPC, so that
+	// instances can reach AWS services without a route to the internet.
+	//
+	// NOTE: This only applies when the VPC is managed by the Cluster API AWS controller.
+	//
+	// +optional
+	Endpoints *VPCEndpointsSpec `json:"endpoints,omitempty"`
+
+	// TransitGateway configures the attachment of the VPC to an existing transit gateway and the
+	// routes sent through it from the private route tables.
+	//
+	// NOTE: This only applies when the VPC is managed by the Cluster API AWS controller.
+	//
+	// +optional
+	TransitGateway *TransitGatewaySpec `json:"transitGateway,omitempty"`
+
+	// FlowLogs configures the publication of the VPC flow logs.
+	//
+	// NOTE: For unmanaged VPCs, the flow logs are only reconciled when the UnmanagedVPCFlowLogs feature gate is enabled.
+	//
+	// +optional
+	FlowLogs *VPCFlowLogsSpec `json:"flowLogs,omitempty"`
+
 	// SubnetSchema specifies how CidrBlock should be divided on subnets in the VPC depending on the number of AZs.
 	// PreferPrivate - one private subnet for each AZ plus one other subnet that will be further sub-divided for the public subnets.
 	// PreferPublic - have the reverse logic of PreferPrivate, one public subnet for each AZ plus one other subnet
@@ -582,6 +706,278 @@ type VPCSpec struct {
 	SubnetSchema *SubnetSchemaType `json:"subnetSchema,omitempty"`
 }
 
+// VPCEndpointsSpec configures the VPC endpoints for a managed VPC.
+type VPCEndpointsSpec struct {
+	// Interfaces is the list of interface endpoints to create in the VPC.
+	// A security group allowing HTTPS from the VPC CIDR blocks is created and attached to every endpoint.
+	// +optional
+	// +listType=map
+	// +listMapKey=service
+	Interfaces []InterfaceVPCEndpointSpec `json:"interfaces,omitempty"`
+}
+
+// VPCEndpointService is the short name of an AWS service reachable through an interface VPC endpoint.
+// The full service name is built as com.amazonaws.<region>.<service>.
+// +kubebuilder:validation:Enum=ec2;ec2messages;sts;ecr.api;ecr.dkr;ssm;ssmmessages;secretsmanager;elasticloadbalancing;autoscaling;eks;eks-auth;logs;kms
+type VPCEndpointService string
+
+// InterfaceVPCEndpointSpec defines an interface VPC endpoint.
+type InterfaceVPCEndpointSpec struct {
+	// Service is the AWS service the endpoint is created for.
+	Service VPCEndpointService `json:"service"`
+
+	// PrivateDNSEnabled associates a private hosted zone with the VPC so that the default
+	// public DNS name of the service resolves to the endpoint. Defaults to true.
+	// +optional
+	// +kubebuilder:default=true
+	PrivateDNSEnabled *bool `json:"privateDnsEnabled,omitempty"`
+
+	// SubnetIDs are the subnets in which an endpoint network interface is created.
+	// Only one subnet per availability zone can be specified.
+	// If empty, one private subnet per availability zone of the cluster is used.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// AdditionalSecurityGroupIDs are security groups attached to the endpoint in addition to the
+	// one managed by the controller.
+	// +optional
+	AdditionalSecurityGroupIDs []string `json:"additionalSecurityGroupIds,omitempty"`
+}
+
+// ServiceName returns the full name of the AWS service in the given region.
+func (e *InterfaceVPCEndpointSpec) ServiceName(region string) string {
+	return fmt.Sprintf("com.amazonaws.%s.%s", region, e.Service)
+}
+
+// IsPrivateDNSEnabled returns whether private DNS is enabled for the endpoint.
+func (e *InterfaceVPCEndpointSpec) IsPrivateDNSEnabled() bool {
+	return ptr.Deref(e.PrivateDNSEnabled, true)
+}
+
+// VPCEndpoint describes a VPC endpoint created by the controller.
+type VPCEndpoint struct {
+	// ID is the id of the VPC endpoint.
+	ID string `json:"id"`
+
+	// ServiceName is the full name of the AWS service.
+	ServiceName string `json:"serviceName"`
+
+	// State is the state of the VPC endpoint.
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// SubnetIDs are the subnets the endpoint network interfaces are placed in.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SecurityGroupIDs are the security groups attached to the endpoint network interfaces.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+}
+
+// TransitGatewaySpec configures the attachment of a managed VPC to a transit gateway.
+type TransitGatewaySpec struct {
+	// ID is the id of the transit gateway to attach the VPC to. The transit gateway can belong to
+	// another account and be shared with this one through AWS Resource Access Manager.
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('tgw-')",message="Transit Gateway ID must start with 'tgw-'"
+	ID string `json:"id"`
+
+	// SubnetIDs are the subnets in which the attachment network interfaces are created.
+	// Only one subnet per availability zone can be specified.
+	// If empty, one private subnet per availability zone of the cluster is used.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// ApplianceModeSupport enables appliance mode on the attachment, so that the traffic of a flow
+	// uses the same availability zone for its whole lifetime.
+	// +optional
+	ApplianceModeSupport bool `json:"applianceModeSupport,omitempty"`
+
+	// RouteCidrBlocks are the destination IPv4 CIDR blocks routed through the transit gateway from
+	// the private route tables. When it contains 0.0.0.0/0, it replaces the default route through the
+	// NAT gateways.
+	// +optional
+	RouteCidrBlocks []string `json:"routeCidrBlocks,omitempty"`
+}
+
+// TransitGatewayAttachment describes the attachment of the VPC to a transit gateway.
+type TransitGatewayAttachment struct {
+	// ID is the id of the transit gateway attachment.
+	ID string `json:"id"`
+
+	// TransitGatewayID is the id of the transit gateway.
+	TransitGatewayID string `json:"transitGatewayId"`
+
+	// State is the state of the attachment.
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// IsTransitional returns true while the attachment is being created or modified.
+func (a *TransitGatewayAttachment) IsTransitional() bool {
+	if a == nil {
+		return false
+	}
+	switch types.TransitGatewayAttachmentState(a.State) {
+	case types.TransitGatewayAttachmentStatePending, types.TransitGatewayAttachmentStateModifying,
+		types.TransitGatewayAttachmentStatePendingAcceptance:
+		return true
+	}
+	return false
+}
+
+// VPCPeeringSpec configures a peering connection between the cluster VPC and another VPC.
+type VPCPeeringSpec struct {
+	// PeerVPCID is the id of the VPC to peer the cluster VPC with.
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('vpc-')",message="VPC ID must start with 'vpc-'"
+	PeerVPCID string `json:"peerVpcId"`
+
+	// PeerOwnerID is the AWS account ID owning the peer VPC.
+	// Defaults to the account of the cluster.
+	// +kubebuilder:validation:Pattern=`^[0-9]{12}$`
+	// +optional
+	PeerOwnerID string `json:"peerOwnerId,omitempty"`
+
+	// PeerRegion is the region of the peer VPC.
+	// Defaults to the region of the cluster.
+	// +optional
+	PeerRegion string `json:"peerRegion,omitempty"`
+
+	// PeerRoleARN is the ARN of a role in the peer account that the controller assumes to accept
+	// the peering connection and to manage the routes of the peer VPC.
+	// When unset, the controller uses its own credentials, which only works when the peer VPC
+	// belongs to the account of the cluster. Otherwise the peering connection has to be accepted
+	// out of band.
+	// +optional
+	PeerRoleARN string `json:"peerRoleARN,omitempty"`
+
+	// RouteCidrBlocks are the destination IPv4 CIDR blocks of the peer VPC routed through the
+	// peering connection from the route tables of the cluster VPC.
+	// +kubebuilder:validation:MinItems=1
+	RouteCidrBlocks []string `json:"routeCidrBlocks"`
+
+	// PeerRouteTableIDs are the route tables of the peer VPC that get a route to the CIDR block of
+	// the cluster VPC through the peering connection.
+	// +optional
+	PeerRouteTableIDs []string `json:"peerRouteTableIds,omitempty"`
+}
+
+// VPCPeering describes a peering connection of the cluster VPC.
+type VPCPeering struct {
+	// ID is the id of the peering connection.
+	ID string `json:"id"`
+
+	// PeerVPCID is the id of the peer VPC.
+	PeerVPCID string `json:"peerVpcId"`
+
+	// PeerOwnerID is the AWS account ID owning the peer VPC.
+	// +optional
+	PeerOwnerID string `json:"peerOwnerId,omitempty"`
+
+	// PeerRegion is the region of the peer VPC.
+	// +optional
+	PeerRegion string `json:"peerRegion,omitempty"`
+
+	// PeerRoleARN is the role assumed to manage the routes of the peer VPC.
+	// +optional
+	PeerRoleARN string `json:"peerRoleARN,omitempty"`
+
+	// PeerRouteTableIDs are the route tables of the peer VPC the controller added routes to.
+	// They are kept so that the routes can be removed once the peering is no longer declared.
+	// +optional
+	PeerRouteTableIDs []string `json:"peerRouteTableIds,omitempty"`
+
+	// State is the state of the peering connection.
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// FlowLogDestinationType is the type of destination the VPC flow logs are published to.
+type FlowLogDestinationType string
+
+var (
+	// FlowLogDestinationTypeCloudWatchLogs publishes the flow logs to a CloudWatch Logs log group.
+	FlowLogDestinationTypeCloudWatchLogs = FlowLogDestinationType("cloud-watch-logs")
+
+	// FlowLogDestinationTypeS3 publishes the flow logs to an S3 bucket.
+	FlowLogDestinationTypeS3 = FlowLogDestinationType("s3")
+
+	// FlowLogDestinationTypeKinesisDataFirehose publishes the flow logs to a Kinesis Data Firehose delivery stream.
+	FlowLogDestinationTypeKinesisDataFirehose = FlowLogDestinationType("kinesis-data-firehose")
+)
+
+// FlowLogTrafficType is the type of traffic captured by the VPC flow logs.
+type FlowLogTrafficType string
+
+var (
+	// FlowLogTrafficTypeAccept captures the accepted traffic only.
+	FlowLogTrafficTypeAccept = FlowLogTrafficType("ACCEPT")
+
+	// FlowLogTrafficTypeReject captures the rejected traffic only.
+	FlowLogTrafficTypeReject = FlowLogTrafficType("REJECT")
+
+	// FlowLogTrafficTypeAll captures both the accepted and the rejected traffic.
+	FlowLogTrafficTypeAll = FlowLogTrafficType("ALL")
+)
+
+// VPCFlowLogsSpec configures the flow logs of a VPC.
+// +kubebuilder:validation:XValidation:rule="self.destinationType == 'cloud-watch-logs' || has(self.destinationARN)",message="destinationARN is required for s3 and kinesis-data-firehose destinations"
+// +kubebuilder:validation:XValidation:rule="self.destinationType != 'cloud-watch-logs' || has(self.deliverLogsPermissionARN) || (has(self.createDeliveryRole) && self.createDeliveryRole)",message="cloud-watch-logs destinations require deliverLogsPermissionARN or createDeliveryRole"
+// +kubebuilder:validation:XValidation:rule="!(has(self.deliverLogsPermissionARN) && has(self.createDeliveryRole) && self.createDeliveryRole)",message="deliverLogsPermissionARN and createDeliveryRole are mutually exclusive"
+type VPCFlowLogsSpec struct {
+	// DestinationType is the type of destination the flow logs are published to.
+	// +kubebuilder:validation:Enum=cloud-watch-logs;s3;kinesis-data-firehose
+	// +kubebuilder:default=cloud-watch-logs
+	// +optional
+	DestinationType FlowLogDestinationType `json:"destinationType,omitempty"`
+
+	// DestinationARN is the ARN of the log group, S3 bucket (optionally with a folder) or Kinesis Data Firehose
+	// delivery stream the flow logs are published to. Required for the s3 and kinesis-data-firehose destinations.
+	// For cloud-watch-logs, it defaults to a log group named /aws/vpc-flow-logs/<cluster-name>, created on first delivery.
+	// +optional
+	DestinationARN string `json:"destinationARN,omitempty"`
+
+	// TrafficType is the type of traffic captured.
+	// +kubebuilder:validation:Enum=ACCEPT;REJECT;ALL
+	// +kubebuilder:default=ALL
+	// +optional
+	TrafficType FlowLogTrafficType `json:"trafficType,omitempty"`
+
+	// LogFormat is the fields to include in the flow log records, in the order in which they should appear.
+	// Defaults to the AWS default format.
+	// For more information, see: https://docs.aws.amazon.com/vpc/latest/userguide/flow-log-records.html
+	// +optional
+	LogFormat string `json:"logFormat,omitempty"`
+
+	// MaxAggregationInterval is the maximum interval of time, in seconds, during which a flow of packets
+	// is captured and aggregated into a flow log record.
+	// +kubebuilder:validation:Enum=60;600
+	// +kubebuilder:default=600
+	// +optional
+	MaxAggregationInterval int32 `json:"maxAggregationInterval,omitempty"`
+
+	// DeliverLogsPermissionARN is the ARN of the IAM role that allows the flow logs service to publish to
+	// the destination. Required for cloud-watch-logs destinations unless CreateDeliveryRole is set,
+	// and for cross-account kinesis-data-firehose destinations.
+	// +optional
+	DeliverLogsPermissionARN string `json:"deliverLogsPermissionARN,omitempty"`
+
+	// CreateDeliveryRole makes the controller create and own the IAM role used by the flow logs service
+	// to publish to CloudWatch Logs. Only applies to cloud-watch-logs destinations.
+	// +optional
+	CreateDeliveryRole bool `json:"createDeliveryRole,omitempty"`
+}
+
+// VPCFlowLog describes the flow log of the VPC created by the controller.
+type VPCFlowLog struct {
+	// ID is the id of the flow log.
+	ID string `json:"id"`
+
+	// DeliveryRoleName is the name of the IAM role created by the controller to publish the flow logs, if any.
+	// +optional
+	DeliveryRoleName string `json:"deliveryRoleName,omitempty"`
+}
+
 // String returns a string representation of the VPC.
 func (v *VPCSpec) String() string {
 	return fmt.Sprintf("id=%s", v.ID)
@@ -984,6 +1380,10 @@ type SecurityGroup struct {
 	// +optional
 	IngressRules IngressRules `json:"ingressRule,omitempty"`
 
+	// EgressRules is the outbound rules associated with the security group.
+	// +optional
+	EgressRules EgressRules `json:"egressRules,omitempty"`
+
 	// Tags is a map of tags associated with the security group.
 	Tags Tags `json:"tags,omitempty"`
 }
@@ -1048,6 +1448,11 @@ type IngressRule struct {
 	// +optional
 	SourceSecurityGroupRoles []SecurityGroupRole `json:"sourceSecurityGroupRoles,omitempty"`
 
+	// The managed prefix list IDs to allow access from, including AWS-managed prefix lists.
+	// Can be specified with CidrBlocks, but not with SourceSecurityGroupIDs or SourceSecurityGroupRoles.
+	// +optional
+	SourcePrefixListIDs []string `json:"sourcePrefixListIds,omitempty"`
+
 	// NatGatewaysIPsSource use the NAT gateways IPs as the source for the ingress rule.
 	// +optional
 	NatGatewaysIPsSource bool `json:"natGatewaysIPsSource,omitempty"`
@@ -1124,6 +1529,19 @@ func (i *IngressRule) Equals(o *IngressRule) bool {
 		}
 	}
 
+	if len(i.SourcePrefixListIDs) != len(o.SourcePrefixListIDs) {
+		return false
+	}
+
+	sort.Strings(i.SourcePrefixListIDs)
+	sort.Strings(o.SourcePrefixListIDs)
+
+	for i, v := range i.SourcePrefixListIDs {
+		if v != o.SourcePrefixListIDs[i] {
+			return false
+		}
+	}
+
 	if i.Description != o.Description || i.Protocol != o.Protocol {
 		return false
 	}
@@ -1144,6 +1562,91 @@ func (i *IngressRule) Equals(o *IngressRule) bool {
 	return true
 }
 
+// EgressRule defines an AWS egress rule for security groups.
+type EgressRule struct {
+	// Description provides extended information about the egress rule.
+	Description string `json:"description"`
+	// Protocol is the protocol for the egress rule. Accepted values are "-1" (all), "4" (IP in IP),"tcp", "udp", "icmp", and "58" (ICMPv6), "50" (ESP).
+	// +kubebuilder:validation:Enum="-1";"4";tcp;udp;icmp;"58";"50"
+	Protocol SecurityGroupProtocol `json:"protocol"`
+	// FromPort is the start of port range.
+	FromPort int64 `json:"fromPort"`
+	// ToPort is the end of port range.
+	ToPort int64 `json:"toPort"`
+
+	// List of CIDR blocks to allow access to.
+	// +optional
+	CidrBlocks []string `json:"cidrBlocks,omitempty"`
+
+	// List of IPv6 CIDR blocks to allow access to.
+	// +optional
+	IPv6CidrBlocks []string `json:"ipv6CidrBlocks,omitempty"`
+
+	// The security group ids to allow access to.
+	// +optional
+	DestinationSecurityGroupIDs []string `json:"destinationSecurityGroupIds,omitempty"`
+
+	// The security group roles to allow access to.
+	// The field will be combined with destination security group IDs if specified.
+	// +optional
+	DestinationSecurityGroupRoles []SecurityGroupRole `json:"destinationSecurityGroupRoles,omitempty"`
+
+	// The managed prefix list IDs to allow access to, including AWS-managed prefix lists.
+	// Can be specified with CidrBlocks, but not with DestinationSecurityGroupIDs or DestinationSecurityGroupRoles.
+	// +optional
+	DestinationPrefixListIDs []string `json:"destinationPrefixListIds,omitempty"`
+}
+
+// String returns a string representation of the egress rule.
+func (e EgressRule) String() string {
+	return fmt.Sprintf("protocol=%s/range=[%d-%d]/description=%s", e.Protocol, e.FromPort, e.ToPort, e.Description)
+}
+
+// EgressRules is a slice of AWS egress rules for security groups.
+type EgressRules []EgressRule
+
+// Difference returns the difference between this slice and the other slice.
+func (e EgressRules) Difference(o EgressRules) (out EgressRules) {
+	for index := range e {
+		x := e[index]
+		found := false
+		for oIndex := range o {
+			y := o[oIndex]
+			if x.Equals(&y) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			out = append(out, x)
+		}
+	}
+
+	return
+}
+
+// Equals returns true if two EgressRule are equal.
+func (e *EgressRule) Equals(o *EgressRule) bool {
+	// Egress rules are matched on the same fields as ingress rules, the destination
+	// security groups taking the place of the source security groups.
+	x, y := e.toIngressRule(), o.toIngressRule()
+	return x.Equals(&y)
+}
+
+func (e *EgressRule) toIngressRule() IngressRule {
+	return IngressRule{
+		Description:            e.Description,
+		Protocol:               e.Protocol,
+		FromPort:               e.FromPort,
+		ToPort:                 e.ToPort,
+		CidrBlocks:             e.CidrBlocks,
+		IPv6CidrBlocks:         e.IPv6CidrBlocks,
+		SourceSecurityGroupIDs: e.DestinationSecurityGroupIDs,
+		SourcePrefixListIDs:    e.DestinationPrefixListIDs,
+	}
+}
+
 // ZoneType defines listener AWS Availability Zone type.
 type ZoneType string
 
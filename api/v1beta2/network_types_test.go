@@ -97,6 +97,125 @@ func TestSGDifference(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "prefix lists differ",
+			self: IngressRules{
+				{
+					Description:         "Office",
+					Protocol:            SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-1"},
+				},
+				{
+					Description:         "VPN",
+					Protocol:            SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-2"},
+				},
+			},
+			input: IngressRules{
+				{
+					Description:         "Office",
+					Protocol:            SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-1"},
+				},
+				{
+					Description:         "VPN",
+					Protocol:            SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-3"},
+				},
+			},
+			expected: IngressRules{
+				{
+					Description:         "VPN",
+					Protocol:            SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-2"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			out := tc.self.Difference(tc.input)
+
+			g.Expect(out).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestEgressRulesDifference(t *testing.T) {
+	allowAll := EgressRule{
+		Protocol:   SecurityGroupProtocolAll,
+		CidrBlocks: []string{"0.0.0.0/0"},
+	}
+	https := EgressRule{
+		Description: "HTTPS",
+		Protocol:    SecurityGroupProtocolTCP,
+		FromPort:    443,
+		ToPort:      443,
+		CidrBlocks:  []string{"10.0.0.0/16"},
+	}
+
+	tests := []struct {
+		name     string
+		self     EgressRules
+		input    EgressRules
+		expected EgressRules
+	}{
+		{
+			name:     "self and input are nil",
+			self:     nil,
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "default rule replaced",
+			self:     EgressRules{allowAll},
+			input:    EgressRules{https},
+			expected: EgressRules{allowAll},
+		},
+		{
+			name: "rules to destination security groups",
+			self: EgressRules{
+				{
+					Description:                 "etcd",
+					Protocol:                    SecurityGroupProtocolTCP,
+					FromPort:                    2379,
+					ToPort:                      2379,
+					DestinationSecurityGroupIDs: []string{"sg-1"},
+				},
+				https,
+			},
+			input: EgressRules{
+				https,
+				{
+					Description:                 "etcd",
+					Protocol:                    SecurityGroupProtocolTCP,
+					FromPort:                    2379,
+					ToPort:                      2379,
+					DestinationSecurityGroupIDs: []string{"sg-2"},
+				},
+			},
+			expected: EgressRules{
+				{
+					Description:                 "etcd",
+					Protocol:                    SecurityGroupProtocolTCP,
+					FromPort:                    2379,
+					ToPort:                      2379,
+					DestinationSecurityGroupIDs: []string{"sg-1"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -848,3 +967,44 @@ func TestSubnets_HasPublicSubnetWavelength(t *testing.T) {
 		})
 	}
 }
+
+func TestTransitGatewayAttachment_IsTransitional(t *testing.T) {
+	tests := []struct {
+		name       string
+		attachment *TransitGatewayAttachment
+		want       bool
+	}{
+		{
+			name:       "no attachment",
+			attachment: nil,
+			want:       false,
+		},
+		{
+			name:       "pending attachment",
+			attachment: &TransitGatewayAttachment{ID: "tgw-attach-1", State: "pending"},
+			want:       true,
+		},
+		{
+			name:       "modifying attachment",
+			attachment: &TransitGatewayAttachment{ID: "tgw-attach-1", State: "modifying"},
+			want:       true,
+		},
+		{
+			name:       "attachment pending acceptance",
+			attachment: &TransitGatewayAttachment{ID: "tgw-attach-1", State: "pendingAcceptance"},
+			want:       true,
+		},
+		{
+			name:       "available attachment",
+			attachment: &TransitGatewayAttachment{ID: "tgw-attach-1", State: "available"},
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.attachment.IsTransitional(); got != tt.want {
+				t.Errorf("TransitGatewayAttachment.IsTransitional() got unwanted value:\n %v", cmp.Diff(got, tt.want))
+			}
+		})
+	}
+}
@@ -66,6 +66,11 @@ func (b *S3Bucket) Validate() []*field.Error {
 		errs = append(errs, validateS3BucketName(b.Name)...)
 	}
 
+	if b.AccessLogging != nil && b.AccessLogging.TargetBucket == b.Name {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "s3Bucket", "accessLogging", "targetBucket"),
+			b.AccessLogging.TargetBucket, "must not be the bucket itself"))
+	}
+
 	return errs
 }
 
@@ -188,6 +188,10 @@ const (
 	// PrivateRoleTagValue describes the value for the private role.
 	PrivateRoleTagValue = "private"
 
+	// VPCEndpointRoleTagValue describes the value for the role of the security group
+	// attached to the interface VPC endpoints.
+	VPCEndpointRoleTagValue = "vpc-endpoint"
+
 	// MachineNameTagKey is the key for machine name.
 	MachineNameTagKey = "MachineName"
 
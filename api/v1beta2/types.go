@@ -86,6 +86,16 @@ const (
 	// ExternalResourceGCTasksAnnotation is the name of an annotation that indicates what
 	// external resources tasks should be executed by garbage collector for the cluster.
 	ExternalResourceGCTasksAnnotation = "aws.cluster.x-k8s.io/external-resource-tasks-gc"
+
+	// InstanceInterruptionEndAnnotation is the name of an annotation set on an AWSMachine whose
+	// instance is notified of an interruption. Its value is the RFC 3339 time the interruption is
+	// over, after which the InstanceInterrupted condition is cleared if the instance is running.
+	InstanceInterruptionEndAnnotation = "aws.cluster.x-k8s.io/instance-interruption-end"
+
+	// InstanceInterruptionAnnotation is the name of an annotation set on the Machine owning an AWSMachine
+	// whose instance is notified of an interruption. Its value is the reason of the interruption, and it is
+	// removed together with the InstanceInterrupted condition once the interruption is over.
+	InstanceInterruptionAnnotation = "aws.cluster.x-k8s.io/instance-interruption"
 )
 
 // GCTask defines a task to be executed by the garbage collector.
@@ -312,6 +322,10 @@ type Instance struct {
 	// When omitted, this means no opinion and the AWS platform is left to choose a reasonable default.
 	// +optional
 	CPUOptions CPUOptions `json:"cpuOptions,omitempty,omitzero"`
+
+	// HibernationEnabled is true if the instance is configured for hibernation.
+	// +optional
+	HibernationEnabled bool `json:"hibernationEnabled,omitempty"`
 }
 
 // CapacityReservationPreference describes the preferred use of capacity reservations
@@ -525,6 +539,23 @@ type Volume struct {
 	// The key must already exist and be accessible by the controller.
 	// +optional
 	EncryptionKey string `json:"encryptionKey,omitempty"`
+
+	// SnapshotID is the ID of the EBS snapshot the volume is created from.
+	// Size must be greater than or equal to the snapshot size.
+	// Not supported on root volumes, which are always created from the snapshot of the AMI.
+	// +kubebuilder:validation:Pattern=`^snap-[0-9a-f]+$`
+	// +optional
+	SnapshotID string `json:"snapshotId,omitempty"`
+
+	// VolumeInitializationRate is the rate, in MiB/s, at which the blocks of the snapshot are
+	// downloaded to the volume after its creation. Only applicable to volumes created from a snapshot,
+	// including root volumes created from the snapshot of the AMI.
+	// When omitted, the volume is initialized at the default rate, or by fast snapshot restore
+	// when it is enabled on the snapshot.
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	VolumeInitializationRate *int64 `json:"volumeInitializationRate,omitempty"`
 }
 
 // VolumeType describes the EBS volume type.
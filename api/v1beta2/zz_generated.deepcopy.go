@@ -285,6 +285,80 @@ func (in *AWSClusterRoleIdentitySpec) DeepCopy() *AWSClusterRoleIdentitySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterRolesAnywhereIdentity) DeepCopyInto(out *AWSClusterRolesAnywhereIdentity) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterRolesAnywhereIdentity.
+func (in *AWSClusterRolesAnywhereIdentity) DeepCopy() *AWSClusterRolesAnywhereIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterRolesAnywhereIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSClusterRolesAnywhereIdentity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterRolesAnywhereIdentityList) DeepCopyInto(out *AWSClusterRolesAnywhereIdentityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSClusterRolesAnywhereIdentity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterRolesAnywhereIdentityList.
+func (in *AWSClusterRolesAnywhereIdentityList) DeepCopy() *AWSClusterRolesAnywhereIdentityList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterRolesAnywhereIdentityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSClusterRolesAnywhereIdentityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterRolesAnywhereIdentitySpec) DeepCopyInto(out *AWSClusterRolesAnywhereIdentitySpec) {
+	*out = *in
+	in.AWSClusterIdentitySpec.DeepCopyInto(&out.AWSClusterIdentitySpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterRolesAnywhereIdentitySpec.
+func (in *AWSClusterRolesAnywhereIdentitySpec) DeepCopy() *AWSClusterRolesAnywhereIdentitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterRolesAnywhereIdentitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 	*out = *in
@@ -535,6 +609,81 @@ func (in *AWSClusterTemplateSpec) DeepCopy() *AWSClusterTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterWebIdentity) DeepCopyInto(out *AWSClusterWebIdentity) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterWebIdentity.
+func (in *AWSClusterWebIdentity) DeepCopy() *AWSClusterWebIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterWebIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSClusterWebIdentity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterWebIdentityList) DeepCopyInto(out *AWSClusterWebIdentityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSClusterWebIdentity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterWebIdentityList.
+func (in *AWSClusterWebIdentityList) DeepCopy() *AWSClusterWebIdentityList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterWebIdentityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSClusterWebIdentityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterWebIdentitySpec) DeepCopyInto(out *AWSClusterWebIdentitySpec) {
+	*out = *in
+	in.AWSClusterIdentitySpec.DeepCopyInto(&out.AWSClusterIdentitySpec)
+	in.AWSRoleSpec.DeepCopyInto(&out.AWSRoleSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterWebIdentitySpec.
+func (in *AWSClusterWebIdentitySpec) DeepCopy() *AWSClusterWebIdentitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterWebIdentitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSIdentityReference) DeepCopyInto(out *AWSIdentityReference) {
 	*out = *in
@@ -607,6 +756,21 @@ func (in *AWSLoadBalancerSpec) DeepCopyInto(out *AWSLoadBalancerSpec) {
 		*out = new(AWSLoadBalancerDNSResolutionCheck)
 		**out = **in
 	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(LoadBalancerDNSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = new(LoadBalancerLogsSpec)
+		**out = **in
+	}
+	if in.ConnectionLogs != nil {
+		in, out := &in.ConnectionLogs, &out.ConnectionLogs
+		*out = new(LoadBalancerLogsSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLoadBalancerSpec.
@@ -1231,6 +1395,21 @@ func (in *AWSRoleSpec) DeepCopy() *AWSRoleSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdditionalListenerSpec) DeepCopyInto(out *AdditionalListenerSpec) {
 	*out = *in
+	if in.CertificateARNs != nil {
+		in, out := &in.CertificateARNs, &out.CertificateARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSLPolicy != nil {
+		in, out := &in.SSLPolicy, &out.SSLPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ALPNPolicy != nil {
+		in, out := &in.ALPNPolicy, &out.ALPNPolicy
+		*out = new(ALPNPolicy)
+		**out = **in
+	}
 	if in.HealthCheck != nil {
 		in, out := &in.HealthCheck, &out.HealthCheck
 		*out = new(TargetGroupHealthCheckAdditionalSpec)
@@ -1474,6 +1653,21 @@ func (in *CloudInit) DeepCopy() *CloudInit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecord) DeepCopyInto(out *DNSRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecord.
+func (in *DNSRecord) DeepCopy() *DNSRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DedicatedHostInfo) DeepCopyInto(out *DedicatedHostInfo) {
 	*out = *in
@@ -1538,6 +1732,67 @@ func (in *DynamicHostAllocationSpec) DeepCopy() *DynamicHostAllocationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressRule) DeepCopyInto(out *EgressRule) {
+	*out = *in
+	if in.CidrBlocks != nil {
+		in, out := &in.CidrBlocks, &out.CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6CidrBlocks != nil {
+		in, out := &in.IPv6CidrBlocks, &out.IPv6CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationSecurityGroupIDs != nil {
+		in, out := &in.DestinationSecurityGroupIDs, &out.DestinationSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationSecurityGroupRoles != nil {
+		in, out := &in.DestinationSecurityGroupRoles, &out.DestinationSecurityGroupRoles
+		*out = make([]SecurityGroupRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationPrefixListIDs != nil {
+		in, out := &in.DestinationPrefixListIDs, &out.DestinationPrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRule.
+func (in *EgressRule) DeepCopy() *EgressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in EgressRules) DeepCopyInto(out *EgressRules) {
+	{
+		in := &in
+		*out = make(EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRules.
+func (in EgressRules) DeepCopy() EgressRules {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRules)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticIPPool) DeepCopyInto(out *ElasticIPPool) {
 	*out = *in
@@ -1741,6 +1996,11 @@ func (in *IngressRule) DeepCopyInto(out *IngressRule) {
 		*out = make([]SecurityGroupRole, len(*in))
 		copy(*out, *in)
 	}
+	if in.SourcePrefixListIDs != nil {
+		in, out := &in.SourcePrefixListIDs, &out.SourcePrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRule.
@@ -1924,10 +2184,45 @@ func (in *InstanceMetadataOptions) DeepCopy() *InstanceMetadataOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceVPCEndpointSpec) DeepCopyInto(out *InterfaceVPCEndpointSpec) {
+	*out = *in
+	if in.PrivateDNSEnabled != nil {
+		in, out := &in.PrivateDNSEnabled, &out.PrivateDNSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalSecurityGroupIDs != nil {
+		in, out := &in.AdditionalSecurityGroupIDs, &out.AdditionalSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceVPCEndpointSpec.
+func (in *InterfaceVPCEndpointSpec) DeepCopy() *InterfaceVPCEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceVPCEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Listener) DeepCopyInto(out *Listener) {
 	*out = *in
 	in.TargetGroup.DeepCopyInto(&out.TargetGroup)
+	if in.CertificateARNs != nil {
+		in, out := &in.CertificateARNs, &out.CertificateARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Listener.
@@ -2011,6 +2306,41 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerDNSSpec) DeepCopyInto(out *LoadBalancerDNSSpec) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerDNSSpec.
+func (in *LoadBalancerDNSSpec) DeepCopy() *LoadBalancerDNSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerDNSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerLogsSpec) DeepCopyInto(out *LoadBalancerLogsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerLogsSpec.
+func (in *LoadBalancerLogsSpec) DeepCopy() *LoadBalancerLogsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerLogsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
@@ -2048,11 +2378,50 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AdditionalControlPlaneEgressRules != nil {
+		in, out := &in.AdditionalControlPlaneEgressRules, &out.AdditionalControlPlaneEgressRules
+		*out = make([]EgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalNodeEgressRules != nil {
+		in, out := &in.AdditionalNodeEgressRules, &out.AdditionalNodeEgressRules
+		*out = make([]EgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityGroupEgressRules != nil {
+		in, out := &in.SecurityGroupEgressRules, &out.SecurityGroupEgressRules
+		*out = make(map[SecurityGroupRole]EgressRules, len(*in))
+		for key, val := range *in {
+			var outVal []EgressRule
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(EgressRules, len(*in))
+				for i := range *in {
+					(*in)[i].DeepCopyInto(&(*out)[i])
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.NodePortIngressRuleCidrBlocks != nil {
 		in, out := &in.NodePortIngressRuleCidrBlocks, &out.NodePortIngressRuleCidrBlocks
 		*out = make(CidrBlocks, len(*in))
 		copy(*out, *in)
 	}
+	if in.VPCPeerings != nil {
+		in, out := &in.VPCPeerings, &out.VPCPeerings
+		*out = make([]VPCPeeringSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
@@ -2082,6 +2451,35 @@ func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.VPCEndpoints != nil {
+		in, out := &in.VPCEndpoints, &out.VPCEndpoints
+		*out = make([]VPCEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransitGatewayAttachment != nil {
+		in, out := &in.TransitGatewayAttachment, &out.TransitGatewayAttachment
+		*out = new(TransitGatewayAttachment)
+		**out = **in
+	}
+	if in.VPCPeerings != nil {
+		in, out := &in.VPCPeerings, &out.VPCPeerings
+		*out = make([]VPCPeering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FlowLog != nil {
+		in, out := &in.FlowLog, &out.FlowLog
+		*out = new(VPCFlowLog)
+		**out = **in
+	}
+	if in.APIServerDNSRecord != nil {
+		in, out := &in.APIServerDNSRecord, &out.APIServerDNSRecord
+		*out = new(DNSRecord)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
@@ -2172,6 +2570,21 @@ func (in *S3Bucket) DeepCopyInto(out *S3Bucket) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BucketKeyEnabled != nil {
+		in, out := &in.BucketKeyEnabled, &out.BucketKeyEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockPublicAccess != nil {
+		in, out := &in.BlockPublicAccess, &out.BlockPublicAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AccessLogging != nil {
+		in, out := &in.AccessLogging, &out.AccessLogging
+		*out = new(S3BucketAccessLogging)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Bucket.
@@ -2184,6 +2597,21 @@ func (in *S3Bucket) DeepCopy() *S3Bucket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3BucketAccessLogging) DeepCopyInto(out *S3BucketAccessLogging) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BucketAccessLogging.
+func (in *S3BucketAccessLogging) DeepCopy() *S3BucketAccessLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(S3BucketAccessLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
@@ -2194,6 +2622,13 @@ func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EgressRules != nil {
+		in, out := &in.EgressRules, &out.EgressRules
+		*out = make(EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(Tags, len(*in))
@@ -2472,6 +2907,168 @@ func (in *TargetGroupSpec) DeepCopy() *TargetGroupSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayAttachment) DeepCopyInto(out *TransitGatewayAttachment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayAttachment.
+func (in *TransitGatewayAttachment) DeepCopy() *TransitGatewayAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewaySpec) DeepCopyInto(out *TransitGatewaySpec) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteCidrBlocks != nil {
+		in, out := &in.RouteCidrBlocks, &out.RouteCidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewaySpec.
+func (in *TransitGatewaySpec) DeepCopy() *TransitGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpoint.
+func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointsSpec) DeepCopyInto(out *VPCEndpointsSpec) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]InterfaceVPCEndpointSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointsSpec.
+func (in *VPCEndpointsSpec) DeepCopy() *VPCEndpointsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCFlowLog) DeepCopyInto(out *VPCFlowLog) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFlowLog.
+func (in *VPCFlowLog) DeepCopy() *VPCFlowLog {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCFlowLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCFlowLogsSpec) DeepCopyInto(out *VPCFlowLogsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFlowLogsSpec.
+func (in *VPCFlowLogsSpec) DeepCopy() *VPCFlowLogsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCFlowLogsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeering) DeepCopyInto(out *VPCPeering) {
+	*out = *in
+	if in.PeerRouteTableIDs != nil {
+		in, out := &in.PeerRouteTableIDs, &out.PeerRouteTableIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeering.
+func (in *VPCPeering) DeepCopy() *VPCPeering {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringSpec) DeepCopyInto(out *VPCPeeringSpec) {
+	*out = *in
+	if in.RouteCidrBlocks != nil {
+		in, out := &in.RouteCidrBlocks, &out.RouteCidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PeerRouteTableIDs != nil {
+		in, out := &in.PeerRouteTableIDs, &out.PeerRouteTableIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringSpec.
+func (in *VPCPeeringSpec) DeepCopy() *VPCPeeringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 	*out = *in
@@ -2527,6 +3124,21 @@ func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 		*out = new(ElasticIPPool)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = new(VPCEndpointsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(TransitGatewaySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(VPCFlowLogsSpec)
+		**out = **in
+	}
 	if in.SubnetSchema != nil {
 		in, out := &in.SubnetSchema, &out.SubnetSchema
 		*out = new(SubnetSchemaType)
@@ -2557,6 +3169,11 @@ func (in *Volume) DeepCopyInto(out *Volume) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.VolumeInitializationRate != nil {
+		in, out := &in.VolumeInitializationRate, &out.VolumeInitializationRate
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Volume.
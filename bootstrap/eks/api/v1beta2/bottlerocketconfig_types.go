@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+)
+
+const (
+	// BottlerocketConfigKind is the Kind for the BottlerocketConfig resource.
+	BottlerocketConfigKind = "BottlerocketConfig"
+)
+
+// BottlerocketConfigSpec defines the desired state of BottlerocketConfig.
+// The cluster name, API server endpoint and certificate authority are discovered
+// from the owning cluster and do not need to be set.
+type BottlerocketConfigSpec struct {
+	// Kubelet contains the kubelet settings rendered under settings.kubernetes.
+	// +optional
+	Kubelet *BottlerocketKubeletSettings `json:"kubelet,omitempty"`
+
+	// NodeLabels are labels the kubelet registers the node with.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+
+	// NodeTaints are taints the kubelet registers the node with.
+	// +optional
+	// +listType=atomic
+	NodeTaints []BottlerocketTaint `json:"nodeTaints,omitempty"`
+
+	// HostContainers configures Bottlerocket host containers, such as the
+	// built-in admin and control containers.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	HostContainers []BottlerocketHostContainer `json:"hostContainers,omitempty"`
+
+	// BootstrapContainers configures containers that run before the kubelet starts.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	BootstrapContainers []BottlerocketBootstrapContainer `json:"bootstrapContainers,omitempty"`
+
+	// RegistryMirrors configures containerd registry mirrors.
+	// +optional
+	// +listType=atomic
+	RegistryMirrors []BottlerocketRegistryMirror `json:"registryMirrors,omitempty"`
+
+	// Proxy configures the HTTPS proxy used by the host and the container runtime.
+	// +optional
+	Proxy *BottlerocketProxy `json:"proxy,omitempty"`
+
+	// AdditionalSettings is a raw TOML snippet appended verbatim to the rendered settings.
+	// It must not redefine any table that is already rendered from the other fields.
+	// +optional
+	AdditionalSettings string `json:"additionalSettings,omitempty"`
+}
+
+// BottlerocketKubeletSettings are the kubelet settings supported by the
+// Bottlerocket settings.kubernetes table.
+type BottlerocketKubeletSettings struct {
+	// MaxPods is the maximum number of pods that can run on the node.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxPods *int32 `json:"maxPods,omitempty"`
+
+	// ClusterDNSIP is the IP address of the cluster DNS service. Bottlerocket
+	// derives it from the cluster's service CIDR when unset.
+	// +optional
+	ClusterDNSIP string `json:"clusterDNSIP,omitempty"`
+
+	// EvictionHard is a map of signal names to quantities that define hard eviction thresholds.
+	// +optional
+	EvictionHard map[string]string `json:"evictionHard,omitempty"`
+
+	// KubeReserved is a map of resources reserved for Kubernetes system components.
+	// +optional
+	KubeReserved map[string]string `json:"kubeReserved,omitempty"`
+
+	// SystemReserved is a map of resources reserved for non-Kubernetes system components.
+	// +optional
+	SystemReserved map[string]string `json:"systemReserved,omitempty"`
+
+	// AllowedUnsafeSysctls is a list of unsafe sysctls pods are allowed to set.
+	// +optional
+	AllowedUnsafeSysctls []string `json:"allowedUnsafeSysctls,omitempty"`
+
+	// CPUManagerPolicy is the CPU manager policy.
+	// +optional
+	// +kubebuilder:validation:Enum=none;static
+	CPUManagerPolicy string `json:"cpuManagerPolicy,omitempty"`
+
+	// TopologyManagerPolicy is the topology manager policy.
+	// +optional
+	// +kubebuilder:validation:Enum=none;restricted;best-effort;single-numa-node
+	TopologyManagerPolicy string `json:"topologyManagerPolicy,omitempty"`
+
+	// ImageGCHighThresholdPercent is the disk usage percentage after which image garbage collection always runs.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ImageGCHighThresholdPercent *int32 `json:"imageGCHighThresholdPercent,omitempty"`
+
+	// ImageGCLowThresholdPercent is the disk usage percentage before which image garbage collection never runs.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ImageGCLowThresholdPercent *int32 `json:"imageGCLowThresholdPercent,omitempty"`
+
+	// ContainerLogMaxSize is the maximum size of a container log file before it is rotated, e.g. 10Mi.
+	// +optional
+	ContainerLogMaxSize string `json:"containerLogMaxSize,omitempty"`
+
+	// ContainerLogMaxFiles is the maximum number of container log files that can be present for a container.
+	// +optional
+	// +kubebuilder:validation:Minimum=2
+	ContainerLogMaxFiles *int32 `json:"containerLogMaxFiles,omitempty"`
+}
+
+// BottlerocketTaintEffect is the effect of a node taint.
+// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+type BottlerocketTaintEffect string
+
+const (
+	// BottlerocketTaintEffectNoSchedule prevents new pods that do not tolerate the taint from being scheduled.
+	BottlerocketTaintEffectNoSchedule BottlerocketTaintEffect = "NoSchedule"
+	// BottlerocketTaintEffectPreferNoSchedule avoids scheduling pods that do not tolerate the taint.
+	BottlerocketTaintEffectPreferNoSchedule BottlerocketTaintEffect = "PreferNoSchedule"
+	// BottlerocketTaintEffectNoExecute evicts running pods that do not tolerate the taint.
+	BottlerocketTaintEffectNoExecute BottlerocketTaintEffect = "NoExecute"
+)
+
+// BottlerocketTaint is a taint the node registers with.
+type BottlerocketTaint struct {
+	// Key is the taint key.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Value is the taint value.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Effect is the taint effect.
+	Effect BottlerocketTaintEffect `json:"effect"`
+}
+
+// BottlerocketHostContainer configures a Bottlerocket host container.
+type BottlerocketHostContainer struct {
+	// Name is the name of the host container, e.g. admin or control.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`
+	Name string `json:"name"`
+
+	// Source is the container image. Defaults to the image shipped with
+	// Bottlerocket for the built-in admin and control containers.
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// Enabled controls whether the host container runs.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Superpowered gives the host container additional privileges on the host.
+	// +optional
+	Superpowered *bool `json:"superpowered,omitempty"`
+
+	// UserData is passed to the host container. It is base64 encoded when rendered.
+	// +optional
+	UserData string `json:"userData,omitempty"`
+}
+
+// BottlerocketBootstrapContainerMode controls when a bootstrap container runs.
+// +kubebuilder:validation:Enum=always;once;off
+type BottlerocketBootstrapContainerMode string
+
+const (
+	// BottlerocketBootstrapContainerModeAlways runs the container on every boot.
+	BottlerocketBootstrapContainerModeAlways BottlerocketBootstrapContainerMode = "always"
+	// BottlerocketBootstrapContainerModeOnce runs the container on first boot only.
+	BottlerocketBootstrapContainerModeOnce BottlerocketBootstrapContainerMode = "once"
+	// BottlerocketBootstrapContainerModeOff disables the container.
+	BottlerocketBootstrapContainerModeOff BottlerocketBootstrapContainerMode = "off"
+)
+
+// BottlerocketBootstrapContainer configures a Bottlerocket bootstrap container.
+type BottlerocketBootstrapContainer struct {
+	// Name is the name of the bootstrap container.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`
+	Name string `json:"name"`
+
+	// Source is the container image.
+	// +kubebuilder:validation:MinLength=1
+	Source string `json:"source"`
+
+	// Mode controls when the bootstrap container runs. Defaults to always.
+	// +optional
+	Mode BottlerocketBootstrapContainerMode `json:"mode,omitempty"`
+
+	// Essential fails the boot if the bootstrap container exits with an error.
+	// +optional
+	Essential bool `json:"essential,omitempty"`
+
+	// UserData is passed to the bootstrap container. It is base64 encoded when rendered.
+	// +optional
+	UserData string `json:"userData,omitempty"`
+}
+
+// BottlerocketRegistryMirror configures mirrors for a container registry.
+type BottlerocketRegistryMirror struct {
+	// Registry is the registry host being mirrored, e.g. docker.io, or * for all registries.
+	// +kubebuilder:validation:MinLength=1
+	Registry string `json:"registry"`
+
+	// Endpoints are the mirror endpoints, tried in order.
+	// +kubebuilder:validation:MinItems=1
+	Endpoints []string `json:"endpoints"`
+}
+
+// BottlerocketProxy configures the HTTPS proxy.
+type BottlerocketProxy struct {
+	// HTTPSProxy is the URL of the proxy, e.g. http://proxy.example.com:3128.
+	// +kubebuilder:validation:MinLength=1
+	HTTPSProxy string `json:"httpsProxy"`
+
+	// NoProxy is a list of hosts, domains or CIDRs that bypass the proxy. The
+	// cluster API server and the Kubernetes service are always excluded by Bottlerocket.
+	// +optional
+	NoProxy []string `json:"noProxy,omitempty"`
+}
+
+// GetConditions returns the observations of the operational state of the BottlerocketConfig resource.
+func (r *BottlerocketConfig) GetConditions() clusterv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the BottlerocketConfig to the predescribed clusterv1.Conditions.
+func (r *BottlerocketConfig) SetConditions(conditions clusterv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// BottlerocketConfigStatus defines the observed state of BottlerocketConfig.
+type BottlerocketConfigStatus struct {
+	// Deprecated: This field will be removed with the CAPI v1beta2 transition
+	// Ready indicates the BootstrapData secret is ready to be consumed.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+	// Initialization provides observations of the BottlerocketConfig initialization process.
+	// NOTE: Fields in this struct are part of the Cluster API contract and are used to orchestrate initial Machine provisioning.
+	// +optional
+	Initialization BottlerocketConfigInitializationStatus `json:"initialization,omitempty"`
+
+	// DataSecretName is the name of the secret that stores the bootstrap settings.
+	// +optional
+	DataSecretName *string `json:"dataSecretName,omitempty"`
+
+	// FailureReason will be set on non-retryable errors.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// FailureMessage will be set on non-retryable errors.
+	// +optional
+	FailureMessage string `json:"failureMessage,omitempty"`
+
+	// ObservedGeneration is the latest generation observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions defines current service state of the BottlerocketConfig.
+	// +optional
+	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
+}
+
+// BottlerocketConfigInitializationStatus provides observations of the BottlerocketConfig initialization process.
+type BottlerocketConfigInitializationStatus struct {
+	// DataSecretCreated is true when the Machine's bootstrap secret is created.
+	// NOTE: This field is part of the Cluster API contract, and it is used to orchestrate initial Machine provisioning.
+	// +optional
+	DataSecretCreated *bool `json:"dataSecretCreated,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// BottlerocketConfig is the Schema for the bottlerocketconfigs API.
+type BottlerocketConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BottlerocketConfigSpec   `json:"spec,omitempty"`
+	Status BottlerocketConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BottlerocketConfigList contains a list of BottlerocketConfig.
+type BottlerocketConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BottlerocketConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BottlerocketConfig{}, &BottlerocketConfigList{})
+}
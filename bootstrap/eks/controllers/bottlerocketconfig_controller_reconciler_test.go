@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestBottlerocketConfigReconciler_CreateSecret(t *testing.T) {
+	g := NewWithT(t)
+
+	amcp := newAMCP("test-cluster")
+	endpoint := clusterv1.APIEndpoint{Host: "https://9.9.9.9", Port: 6443}
+	cluster := newCluster(amcp.Name)
+	cluster.Spec.ControlPlaneEndpoint = endpoint
+	newStatus := cluster.Status
+	amcpStatus := amcp.Status
+	g.Expect(testEnv.Client.Create(ctx, amcp)).To(Succeed())
+	g.Expect(testEnv.Client.Create(ctx, cluster)).To(Succeed())
+	cluster.Status = newStatus
+	g.Expect(testEnv.Client.Status().Update(ctx, cluster)).To(Succeed())
+	amcp.Status = amcpStatus
+	g.Expect(testEnv.Client.Status().Update(ctx, amcp)).To(Succeed())
+	kubeconfigSecret := newKubeconfigSecret("https://9.9.9.9:6443", cluster)
+	g.Expect(testEnv.Client.Create(ctx, kubeconfigSecret)).To(Succeed())
+
+	machine := newMachine(cluster, "test-bottlerocket-machine")
+	cfg := newBottlerocketConfig(machine)
+	cfg.Spec.NodeLabels = map[string]string{"team": "a"}
+	g.Expect(testEnv.Client.Create(ctx, cfg)).To(Succeed())
+
+	reconciler := BottlerocketConfigReconciler{Client: testEnv.Client}
+
+	g.Eventually(func(gomega Gomega) {
+		_, err := reconciler.joinWorker(ctx, cluster, cfg, configOwner("Machine"))
+		gomega.Expect(err).NotTo(HaveOccurred())
+	}, time.Second*15, time.Second*5).Should(Succeed())
+
+	secret := &corev1.Secret{}
+	g.Eventually(func(gomega Gomega) {
+		gomega.Expect(testEnv.Client.Get(ctx, client.ObjectKey{Name: cfg.Name, Namespace: "default"}, secret)).To(Succeed())
+	}, time.Minute, time.Second*5).Should(Succeed())
+
+	g.Expect(string(secret.Data["value"])).To(ContainSubstring("[settings.kubernetes]"))
+	g.Expect(string(secret.Data["value"])).To(ContainSubstring(`api-server = "https://9.9.9.9"`))
+	g.Expect(string(secret.Data["value"])).To(ContainSubstring(`"team" = "a"`))
+}
+
+func TestBottlerocketConfigReconciler_UpdateSecret_ForMachinePool(t *testing.T) {
+	g := NewWithT(t)
+
+	amcp := newAMCP("test-cluster")
+	endpoint := clusterv1.APIEndpoint{Host: "https://9.9.9.9", Port: 6443}
+	cluster := newCluster(amcp.Name)
+	cluster.Spec.ControlPlaneEndpoint = endpoint
+	newStatus := cluster.Status
+	amcpStatus := amcp.Status
+	g.Expect(testEnv.Client.Create(ctx, amcp)).To(Succeed())
+	g.Expect(testEnv.Client.Create(ctx, cluster)).To(Succeed())
+	cluster.Status = newStatus
+	g.Expect(testEnv.Client.Status().Update(ctx, cluster)).To(Succeed())
+	amcp.Status = amcpStatus
+	g.Expect(testEnv.Client.Status().Update(ctx, amcp)).To(Succeed())
+	kubeconfigSecret := newKubeconfigSecret("https://9.9.9.9:6443", cluster)
+	g.Expect(testEnv.Client.Create(ctx, kubeconfigSecret)).To(Succeed())
+
+	mp := newMachinePool(cluster, "test-bottlerocket-mp")
+	cfg := newBottlerocketConfig(nil)
+	cfg.ObjectMeta.Name = mp.Name
+	cfg.ObjectMeta.UID = types.UID(fmt.Sprintf("%s uid", mp.Name))
+	cfg.ObjectMeta.OwnerReferences = []metav1.OwnerReference{{
+		Kind:       "MachinePool",
+		APIVersion: clusterv1beta1.GroupVersion.String(),
+		Name:       mp.Name,
+		UID:        types.UID(fmt.Sprintf("%s uid", mp.Name)),
+	}}
+	cfg.Status.DataSecretName = &mp.Name
+	cfg.Spec.Kubelet = &eksbootstrapv1.BottlerocketKubeletSettings{MaxPods: ptr.To[int32](29)}
+
+	reconciler := BottlerocketConfigReconciler{Client: testEnv.Client}
+
+	// first reconcile creates secret
+	g.Eventually(func(gomega Gomega) {
+		_, err := reconciler.joinWorker(ctx, cluster, cfg, configOwner("MachinePool"))
+		gomega.Expect(err).NotTo(HaveOccurred())
+	}, time.Minute, time.Second*5).Should(Succeed())
+
+	secret := &corev1.Secret{}
+	g.Eventually(func(gomega Gomega) {
+		gomega.Expect(testEnv.Client.Get(ctx, client.ObjectKey{Name: cfg.Name, Namespace: "default"}, secret)).To(Succeed())
+	}, time.Minute, time.Second*5).Should(Succeed())
+	oldData := append([]byte(nil), secret.Data["value"]...)
+
+	// change max pods to force different userdata
+	cfg.Spec.Kubelet.MaxPods = ptr.To[int32](110)
+
+	g.Eventually(func(gomega Gomega) {
+		_, err := reconciler.joinWorker(ctx, cluster, cfg, configOwner("MachinePool"))
+		gomega.Expect(err).NotTo(HaveOccurred())
+	}, time.Minute, time.Second*5).Should(Succeed())
+
+	g.Eventually(func(gomega Gomega) {
+		gomega.Expect(testEnv.Client.Get(ctx, client.ObjectKey{Name: cfg.Name, Namespace: "default"}, secret)).To(Succeed())
+		gomega.Expect(secret.Data["value"]).NotTo(Equal(oldData))
+		gomega.Expect(string(secret.Data["value"])).To(ContainSubstring("max-pods = 110"))
+	}, time.Minute, time.Second*5).Should(Succeed())
+}
+
+func TestBottlerocketConfigReconcilerReturnEarlyIfClusterInfraNotReady(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := newCluster("cluster")
+	machine := newMachine(cluster, "machine")
+	config := newBottlerocketConfig(machine)
+
+	cluster.Status = clusterv1.ClusterStatus{
+		Initialization: clusterv1.ClusterInitializationStatus{
+			InfrastructureProvisioned: ptr.To(false),
+		},
+	}
+
+	reconciler := BottlerocketConfigReconciler{
+		Client: testEnv.Client,
+	}
+
+	_, err := reconciler.joinWorker(context.Background(), cluster, config, configOwner("Machine"))
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func newBottlerocketConfig(machine *clusterv1.Machine) *eksbootstrapv1.BottlerocketConfig {
+	config := &eksbootstrapv1.BottlerocketConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       eksbootstrapv1.BottlerocketConfigKind,
+			APIVersion: eksbootstrapv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+	}
+	if machine != nil {
+		config.ObjectMeta.Name = machine.Name
+		config.ObjectMeta.UID = types.UID(fmt.Sprintf("%s uid", machine.Name))
+		config.ObjectMeta.OwnerReferences = []metav1.OwnerReference{
+			{
+				Kind:       "Machine",
+				APIVersion: clusterv1.GroupVersion.String(),
+				Name:       machine.Name,
+				UID:        types.UID(fmt.Sprintf("%s uid", machine.Name)),
+			},
+		}
+		config.Status.DataSecretName = &machine.Name
+		machine.Spec.Bootstrap.ConfigRef.Name = config.Name
+	}
+	return config
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"text/template"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+)
+
+const (
+	// bottlerocketSettingsTemplate renders Bottlerocket TOML settings. Plain
+	// keys of a table have to be rendered before any of its sub-tables.
+	bottlerocketSettingsTemplate = `[settings.kubernetes]
+cluster-name = {{ quote .ClusterName }}
+api-server = {{ quote .APIServerEndpoint }}
+cluster-certificate = {{ quote .CACert }}
+{{- with .Kubelet }}
+{{- with .MaxPods }}
+max-pods = {{ . }}
+{{- end }}
+{{- if .ClusterDNSIP }}
+cluster-dns-ip = {{ quote .ClusterDNSIP }}
+{{- end }}
+{{- if .AllowedUnsafeSysctls }}
+allowed-unsafe-sysctls = {{ quoteList .AllowedUnsafeSysctls }}
+{{- end }}
+{{- if .CPUManagerPolicy }}
+cpu-manager-policy = {{ quote .CPUManagerPolicy }}
+{{- end }}
+{{- if .TopologyManagerPolicy }}
+topology-manager-policy = {{ quote .TopologyManagerPolicy }}
+{{- end }}
+{{- with .ImageGCHighThresholdPercent }}
+image-gc-high-threshold-percent = {{ . }}
+{{- end }}
+{{- with .ImageGCLowThresholdPercent }}
+image-gc-low-threshold-percent = {{ . }}
+{{- end }}
+{{- if .ContainerLogMaxSize }}
+container-log-max-size = {{ quote .ContainerLogMaxSize }}
+{{- end }}
+{{- with .ContainerLogMaxFiles }}
+container-log-max-files = {{ . }}
+{{- end }}
+{{- end }}
+{{- if .NodeLabels }}
+
+[settings.kubernetes.node-labels]
+{{- range $k, $v := .NodeLabels }}
+{{ quote $k }} = {{ quote $v }}
+{{- end }}
+{{- end }}
+{{- if .NodeTaints }}
+
+[settings.kubernetes.node-taints]
+{{- range $k, $v := taintsByKey .NodeTaints }}
+{{ quote $k }} = {{ quoteList $v }}
+{{- end }}
+{{- end }}
+{{- with .Kubelet }}
+{{- if .EvictionHard }}
+
+[settings.kubernetes.eviction-hard]
+{{- range $k, $v := .EvictionHard }}
+{{ quote $k }} = {{ quote $v }}
+{{- end }}
+{{- end }}
+{{- if .KubeReserved }}
+
+[settings.kubernetes.kube-reserved]
+{{- range $k, $v := .KubeReserved }}
+{{ quote $k }} = {{ quote $v }}
+{{- end }}
+{{- end }}
+{{- if .SystemReserved }}
+
+[settings.kubernetes.system-reserved]
+{{- range $k, $v := .SystemReserved }}
+{{ quote $k }} = {{ quote $v }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- range .HostContainers }}
+
+[settings.host-containers.{{ .Name }}]
+{{- if .Source }}
+source = {{ quote .Source }}
+{{- end }}
+{{- with .Enabled }}
+enabled = {{ . }}
+{{- end }}
+{{- with .Superpowered }}
+superpowered = {{ . }}
+{{- end }}
+{{- if .UserData }}
+user-data = {{ quote (base64 .UserData) }}
+{{- end }}
+{{- end }}
+{{- range .BootstrapContainers }}
+
+[settings.bootstrap-containers.{{ .Name }}]
+source = {{ quote .Source }}
+mode = {{ quote (or .Mode "always") }}
+essential = {{ .Essential }}
+{{- if .UserData }}
+user-data = {{ quote (base64 .UserData) }}
+{{- end }}
+{{- end }}
+{{- range .RegistryMirrors }}
+
+[[settings.container-registry.mirrors]]
+registry = {{ quote .Registry }}
+endpoint = {{ quoteList .Endpoints }}
+{{- end }}
+{{- with .Proxy }}
+
+[settings.network]
+https-proxy = {{ quote .HTTPSProxy }}
+{{- if .NoProxy }}
+no-proxy = {{ quoteList .NoProxy }}
+{{- end }}
+{{- end }}
+{{- if .AdditionalSettings }}
+
+{{ .AdditionalSettings }}
+{{- end }}
+`
+)
+
+var bottlerocketTemplateFuncMap = template.FuncMap{
+	"quote":       tomlQuote,
+	"quoteList":   tomlQuoteList,
+	"base64":      func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+	"taintsByKey": taintsByKey,
+}
+
+// BottlerocketInput contains all the information required to generate Bottlerocket settings for a node.
+type BottlerocketInput struct {
+	ClusterName       string
+	APIServerEndpoint string
+	CACert            string
+
+	Kubelet             *eksbootstrapv1.BottlerocketKubeletSettings
+	NodeLabels          map[string]string
+	NodeTaints          []eksbootstrapv1.BottlerocketTaint
+	HostContainers      []eksbootstrapv1.BottlerocketHostContainer
+	BootstrapContainers []eksbootstrapv1.BottlerocketBootstrapContainer
+	RegistryMirrors     []eksbootstrapv1.BottlerocketRegistryMirror
+	Proxy               *eksbootstrapv1.BottlerocketProxy
+	AdditionalSettings  string
+}
+
+// validateBottlerocketInput validates the input for Bottlerocket user data generation.
+func validateBottlerocketInput(input *BottlerocketInput) error {
+	if input.APIServerEndpoint == "" {
+		return fmt.Errorf("API server endpoint is required for bottlerocket")
+	}
+	if input.CACert == "" {
+		return fmt.Errorf("CA certificate is required for bottlerocket")
+	}
+	if input.ClusterName == "" {
+		return fmt.Errorf("cluster name is required for bottlerocket")
+	}
+	// Keys that are not preceded by a table header would silently end up in
+	// whichever table was rendered last.
+	if s := strings.TrimSpace(input.AdditionalSettings); s != "" && !strings.HasPrefix(s, "[") {
+		return fmt.Errorf("additional settings must start with a TOML table header")
+	}
+
+	return nil
+}
+
+// NewBottlerocketUserdata returns the TOML settings to be used as user data on a Bottlerocket node instance.
+func NewBottlerocketUserdata(input *BottlerocketInput) ([]byte, error) {
+	if err := validateBottlerocketInput(input); err != nil {
+		return nil, err
+	}
+
+	tm, err := template.New("Bottlerocket").Funcs(bottlerocketTemplateFuncMap).Parse(bottlerocketSettingsTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bottlerocket settings template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tm.Execute(&buf, input); err != nil {
+		return nil, fmt.Errorf("failed to execute bottlerocket settings template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// tomlQuote renders v as a TOML basic string.
+func tomlQuote(v any) string {
+	s := fmt.Sprint(v)
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04X`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// tomlQuoteList renders values as a TOML array of basic strings.
+func tomlQuoteList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, tomlQuote(v))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// taintsByKey groups taints by key into the value:effect lists Bottlerocket expects.
+func taintsByKey(taints []eksbootstrapv1.BottlerocketTaint) map[string][]string {
+	result := make(map[string][]string, len(taints))
+	for _, t := range taints {
+		result[t.Key] = append(result[t.Key], fmt.Sprintf("%s:%s", t.Value, t.Effect))
+	}
+	return result
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+	"k8s.io/utils/ptr"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+)
+
+func TestBottlerocketUserdata(t *testing.T) {
+	format.TruncatedDiff = false
+
+	tests := []struct {
+		name      string
+		input     *BottlerocketInput
+		expectErr bool
+		expected  string
+	}{
+		{
+			name: "basic bottlerocket settings",
+			input: &BottlerocketInput{
+				ClusterName:       "test-cluster",
+				APIServerEndpoint: "https://example.com",
+				CACert:            "dGVzdC1jYQ==",
+			},
+			expected: `[settings.kubernetes]
+cluster-name = "test-cluster"
+api-server = "https://example.com"
+cluster-certificate = "dGVzdC1jYQ=="
+`,
+		},
+		{
+			name: "all settings",
+			input: &BottlerocketInput{
+				ClusterName:       "test-cluster",
+				APIServerEndpoint: "https://example.com",
+				CACert:            "dGVzdC1jYQ==",
+				Kubelet: &eksbootstrapv1.BottlerocketKubeletSettings{
+					MaxPods:                     ptr.To[int32](58),
+					ClusterDNSIP:                "10.100.0.10",
+					EvictionHard:                map[string]string{"memory.available": "100Mi", "nodefs.available": "10%"},
+					KubeReserved:                map[string]string{"cpu": "100m"},
+					SystemReserved:              map[string]string{"memory": "256Mi"},
+					AllowedUnsafeSysctls:        []string{"net.core.somaxconn"},
+					CPUManagerPolicy:            "static",
+					TopologyManagerPolicy:       "single-numa-node",
+					ImageGCHighThresholdPercent: ptr.To[int32](85),
+					ImageGCLowThresholdPercent:  ptr.To[int32](80),
+					ContainerLogMaxSize:         "10Mi",
+					ContainerLogMaxFiles:        ptr.To[int32](5),
+				},
+				NodeLabels: map[string]string{"node.kubernetes.io/role": "worker", "team": "a"},
+				NodeTaints: []eksbootstrapv1.BottlerocketTaint{
+					{Key: "dedicated", Value: "gpu", Effect: eksbootstrapv1.BottlerocketTaintEffectNoSchedule},
+					{Key: "dedicated", Value: "gpu", Effect: eksbootstrapv1.BottlerocketTaintEffectNoExecute},
+				},
+				HostContainers: []eksbootstrapv1.BottlerocketHostContainer{
+					{Name: "admin", Enabled: ptr.To(true), Superpowered: ptr.To(true), UserData: `{"ssh":{}}`},
+					{Name: "control", Enabled: ptr.To(false)},
+				},
+				BootstrapContainers: []eksbootstrapv1.BottlerocketBootstrapContainer{
+					{Name: "setup", Source: "example.com/setup:v1", Essential: true, UserData: "hello"},
+					{Name: "once", Source: "example.com/once:v1", Mode: eksbootstrapv1.BottlerocketBootstrapContainerModeOnce},
+				},
+				RegistryMirrors: []eksbootstrapv1.BottlerocketRegistryMirror{
+					{Registry: "docker.io", Endpoints: []string{"https://mirror.example.com"}},
+				},
+				Proxy: &eksbootstrapv1.BottlerocketProxy{
+					HTTPSProxy: "http://proxy.example.com:3128",
+					NoProxy:    []string{"localhost", "169.254.169.254"},
+				},
+				AdditionalSettings: "[settings.kernel.sysctl]\n\"vm.max_map_count\" = \"262144\"",
+			},
+			expected: `[settings.kubernetes]
+cluster-name = "test-cluster"
+api-server = "https://example.com"
+cluster-certificate = "dGVzdC1jYQ=="
+max-pods = 58
+cluster-dns-ip = "10.100.0.10"
+allowed-unsafe-sysctls = ["net.core.somaxconn"]
+cpu-manager-policy = "static"
+topology-manager-policy = "single-numa-node"
+image-gc-high-threshold-percent = 85
+image-gc-low-threshold-percent = 80
+container-log-max-size = "10Mi"
+container-log-max-files = 5
+
+[settings.kubernetes.node-labels]
+"node.kubernetes.io/role" = "worker"
+"team" = "a"
+
+[settings.kubernetes.node-taints]
+"dedicated" = ["gpu:NoSchedule", "gpu:NoExecute"]
+
+[settings.kubernetes.eviction-hard]
+"memory.available" = "100Mi"
+"nodefs.available" = "10%"
+
+[settings.kubernetes.kube-reserved]
+"cpu" = "100m"
+
+[settings.kubernetes.system-reserved]
+"memory" = "256Mi"
+
+[settings.host-containers.admin]
+enabled = true
+superpowered = true
+user-data = "eyJzc2giOnt9fQ=="
+
+[settings.host-containers.control]
+enabled = false
+
+[settings.bootstrap-containers.setup]
+source = "example.com/setup:v1"
+mode = "always"
+essential = true
+user-data = "aGVsbG8="
+
+[settings.bootstrap-containers.once]
+source = "example.com/once:v1"
+mode = "once"
+essential = false
+
+[[settings.container-registry.mirrors]]
+registry = "docker.io"
+endpoint = ["https://mirror.example.com"]
+
+[settings.network]
+https-proxy = "http://proxy.example.com:3128"
+no-proxy = ["localhost", "169.254.169.254"]
+
+[settings.kernel.sysctl]
+"vm.max_map_count" = "262144"
+`,
+		},
+		{
+			name: "escapes strings",
+			input: &BottlerocketInput{
+				ClusterName:       "test-cluster",
+				APIServerEndpoint: "https://example.com",
+				CACert:            "dGVzdC1jYQ==",
+				NodeLabels:        map[string]string{"quote": "a\"b\\c\n"},
+			},
+			expected: `[settings.kubernetes]
+cluster-name = "test-cluster"
+api-server = "https://example.com"
+cluster-certificate = "dGVzdC1jYQ=="
+
+[settings.kubernetes.node-labels]
+"quote" = "a\"b\\c\n"
+`,
+		},
+		{
+			name: "missing cluster name",
+			input: &BottlerocketInput{
+				APIServerEndpoint: "https://example.com",
+				CACert:            "dGVzdC1jYQ==",
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing API server endpoint",
+			input: &BottlerocketInput{
+				ClusterName: "test-cluster",
+				CACert:      "dGVzdC1jYQ==",
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing CA certificate",
+			input: &BottlerocketInput{
+				ClusterName:       "test-cluster",
+				APIServerEndpoint: "https://example.com",
+			},
+			expectErr: true,
+		},
+		{
+			name: "additional settings without a table header",
+			input: &BottlerocketInput{
+				ClusterName:        "test-cluster",
+				APIServerEndpoint:  "https://example.com",
+				CACert:             "dGVzdC1jYQ==",
+				AdditionalSettings: `motd = "hello"`,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			out, err := NewBottlerocketUserdata(tc.input)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(out)).To(Equal(tc.expected))
+		})
+	}
+}
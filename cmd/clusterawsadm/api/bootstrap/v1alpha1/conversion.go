@@ -30,3 +30,8 @@ func Convert_v1beta1_AWSIAMConfigurationSpec_To_v1alpha1_AWSIAMConfigurationSpec
 func Convert_v1beta1_AWSIAMRoleSpec_To_v1alpha1_AWSIAMRoleSpec(in *v1beta1.AWSIAMRoleSpec, out *AWSIAMRoleSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta1_AWSIAMRoleSpec_To_v1alpha1_AWSIAMRoleSpec(in, out, s)
 }
+
+// Convert_v1beta1_EKSConfig_To_v1alpha1_EKSConfig is an autogenerated conversion function.
+func Convert_v1beta1_EKSConfig_To_v1alpha1_EKSConfig(in *v1beta1.EKSConfig, out *EKSConfig, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_EKSConfig_To_v1alpha1_EKSConfig(in, out, s)
+}
@@ -93,11 +93,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.EKSConfig)(nil), (*EKSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_EKSConfig_To_v1alpha1_EKSConfig(a.(*v1beta1.EKSConfig), b.(*EKSConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*EventBridgeConfig)(nil), (*v1beta1.EventBridgeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_EventBridgeConfig_To_v1beta1_EventBridgeConfig(a.(*EventBridgeConfig), b.(*v1beta1.EventBridgeConfig), scope)
 	}); err != nil {
@@ -128,6 +123,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta1.EKSConfig)(nil), (*EKSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EKSConfig_To_v1alpha1_EKSConfig(a.(*v1beta1.EKSConfig), b.(*EKSConfig), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -224,6 +224,7 @@ func autoConvert_v1beta1_AWSIAMConfigurationSpec_To_v1alpha1_AWSIAMConfiguration
 	out.SecureSecretsBackends = *(*[]v1beta2.SecretBackend)(unsafe.Pointer(&in.SecureSecretsBackends))
 	// WARNING: in.S3Buckets requires manual conversion: does not exist in peer-type
 	// WARNING: in.AllowAssumeRole requires manual conversion: does not exist in peer-type
+	// WARNING: in.OIDCProviders requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -400,14 +401,10 @@ func autoConvert_v1beta1_EKSConfig_To_v1alpha1_EKSConfig(in *v1beta1.EKSConfig,
 		out.Fargate = nil
 	}
 	out.KMSAliasPrefix = in.KMSAliasPrefix
+	// WARNING: in.AutoMode requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_EKSConfig_To_v1alpha1_EKSConfig is an autogenerated conversion function.
-func Convert_v1beta1_EKSConfig_To_v1alpha1_EKSConfig(in *v1beta1.EKSConfig, out *EKSConfig, s conversion.Scope) error {
-	return autoConvert_v1beta1_EKSConfig_To_v1alpha1_EKSConfig(in, out, s)
-}
-
 func autoConvert_v1alpha1_EventBridgeConfig_To_v1beta1_EventBridgeConfig(in *EventBridgeConfig, out *v1beta1.EventBridgeConfig, s conversion.Scope) error {
 	out.Enable = in.Enable
 	return nil
@@ -39,6 +39,8 @@ const (
 	DefaultKMSAliasPattern = "cluster-api-provider-aws-*"
 	// DefaultS3BucketPrefix is the default S3 bucket prefix.
 	DefaultS3BucketPrefix = "cluster-api-provider-aws-"
+	// DefaultOIDCClientID is the default audience of OpenID Connect identity providers.
+	DefaultOIDCClientID = "sts.amazonaws.com"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
@@ -106,6 +108,12 @@ func SetDefaults_AWSIAMConfigurationSpec(obj *AWSIAMConfigurationSpec) { //nolin
 	if obj.S3Buckets.NamePrefix == "" {
 		obj.S3Buckets.NamePrefix = DefaultS3BucketPrefix
 	}
+
+	for i := range obj.OIDCProviders {
+		if len(obj.OIDCProviders[i].ClientIDs) == 0 {
+			obj.OIDCProviders[i].ClientIDs = []string{DefaultOIDCClientID}
+		}
+	}
 }
 
 // SetDefaults_AWSIAMConfiguration is used by defaulter-gen.
@@ -123,6 +123,15 @@ type EKSConfig struct {
 	// name that is prefixed by this.
 	// Defaults to cluster-api-provider-aws-*
 	KMSAliasPrefix string `json:"kmsAliasPrefix,omitempty"`
+	// AutoMode controls the permissions of the default EKS control plane role required by EKS Auto Mode.
+	AutoMode *EKSAutoModeConfig `json:"autoMode,omitempty"`
+}
+
+// EKSAutoModeConfig represents the EKS Auto Mode related configuration.
+type EKSAutoModeConfig struct {
+	// Enable controls whether the managed policies and the trust relationship required by EKS Auto Mode
+	// are granted to the default EKS control plane role.
+	Enable bool `json:"enable,omitempty"`
 }
 
 // EventBridgeConfig represents configuration for enabling experimental feature to consume
@@ -176,6 +185,30 @@ type S3Buckets struct {
 	// NamePrefix will be prepended to every AWS IAM role bucket name. Defaults to "cluster-api-provider-aws-".
 	// AWSCluster S3 Bucket name must be prefixed with the same prefix.
 	NamePrefix string `json:"namePrefix"`
+
+	// KMSKeyARNs are the ARNs of the customer managed KMS keys the S3 buckets are encrypted with.
+	// The controllers are granted kms:GenerateDataKey and kms:Decrypt, the control plane and worker
+	// nodes kms:Decrypt on these keys.
+	// +optional
+	KMSKeyARNs []string `json:"kmsKeyARNs,omitempty"`
+}
+
+// OIDCProvider defines an AWS IAM OpenID Connect identity provider.
+type OIDCProvider struct {
+	// URL is the issuer URL of the identity provider, e.g. https://oidc.eks.us-west-2.amazonaws.com/id/EXAMPLE.
+	URL string `json:"url"`
+
+	// ClientIDs are the audiences accepted from the identity provider. Defaults to "sts.amazonaws.com".
+	// +optional
+	ClientIDs []string `json:"clientIDs,omitempty"`
+
+	// Thumbprints are the SHA-1 thumbprints of the identity provider's server certificates.
+	// +optional
+	Thumbprints []string `json:"thumbprints,omitempty"`
+
+	// Tags is a map of tags to apply to the identity provider.
+	// +optional
+	Tags infrav1.Tags `json:"tags,omitempty"`
 }
 
 // AWSIAMConfigurationSpec defines the specification of the AWSIAMConfiguration.
@@ -235,6 +268,12 @@ type AWSIAMConfigurationSpec struct {
 
 	// AllowAssumeRole enables the sts:AssumeRole permission within the CAPA policies
 	AllowAssumeRole bool `json:"allowAssumeRole,omitempty"`
+
+	// OIDCProviders are AWS IAM OpenID Connect identity providers to create, e.g. for the
+	// service account issuer of the management cluster, so that roles can trust its
+	// service accounts through trustStatements.
+	// +optional
+	OIDCProviders []OIDCProvider `json:"oidcProviders,omitempty"`
 }
 
 // GetObjectKind returns the AAWSIAMConfiguration's TypeMeta.
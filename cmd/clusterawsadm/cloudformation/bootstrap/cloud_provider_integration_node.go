@@ -17,18 +17,16 @@ limitations under the License.
 package bootstrap
 
 import (
-	"github.com/awslabs/goformation/v4/cloudformation"
-
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
 )
 
 func (t Template) cloudProviderNodeAwsRoles() []string {
 	roles := []string{}
 	if !t.Spec.ControlPlane.DisableCloudProviderPolicy {
-		roles = append(roles, cloudformation.Ref(AWSIAMRoleControlPlane))
+		roles = append(roles, AWSIAMRoleControlPlane)
 	}
 	if !t.Spec.Nodes.DisableCloudProviderPolicy {
-		roles = append(roles, cloudformation.Ref(AWSIAMRoleNodes))
+		roles = append(roles, AWSIAMRoleNodes)
 	}
 
 	return roles
@@ -19,9 +19,6 @@ package bootstrap
 import (
 	"fmt"
 
-	"github.com/awslabs/goformation/v4/cloudformation"
-	cfn_iam "github.com/awslabs/goformation/v4/cloudformation/iam"
-
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
 )
@@ -33,19 +30,19 @@ const (
 func (t Template) controllersPolicyGroups() []string {
 	groups := []string{}
 	if t.Spec.BootstrapUser.Enable {
-		groups = append(groups, cloudformation.Ref(AWSIAMGroupBootstrapper))
+		groups = append(groups, AWSIAMGroupBootstrapper)
 	}
 	return groups
 }
 
 func (t Template) controllersPolicyRoleAttachments() []string {
 	attachments := []string{
-		cloudformation.Ref(AWSIAMRoleControllers),
+		AWSIAMRoleControllers,
 	}
 	if !t.Spec.ControlPlane.DisableClusterAPIControllerPolicyAttachment {
 		attachments = append(
 			attachments,
-			cloudformation.Ref(AWSIAMRoleControlPlane),
+			AWSIAMRoleControlPlane,
 		)
 	}
 	return attachments
@@ -57,14 +54,14 @@ func (t Template) controllersTrustPolicy(eksEnabled bool) *iamv1.PolicyDocument
 	return policyDocument
 }
 
-func (t Template) controllersRolePolicy() []cfn_iam.Role_Policy {
-	policies := []cfn_iam.Role_Policy{}
+func (t Template) controllersRolePolicy() []InlinePolicy {
+	policies := []InlinePolicy{}
 
 	if t.Spec.ClusterAPIControllers.ExtraStatements != nil {
 		policies = append(policies,
-			cfn_iam.Role_Policy{
-				PolicyName: t.Spec.StackName,
-				PolicyDocument: iamv1.PolicyDocument{
+			InlinePolicy{
+				Name: t.Spec.StackName,
+				Document: iamv1.PolicyDocument{
 					Statement: t.Spec.ClusterAPIControllers.ExtraStatements,
 					Version:   iamv1.CurrentVersion,
 				},
@@ -96,6 +93,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:CreateCarrierGateway",
 				"ec2:CreateInternetGateway",
 				"ec2:CreateEgressOnlyInternetGateway",
+				"ec2:CreateFlowLogs",
 				"ec2:CreateNatGateway",
 				"ec2:CreateNetworkInterface",
 				"ec2:CreateRoute",
@@ -105,13 +103,19 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:CreateTags",
 				"ec2:CreateVpc",
 				"ec2:CreateVpcEndpoint",
+				"ec2:CreateVpcPeeringConnection",
+				"ec2:AcceptVpcPeeringConnection",
+				"ec2:CreateTransitGatewayVpcAttachment",
 				"ec2:DisassociateVpcCidrBlock",
 				"ec2:ModifyVpcAttribute",
 				"ec2:ModifyVpcEndpoint",
+				"ec2:ModifyTransitGatewayVpcAttachment",
 				"ec2:DeleteCarrierGateway",
 				"ec2:DeleteInternetGateway",
 				"ec2:DeleteEgressOnlyInternetGateway",
+				"ec2:DeleteFlowLogs",
 				"ec2:DeleteNatGateway",
+				"ec2:DeleteRoute",
 				"ec2:DeleteRouteTable",
 				"ec2:ReplaceRoute",
 				"ec2:DeleteSecurityGroup",
@@ -119,6 +123,8 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DeleteTags",
 				"ec2:DeleteVpc",
 				"ec2:DeleteVpcEndpoints",
+				"ec2:DeleteVpcPeeringConnection",
+				"ec2:DeleteTransitGatewayVpcAttachment",
 				"ec2:DescribeAccountAttributes",
 				"ec2:DescribeAddresses",
 				"ec2:DescribeAvailabilityZones",
@@ -127,6 +133,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DescribeInstanceTypes",
 				"ec2:DescribeInternetGateways",
 				"ec2:DescribeEgressOnlyInternetGateways",
+				"ec2:DescribeFlowLogs",
 				"ec2:DescribeInstanceTypes",
 				"ec2:DescribeImages",
 				"ec2:DescribeNatGateways",
@@ -139,7 +146,10 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DescribeDhcpOptions",
 				"ec2:DescribeVpcAttribute",
 				"ec2:DescribeVpcEndpoints",
+				"ec2:DescribeVpcPeeringConnections",
+				"ec2:DescribeTransitGatewayVpcAttachments",
 				"ec2:DescribeVolumes",
+				"ec2:DescribeSnapshots",
 				"ec2:DescribeTags",
 				"ec2:DetachInternetGateway",
 				"ec2:DisassociateRouteTable",
@@ -152,7 +162,11 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:RevokeSecurityGroupIngress",
 				"ec2:RunInstances",
 				"ec2:TerminateInstances",
+				"ec2:StopInstances",
+				"ec2:StartInstances",
 				"ec2:GetSecurityGroupsForVpc",
+				"logs:CreateLogDelivery",
+				"logs:DeleteLogDelivery",
 				"tag:GetResources",
 				"elasticloadbalancing:AddTags",
 				"elasticloadbalancing:CreateLoadBalancer",
@@ -178,11 +192,18 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"elasticloadbalancing:RegisterTargets",
 				"elasticloadbalancing:DeregisterTargets",
 				"elasticloadbalancing:DeleteListener",
+				"elasticloadbalancing:ModifyListener",
+				"elasticloadbalancing:DescribeListenerCertificates",
+				"elasticloadbalancing:AddListenerCertificates",
+				"elasticloadbalancing:RemoveListenerCertificates",
 				"autoscaling:DescribeAutoScalingGroups",
 				"autoscaling:DescribeInstanceRefreshes",
 				"autoscaling:DeleteLifecycleHook",
 				"autoscaling:DescribeLifecycleHooks",
 				"autoscaling:PutLifecycleHook",
+				"autoscaling:DescribeWarmPool",
+				"autoscaling:DescribeScheduledActions",
+				"autoscaling:DescribePolicies",
 				"ec2:CreateLaunchTemplate",
 				"ec2:CreateLaunchTemplateVersion",
 				"ec2:DescribeLaunchTemplates",
@@ -214,6 +235,13 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"autoscaling:StartInstanceRefresh",
 				"autoscaling:DeleteAutoScalingGroup",
 				"autoscaling:DeleteTags",
+				"autoscaling:PutWarmPool",
+				"autoscaling:DeleteWarmPool",
+				"autoscaling:PutScheduledUpdateGroupAction",
+				"autoscaling:DeleteScheduledAction",
+				"autoscaling:PutScalingPolicy",
+				"autoscaling:DeletePolicy",
+				"autoscaling:DetachInstances",
 			},
 		},
 		{
@@ -259,6 +287,42 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"iam:PassRole",
 			},
 		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:iam::*:role/vpc-*-flow-logs",
+			},
+			Action: iamv1.Actions{
+				"iam:CreateRole",
+				"iam:DeleteRole",
+				"iam:DeleteRolePolicy",
+				"iam:GetRole",
+				"iam:PutRolePolicy",
+				"iam:TagRole",
+			},
+		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"*",
+			},
+			Action: iamv1.Actions{
+				"iam:PassRole",
+			},
+			Condition: iamv1.Conditions{
+				iamv1.StringEquals: map[string]string{"iam:PassedToService": "vpc-flow-logs.amazonaws.com"},
+			},
+		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:route53:::hostedzone/*",
+			},
+			Action: iamv1.Actions{
+				"route53:ChangeResourceRecordSets",
+				"route53:ListResourceRecordSets",
+			},
+		},
 	}
 	for _, secureSecretBackend := range t.Spec.SecureSecretsBackends {
 		switch secureSecretBackend {
@@ -307,14 +371,31 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"s3:CreateBucket",
 				"s3:DeleteBucket",
 				"s3:DeleteObject",
+				"s3:GetBucketLogging",
+				"s3:GetBucketPolicy",
+				"s3:GetBucketPublicAccessBlock",
+				"s3:GetEncryptionConfiguration",
 				"s3:GetObject",
 				"s3:ListBucket",
+				"s3:PutBucketLogging",
 				"s3:PutBucketPolicy",
+				"s3:PutBucketPublicAccessBlock",
 				"s3:PutBucketTagging",
+				"s3:PutEncryptionConfiguration",
 				"s3:PutLifecycleConfiguration",
 				"s3:PutObject",
 			},
 		})
+		if len(t.Spec.S3Buckets.KMSKeyARNs) > 0 {
+			statement = append(statement, iamv1.StatementEntry{
+				Effect:   iamv1.EffectAllow,
+				Resource: iamv1.Resources(t.Spec.S3Buckets.KMSKeyARNs),
+				Action: iamv1.Actions{
+					"kms:Decrypt",
+					"kms:GenerateDataKey",
+				},
+			})
+		}
 	}
 	if t.Spec.EventBridge.Enable {
 		statement = append(statement, iamv1.StatementEntry{
@@ -407,6 +488,7 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 			"iam:CreateRole",
 			"iam:TagRole",
 			"iam:AttachRolePolicy",
+			"iam:UpdateAssumeRolePolicy",
 		}...)
 
 		statements = append(statements, iamv1.StatementEntry{
@@ -484,6 +566,11 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"eks:DescribeFargateProfile",
 				"eks:CreateFargateProfile",
 				"eks:DeleteFargateProfile",
+				"eks:ListPodIdentityAssociations",
+				"eks:DescribePodIdentityAssociation",
+				"eks:CreatePodIdentityAssociation",
+				"eks:UpdatePodIdentityAssociation",
+				"eks:DeletePodIdentityAssociation",
 			},
 			Resource: iamv1.Resources{
 				"*",
@@ -504,6 +591,20 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 			},
 			Effect: iamv1.EffectAllow,
 		},
+		{
+			Action: iamv1.Actions{
+				"iam:PassRole",
+			},
+			Resource: iamv1.Resources{
+				"*",
+			},
+			Condition: iamv1.Conditions{
+				"StringEquals": map[string]string{
+					"iam:PassedToService": "pods.eks.amazonaws.com",
+				},
+			},
+			Effect: iamv1.EffectAllow,
+		},
 		{
 			Action: iamv1.Actions{
 				"kms:CreateGrant",
@@ -93,6 +93,15 @@ func (t Template) nodePolicy() *iamv1.PolicyDocument {
 		policyDocument.Statement,
 		t.sessionManagerPolicy(),
 	)
+	if t.Spec.S3Buckets.Enable && len(t.Spec.S3Buckets.KMSKeyARNs) > 0 {
+		policyDocument.Statement = append(policyDocument.Statement, iamv1.StatementEntry{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources(t.Spec.S3Buckets.KMSKeyARNs),
+			Action: iamv1.Actions{
+				"kms:Decrypt",
+			},
+		})
+	}
 
 	return policyDocument
 }
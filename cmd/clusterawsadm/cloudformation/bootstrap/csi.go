@@ -17,15 +17,13 @@ limitations under the License.
 package bootstrap
 
 import (
-	"github.com/awslabs/goformation/v4/cloudformation"
-
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
 )
 
 func (t Template) csiControlPlaneAwsRoles() []string {
 	roles := []string{}
 	if !t.Spec.ControlPlane.DisableCloudProviderPolicy && t.Spec.ControlPlane.EnableCSIPolicy {
-		roles = append(roles, cloudformation.Ref(AWSIAMRoleControlPlane))
+		roles = append(roles, AWSIAMRoleControlPlane)
 	}
 	return roles
 }
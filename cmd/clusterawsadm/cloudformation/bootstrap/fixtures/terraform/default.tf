@@ -0,0 +1,734 @@
+resource "aws_iam_policy" "managed_policy_controllers" {
+  name        = "controllers.cluster-api-provider-aws.sigs.k8s.io"
+  description = "For the Kubernetes Cluster API Provider AWS Controllers"
+  policy      = jsonencode({
+    "Version": "2012-10-17",
+    "Statement": [
+      {
+        "Effect": "Allow",
+        "Action": [
+          "ec2:DescribeIpamPools",
+          "ec2:AllocateIpamPoolCidr",
+          "ec2:AttachNetworkInterface",
+          "ec2:DetachNetworkInterface",
+          "ec2:AllocateAddress",
+          "ec2:AssignIpv6Addresses",
+          "ec2:AssignPrivateIpAddresses",
+          "ec2:UnassignPrivateIpAddresses",
+          "ec2:AssociateRouteTable",
+          "ec2:AssociateVpcCidrBlock",
+          "ec2:AttachInternetGateway",
+          "ec2:AuthorizeSecurityGroupIngress",
+          "ec2:CreateCarrierGateway",
+          "ec2:CreateInternetGateway",
+          "ec2:CreateEgressOnlyInternetGateway",
+          "ec2:CreateFlowLogs",
+          "ec2:CreateNatGateway",
+          "ec2:CreateNetworkInterface",
+          "ec2:CreateRoute",
+          "ec2:CreateRouteTable",
+          "ec2:CreateSecurityGroup",
+          "ec2:CreateSubnet",
+          "ec2:CreateTags",
+          "ec2:CreateVpc",
+          "ec2:CreateVpcEndpoint",
+          "ec2:CreateVpcPeeringConnection",
+          "ec2:AcceptVpcPeeringConnection",
+          "ec2:CreateTransitGatewayVpcAttachment",
+          "ec2:DisassociateVpcCidrBlock",
+          "ec2:ModifyVpcAttribute",
+          "ec2:ModifyVpcEndpoint",
+          "ec2:ModifyTransitGatewayVpcAttachment",
+          "ec2:DeleteCarrierGateway",
+          "ec2:DeleteInternetGateway",
+          "ec2:DeleteEgressOnlyInternetGateway",
+          "ec2:DeleteFlowLogs",
+          "ec2:DeleteNatGateway",
+          "ec2:DeleteRoute",
+          "ec2:DeleteRouteTable",
+          "ec2:ReplaceRoute",
+          "ec2:DeleteSecurityGroup",
+          "ec2:DeleteSubnet",
+          "ec2:DeleteTags",
+          "ec2:DeleteVpc",
+          "ec2:DeleteVpcEndpoints",
+          "ec2:DeleteVpcPeeringConnection",
+          "ec2:DeleteTransitGatewayVpcAttachment",
+          "ec2:DescribeAccountAttributes",
+          "ec2:DescribeAddresses",
+          "ec2:DescribeAvailabilityZones",
+          "ec2:DescribeCarrierGateways",
+          "ec2:DescribeInstances",
+          "ec2:DescribeInstanceTypes",
+          "ec2:DescribeInternetGateways",
+          "ec2:DescribeEgressOnlyInternetGateways",
+          "ec2:DescribeFlowLogs",
+          "ec2:DescribeInstanceTypes",
+          "ec2:DescribeImages",
+          "ec2:DescribeNatGateways",
+          "ec2:DescribeNetworkInterfaces",
+          "ec2:DescribeNetworkInterfaceAttribute",
+          "ec2:DescribeRouteTables",
+          "ec2:DescribeSecurityGroups",
+          "ec2:DescribeSubnets",
+          "ec2:DescribeVpcs",
+          "ec2:DescribeDhcpOptions",
+          "ec2:DescribeVpcAttribute",
+          "ec2:DescribeVpcEndpoints",
+          "ec2:DescribeVpcPeeringConnections",
+          "ec2:DescribeTransitGatewayVpcAttachments",
+          "ec2:DescribeVolumes",
+          "ec2:DescribeSnapshots",
+          "ec2:DescribeTags",
+          "ec2:DetachInternetGateway",
+          "ec2:DisassociateRouteTable",
+          "ec2:DisassociateAddress",
+          "ec2:ModifyInstanceAttribute",
+          "ec2:ModifyNetworkInterfaceAttribute",
+          "ec2:ModifySubnetAttribute",
+          "ec2:ReleaseAddress",
+          "ec2:RevokeSecurityGroupEgress",
+          "ec2:RevokeSecurityGroupIngress",
+          "ec2:RunInstances",
+          "ec2:TerminateInstances",
+          "ec2:StopInstances",
+          "ec2:StartInstances",
+          "ec2:GetSecurityGroupsForVpc",
+          "logs:CreateLogDelivery",
+          "logs:DeleteLogDelivery",
+          "tag:GetResources",
+          "elasticloadbalancing:AddTags",
+          "elasticloadbalancing:CreateLoadBalancer",
+          "elasticloadbalancing:ConfigureHealthCheck",
+          "elasticloadbalancing:DeleteLoadBalancer",
+          "elasticloadbalancing:DeleteTargetGroup",
+          "elasticloadbalancing:DescribeLoadBalancers",
+          "elasticloadbalancing:DescribeLoadBalancerAttributes",
+          "elasticloadbalancing:DescribeTargetGroups",
+          "elasticloadbalancing:ApplySecurityGroupsToLoadBalancer",
+          "elasticloadbalancing:SetSecurityGroups",
+          "elasticloadbalancing:DescribeTags",
+          "elasticloadbalancing:ModifyLoadBalancerAttributes",
+          "elasticloadbalancing:RegisterInstancesWithLoadBalancer",
+          "elasticloadbalancing:DeregisterInstancesFromLoadBalancer",
+          "elasticloadbalancing:RemoveTags",
+          "elasticloadbalancing:SetSubnets",
+          "elasticloadbalancing:ModifyTargetGroupAttributes",
+          "elasticloadbalancing:CreateTargetGroup",
+          "elasticloadbalancing:DescribeListeners",
+          "elasticloadbalancing:CreateListener",
+          "elasticloadbalancing:DescribeTargetHealth",
+          "elasticloadbalancing:RegisterTargets",
+          "elasticloadbalancing:DeregisterTargets",
+          "elasticloadbalancing:DeleteListener",
+          "elasticloadbalancing:ModifyListener",
+          "elasticloadbalancing:DescribeListenerCertificates",
+          "elasticloadbalancing:AddListenerCertificates",
+          "elasticloadbalancing:RemoveListenerCertificates",
+          "autoscaling:DescribeAutoScalingGroups",
+          "autoscaling:DescribeInstanceRefreshes",
+          "autoscaling:DeleteLifecycleHook",
+          "autoscaling:DescribeLifecycleHooks",
+          "autoscaling:PutLifecycleHook",
+          "autoscaling:DescribeWarmPool",
+          "autoscaling:DescribeScheduledActions",
+          "autoscaling:DescribePolicies",
+          "ec2:CreateLaunchTemplate",
+          "ec2:CreateLaunchTemplateVersion",
+          "ec2:DescribeLaunchTemplates",
+          "ec2:DescribeLaunchTemplateVersions",
+          "ec2:DeleteLaunchTemplate",
+          "ec2:DeleteLaunchTemplateVersions",
+          "ec2:DescribeKeyPairs",
+          "ec2:ModifyInstanceMetadataOptions",
+          "eks:CreateAccessEntry",
+          "eks:DeleteAccessEntry",
+          "eks:DescribeAccessEntry",
+          "eks:UpdateAccessEntry",
+          "eks:ListAccessEntries",
+          "eks:AssociateAccessPolicy",
+          "eks:DisassociateAccessPolicy",
+          "eks:ListAssociatedAccessPolicies"
+        ],
+        "Resource": [
+          "*"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "autoscaling:CancelInstanceRefresh",
+          "autoscaling:CreateAutoScalingGroup",
+          "autoscaling:UpdateAutoScalingGroup",
+          "autoscaling:CreateOrUpdateTags",
+          "autoscaling:StartInstanceRefresh",
+          "autoscaling:DeleteAutoScalingGroup",
+          "autoscaling:DeleteTags",
+          "autoscaling:PutWarmPool",
+          "autoscaling:DeleteWarmPool",
+          "autoscaling:PutScheduledUpdateGroupAction",
+          "autoscaling:DeleteScheduledAction",
+          "autoscaling:PutScalingPolicy",
+          "autoscaling:DeletePolicy",
+          "autoscaling:DetachInstances"
+        ],
+        "Resource": [
+          "arn:*:autoscaling:*:*:autoScalingGroup:*:autoScalingGroupName/*"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:CreateServiceLinkedRole"
+        ],
+        "Resource": [
+          "arn:*:iam::*:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling"
+        ],
+        "Condition": {
+          "StringLike": {
+            "iam:AWSServiceName": "autoscaling.amazonaws.com"
+          }
+        }
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:CreateServiceLinkedRole"
+        ],
+        "Resource": [
+          "arn:*:iam::*:role/aws-service-role/elasticloadbalancing.amazonaws.com/AWSServiceRoleForElasticLoadBalancing"
+        ],
+        "Condition": {
+          "StringLike": {
+            "iam:AWSServiceName": "elasticloadbalancing.amazonaws.com"
+          }
+        }
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:CreateServiceLinkedRole"
+        ],
+        "Resource": [
+          "arn:*:iam::*:role/aws-service-role/spot.amazonaws.com/AWSServiceRoleForEC2Spot"
+        ],
+        "Condition": {
+          "StringLike": {
+            "iam:AWSServiceName": "spot.amazonaws.com"
+          }
+        }
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:PassRole"
+        ],
+        "Resource": [
+          "arn:*:iam::*:role/*.cluster-api-provider-aws.sigs.k8s.io"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:CreateRole",
+          "iam:DeleteRole",
+          "iam:DeleteRolePolicy",
+          "iam:GetRole",
+          "iam:PutRolePolicy",
+          "iam:TagRole"
+        ],
+        "Resource": [
+          "arn:*:iam::*:role/vpc-*-flow-logs"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:PassRole"
+        ],
+        "Resource": [
+          "*"
+        ],
+        "Condition": {
+          "StringEquals": {
+            "iam:PassedToService": "vpc-flow-logs.amazonaws.com"
+          }
+        }
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "route53:ChangeResourceRecordSets",
+          "route53:ListResourceRecordSets"
+        ],
+        "Resource": [
+          "arn:*:route53:::hostedzone/*"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "secretsmanager:CreateSecret",
+          "secretsmanager:DeleteSecret",
+          "secretsmanager:TagResource"
+        ],
+        "Resource": [
+          "arn:*:secretsmanager:*:*:secret:aws.cluster.x-k8s.io/*"
+        ]
+      }
+    ]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "role_controllers_managed_policy_controllers" {
+  role       = aws_iam_role.role_controllers.name
+  policy_arn = aws_iam_policy.managed_policy_controllers.arn
+}
+
+resource "aws_iam_role_policy_attachment" "role_control_plane_managed_policy_controllers" {
+  role       = aws_iam_role.role_control_plane.name
+  policy_arn = aws_iam_policy.managed_policy_controllers.arn
+}
+
+resource "aws_iam_policy" "managed_policy_controllers_eks" {
+  name        = "controllers-eks.cluster-api-provider-aws.sigs.k8s.io"
+  description = "For the Kubernetes Cluster API Provider AWS Controllers"
+  policy      = jsonencode({
+    "Version": "2012-10-17",
+    "Statement": [
+      {
+        "Effect": "Allow",
+        "Action": [
+          "ssm:GetParameter"
+        ],
+        "Resource": [
+          "arn:*:ssm:*:*:parameter/aws/service/eks/optimized-ami/*"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:CreateServiceLinkedRole"
+        ],
+        "Resource": [
+          "arn:*:iam::*:role/aws-service-role/eks.amazonaws.com/AWSServiceRoleForAmazonEKS"
+        ],
+        "Condition": {
+          "StringLike": {
+            "iam:AWSServiceName": "eks.amazonaws.com"
+          }
+        }
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:CreateServiceLinkedRole"
+        ],
+        "Resource": [
+          "arn:*:iam::*:role/aws-service-role/eks-nodegroup.amazonaws.com/AWSServiceRoleForAmazonEKSNodegroup"
+        ],
+        "Condition": {
+          "StringLike": {
+            "iam:AWSServiceName": "eks-nodegroup.amazonaws.com"
+          }
+        }
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:CreateServiceLinkedRole"
+        ],
+        "Resource": [
+          "arn:aws:iam::*:role/aws-service-role/eks-fargate-pods.amazonaws.com/AWSServiceRoleForAmazonEKSForFargate"
+        ],
+        "Condition": {
+          "StringLike": {
+            "iam:AWSServiceName": "eks-fargate.amazonaws.com"
+          }
+        }
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:GetRole",
+          "iam:ListAttachedRolePolicies"
+        ],
+        "Resource": [
+          "arn:*:iam::*:role/*"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:GetPolicy"
+        ],
+        "Resource": [
+          "arn:aws:iam::aws:policy/AmazonEKSClusterPolicy"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "eks:DescribeCluster",
+          "eks:ListClusters",
+          "eks:CreateCluster",
+          "eks:TagResource",
+          "eks:UpdateClusterVersion",
+          "eks:DeleteCluster",
+          "eks:UpdateClusterConfig",
+          "eks:UntagResource",
+          "eks:UpdateNodegroupVersion",
+          "eks:DescribeNodegroup",
+          "eks:DeleteNodegroup",
+          "eks:UpdateNodegroupConfig",
+          "eks:CreateNodegroup",
+          "eks:AssociateEncryptionConfig",
+          "eks:ListIdentityProviderConfigs",
+          "eks:AssociateIdentityProviderConfig",
+          "eks:DescribeIdentityProviderConfig",
+          "eks:DisassociateIdentityProviderConfig"
+        ],
+        "Resource": [
+          "arn:*:eks:*:*:cluster/*",
+          "arn:*:eks:*:*:nodegroup/*/*/*"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "ec2:AssociateVpcCidrBlock",
+          "ec2:DisassociateVpcCidrBlock",
+          "eks:ListAddons",
+          "eks:CreateAddon",
+          "eks:DescribeAddonVersions",
+          "eks:DescribeAddon",
+          "eks:DeleteAddon",
+          "eks:UpdateAddon",
+          "eks:TagResource",
+          "eks:DescribeFargateProfile",
+          "eks:CreateFargateProfile",
+          "eks:DeleteFargateProfile",
+          "eks:ListPodIdentityAssociations",
+          "eks:DescribePodIdentityAssociation",
+          "eks:CreatePodIdentityAssociation",
+          "eks:UpdatePodIdentityAssociation",
+          "eks:DeletePodIdentityAssociation"
+        ],
+        "Resource": [
+          "*"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:PassRole"
+        ],
+        "Resource": [
+          "*"
+        ],
+        "Condition": {
+          "StringEquals": {
+            "iam:PassedToService": "eks.amazonaws.com"
+          }
+        }
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "iam:PassRole"
+        ],
+        "Resource": [
+          "*"
+        ],
+        "Condition": {
+          "StringEquals": {
+            "iam:PassedToService": "pods.eks.amazonaws.com"
+          }
+        }
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "kms:CreateGrant",
+          "kms:DescribeKey"
+        ],
+        "Resource": [
+          "*"
+        ],
+        "Condition": {
+          "ForAnyValue:StringLike": {
+            "kms:ResourceAliases": "alias/cluster-api-provider-aws-*"
+          }
+        }
+      }
+    ]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "role_controllers_managed_policy_controllers_eks" {
+  role       = aws_iam_role.role_controllers.name
+  policy_arn = aws_iam_policy.managed_policy_controllers_eks.arn
+}
+
+resource "aws_iam_role_policy_attachment" "role_control_plane_managed_policy_controllers_eks" {
+  role       = aws_iam_role.role_control_plane.name
+  policy_arn = aws_iam_policy.managed_policy_controllers_eks.arn
+}
+
+resource "aws_iam_policy" "managed_policy_cloud_provider_control_plane" {
+  name        = "control-plane.cluster-api-provider-aws.sigs.k8s.io"
+  description = "For the Kubernetes Cloud Provider AWS Control Plane"
+  policy      = jsonencode({
+    "Version": "2012-10-17",
+    "Statement": [
+      {
+        "Effect": "Allow",
+        "Action": [
+          "autoscaling:DescribeAutoScalingGroups",
+          "autoscaling:DescribeLaunchConfigurations",
+          "autoscaling:DescribeTags",
+          "ec2:AssignIpv6Addresses",
+          "ec2:DescribeInstances",
+          "ec2:DescribeImages",
+          "ec2:DescribeRegions",
+          "ec2:DescribeRouteTables",
+          "ec2:DescribeSecurityGroups",
+          "ec2:DescribeSubnets",
+          "ec2:DescribeVolumes",
+          "ec2:CreateSecurityGroup",
+          "ec2:CreateTags",
+          "ec2:CreateVolume",
+          "ec2:ModifyInstanceAttribute",
+          "ec2:ModifyVolume",
+          "ec2:AttachVolume",
+          "ec2:AuthorizeSecurityGroupIngress",
+          "ec2:CreateRoute",
+          "ec2:DeleteRoute",
+          "ec2:DeleteSecurityGroup",
+          "ec2:DeleteVolume",
+          "ec2:DetachVolume",
+          "ec2:RevokeSecurityGroupIngress",
+          "ec2:DescribeVpcs",
+          "elasticloadbalancing:AddTags",
+          "elasticloadbalancing:AttachLoadBalancerToSubnets",
+          "elasticloadbalancing:ApplySecurityGroupsToLoadBalancer",
+          "elasticloadbalancing:SetSecurityGroups",
+          "elasticloadbalancing:CreateLoadBalancer",
+          "elasticloadbalancing:CreateLoadBalancerPolicy",
+          "elasticloadbalancing:CreateLoadBalancerListeners",
+          "elasticloadbalancing:ConfigureHealthCheck",
+          "elasticloadbalancing:DeleteLoadBalancer",
+          "elasticloadbalancing:DeleteLoadBalancerListeners",
+          "elasticloadbalancing:DescribeLoadBalancers",
+          "elasticloadbalancing:DescribeLoadBalancerAttributes",
+          "elasticloadbalancing:DetachLoadBalancerFromSubnets",
+          "elasticloadbalancing:DeregisterInstancesFromLoadBalancer",
+          "elasticloadbalancing:ModifyLoadBalancerAttributes",
+          "elasticloadbalancing:RegisterInstancesWithLoadBalancer",
+          "elasticloadbalancing:SetLoadBalancerPoliciesForBackendServer",
+          "elasticloadbalancing:CreateListener",
+          "elasticloadbalancing:CreateTargetGroup",
+          "elasticloadbalancing:DeleteListener",
+          "elasticloadbalancing:DeleteTargetGroup",
+          "elasticloadbalancing:DeregisterTargets",
+          "elasticloadbalancing:DescribeListeners",
+          "elasticloadbalancing:DescribeLoadBalancerPolicies",
+          "elasticloadbalancing:DescribeTargetGroups",
+          "elasticloadbalancing:DescribeTargetHealth",
+          "elasticloadbalancing:ModifyListener",
+          "elasticloadbalancing:ModifyTargetGroup",
+          "elasticloadbalancing:RegisterTargets",
+          "elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+          "iam:CreateServiceLinkedRole",
+          "kms:DescribeKey"
+        ],
+        "Resource": [
+          "*"
+        ]
+      }
+    ]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "role_control_plane_managed_policy_cloud_provider_control_plane" {
+  role       = aws_iam_role.role_control_plane.name
+  policy_arn = aws_iam_policy.managed_policy_cloud_provider_control_plane.arn
+}
+
+resource "aws_iam_policy" "managed_policy_cloud_provider_nodes" {
+  name        = "nodes.cluster-api-provider-aws.sigs.k8s.io"
+  description = "For the Kubernetes Cloud Provider AWS nodes"
+  policy      = jsonencode({
+    "Version": "2012-10-17",
+    "Statement": [
+      {
+        "Effect": "Allow",
+        "Action": [
+          "ec2:AssignIpv6Addresses",
+          "ec2:DescribeInstances",
+          "ec2:DescribeRegions",
+          "ec2:CreateTags",
+          "ec2:DescribeTags",
+          "ec2:DescribeNetworkInterfaces",
+          "ec2:DescribeInstanceTypes",
+          "ecr:GetAuthorizationToken",
+          "ecr:BatchCheckLayerAvailability",
+          "ecr:GetDownloadUrlForLayer",
+          "ecr:GetRepositoryPolicy",
+          "ecr:DescribeRepositories",
+          "ecr:ListImages",
+          "ecr:BatchGetImage"
+        ],
+        "Resource": [
+          "*"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "secretsmanager:DeleteSecret",
+          "secretsmanager:GetSecretValue"
+        ],
+        "Resource": [
+          "arn:*:secretsmanager:*:*:secret:aws.cluster.x-k8s.io/*"
+        ]
+      },
+      {
+        "Effect": "Allow",
+        "Action": [
+          "ssm:UpdateInstanceInformation",
+          "ssmmessages:CreateControlChannel",
+          "ssmmessages:CreateDataChannel",
+          "ssmmessages:OpenControlChannel",
+          "ssmmessages:OpenDataChannel",
+          "s3:GetEncryptionConfiguration"
+        ],
+        "Resource": [
+          "*"
+        ]
+      }
+    ]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "role_control_plane_managed_policy_cloud_provider_nodes" {
+  role       = aws_iam_role.role_control_plane.name
+  policy_arn = aws_iam_policy.managed_policy_cloud_provider_nodes.arn
+}
+
+resource "aws_iam_role_policy_attachment" "role_nodes_managed_policy_cloud_provider_nodes" {
+  role       = aws_iam_role.role_nodes.name
+  policy_arn = aws_iam_policy.managed_policy_cloud_provider_nodes.arn
+}
+
+resource "aws_iam_role" "role_control_plane" {
+  name               = "control-plane.cluster-api-provider-aws.sigs.k8s.io"
+  assume_role_policy = jsonencode({
+    "Version": "2012-10-17",
+    "Statement": [
+      {
+        "Principal": {
+          "Service": [
+            "ec2.amazonaws.com"
+          ]
+        },
+        "Effect": "Allow",
+        "Action": [
+          "sts:AssumeRole"
+        ]
+      }
+    ]
+  })
+}
+
+resource "aws_iam_role" "role_controllers" {
+  name               = "controllers.cluster-api-provider-aws.sigs.k8s.io"
+  assume_role_policy = jsonencode({
+    "Version": "2012-10-17",
+    "Statement": [
+      {
+        "Principal": {
+          "Service": [
+            "ec2.amazonaws.com",
+            "pods.eks.amazonaws.com"
+          ]
+        },
+        "Effect": "Allow",
+        "Action": [
+          "sts:AssumeRole",
+          "sts:TagSession"
+        ]
+      }
+    ]
+  })
+}
+
+resource "aws_iam_role" "role_nodes" {
+  name               = "nodes.cluster-api-provider-aws.sigs.k8s.io"
+  assume_role_policy = jsonencode({
+    "Version": "2012-10-17",
+    "Statement": [
+      {
+        "Principal": {
+          "Service": [
+            "ec2.amazonaws.com"
+          ]
+        },
+        "Effect": "Allow",
+        "Action": [
+          "sts:AssumeRole"
+        ]
+      }
+    ]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "role_nodes_amazon_eks_worker_node_policy" {
+  role       = aws_iam_role.role_nodes.name
+  policy_arn = "arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy"
+}
+
+resource "aws_iam_role_policy_attachment" "role_nodes_amazon_eks_cni_policy" {
+  role       = aws_iam_role.role_nodes.name
+  policy_arn = "arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"
+}
+
+resource "aws_iam_role" "role_eks_control_plane" {
+  name               = "eks-controlplane.cluster-api-provider-aws.sigs.k8s.io"
+  assume_role_policy = jsonencode({
+    "Version": "2012-10-17",
+    "Statement": [
+      {
+        "Principal": {
+          "Service": [
+            "eks.amazonaws.com"
+          ]
+        },
+        "Effect": "Allow",
+        "Action": [
+          "sts:AssumeRole"
+        ]
+      }
+    ]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "role_eks_control_plane_amazon_eks_cluster_policy" {
+  role       = aws_iam_role.role_eks_control_plane.name
+  policy_arn = "arn:aws:iam::aws:policy/AmazonEKSClusterPolicy"
+}
+
+resource "aws_iam_instance_profile" "instance_profile_control_plane" {
+  name = "control-plane.cluster-api-provider-aws.sigs.k8s.io"
+  role = aws_iam_role.role_control_plane.name
+}
+
+resource "aws_iam_instance_profile" "instance_profile_controllers" {
+  name = "controllers.cluster-api-provider-aws.sigs.k8s.io"
+  role = aws_iam_role.role_controllers.name
+}
+
+resource "aws_iam_instance_profile" "instance_profile_nodes" {
+  name = "nodes.cluster-api-provider-aws.sigs.k8s.io"
+  role = aws_iam_role.role_nodes.name
+}
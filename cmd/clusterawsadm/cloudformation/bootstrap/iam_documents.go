@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+)
+
+// IAMDocuments are the plain AWS IAM JSON policy documents described by a Template,
+// for use with tooling other than CloudFormation.
+type IAMDocuments struct {
+	// Roles are the roles and the documents making them up.
+	Roles []RoleDocuments `json:"roles"`
+
+	// Policies are the customer managed policies, by name.
+	Policies map[string]*iamv1.PolicyDocument `json:"policies,omitempty"`
+}
+
+// RoleDocuments are the policy documents making up a role.
+type RoleDocuments struct {
+	Name                string `json:"name"`
+	Path                string `json:"path,omitempty"`
+	PermissionsBoundary string `json:"permissionsBoundary,omitempty"`
+
+	// InstanceProfile is the name of the instance profile for the role, if any.
+	InstanceProfile string `json:"instanceProfile,omitempty"`
+
+	// TrustPolicy is the assume role policy document of the role.
+	TrustPolicy *iamv1.PolicyDocument `json:"trustPolicy"`
+
+	// Policies are the names of the customer managed policies attached to the role.
+	Policies []string `json:"policies,omitempty"`
+
+	// ManagedPolicyARNs are the ARNs of the existing managed policies attached to the role.
+	ManagedPolicyARNs []string `json:"managedPolicyARNs,omitempty"`
+
+	// InlinePolicies are the policies embedded in the role, by name.
+	InlinePolicies map[string]iamv1.PolicyDocument `json:"inlinePolicies,omitempty"`
+
+	Tags infrav1.Tags `json:"tags,omitempty"`
+}
+
+// RenderIAMDocuments returns the AWS IAM policy documents for each role of the Template.
+func (t Template) RenderIAMDocuments() IAMDocuments {
+	r := t.Resources()
+	docs := IAMDocuments{
+		Roles:    []RoleDocuments{},
+		Policies: map[string]*iamv1.PolicyDocument{},
+	}
+
+	for _, policy := range r.ManagedPolicies {
+		docs.Policies[policy.Name] = policy.Document
+	}
+
+	for _, role := range r.Roles {
+		rd := RoleDocuments{
+			Name:                role.Name,
+			Path:                role.Path,
+			PermissionsBoundary: role.PermissionsBoundary,
+			TrustPolicy:         role.AssumeRolePolicy,
+			ManagedPolicyARNs:   role.ManagedPolicyARNs,
+			Tags:                role.Tags,
+		}
+		for _, profile := range r.InstanceProfiles {
+			if profile.Role == role.ID {
+				rd.InstanceProfile = profile.Name
+			}
+		}
+		for _, policy := range r.ManagedPolicies {
+			for _, id := range policy.Roles {
+				if id == role.ID {
+					rd.Policies = append(rd.Policies, policy.Name)
+				}
+			}
+		}
+		if len(role.Policies) > 0 {
+			rd.InlinePolicies = map[string]iamv1.PolicyDocument{}
+			for _, p := range role.Policies {
+				rd.InlinePolicies[p.Name] = p.Document
+			}
+		}
+		docs.Roles = append(docs.Roles, rd)
+	}
+
+	return docs
+}
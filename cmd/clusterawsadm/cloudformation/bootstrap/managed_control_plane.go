@@ -16,8 +16,21 @@ limitations under the License.
 
 package bootstrap
 
+import (
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+)
+
+// eksControlPlanePolicies includes the policies required by EKS Auto Mode when it is enabled, as the default
+// control plane role isn't managed by the controllers and is shared by all the clusters.
 func (t Template) eksControlPlanePolicies() []string {
 	policies := []string{t.generateAWSManagedPolicyARN(eksClusterPolicyName)}
+	if t.eksAutoModeEnabled() {
+		for _, policy := range eks.AutoModeControlPlanePolicies() {
+			policies = append(policies, t.generateAWSManagedPolicyARN(policy))
+		}
+	}
 	if t.Spec.EKS.DefaultControlPlaneRole.ExtraPolicyAttachments != nil {
 		for _, policy := range t.Spec.EKS.DefaultControlPlaneRole.ExtraPolicyAttachments {
 			additionalPolicy := policy
@@ -27,3 +40,15 @@ func (t Template) eksControlPlanePolicies() []string {
 
 	return policies
 }
+
+// eksControlPlaneTrustRelationship additionally allows EKS to tag its sessions when EKS Auto Mode is enabled.
+func (t Template) eksControlPlaneTrustRelationship() *iamv1.PolicyDocument {
+	if t.eksAutoModeEnabled() {
+		return eksiam.ControlPlaneAutoModeTrustRelationship()
+	}
+	return AssumeRolePolicy(iamv1.PrincipalService, []string{"eks.amazonaws.com"})
+}
+
+func (t Template) eksAutoModeEnabled() bool {
+	return t.Spec.EKS.AutoMode != nil && t.Spec.EKS.AutoMode.Enable
+}
@@ -17,18 +17,16 @@ limitations under the License.
 package bootstrap
 
 import (
-	cfn_iam "github.com/awslabs/goformation/v4/cloudformation/iam"
-
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
 )
 
-func (t Template) nodePolicies() []cfn_iam.Role_Policy {
-	policies := []cfn_iam.Role_Policy{}
+func (t Template) nodePolicies() []InlinePolicy {
+	policies := []InlinePolicy{}
 	if t.Spec.Nodes.ExtraStatements != nil {
 		policies = append(policies,
-			cfn_iam.Role_Policy{
-				PolicyName: t.Spec.StackName,
-				PolicyDocument: iamv1.PolicyDocument{
+			InlinePolicy{
+				Name: t.Spec.StackName,
+				Document: iamv1.PolicyDocument{
 					Statement: t.Spec.Nodes.ExtraStatements,
 					Version:   iamv1.CurrentVersion,
 				},
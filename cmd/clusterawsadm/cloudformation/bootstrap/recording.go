@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	metricsv2 "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/metrics"
+)
+
+// PolicyDiff describes how the actions exercised by the controllers compare to
+// the actions granted to them.
+type PolicyDiff struct {
+	// Missing are the recorded actions, and the resources they were called
+	// against, that are not granted.
+	Missing []metricsv2.RecordedAction `json:"missing,omitempty"`
+
+	// Unused are the granted actions that were not exercised.
+	Unused []string `json:"unused,omitempty"`
+}
+
+// PolicyFromRecording returns a policy granting exactly the recorded actions on the
+// recorded resources. Actions called against the same resources share a statement.
+func PolicyFromRecording(recording metricsv2.PermissionRecording) *iamv1.PolicyDocument {
+	byResources := map[string]*iamv1.StatementEntry{}
+	for _, a := range recording.Actions {
+		resources := a.Resources
+		if len(resources) == 0 {
+			resources = []string{iamv1.Any}
+		}
+		key := strings.Join(resources, ",")
+		statement, ok := byResources[key]
+		if !ok {
+			statement = &iamv1.StatementEntry{
+				Effect:   iamv1.EffectAllow,
+				Resource: iamv1.Resources(resources),
+			}
+			byResources[key] = statement
+		}
+		statement.Action = append(statement.Action, a.Action)
+	}
+
+	keys := make([]string, 0, len(byResources))
+	for key := range byResources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	policy := &iamv1.PolicyDocument{
+		Version:   iamv1.CurrentVersion,
+		Statement: iamv1.Statements{},
+	}
+	for _, key := range keys {
+		statement := byResources[key]
+		sort.Strings(statement.Action)
+		policy.Statement = append(policy.Statement, *statement)
+	}
+	return policy
+}
+
+// ControllersPolicies returns the policies the Template grants to the controllers.
+func (t Template) ControllersPolicies() []*iamv1.PolicyDocument {
+	policies := []*iamv1.PolicyDocument{t.ControllersPolicy()}
+	if !t.Spec.EKS.Disable {
+		policies = append(policies, t.ControllersPolicyEKS())
+	}
+	if t.Spec.ClusterAPIControllers.ExtraStatements != nil {
+		policies = append(policies, &iamv1.PolicyDocument{
+			Version:   iamv1.CurrentVersion,
+			Statement: t.Spec.ClusterAPIControllers.ExtraStatements,
+		})
+	}
+	return policies
+}
+
+// DiffControllersPolicies compares a recording against the policies the Template
+// grants to the controllers. Statement conditions are not evaluated, and actions
+// recorded without a known resource are considered granted by any statement
+// allowing the action.
+func (t Template) DiffControllersPolicies(recording metricsv2.PermissionRecording) PolicyDiff {
+	return DiffPolicies(recording, t.ControllersPolicies()...)
+}
+
+// DiffPolicies compares a recording against a set of granted policies.
+func DiffPolicies(recording metricsv2.PermissionRecording, policies ...*iamv1.PolicyDocument) PolicyDiff {
+	statements := iamv1.Statements{}
+	for _, p := range policies {
+		for _, s := range p.Statement {
+			if s.Effect == iamv1.EffectAllow {
+				statements = append(statements, s)
+			}
+		}
+	}
+
+	diff := PolicyDiff{}
+	for _, a := range recording.Actions {
+		resources := a.Resources
+		if len(resources) == 0 {
+			resources = []string{iamv1.Any}
+		}
+		missing := []string{}
+		for _, resource := range resources {
+			if !isGranted(statements, a.Action, resource) {
+				missing = append(missing, resource)
+			}
+		}
+		if len(missing) > 0 {
+			diff.Missing = append(diff.Missing, metricsv2.RecordedAction{
+				Action:      a.Action,
+				Resources:   missing,
+				Controllers: a.Controllers,
+				Denied:      a.Denied,
+			})
+		}
+	}
+
+	unused := map[string]struct{}{}
+	for _, s := range statements {
+		for _, granted := range s.Action {
+			used := false
+			for _, a := range recording.Actions {
+				if wildcardMatch(granted, a.Action, true) {
+					used = true
+					break
+				}
+			}
+			if !used {
+				unused[granted] = struct{}{}
+			}
+		}
+	}
+	for action := range unused {
+		diff.Unused = append(diff.Unused, action)
+	}
+	sort.Strings(diff.Unused)
+
+	return diff
+}
+
+func isGranted(statements iamv1.Statements, action, resource string) bool {
+	for _, s := range statements {
+		actionMatches := false
+		for _, granted := range s.Action {
+			if wildcardMatch(granted, action, true) {
+				actionMatches = true
+				break
+			}
+		}
+		if !actionMatches {
+			continue
+		}
+		if resource == iamv1.Any {
+			return true
+		}
+		for _, granted := range s.Resource {
+			if wildcardMatch(granted, resource, false) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// wildcardMatch reports whether s matches an IAM pattern, where "*" matches any
+// sequence of characters and "?" any single character. Action names are case
+// insensitive, resources are not.
+func wildcardMatch(pattern, s string, caseInsensitive bool) bool {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	if caseInsensitive {
+		expr = "(?i)" + expr
+	}
+	return regexp.MustCompile("^" + expr + "$").MatchString(s)
+}
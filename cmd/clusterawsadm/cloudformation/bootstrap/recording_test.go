@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	metricsv2 "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/metrics"
+)
+
+func TestPolicyFromRecording(t *testing.T) {
+	g := NewWithT(t)
+
+	policy := PolicyFromRecording(metricsv2.PermissionRecording{
+		Actions: []metricsv2.RecordedAction{
+			{Action: "ec2:RunInstances", Resources: []string{"*"}},
+			{Action: "s3:PutObject", Resources: []string{"arn:aws:s3:::bucket/*"}},
+			{Action: "ec2:DescribeInstances"},
+			{Action: "s3:GetObject", Resources: []string{"arn:aws:s3:::bucket/*"}},
+		},
+	})
+
+	g.Expect(policy).To(Equal(&iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: iamv1.Statements{
+			{
+				Effect:   iamv1.EffectAllow,
+				Action:   iamv1.Actions{"ec2:DescribeInstances", "ec2:RunInstances"},
+				Resource: iamv1.Resources{"*"},
+			},
+			{
+				Effect:   iamv1.EffectAllow,
+				Action:   iamv1.Actions{"s3:GetObject", "s3:PutObject"},
+				Resource: iamv1.Resources{"arn:aws:s3:::bucket/*"},
+			},
+		},
+	}))
+}
+
+func TestDiffPolicies(t *testing.T) {
+	g := NewWithT(t)
+
+	granted := &iamv1.PolicyDocument{
+		Statement: iamv1.Statements{
+			{
+				Effect:   iamv1.EffectAllow,
+				Action:   iamv1.Actions{"ec2:Describe*", "ec2:RunInstances", "ec2:DeleteVpc"},
+				Resource: iamv1.Resources{"*"},
+			},
+			{
+				Effect:   iamv1.EffectAllow,
+				Action:   iamv1.Actions{"s3:PutObject"},
+				Resource: iamv1.Resources{"arn:*:s3:::cluster-api-provider-aws-*"},
+			},
+			{
+				Effect:   iamv1.EffectDeny,
+				Action:   iamv1.Actions{"iam:CreateRole"},
+				Resource: iamv1.Resources{"*"},
+			},
+		},
+	}
+
+	diff := DiffPolicies(metricsv2.PermissionRecording{
+		Actions: []metricsv2.RecordedAction{
+			{Action: "ec2:DescribeInstances", Resources: []string{"*"}},
+			{Action: "ec2:runinstances", Resources: []string{"*"}},
+			{Action: "s3:PutObject", Resources: []string{"arn:aws:s3:::cluster-api-provider-aws-a", "arn:aws:s3:::other"}, Controllers: []string{"awscluster"}},
+			{Action: "iam:CreateRole", Resources: []string{"*"}, Denied: true},
+		},
+	}, granted)
+
+	g.Expect(diff).To(Equal(PolicyDiff{
+		Missing: []metricsv2.RecordedAction{
+			{Action: "s3:PutObject", Resources: []string{"arn:aws:s3:::other"}, Controllers: []string{"awscluster"}},
+			{Action: "iam:CreateRole", Resources: []string{"*"}, Denied: true},
+		},
+		Unused: []string{"ec2:DeleteVpc"},
+	}))
+}
+
+func TestDiffControllersPolicies(t *testing.T) {
+	g := NewWithT(t)
+
+	template := NewTemplate()
+	diff := template.DiffControllersPolicies(metricsv2.PermissionRecording{
+		Actions: []metricsv2.RecordedAction{
+			{Action: "ec2:RunInstances", Resources: []string{"*"}},
+			{Action: "eks:DescribeCluster", Resources: []string{"*"}},
+		},
+	})
+
+	g.Expect(diff.Missing).To(BeEmpty())
+	g.Expect(diff.Unused).NotTo(ContainElements("ec2:RunInstances", "eks:DescribeCluster"))
+	g.Expect(diff.Unused).To(ContainElement("ec2:CreateVpc"))
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"testing"
+
+	cfn_iam "github.com/awslabs/goformation/v4/cloudformation/iam"
+	. "github.com/onsi/gomega"
+	"github.com/sergi/go-diff/diffmatchpatch"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	bootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/api/bootstrap/v1beta1"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+)
+
+func withOIDCProviderTemplate() Template {
+	t := NewTemplate()
+	t.Spec.OIDCProviders = []bootstrapv1.OIDCProvider{
+		{
+			URL:         "https://oidc.eks.us-west-2.amazonaws.com/id/EXAMPLED539D4633E53DE1B71EXAMPLE",
+			ClientIDs:   []string{bootstrapv1.DefaultOIDCClientID},
+			Thumbprints: []string{"9e99a48a9960b14926bb7f3b02e22da2b0ab7280"},
+			Tags:        infrav1.Tags{"team": "platform"},
+		},
+	}
+	return t
+}
+
+func customizedTemplate() Template {
+	t := withOIDCProviderTemplate()
+	t.Spec.BootstrapUser.Enable = true
+	t.Spec.BootstrapUser.ExtraGroups = []string{"admins"}
+	t.Spec.ControlPlane.Path = "/capa/"
+	t.Spec.ControlPlane.PermissionsBoundary = "arn:aws:iam::123456789012:policy/boundary"
+	t.Spec.ControlPlane.ExtraPolicyAttachments = []string{"arn:aws:iam::123456789012:policy/extra"}
+	t.Spec.ControlPlane.Tags = infrav1.Tags{"team": "platform"}
+	t.Spec.Nodes.ExtraStatements = []iamv1.StatementEntry{
+		{
+			Effect:   iamv1.EffectAllow,
+			Action:   iamv1.Actions{"s3:GetObject"},
+			Resource: iamv1.Resources{"arn:aws:s3:::home/${aws:username}/*"},
+		},
+	}
+	return t
+}
+
+func TestRenderTerraform(t *testing.T) {
+	cases := []struct {
+		fixture  string
+		template func() Template
+	}{
+		{
+			fixture:  "default",
+			template: NewTemplate,
+		},
+		{
+			fixture:  "customized",
+			template: customizedTemplate,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.fixture, func(t *testing.T) {
+			data, err := os.ReadFile(path.Join("fixtures", "terraform", c.fixture+".tf"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tData, err := c.template().RenderTerraform()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(tData, data) {
+				dmp := diffmatchpatch.New()
+				diffs := dmp.DiffMain(string(tData), string(data), false)
+				out := dmp.DiffPrettyText(diffs)
+				t.Fatalf("Differing output (%s):\n%s", c.fixture, out)
+			}
+		})
+	}
+}
+
+func TestTerraformName(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(terraformName(AWSIAMRoleEKSControlPlane)).To(Equal("role_eks_control_plane"))
+	g.Expect(terraformName(string(CSIPolicy))).To(Equal("awsebscsi_policy_controller"))
+	g.Expect(terraformName("AWSIAMOIDCProvider0")).To(Equal("oidc_provider0"))
+	g.Expect(arnTerraformName("arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy")).To(Equal("amazon_eks_cni_policy"))
+}
+
+// TestRenderIAMDocumentsMatchesCloudFormation checks the documents rendered for each
+// role are the ones the CloudFormation template provisions.
+func TestRenderIAMDocumentsMatchesCloudFormation(t *testing.T) {
+	for name, template := range map[string]func() Template{
+		"default":    NewTemplate,
+		"customized": customizedTemplate,
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			tmpl := template()
+			cfn := tmpl.RenderCloudFormation()
+			docs := tmpl.RenderIAMDocuments()
+
+			roles := cfn.GetAllIAMRoleResources()
+			g.Expect(docs.Roles).To(HaveLen(len(roles)))
+
+			policies := cfn.GetAllIAMManagedPolicyResources()
+			g.Expect(docs.Policies).To(HaveLen(len(policies)))
+			for _, p := range policies {
+				g.Expect(docs.Policies).To(HaveKeyWithValue(p.ManagedPolicyName, p.PolicyDocument))
+			}
+
+			for _, rd := range docs.Roles {
+				var role *cfn_iam.Role
+				for _, r := range roles {
+					if r.RoleName == rd.Name {
+						role = r
+					}
+				}
+				g.Expect(role).NotTo(BeNil(), "role %s", rd.Name)
+				g.Expect(rd.TrustPolicy).To(Equal(role.AssumeRolePolicyDocument))
+				g.Expect(rd.ManagedPolicyARNs).To(Equal(role.ManagedPolicyArns))
+				g.Expect(rd.InlinePolicies).To(HaveLen(len(role.Policies)))
+				for _, p := range role.Policies {
+					g.Expect(rd.InlinePolicies).To(HaveKeyWithValue(p.PolicyName, p.PolicyDocument))
+				}
+			}
+		})
+	}
+}
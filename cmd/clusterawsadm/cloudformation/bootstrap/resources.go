@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"fmt"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+)
+
+// Resources are the AWS IAM resources described by a Template, independent of the
+// format they are rendered to. Resources refer to each other by ID, which is also
+// their CloudFormation logical ID.
+type Resources struct {
+	Users            []User
+	Groups           []Group
+	ManagedPolicies  []ManagedPolicy
+	Roles            []Role
+	InstanceProfiles []InstanceProfile
+	OIDCProviders    []OIDCProvider
+}
+
+// InlinePolicy is a policy embedded in a role or user.
+type InlinePolicy struct {
+	Name     string
+	Document iamv1.PolicyDocument
+}
+
+// User is an AWS IAM user.
+type User struct {
+	ID   string
+	Name string
+	// Groups are the IDs of the groups the user is a member of.
+	Groups []string
+	// ExtraGroups are the names of groups, not managed by the Template, the user is a member of.
+	ExtraGroups       []string
+	ManagedPolicyARNs []string
+	Policies          []InlinePolicy
+	Tags              infrav1.Tags
+}
+
+// Group is an AWS IAM group.
+type Group struct {
+	ID   string
+	Name string
+}
+
+// ManagedPolicy is a customer managed AWS IAM policy.
+type ManagedPolicy struct {
+	ID          string
+	Name        string
+	Description string
+	Document    *iamv1.PolicyDocument
+	// Groups are the IDs of the groups the policy is attached to.
+	Groups []string
+	// Roles are the IDs of the roles the policy is attached to.
+	Roles []string
+}
+
+// Role is an AWS IAM role.
+type Role struct {
+	ID                  string
+	Name                string
+	Path                string
+	AssumeRolePolicy    *iamv1.PolicyDocument
+	ManagedPolicyARNs   []string
+	Policies            []InlinePolicy
+	PermissionsBoundary string
+	Tags                infrav1.Tags
+}
+
+// InstanceProfile is an AWS IAM instance profile.
+type InstanceProfile struct {
+	ID   string
+	Name string
+	// Role is the ID of the role in the instance profile.
+	Role string
+}
+
+// OIDCProvider is an AWS IAM OpenID Connect identity provider.
+type OIDCProvider struct {
+	ID          string
+	URL         string
+	ClientIDs   []string
+	Thumbprints []string
+	Tags        infrav1.Tags
+}
+
+// Resources returns the AWS IAM resources described by the Template.
+func (t Template) Resources() Resources {
+	r := Resources{}
+
+	if t.Spec.BootstrapUser.Enable {
+		r.Users = append(r.Users, User{
+			ID:                AWSIAMUserBootstrapper,
+			Name:              t.Spec.BootstrapUser.UserName,
+			Groups:            t.bootstrapUserGroups(),
+			ExtraGroups:       t.Spec.BootstrapUser.ExtraGroups,
+			ManagedPolicyARNs: t.Spec.ControlPlane.ExtraPolicyAttachments,
+			Policies:          t.bootstrapUserPolicy(),
+			Tags:              t.Spec.BootstrapUser.Tags,
+		})
+
+		r.Groups = append(r.Groups, Group{
+			ID:   AWSIAMGroupBootstrapper,
+			Name: t.Spec.BootstrapUser.GroupName,
+		})
+	}
+
+	r.ManagedPolicies = append(r.ManagedPolicies, ManagedPolicy{
+		ID:          string(ControllersPolicy),
+		Name:        t.NewManagedName("controllers"),
+		Description: `For the Kubernetes Cluster API Provider AWS Controllers`,
+		Document:    t.ControllersPolicy(),
+		Groups:      t.controllersPolicyGroups(),
+		Roles:       t.controllersPolicyRoleAttachments(),
+	})
+
+	if !t.Spec.EKS.Disable {
+		r.ManagedPolicies = append(r.ManagedPolicies, ManagedPolicy{
+			ID:          string(ControllersPolicyEKS),
+			Name:        t.NewManagedName("controllers-eks"),
+			Description: `For the Kubernetes Cluster API Provider AWS Controllers`,
+			Document:    t.ControllersPolicyEKS(),
+			Groups:      t.controllersPolicyGroups(),
+			Roles:       t.controllersPolicyRoleAttachments(),
+		})
+	}
+
+	if !t.Spec.ControlPlane.DisableCloudProviderPolicy {
+		r.ManagedPolicies = append(r.ManagedPolicies, ManagedPolicy{
+			ID:          string(ControlPlanePolicy),
+			Name:        t.NewManagedName("control-plane"),
+			Description: `For the Kubernetes Cloud Provider AWS Control Plane`,
+			Document:    t.cloudProviderControlPlaneAwsPolicy(),
+			Roles:       t.cloudProviderControlPlaneAwsRoles(),
+		})
+	}
+
+	if !t.Spec.Nodes.DisableCloudProviderPolicy {
+		r.ManagedPolicies = append(r.ManagedPolicies, ManagedPolicy{
+			ID:          string(NodePolicy),
+			Name:        t.NewManagedName("nodes"),
+			Description: `For the Kubernetes Cloud Provider AWS nodes`,
+			Document:    t.nodePolicy(),
+			Roles:       t.cloudProviderNodeAwsRoles(),
+		})
+	}
+
+	if t.Spec.ControlPlane.EnableCSIPolicy {
+		r.ManagedPolicies = append(r.ManagedPolicies, ManagedPolicy{
+			ID:          string(CSIPolicy),
+			Name:        t.NewManagedName("csi"),
+			Description: `For the AWS EBS CSI Driver for Kubernetes`,
+			Document:    t.csiControllerPolicy(),
+			Roles:       t.csiControlPlaneAwsRoles(),
+		})
+	}
+
+	if t.Spec.EKS.EnableUserEKSConsolePolicy && !t.Spec.EKS.Disable {
+		r.ManagedPolicies = append(r.ManagedPolicies, ManagedPolicy{
+			ID:          string(EKSConsolePolicy),
+			Name:        t.NewManagedName("eks-console"),
+			Description: `For users/groups to view EKS nodes and workloads`,
+			Document:    t.eksConsolePolicies(),
+		})
+	}
+
+	r.Roles = append(r.Roles,
+		Role{
+			ID:                  AWSIAMRoleControlPlane,
+			Name:                t.NewManagedName("control-plane"),
+			Path:                t.Spec.ControlPlane.Path,
+			AssumeRolePolicy:    t.controlPlaneTrustPolicy(),
+			ManagedPolicyARNs:   t.Spec.ControlPlane.ExtraPolicyAttachments,
+			Policies:            t.controlPlanePolicies(),
+			PermissionsBoundary: t.Spec.ControlPlane.PermissionsBoundary,
+			Tags:                t.Spec.ControlPlane.Tags,
+		},
+		Role{
+			ID:                  AWSIAMRoleControllers,
+			Name:                t.NewManagedName("controllers"),
+			Path:                t.Spec.ControlPlane.Path,
+			AssumeRolePolicy:    t.controllersTrustPolicy(!t.Spec.EKS.Disable),
+			Policies:            t.controllersRolePolicy(),
+			PermissionsBoundary: t.Spec.ControlPlane.PermissionsBoundary,
+			Tags:                t.Spec.ClusterAPIControllers.Tags,
+		},
+		Role{
+			ID:                  AWSIAMRoleNodes,
+			Name:                t.NewManagedName("nodes"),
+			Path:                t.Spec.ControlPlane.Path,
+			AssumeRolePolicy:    t.nodeTrustPolicy(),
+			ManagedPolicyARNs:   t.nodeManagedPolicies(),
+			Policies:            t.nodePolicies(),
+			PermissionsBoundary: t.Spec.ControlPlane.PermissionsBoundary,
+			Tags:                t.Spec.Nodes.Tags,
+		},
+	)
+
+	r.InstanceProfiles = append(r.InstanceProfiles,
+		InstanceProfile{
+			ID:   AWSIAMInstanceProfileControlPlane,
+			Name: t.NewManagedName("control-plane"),
+			Role: AWSIAMRoleControlPlane,
+		},
+		InstanceProfile{
+			ID:   AWSIAMInstanceProfileControllers,
+			Name: t.NewManagedName("controllers"),
+			Role: AWSIAMRoleControllers,
+		},
+		InstanceProfile{
+			ID:   AWSIAMInstanceProfileNodes,
+			Name: t.NewManagedName("nodes"),
+			Role: AWSIAMRoleNodes,
+		},
+	)
+
+	if !t.Spec.EKS.DefaultControlPlaneRole.Disable && !t.Spec.EKS.Disable {
+		r.Roles = append(r.Roles, Role{
+			ID:                AWSIAMRoleEKSControlPlane,
+			Name:              ekscontrolplanev1.DefaultEKSControlPlaneRole,
+			AssumeRolePolicy:  t.eksControlPlaneTrustRelationship(),
+			ManagedPolicyARNs: t.eksControlPlanePolicies(),
+			Tags:              t.Spec.EKS.DefaultControlPlaneRole.Tags,
+		})
+	}
+
+	if !t.Spec.EKS.ManagedMachinePool.Disable && !t.Spec.EKS.Disable {
+		r.Roles = append(r.Roles, Role{
+			ID:                AWSIAMRoleEKSNodegroup,
+			Name:              expinfrav1.DefaultEKSNodegroupRole,
+			AssumeRolePolicy:  AssumeRolePolicy(iamv1.PrincipalService, []string{"ec2.amazonaws.com", "eks.amazonaws.com"}),
+			ManagedPolicyARNs: t.eksMachinePoolPolicies(),
+			Tags:              t.Spec.EKS.ManagedMachinePool.Tags,
+		})
+	}
+
+	if !t.Spec.EKS.Fargate.Disable && !t.Spec.EKS.Disable {
+		r.Roles = append(r.Roles, Role{
+			ID:                AWSIAMRoleEKSFargate,
+			Name:              expinfrav1.DefaultEKSFargateRole,
+			AssumeRolePolicy:  AssumeRolePolicy(iamv1.PrincipalService, []string{eksiam.EKSFargateService}),
+			ManagedPolicyARNs: t.fargateProfilePolicies(t.Spec.EKS.Fargate),
+			Tags:              t.Spec.EKS.Fargate.Tags,
+		})
+	}
+
+	for i, provider := range t.Spec.OIDCProviders {
+		r.OIDCProviders = append(r.OIDCProviders, OIDCProvider{
+			ID:          fmt.Sprintf("%s%d", AWSIAMOIDCProvider, i),
+			URL:         provider.URL,
+			ClientIDs:   provider.ClientIDs,
+			Thumbprints: provider.Thumbprints,
+			Tags:        provider.Tags,
+		})
+	}
+
+	return r
+}
@@ -26,10 +26,7 @@ import (
 
 	bootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/api/bootstrap/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/converters"
-	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
-	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
-	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
 )
 
 // Constants that define resources for a Template.
@@ -45,6 +42,7 @@ const (
 	AWSIAMRoleEKSNodegroup                       = "AWSIAMRoleEKSNodegroup"
 	AWSIAMRoleEKSFargate                         = "AWSIAMRoleEKSFargate"
 	AWSIAMUserBootstrapper                       = "AWSIAMUserBootstrapper"
+	AWSIAMOIDCProvider                           = "AWSIAMOIDCProvider"
 	ControllersPolicy                 PolicyName = "AWSIAMManagedPolicyControllers"
 	ControllersPolicyEKS              PolicyName = "AWSIAMManagedPolicyControllersEKS"
 	ControlPlanePolicy                PolicyName = "AWSIAMManagedPolicyCloudProviderControlPlane"
@@ -76,152 +74,88 @@ func (t Template) NewManagedName(name string) string {
 // RenderCloudFormation will render and return a cloudformation Template.
 func (t Template) RenderCloudFormation() *cloudformation.Template {
 	template := cloudformation.NewTemplate()
-
-	if t.Spec.BootstrapUser.Enable {
-		template.Resources[AWSIAMUserBootstrapper] = &cfn_iam.User{
-			UserName:          t.Spec.BootstrapUser.UserName,
-			Groups:            t.bootstrapUserGroups(),
-			ManagedPolicyArns: t.Spec.ControlPlane.ExtraPolicyAttachments,
-			Policies:          t.bootstrapUserPolicy(),
-			Tags:              converters.MapToCloudFormationTags(t.Spec.BootstrapUser.Tags),
+	r := t.Resources()
+
+	for _, user := range r.Users {
+		policies := make([]cfn_iam.User_Policy, 0, len(user.Policies))
+		for _, p := range user.Policies {
+			policies = append(policies, cfn_iam.User_Policy{
+				PolicyName:     p.Name,
+				PolicyDocument: p.Document,
+			})
 		}
-
-		template.Resources[AWSIAMGroupBootstrapper] = &cfn_iam.Group{
-			GroupName: t.Spec.BootstrapUser.GroupName,
+		template.Resources[user.ID] = &cfn_iam.User{
+			UserName:          user.Name,
+			Groups:            append(cloudFormationRefs(user.Groups), user.ExtraGroups...),
+			ManagedPolicyArns: user.ManagedPolicyARNs,
+			Policies:          policies,
+			Tags:              converters.MapToCloudFormationTags(user.Tags),
 		}
 	}
 
-	template.Resources[string(ControllersPolicy)] = &cfn_iam.ManagedPolicy{
-		ManagedPolicyName: t.NewManagedName("controllers"),
-		Description:       `For the Kubernetes Cluster API Provider AWS Controllers`,
-		PolicyDocument:    t.ControllersPolicy(),
-		Groups:            t.controllersPolicyGroups(),
-		Roles:             t.controllersPolicyRoleAttachments(),
-	}
-
-	if !t.Spec.EKS.Disable {
-		template.Resources[string(ControllersPolicyEKS)] = &cfn_iam.ManagedPolicy{
-			ManagedPolicyName: t.NewManagedName("controllers-eks"),
-			Description:       `For the Kubernetes Cluster API Provider AWS Controllers`,
-			PolicyDocument:    t.ControllersPolicyEKS(),
-			Groups:            t.controllersPolicyGroups(),
-			Roles:             t.controllersPolicyRoleAttachments(),
+	for _, group := range r.Groups {
+		template.Resources[group.ID] = &cfn_iam.Group{
+			GroupName: group.Name,
 		}
 	}
 
-	if !t.Spec.ControlPlane.DisableCloudProviderPolicy {
-		template.Resources[string(ControlPlanePolicy)] = &cfn_iam.ManagedPolicy{
-			ManagedPolicyName: t.NewManagedName("control-plane"),
-			Description:       `For the Kubernetes Cloud Provider AWS Control Plane`,
-			PolicyDocument:    t.cloudProviderControlPlaneAwsPolicy(),
-			Roles:             t.cloudProviderControlPlaneAwsRoles(),
+	for _, policy := range r.ManagedPolicies {
+		template.Resources[policy.ID] = &cfn_iam.ManagedPolicy{
+			ManagedPolicyName: policy.Name,
+			Description:       policy.Description,
+			PolicyDocument:    policy.Document,
+			Groups:            cloudFormationRefs(policy.Groups),
+			Roles:             cloudFormationRefs(policy.Roles),
 		}
 	}
 
-	if !t.Spec.Nodes.DisableCloudProviderPolicy {
-		template.Resources[string(NodePolicy)] = &cfn_iam.ManagedPolicy{
-			ManagedPolicyName: t.NewManagedName("nodes"),
-			Description:       `For the Kubernetes Cloud Provider AWS nodes`,
-			PolicyDocument:    t.nodePolicy(),
-			Roles:             t.cloudProviderNodeAwsRoles(),
+	for _, role := range r.Roles {
+		policies := make([]cfn_iam.Role_Policy, 0, len(role.Policies))
+		for _, p := range role.Policies {
+			policies = append(policies, cfn_iam.Role_Policy{
+				PolicyName:     p.Name,
+				PolicyDocument: p.Document,
+			})
 		}
-	}
-
-	if t.Spec.ControlPlane.EnableCSIPolicy {
-		template.Resources[string(CSIPolicy)] = &cfn_iam.ManagedPolicy{
-			ManagedPolicyName: t.NewManagedName("csi"),
-			Description:       `For the AWS EBS CSI Driver for Kubernetes`,
-			PolicyDocument:    t.csiControllerPolicy(),
-			Roles:             t.csiControlPlaneAwsRoles(),
+		template.Resources[role.ID] = &cfn_iam.Role{
+			RoleName:                 role.Name,
+			Path:                     role.Path,
+			AssumeRolePolicyDocument: role.AssumeRolePolicy,
+			ManagedPolicyArns:        role.ManagedPolicyARNs,
+			Policies:                 policies,
+			PermissionsBoundary:      role.PermissionsBoundary,
+			Tags:                     converters.MapToCloudFormationTags(role.Tags),
 		}
 	}
 
-	template.Resources[AWSIAMRoleControlPlane] = &cfn_iam.Role{
-		RoleName:                 t.NewManagedName("control-plane"),
-		Path:                     t.Spec.ControlPlane.Path,
-		AssumeRolePolicyDocument: t.controlPlaneTrustPolicy(),
-		ManagedPolicyArns:        t.Spec.ControlPlane.ExtraPolicyAttachments,
-		Policies:                 t.controlPlanePolicies(),
-		PermissionsBoundary:      t.Spec.ControlPlane.PermissionsBoundary,
-		Tags:                     converters.MapToCloudFormationTags(t.Spec.ControlPlane.Tags),
-	}
-
-	template.Resources[AWSIAMRoleControllers] = &cfn_iam.Role{
-		RoleName:                 t.NewManagedName("controllers"),
-		Path:                     t.Spec.ControlPlane.Path,
-		AssumeRolePolicyDocument: t.controllersTrustPolicy(!t.Spec.EKS.Disable),
-		Policies:                 t.controllersRolePolicy(),
-		PermissionsBoundary:      t.Spec.ControlPlane.PermissionsBoundary,
-		Tags:                     converters.MapToCloudFormationTags(t.Spec.ClusterAPIControllers.Tags),
-	}
-
-	template.Resources[AWSIAMRoleNodes] = &cfn_iam.Role{
-		RoleName:                 t.NewManagedName("nodes"),
-		Path:                     t.Spec.ControlPlane.Path,
-		AssumeRolePolicyDocument: t.nodeTrustPolicy(),
-		ManagedPolicyArns:        t.nodeManagedPolicies(),
-		Policies:                 t.nodePolicies(),
-		PermissionsBoundary:      t.Spec.ControlPlane.PermissionsBoundary,
-		Tags:                     converters.MapToCloudFormationTags(t.Spec.Nodes.Tags),
-	}
-
-	template.Resources[AWSIAMInstanceProfileControlPlane] = &cfn_iam.InstanceProfile{
-		InstanceProfileName: t.NewManagedName("control-plane"),
-		Roles: []string{
-			cloudformation.Ref(AWSIAMRoleControlPlane),
-		},
-	}
-
-	template.Resources[AWSIAMInstanceProfileControllers] = &cfn_iam.InstanceProfile{
-		InstanceProfileName: t.NewManagedName("controllers"),
-		Roles: []string{
-			cloudformation.Ref(AWSIAMRoleControllers),
-		},
-	}
-
-	template.Resources[AWSIAMInstanceProfileNodes] = &cfn_iam.InstanceProfile{
-		InstanceProfileName: t.NewManagedName("nodes"),
-		Roles: []string{
-			cloudformation.Ref(AWSIAMRoleNodes),
-		},
-	}
-
-	if !t.Spec.EKS.DefaultControlPlaneRole.Disable && !t.Spec.EKS.Disable {
-		template.Resources[AWSIAMRoleEKSControlPlane] = &cfn_iam.Role{
-			RoleName:                 ekscontrolplanev1.DefaultEKSControlPlaneRole,
-			AssumeRolePolicyDocument: AssumeRolePolicy(iamv1.PrincipalService, []string{"eks.amazonaws.com"}),
-			ManagedPolicyArns:        t.eksControlPlanePolicies(),
-			Tags:                     converters.MapToCloudFormationTags(t.Spec.EKS.DefaultControlPlaneRole.Tags),
+	for _, profile := range r.InstanceProfiles {
+		template.Resources[profile.ID] = &cfn_iam.InstanceProfile{
+			InstanceProfileName: profile.Name,
+			Roles: []string{
+				cloudformation.Ref(profile.Role),
+			},
 		}
 	}
 
-	if !t.Spec.EKS.ManagedMachinePool.Disable && !t.Spec.EKS.Disable {
-		template.Resources[AWSIAMRoleEKSNodegroup] = &cfn_iam.Role{
-			RoleName:                 expinfrav1.DefaultEKSNodegroupRole,
-			AssumeRolePolicyDocument: AssumeRolePolicy(iamv1.PrincipalService, []string{"ec2.amazonaws.com", "eks.amazonaws.com"}),
-			ManagedPolicyArns:        t.eksMachinePoolPolicies(),
-			Tags:                     converters.MapToCloudFormationTags(t.Spec.EKS.ManagedMachinePool.Tags),
+	for _, provider := range r.OIDCProviders {
+		template.Resources[provider.ID] = &cfn_iam.OIDCProvider{
+			Url:            provider.URL,
+			ClientIdList:   provider.ClientIDs,
+			ThumbprintList: provider.Thumbprints,
+			Tags:           converters.MapToCloudFormationTags(provider.Tags),
 		}
 	}
 
-	if !t.Spec.EKS.Fargate.Disable && !t.Spec.EKS.Disable {
-		template.Resources[AWSIAMRoleEKSFargate] = &cfn_iam.Role{
-			RoleName:                 expinfrav1.DefaultEKSFargateRole,
-			AssumeRolePolicyDocument: AssumeRolePolicy(iamv1.PrincipalService, []string{eksiam.EKSFargateService}),
-			ManagedPolicyArns:        t.fargateProfilePolicies(t.Spec.EKS.Fargate),
-			Tags:                     converters.MapToCloudFormationTags(t.Spec.EKS.Fargate.Tags),
-		}
-	}
+	return template
+}
 
-	if t.Spec.EKS.EnableUserEKSConsolePolicy && !t.Spec.EKS.Disable {
-		template.Resources[string(EKSConsolePolicy)] = &cfn_iam.ManagedPolicy{
-			ManagedPolicyName: t.NewManagedName("eks-console"),
-			Description:       `For users/groups to view EKS nodes and workloads`,
-			PolicyDocument:    t.eksConsolePolicies(),
-		}
+// cloudFormationRefs returns references to the resources with the given IDs.
+func cloudFormationRefs(ids []string) []string {
+	refs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		refs = append(refs, cloudformation.Ref(id))
 	}
-
-	return template
+	return refs
 }
 
 func ec2AssumeRolePolicy(eksEnabled bool) *iamv1.PolicyDocument {
@@ -28,6 +28,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	bootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/api/bootstrap/v1beta1"
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
 )
 
@@ -69,6 +70,15 @@ func TestRenderCloudformation(t *testing.T) {
 				return t
 			},
 		},
+		{
+			fixture: "with_s3_bucket_kms",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.S3Buckets.Enable = true
+				t.Spec.S3Buckets.KMSKeyARNs = []string{"arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"}
+				return t
+			},
+		},
 		{
 			fixture: "customsuffix",
 			template: func() Template {
@@ -174,6 +184,14 @@ func TestRenderCloudformation(t *testing.T) {
 				return t
 			},
 		},
+		{
+			fixture: "with_eks_auto_mode",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.EKS.AutoMode = &bootstrapv1.EKSAutoModeConfig{Enable: true}
+				return t
+			},
+		},
 		{
 			fixture: "with_allow_assume_role",
 			template: func() Template {
@@ -182,6 +200,10 @@ func TestRenderCloudformation(t *testing.T) {
 				return t
 			},
 		},
+		{
+			fixture:  "with_oidc_provider",
+			template: withOIDCProviderTemplate,
+		},
 	}
 
 	for _, c := range cases {
@@ -0,0 +1,295 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+var nonTerraformNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// RenderTerraform renders the Template as Terraform HCL using the resources of the
+// hashicorp/aws provider.
+func (t Template) RenderTerraform() ([]byte, error) {
+	r := t.Resources()
+	w := &terraformWriter{names: map[string]bool{}}
+
+	for _, group := range r.Groups {
+		w.resource("aws_iam_group", terraformName(group.ID),
+			terraformAttr{"name", hclString(group.Name)},
+		)
+	}
+
+	for _, user := range r.Users {
+		name := terraformName(user.ID)
+		w.resource("aws_iam_user", name,
+			terraformAttr{"name", hclString(user.Name)},
+			terraformAttr{"tags", hclMap(user.Tags)},
+		)
+
+		groups := []string{}
+		for _, id := range user.Groups {
+			groups = append(groups, "aws_iam_group."+terraformName(id)+".name")
+		}
+		for _, g := range user.ExtraGroups {
+			groups = append(groups, hclString(g))
+		}
+		if len(groups) > 0 {
+			w.resource("aws_iam_user_group_membership", name,
+				terraformAttr{"user", "aws_iam_user." + name + ".name"},
+				terraformAttr{"groups", "[" + strings.Join(groups, ", ") + "]"},
+			)
+		}
+
+		for _, p := range user.Policies {
+			policy, err := hclJSON(p.Document)
+			if err != nil {
+				return nil, err
+			}
+			w.resource("aws_iam_user_policy", w.uniqueName(name+"_"+terraformName(p.Name)),
+				terraformAttr{"name", hclString(p.Name)},
+				terraformAttr{"user", "aws_iam_user." + name + ".name"},
+				terraformAttr{"policy", policy},
+			)
+		}
+
+		for _, arn := range user.ManagedPolicyARNs {
+			w.resource("aws_iam_user_policy_attachment", w.uniqueName(name+"_"+arnTerraformName(arn)),
+				terraformAttr{"user", "aws_iam_user." + name + ".name"},
+				terraformAttr{"policy_arn", hclString(arn)},
+			)
+		}
+	}
+
+	for _, policy := range r.ManagedPolicies {
+		name := terraformName(policy.ID)
+		document, err := hclJSON(policy.Document)
+		if err != nil {
+			return nil, err
+		}
+		w.resource("aws_iam_policy", name,
+			terraformAttr{"name", hclString(policy.Name)},
+			terraformAttr{"description", hclString(policy.Description)},
+			terraformAttr{"policy", document},
+		)
+
+		for _, id := range policy.Groups {
+			w.resource("aws_iam_group_policy_attachment", w.uniqueName(terraformName(id)+"_"+name),
+				terraformAttr{"group", "aws_iam_group." + terraformName(id) + ".name"},
+				terraformAttr{"policy_arn", "aws_iam_policy." + name + ".arn"},
+			)
+		}
+		for _, id := range policy.Roles {
+			w.resource("aws_iam_role_policy_attachment", w.uniqueName(terraformName(id)+"_"+name),
+				terraformAttr{"role", "aws_iam_role." + terraformName(id) + ".name"},
+				terraformAttr{"policy_arn", "aws_iam_policy." + name + ".arn"},
+			)
+		}
+	}
+
+	for _, role := range r.Roles {
+		name := terraformName(role.ID)
+		trust, err := hclJSON(role.AssumeRolePolicy)
+		if err != nil {
+			return nil, err
+		}
+		w.resource("aws_iam_role", name,
+			terraformAttr{"name", hclString(role.Name)},
+			terraformAttr{"path", hclString(role.Path)},
+			terraformAttr{"permissions_boundary", hclString(role.PermissionsBoundary)},
+			terraformAttr{"assume_role_policy", trust},
+			terraformAttr{"tags", hclMap(role.Tags)},
+		)
+
+		for _, p := range role.Policies {
+			policy, err := hclJSON(p.Document)
+			if err != nil {
+				return nil, err
+			}
+			w.resource("aws_iam_role_policy", w.uniqueName(name+"_"+terraformName(p.Name)),
+				terraformAttr{"name", hclString(p.Name)},
+				terraformAttr{"role", "aws_iam_role." + name + ".id"},
+				terraformAttr{"policy", policy},
+			)
+		}
+
+		for _, arn := range role.ManagedPolicyARNs {
+			w.resource("aws_iam_role_policy_attachment", w.uniqueName(name+"_"+arnTerraformName(arn)),
+				terraformAttr{"role", "aws_iam_role." + name + ".name"},
+				terraformAttr{"policy_arn", hclString(arn)},
+			)
+		}
+	}
+
+	for _, profile := range r.InstanceProfiles {
+		w.resource("aws_iam_instance_profile", terraformName(profile.ID),
+			terraformAttr{"name", hclString(profile.Name)},
+			terraformAttr{"role", "aws_iam_role." + terraformName(profile.Role) + ".name"},
+		)
+	}
+
+	for _, provider := range r.OIDCProviders {
+		w.resource("aws_iam_openid_connect_provider", terraformName(provider.ID),
+			terraformAttr{"url", hclString(provider.URL)},
+			terraformAttr{"client_id_list", hclStringList(provider.ClientIDs)},
+			terraformAttr{"thumbprint_list", hclStringList(provider.Thumbprints)},
+			terraformAttr{"tags", hclMap(provider.Tags)},
+		)
+	}
+
+	return w.buf.Bytes(), nil
+}
+
+// terraformAttr is a resource argument. Arguments with an empty value are omitted.
+type terraformAttr struct {
+	name  string
+	value string
+}
+
+type terraformWriter struct {
+	buf   bytes.Buffer
+	names map[string]bool
+}
+
+// uniqueName disambiguates resource names derived from user provided values.
+func (w *terraformWriter) uniqueName(name string) string {
+	unique := name
+	for i := 1; w.names[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	w.names[unique] = true
+	return unique
+}
+
+// resource writes a resource block, aligning consecutive arguments the way terraform
+// fmt does.
+func (w *terraformWriter) resource(resourceType, name string, attrs ...terraformAttr) {
+	w.names[name] = true
+	if w.buf.Len() > 0 {
+		w.buf.WriteString("\n")
+	}
+	fmt.Fprintf(&w.buf, "resource %q %q {\n", resourceType, name)
+
+	present := []terraformAttr{}
+	for _, a := range attrs {
+		if a.value != "" {
+			present = append(present, a)
+		}
+	}
+	for i := 0; i < len(present); {
+		// A multi-line argument ends the group of aligned arguments.
+		j, width := i, 0
+		for j < len(present) {
+			width = max(width, len(present[j].name))
+			j++
+			if strings.Contains(present[j-1].value, "\n") {
+				break
+			}
+		}
+		for ; i < j; i++ {
+			fmt.Fprintf(&w.buf, "  %-*s = %s\n", width, present[i].name, present[i].value)
+		}
+	}
+	w.buf.WriteString("}\n")
+}
+
+// terraformName converts a resource ID such as AWSIAMRoleControlPlane into a
+// Terraform resource name such as role_control_plane.
+func terraformName(id string) string {
+	id = strings.TrimPrefix(id, "AWSIAM")
+	var b strings.Builder
+	runes := []rune(id)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) &&
+			(unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return strings.Trim(nonTerraformNameChars.ReplaceAllString(b.String(), "_"), "_")
+}
+
+// arnTerraformName derives a Terraform resource name from the last segment of a policy ARN.
+func arnTerraformName(arn string) string {
+	return terraformName(arn[strings.LastIndex(arn, "/")+1:])
+}
+
+// hclString quotes s as an HCL string literal, escaping template sequences.
+func hclString(s string) string {
+	if s == "" {
+		return ""
+	}
+	return escapeHCLTemplate(strconv.Quote(s))
+}
+
+func hclStringList(list []string) string {
+	if len(list) == 0 {
+		return ""
+	}
+	quoted := make([]string, 0, len(list))
+	for _, s := range list {
+		quoted = append(quoted, escapeHCLTemplate(strconv.Quote(s)))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+func hclMap(m infrav1.Tags) string {
+	if len(m) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(m))
+	width := 0
+	for k := range m {
+		keys = append(keys, k)
+		width = max(width, len(escapeHCLTemplate(strconv.Quote(k))))
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "    %-*s = %s\n", width, escapeHCLTemplate(strconv.Quote(k)), escapeHCLTemplate(strconv.Quote(m[k])))
+	}
+	b.WriteString("  }")
+	return b.String()
+}
+
+// hclJSON renders v as a jsonencode() expression. HCL object constructors accept
+// JSON syntax, so the policy reads the same as in the AWS console.
+func hclJSON(v interface{}) (string, error) {
+	data, err := json.MarshalIndent(v, "  ", "  ")
+	if err != nil {
+		return "", err
+	}
+	return "jsonencode(" + escapeHCLTemplate(string(data)) + ")", nil
+}
+
+// escapeHCLTemplate escapes the HCL template sequences "${" and "%{", which appear
+// in IAM policy variables such as ${aws:username}.
+func escapeHCLTemplate(s string) string {
+	s = strings.ReplaceAll(s, "${", "$${")
+	return strings.ReplaceAll(s, "%{", "%%{")
+}
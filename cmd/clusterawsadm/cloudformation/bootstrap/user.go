@@ -17,27 +17,22 @@ limitations under the License.
 package bootstrap
 
 import (
-	"github.com/awslabs/goformation/v4/cloudformation"
-	cfn_iam "github.com/awslabs/goformation/v4/cloudformation/iam"
-
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
 )
 
 func (t Template) bootstrapUserGroups() []string {
-	groups := []string{
-		cloudformation.Ref(AWSIAMGroupBootstrapper),
+	return []string{
+		AWSIAMGroupBootstrapper,
 	}
-	groups = append(groups, t.Spec.BootstrapUser.ExtraGroups...)
-	return groups
 }
 
-func (t Template) bootstrapUserPolicy() []cfn_iam.User_Policy {
-	userPolicies := []cfn_iam.User_Policy{}
+func (t Template) bootstrapUserPolicy() []InlinePolicy {
+	userPolicies := []InlinePolicy{}
 	if t.Spec.BootstrapUser.ExtraStatements != nil {
 		userPolicies = append(userPolicies,
-			cfn_iam.User_Policy{
-				PolicyName: t.Spec.StackName,
-				PolicyDocument: iamv1.PolicyDocument{
+			InlinePolicy{
+				Name: t.Spec.StackName,
+				Document: iamv1.PolicyDocument{
 					Statement: t.Spec.BootstrapUser.ExtraStatements,
 					Version:   iamv1.CurrentVersion,
 				},
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cloudformation/bootstrap"
+	cmdout "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/printers"
+	metricsv2 "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/metrics"
+)
+
+func generatePolicyCmd() *cobra.Command {
+	newCmd := &cobra.Command{
+		Use:   "generate-policy",
+		Short: "Generate a least privileged IAM policy from recorded controller activity",
+		Long: templates.LongDesc(`
+			Generate a least privileged AWS Identity and Access Management (IAM) policy for
+			the Kubernetes Cluster API Provider AWS controllers from the IAM actions they
+			were observed to use.
+
+			Recordings are produced by running the controller manager with
+			--record-aws-permissions-configmap or --record-aws-permissions-file. The recording
+			may be given either as the recorded JSON file or as the recorded ConfigMap in YAML
+			or JSON, e.g. the output of 'kubectl get configmap -o yaml'.
+
+			The generated policy only covers the code paths exercised while recording, so
+			record against all the features and lifecycle operations (creation, scaling,
+			upgrades and deletion) that will be used.
+		`),
+		Example: templates.Examples(`
+		# Generate a policy from a recording.
+		clusterawsadm bootstrap iam generate-policy --from-recording recording.json
+
+		# Generate a policy from the recording ConfigMap of a running controller manager.
+		kubectl get configmap -n capa-system capa-permissions -o yaml > recording.yaml
+		clusterawsadm bootstrap iam generate-policy --from-recording recording.yaml
+
+		# Show which recorded actions are not granted, and which granted actions were not used,
+		# by the controller policies generated from a given configuration file.
+		clusterawsadm bootstrap iam generate-policy --from-recording recording.json --diff --config bootstrap_config.yaml
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := cmd.Flags().GetString("from-recording")
+			if err != nil {
+				return err
+			}
+			recording, err := loadPermissionRecording(path)
+			if err != nil {
+				return err
+			}
+
+			printer, err := cmdout.New("json", os.Stdout)
+			if err != nil {
+				return fmt.Errorf("failed creating output printer: %w", err)
+			}
+
+			showDiff, err := cmd.Flags().GetBool("diff")
+			if err != nil {
+				return err
+			}
+			if !showDiff {
+				return printer.Print(bootstrap.PolicyFromRecording(recording))
+			}
+
+			t, err := getBootstrapTemplate(cmd)
+			if err != nil {
+				return err
+			}
+			return printer.Print(t.DiffControllersPolicies(recording))
+		},
+	}
+	addConfigFlag(newCmd)
+	newCmd.Flags().String("from-recording", "", "File holding the recording of the IAM actions used by the controllers")
+	newCmd.Flags().Bool("diff", false, "Compare the recording against the controller policies instead of printing the generated policy")
+	_ = newCmd.MarkFlagRequired("from-recording")
+	return newCmd
+}
+
+// loadPermissionRecording reads a recording either as written to a file, or as the
+// ConfigMap it was written to.
+func loadPermissionRecording(path string) (metricsv2.PermissionRecording, error) {
+	recording := metricsv2.PermissionRecording{}
+
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return recording, fmt.Errorf("failed to read recording: %w", err)
+	}
+
+	configMap := struct {
+		Kind string            `json:"kind"`
+		Data map[string]string `json:"data"`
+	}{}
+	if err := yaml.Unmarshal(data, &configMap); err == nil && strings.EqualFold(configMap.Kind, "ConfigMap") {
+		recorded, ok := configMap.Data[metricsv2.PermissionRecordingKey]
+		if !ok {
+			return recording, fmt.Errorf("ConfigMap in %q has no %q key", path, metricsv2.PermissionRecordingKey)
+		}
+		data = []byte(recorded)
+	}
+
+	if err := yaml.Unmarshal(data, &recording); err != nil {
+		return recording, fmt.Errorf("failed to parse recording %q: %w", path, err)
+	}
+	return recording, nil
+}
@@ -17,8 +17,11 @@ limitations under the License.
 package iam
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
 
 	"github.com/spf13/cobra"
 	"k8s.io/kubectl/pkg/util/templates"
@@ -87,6 +90,98 @@ func printPolicyCmd() *cobra.Command {
 	return newCmd
 }
 
+func printIAMDocumentsCmd() *cobra.Command {
+	newCmd := &cobra.Command{
+		Use:   "print-iam-documents",
+		Short: "Generate and show the IAM policy documents for each role",
+		Long: templates.LongDesc(`
+			Generate and show the plain AWS Identity and Access Management (IAM) JSON policy
+			documents for each role used by Kubernetes Cluster API Provider AWS: the trust
+			policy, the attached customer managed and AWS managed policies, and the inline
+			policies. These are the same documents the CloudFormation template provisions,
+			for use with other tooling.
+		`),
+		Example: templates.Examples(`
+		# Print out the IAM policy documents for each role.
+		clusterawsadm bootstrap iam print-iam-documents
+
+		# Write each IAM policy document to its own file, e.g. for use with
+		# "aws iam create-role --assume-role-policy-document file://iam/roles/<role>/trust-policy.json".
+		clusterawsadm bootstrap iam print-iam-documents --config bootstrap_config.yaml --output-dir iam
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := getBootstrapTemplate(cmd)
+			if err != nil {
+				return err
+			}
+			docs := t.RenderIAMDocuments()
+
+			outputDir, err := cmd.Flags().GetString("output-dir")
+			if err != nil {
+				return err
+			}
+			if outputDir == "" {
+				printer, err := cmdout.New("json", os.Stdout)
+				if err != nil {
+					return fmt.Errorf("failed creating output printer: %w", err)
+				}
+				return printer.Print(docs)
+			}
+
+			return writeIAMDocuments(outputDir, docs)
+		},
+	}
+	addConfigFlag(newCmd)
+	newCmd.Flags().String("output-dir", "", "write each document to its own file in this directory instead of printing them")
+	return newCmd
+}
+
+// writeIAMDocuments writes the documents to dir as:
+//
+//	policies/<policy>.json
+//	roles/<role>/trust-policy.json
+//	roles/<role>/inline-policies/<policy>.json
+//	roles/<role>/role.json
+//
+// where role.json lists the attached policies and the other role settings.
+func writeIAMDocuments(dir string, docs bootstrap.IAMDocuments) error {
+	files := map[string]interface{}{}
+	for name, policy := range docs.Policies {
+		files[filepath.Join("policies", name+".json")] = policy
+	}
+	for _, role := range docs.Roles {
+		roleDir := filepath.Join("roles", role.Name)
+		files[filepath.Join(roleDir, "trust-policy.json")] = role.TrustPolicy
+		for name, policy := range role.InlinePolicies {
+			files[filepath.Join(roleDir, "inline-policies", name+".json")] = policy
+		}
+		files[filepath.Join(roleDir, "role.json")] = role
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		data, err := json.MarshalIndent(files[name], "", "  ")
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+			return err
+		}
+		fmt.Println(path)
+	}
+	return nil
+}
+
 func getPolicyName(cmd *cobra.Command) (bootstrap.PolicyName, error) {
 	val := bootstrap.PolicyName(cmd.Flags().Lookup("document").Value.String())
 
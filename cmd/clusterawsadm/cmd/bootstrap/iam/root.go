@@ -39,8 +39,11 @@ func RootCmd() *cobra.Command {
 	}
 
 	newCmd.AddCommand(printPolicyCmd())
+	newCmd.AddCommand(generatePolicyCmd())
 	newCmd.AddCommand(printConfigCmd())
 	newCmd.AddCommand(printCloudFormationTemplateCmd())
+	newCmd.AddCommand(printTerraformCmd())
+	newCmd.AddCommand(printIAMDocumentsCmd())
 	newCmd.AddCommand(createCloudFormationStackCmd())
 	newCmd.AddCommand(deleteCloudFormationStackCmd())
 	return newCmd
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+func printTerraformCmd() *cobra.Command {
+	newCmd := &cobra.Command{
+		Use:   "print-terraform",
+		Short: "Print Terraform configuration",
+		Long: templates.LongDesc(`
+			Generate and print out Terraform configuration, using the hashicorp/aws provider,
+			that provisions the same AWS Identity and Access Management (IAM) policies, roles,
+			instance profiles and OpenID Connect providers as the CloudFormation template.
+		`),
+		Example: templates.Examples(`
+		# Print out the default Terraform configuration.
+		clusterawsadm bootstrap iam print-terraform
+
+		# Write the Terraform configuration for a custom configuration to a file.
+		clusterawsadm bootstrap iam print-terraform --config bootstrap_config.yaml > capa-iam.tf
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := getBootstrapTemplate(cmd)
+			if err != nil {
+				return err
+			}
+
+			hcl, err := t.RenderTerraform()
+			if err != nil {
+				return err
+			}
+
+			fmt.Print(string(hcl))
+			return nil
+		},
+	}
+	addConfigFlag(newCmd)
+
+	return newCmd
+}
@@ -45,6 +45,7 @@ func RootCmd() *cobra.Command {
 	newCmd.AddCommand(credentials.PrintCredentialsCmd())
 	newCmd.AddCommand(rollout.RolloutControllersCmd())
 	newCmd.AddCommand(credentials.UseEKSPodIdentityCmd())
+	newCmd.AddCommand(credentials.UseRolesAnywhereCmd())
 
 	return newCmd
 }
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/controller/credentials"
+	awscredentials "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/credentials"
+)
+
+// UseRolesAnywhereCmd is a CLI command that will configure CAPA to use an IAM Roles Anywhere certificate.
+func UseRolesAnywhereCmd() *cobra.Command {
+	input := credentials.UseRolesAnywhereInput{}
+
+	newCmd := &cobra.Command{
+		Use:   "use-roles-anywhere",
+		Short: "Configure an IAM Roles Anywhere identity for CAPA",
+		Long: templates.LongDesc(`
+			Checks that the certificate can be exchanged for credentials with IAM Roles Anywhere,
+			stores the certificate and its private key in a secret of the controller namespace and
+			prints an AWSClusterRolesAnywhereIdentity using it. Set the allowedNamespaces of the
+			identity before applying it to the management cluster.
+		`),
+		Example: templates.Examples(`
+		clusterawsadm controller use-roles-anywhere --certificate tls.crt --private-key tls.key \
+			--trust-anchor-arn arn:aws:rolesanywhere:us-east-1:123456789012:trust-anchor/TA_ID \
+			--profile-arn arn:aws:rolesanywhere:us-east-1:123456789012:profile/PROFILE_ID \
+			--role-arn arn:aws:iam::123456789012:role/capa > identity.yaml
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// The credentials are requested in the region of the trust anchor. The configured region is
+			// only used when the trust anchor ARN has none.
+			if trustAnchorARN, err := arn.Parse(input.TrustAnchorARN); err != nil || trustAnchorARN.Region == "" {
+				region, err := awscredentials.ResolveRegion(input.Region)
+				if err != nil {
+					return err
+				}
+				input.Region = region
+			}
+			input.KubeconfigPath = kubeconfigPath
+			input.KubeconfigContext = kubeconfigContext
+			input.Namespace = namespace
+
+			return credentials.UseRolesAnywhere(cmd.Context(), input)
+		},
+	}
+
+	newCmd.Flags().StringVar(&input.IdentityName, "name", "roles-anywhere", "The name of the AWSClusterRolesAnywhereIdentity")
+	newCmd.Flags().StringVar(&input.SecretName, "secret-name", "capa-roles-anywhere-certificate", "The name of the secret storing the certificate and private key")
+	newCmd.Flags().StringVar(&input.CertificateFile, "certificate", "", "Path to the PEM encoded certificate issued by the trust anchor")
+	newCmd.Flags().StringVar(&input.PrivateKeyFile, "private-key", "", "Path to the PEM encoded private key of the certificate")
+	newCmd.Flags().StringVar(&input.CertificateChainFile, "certificate-chain", "", "Path to the PEM encoded intermediate certificates, if any")
+	newCmd.Flags().StringVar(&input.TrustAnchorARN, "trust-anchor-arn", "", "The ARN of the Roles Anywhere trust anchor")
+	newCmd.Flags().StringVar(&input.ProfileARN, "profile-arn", "", "The ARN of the Roles Anywhere profile")
+	newCmd.Flags().StringVar(&input.RoleARN, "role-arn", "", "The ARN of the role to assume")
+	newCmd.Flags().StringVarP(&input.Region, "region", "r", "", "The AWS region used when the trust anchor ARN has no region")
+	addKubeconfigFlag(newCmd)
+	addKubeconfigContextFlag(newCmd)
+	addNamespaceFlag(newCmd)
+
+	for _, flag := range []string{"certificate", "private-key", "trust-anchor-arn", "profile-arn", "role-arn"} {
+		newCmd.MarkFlagRequired(flag) //nolint: errcheck
+	}
+
+	return newCmd
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package doctor provides a command to check that a cluster can be created.
+package doctor
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/util/homedir"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	doctorproc "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/doctor"
+	cmdout "sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/printers"
+)
+
+// RootCmd is the doctor command.
+func RootCmd() *cobra.Command {
+	var (
+		clusterName       string
+		namespace         string
+		kubeConfig        string
+		kubeConfigDefault string
+		fromFile          string
+		outputPrinter     string
+	)
+
+	if home := homedir.HomeDir(); home != "" {
+		kubeConfigDefault = filepath.Join(home, ".kube", "config")
+	}
+
+	newCmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that the AWS account is ready for a cluster to be created",
+		Long: templates.LongDesc(`
+			Check a cluster definition against the AWS account it is created in,
+			using the same scopes and services as the controllers without
+			modifying anything.
+
+			The cluster is read from a file, e.g. the output of clusterctl
+			generate cluster, or from a management cluster. The checks cover the
+			credentials of the cluster identity, the existing VPC and subnets
+			and their tags, availability zones, service quotas, the instance
+			types, AMIs and instance profiles of the machine templates, and a
+			simulation of the controller IAM policies for the identity.
+
+			Clusters using the controller identity are checked with the local
+			AWS credentials in place of the controller's.
+
+			The command exits with a non-zero status if any check fails.
+		`),
+		Example: templates.Examples(`
+			# Check a cluster before applying it
+			clusterctl generate cluster test-cluster --infrastructure aws > cluster.yaml
+			clusterawsadm doctor --from-file cluster.yaml
+
+			# Check a cluster of the management cluster of the current context
+			clusterawsadm doctor --cluster-name=test-cluster
+
+			# Output the report as JSON
+			clusterawsadm doctor --cluster-name=test-cluster --kubeconfig=test.kubeconfig -o json
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if fromFile == "" && clusterName == "" {
+				return fmt.Errorf("either --cluster-name or --from-file is required")
+			}
+
+			printer, err := cmdout.New(outputPrinter, os.Stdout)
+			if err != nil {
+				return fmt.Errorf("failed creating output printer: %w", err)
+			}
+
+			opts := []doctorproc.DoctorOption{}
+			if fromFile != "" {
+				var r io.Reader = os.Stdin
+				if fromFile != "-" {
+					f, err := os.Open(fromFile) //nolint:gosec
+					if err != nil {
+						return fmt.Errorf("opening %s: %w", fromFile, err)
+					}
+					defer f.Close() //nolint:errcheck
+					r = f
+				}
+				opts = append(opts, doctorproc.WithObjects(r))
+			}
+
+			proc, err := doctorproc.New(doctorproc.DoctorInput{
+				ClusterName:    clusterName,
+				Namespace:      namespace,
+				KubeconfigPath: kubeConfig,
+			}, opts...)
+			if err != nil {
+				return fmt.Errorf("creating doctor: %w", err)
+			}
+
+			report, err := proc.Run(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("checking cluster: %w", err)
+			}
+
+			if outputPrinter == string(cmdout.PrinterTypeTable) {
+				if err := printer.Print(report.ToTable()); err != nil {
+					return err
+				}
+				fmt.Fprintf(os.Stdout, "\n%s in %s: %d passed, %d warnings, %d failed, %d skipped\n", report.Cluster, report.Region,
+					report.Count(doctorproc.StatusPass), report.Count(doctorproc.StatusWarn), report.Count(doctorproc.StatusFail), report.Count(doctorproc.StatusSkip))
+			} else if err := printer.Print(report); err != nil {
+				return err
+			}
+
+			if report.Failed() {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("%d checks failed", report.Count(doctorproc.StatusFail))
+			}
+
+			return nil
+		},
+	}
+
+	newCmd.Flags().StringVar(&clusterName, "cluster-name", "", "The name of the CAPA cluster. May be omitted with --from-file if the file defines a single cluster")
+	newCmd.Flags().StringVarP(&namespace, "namespace", "n", "default", "The namespace for the cluster definition")
+	newCmd.Flags().StringVar(&kubeConfig, "kubeconfig", kubeConfigDefault, "Path to the kubeconfig file to use")
+	newCmd.Flags().StringVarP(&fromFile, "from-file", "f", "", "Path to a file defining the cluster to check instead of reading it from a management cluster, or - for stdin")
+	newCmd.Flags().StringVarP(&outputPrinter, "output", "o", string(cmdout.PrinterTypeTable), "The output format of the report. Possible values: table,json,yaml")
+
+	return newCmd
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/controller"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/identity"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+)
+
+// UseRolesAnywhereInput defines the specs for use Roles Anywhere input.
+type UseRolesAnywhereInput struct {
+	KubeconfigPath    string
+	KubeconfigContext string
+	Namespace         string
+
+	IdentityName         string
+	SecretName           string
+	CertificateFile      string
+	PrivateKeyFile       string
+	CertificateChainFile string
+	TrustAnchorARN       string
+	ProfileARN           string
+	RoleARN              string
+	Region               string
+}
+
+// UseRolesAnywhere checks the certificate can be exchanged for credentials with IAM Roles Anywhere,
+// stores it in a secret of the controller namespace and prints the AWSClusterRolesAnywhereIdentity using it.
+func UseRolesAnywhere(ctx context.Context, input UseRolesAnywhereInput) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      input.SecretName,
+			Namespace: input.Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{},
+	}
+	for key, file := range map[string]string{
+		corev1.TLSCertKey:       input.CertificateFile,
+		corev1.TLSPrivateKeyKey: input.PrivateKeyFile,
+		"ca.crt":                input.CertificateChainFile,
+	} {
+		if file == "" {
+			continue
+		}
+		data, err := os.ReadFile(file) //nolint:gosec
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", file, err)
+		}
+		secret.Data[key] = data
+	}
+
+	rolesAnywhereIdentity := &infrav1.AWSClusterRolesAnywhereIdentity{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: infrav1.GroupVersion.String(),
+			Kind:       string(infrav1.ClusterRolesAnywhereIdentityKind),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: input.IdentityName,
+		},
+		Spec: infrav1.AWSClusterRolesAnywhereIdentitySpec{
+			SecretRef:      input.SecretName,
+			TrustAnchorARN: input.TrustAnchorARN,
+			ProfileARN:     input.ProfileARN,
+			RoleARN:        input.RoleARN,
+		},
+	}
+
+	provider := identity.NewAWSRolesAnywherePrincipalTypeProvider(rolesAnywhereIdentity, secret, input.Region, logger.NewLogger(klog.Background()))
+	creds, err := provider.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("creating a Roles Anywhere session: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Created a Roles Anywhere session for role %s, valid until %s\n", input.RoleARN, creds.Expires.Format("2006-01-02T15:04:05Z07:00"))
+
+	client, err := controller.GetClient(input.KubeconfigPath, input.KubeconfigContext)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to get client-go client for the cluster: %s\n", err.Error())
+		return err
+	}
+
+	existing, err := client.CoreV1().Secrets(input.Namespace).Get(ctx, input.SecretName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		if _, err := client.CoreV1().Secrets(input.Namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to create Roles Anywhere certificate secret: %s\n", err.Error())
+			return err
+		}
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "Failed to get Roles Anywhere certificate secret: %s\n", err.Error())
+		return err
+	default:
+		existing.Data = secret.Data
+		if _, err := client.CoreV1().Secrets(input.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to update Roles Anywhere certificate secret: %s\n", err.Error())
+			return err
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Stored the certificate in secret %s/%s\n", input.Namespace, input.SecretName)
+
+	out, err := yaml.Marshal(rolesAnywhereIdentity)
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(out))
+	return nil
+}
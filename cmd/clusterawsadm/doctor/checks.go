@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// Names of the checks.
+const (
+	// CheckReferences checks that the objects referenced by the cluster exist.
+	CheckReferences = "References"
+	// CheckRegion checks that the cluster sets a region.
+	CheckRegion = "Region"
+	// CheckIdentity checks that credentials can be obtained for the identity of the cluster.
+	CheckIdentity = "Identity"
+	// CheckAvailabilityZones checks that the availability zones used by the cluster are available.
+	CheckAvailabilityZones = "AvailabilityZones"
+	// CheckVPC checks that an existing VPC used by the cluster exists.
+	CheckVPC = "VPC"
+	// CheckSubnets checks that existing subnets used by the cluster exist and are tagged.
+	CheckSubnets = "Subnets"
+	// CheckServiceQuotas checks that the quotas of the resources the controller creates are not exhausted.
+	CheckServiceQuotas = "ServiceQuotas"
+	// CheckInstanceType checks that instance types are offered in the availability zones of the cluster.
+	CheckInstanceType = "InstanceType"
+	// CheckAMI checks that the AMI of each machine template can be resolved and is usable by the account.
+	CheckAMI = "AMI"
+	// CheckInstanceProfile checks that the instance profiles of machine templates exist.
+	CheckInstanceProfile = "InstanceProfile"
+	// CheckControlPlaneRole checks that the IAM role of an EKS control plane exists.
+	CheckControlPlaneRole = "ControlPlaneRole"
+	// CheckPermissions checks that the identity of the cluster is allowed the actions of the controller policies.
+	CheckPermissions = "IAMPermissions"
+)
+
+// defaultMaxNumAZs mirrors the default number of availability zones the network
+// service creates subnets in.
+const defaultMaxNumAZs = 3
+
+// checker runs the checks against a single scope.
+type checker struct {
+	scope    clusterScope
+	target   *target
+	clients  awsClients
+	report   *Report
+	fromFile bool
+
+	accountID string
+	callerARN string
+	// zones holds the availability zones of the region by name.
+	zones map[string]ec2types.AvailabilityZone
+	// clusterZones lists the availability zones the cluster has subnets in.
+	clusterZones []string
+}
+
+func (c *checker) run(ctx context.Context) {
+	if c.scope.Region() == "" {
+		c.add(Result{
+			Check:       CheckRegion,
+			Object:      c.scope.InfraClusterName(),
+			Status:      StatusFail,
+			Message:     "The cluster does not set spec.region",
+			Remediation: "Set spec.region to the AWS region to create the cluster in",
+		})
+		return
+	}
+
+	// Nothing else can be checked without credentials.
+	if !c.checkIdentity(ctx) {
+		return
+	}
+
+	c.checkAvailabilityZones(ctx)
+	c.checkNetwork(ctx)
+	c.checkServiceQuotas(ctx)
+	c.checkMachineTemplates(ctx)
+	c.checkControlPlaneRole(ctx)
+	c.checkPermissions(ctx)
+}
+
+func (c *checker) add(results ...Result) {
+	c.report.add(results...)
+}
+
+func (c *checker) checkIdentity(ctx context.Context) bool {
+	ref := c.target.identityRef()
+
+	out, err := c.clients.STS.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		c.add(Result{
+			Check:       CheckIdentity,
+			Object:      identityName(ref),
+			Status:      StatusFail,
+			Message:     fmt.Sprintf("Failed to get the caller identity: %v", err),
+			Remediation: identityRemediation(ref, c.fromFile),
+		})
+		return false
+	}
+
+	c.accountID = aws.ToString(out.Account)
+	c.callerARN = aws.ToString(out.Arn)
+
+	message := fmt.Sprintf("Authenticated as %s in account %s", c.callerARN, c.accountID)
+	if usesControllerCredentials(ref) {
+		message += ", the local credentials are used in place of the controller's"
+	}
+	c.add(Result{
+		Check:   CheckIdentity,
+		Object:  identityName(ref),
+		Status:  StatusPass,
+		Message: message,
+	})
+	return true
+}
+
+func (c *checker) checkAvailabilityZones(ctx context.Context) {
+	region := c.scope.Region()
+
+	out, err := c.clients.EC2.DescribeAvailabilityZones(ctx, &ec2.DescribeAvailabilityZonesInput{
+		AllAvailabilityZones: aws.Bool(true),
+	})
+	if err != nil {
+		c.add(Result{
+			Check:   CheckAvailabilityZones,
+			Object:  region,
+			Status:  StatusFail,
+			Message: fmt.Sprintf("Failed to describe the availability zones of %s: %v", region, err),
+		})
+		return
+	}
+
+	c.zones = map[string]ec2types.AvailabilityZone{}
+	for _, zone := range out.AvailabilityZones {
+		c.zones[aws.ToString(zone.ZoneName)] = zone
+	}
+	available := c.availableZones()
+
+	referenced := map[string]bool{}
+	for _, subnet := range c.scope.Subnets() {
+		if subnet.AvailabilityZone != "" {
+			referenced[subnet.AvailabilityZone] = true
+		}
+	}
+	for _, m := range c.target.machines {
+		if m.failureDomain != "" {
+			referenced[m.failureDomain] = true
+		}
+	}
+
+	if len(referenced) == 0 {
+		if len(available) == 0 {
+			c.add(Result{
+				Check:       CheckAvailabilityZones,
+				Object:      region,
+				Status:      StatusFail,
+				Message:     fmt.Sprintf("No availability zones are available in %s", region),
+				Remediation: "Check that the region is enabled for the account",
+			})
+			return
+		}
+		c.add(Result{
+			Check:   CheckAvailabilityZones,
+			Object:  region,
+			Status:  StatusPass,
+			Message: fmt.Sprintf("%d availability zones are available in %s", len(available), region),
+		})
+		return
+	}
+
+	for _, name := range sortedKeys(referenced) {
+		result := Result{
+			Check:  CheckAvailabilityZones,
+			Object: name,
+			Status: StatusFail,
+		}
+
+		zone, ok := c.zones[name]
+		switch {
+		case !ok:
+			result.Message = fmt.Sprintf("Availability zone %s does not exist in %s", name, region)
+			result.Remediation = fmt.Sprintf("Use one of the availability zones of %s: %s", region, strings.Join(available, ", "))
+		case zone.OptInStatus == ec2types.AvailabilityZoneOptInStatusNotOptedIn:
+			result.Message = fmt.Sprintf("The account is not opted in to availability zone %s", name)
+			result.Remediation = fmt.Sprintf("Opt in to the zone group %s, or use another availability zone", aws.ToString(zone.GroupName))
+		case zone.State != ec2types.AvailabilityZoneStateAvailable:
+			result.Message = fmt.Sprintf("Availability zone %s is %s", name, zone.State)
+			result.Remediation = fmt.Sprintf("Use one of the availability zones of %s: %s", region, strings.Join(available, ", "))
+		default:
+			result.Status = StatusPass
+			result.Message = fmt.Sprintf("Availability zone %s is available", name)
+		}
+		c.add(result)
+	}
+}
+
+// availableZones returns the names of the available availability zones of the
+// region, excluding local and wavelength zones, as the network service does when
+// picking zones for default subnets.
+func (c *checker) availableZones() []string {
+	zones := []string{}
+	for name, zone := range c.zones {
+		if zone.State != ec2types.AvailabilityZoneStateAvailable || aws.ToString(zone.ZoneType) != "availability-zone" {
+			continue
+		}
+		zones = append(zones, name)
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+// defaultZones returns the availability zones the network service creates subnets
+// in when none are specified. Only the ordered selection scheme is predictable, all
+// available zones are returned otherwise.
+func (c *checker) defaultZones() []string {
+	zones := c.availableZones()
+	vpc := c.scope.VPC()
+
+	maxZones := defaultMaxNumAZs
+	if vpc.AvailabilityZoneUsageLimit != nil {
+		maxZones = *vpc.AvailabilityZoneUsageLimit
+	}
+	if vpc.AvailabilityZoneSelection != nil && *vpc.AvailabilityZoneSelection != infrav1.AZSelectionSchemeOrdered {
+		return zones
+	}
+	if len(zones) > maxZones {
+		zones = zones[:maxZones]
+	}
+	return zones
+}
+
+func identityName(ref *infrav1.AWSIdentityReference) string {
+	if ref == nil {
+		return "default credentials"
+	}
+	return fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+}
+
+// usesControllerCredentials returns true if the cluster is reconciled with the
+// credentials of the controller, which the doctor substitutes with its own.
+func usesControllerCredentials(ref *infrav1.AWSIdentityReference) bool {
+	return ref == nil || ref.Kind == infrav1.ControllerIdentityKind
+}
+
+func identityRemediation(ref *infrav1.AWSIdentityReference, fromFile bool) string {
+	switch {
+	case usesControllerCredentials(ref):
+		return "Check the local AWS credentials, e.g. with aws sts get-caller-identity. They are used in place of the controller's"
+	case fromFile:
+		return fmt.Sprintf("Include the %s and any secret it references in the file, or run the doctor against the management cluster", identityName(ref))
+	default:
+		return fmt.Sprintf("Check that %s exists, allows the namespace of the cluster and that its credentials or role can be used", identityName(ref))
+	}
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
+	stsservice "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts"
+)
+
+// clusterScope is implemented by both the ClusterScope and the
+// ManagedControlPlaneScope.
+type clusterScope interface {
+	scope.NetworkScope
+
+	// ImageLookupFormat returns the format string to use when looking up AMIs.
+	ImageLookupFormat() string
+	// ImageLookupOrg returns the organization name to use when looking up AMIs.
+	ImageLookupOrg() string
+	// ImageLookupBaseOS returns the base operating system name to use when looking up AMIs.
+	ImageLookupBaseOS() string
+	// Partition returns the AWS partition of the cluster.
+	Partition() string
+}
+
+// IAMAPI defines the IAM operations used by the checks.
+type IAMAPI interface {
+	GetInstanceProfile(ctx context.Context, params *iam.GetInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.GetInstanceProfileOutput, error)
+	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	SimulatePrincipalPolicy(ctx context.Context, params *iam.SimulatePrincipalPolicyInput, optFns ...func(*iam.Options)) (*iam.SimulatePrincipalPolicyOutput, error)
+}
+
+// ServiceQuotasAPI defines the Service Quotas operations used by the checks.
+type ServiceQuotasAPI interface {
+	GetAWSDefaultServiceQuota(ctx context.Context, params *servicequotas.GetAWSDefaultServiceQuotaInput, optFns ...func(*servicequotas.Options)) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error)
+	GetServiceQuota(ctx context.Context, params *servicequotas.GetServiceQuotaInput, optFns ...func(*servicequotas.Options)) (*servicequotas.GetServiceQuotaOutput, error)
+}
+
+// awsClients holds the AWS clients used by the checks.
+type awsClients struct {
+	EC2           common.EC2API
+	IAM           IAMAPI
+	STS           stsservice.STSClient
+	ServiceQuotas ServiceQuotasAPI
+}
+
+// newAWSClients creates the AWS clients from the session of the scope, the same
+// way the services used by the controllers do.
+func newAWSClients(sc clusterScope) awsClients {
+	return awsClients{
+		EC2:           scope.NewEC2Client(sc, sc, sc, sc.InfraCluster()),
+		IAM:           scope.NewIAMClient(sc, sc, sc, sc.InfraCluster()),
+		STS:           scope.NewSTSClient(sc, sc, sc, sc.InfraCluster()),
+		ServiceQuotas: scope.NewServiceQuotasClient(sc, sc, sc, sc.InfraCluster()),
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package doctor checks that the AWS account and the cluster definition are ready
+// for a cluster to be created, using the same scopes and services as the controllers.
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	_ "k8s.io/client-go/plugin/pkg/client/auth/exec" // import all auth plugins
+	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc" // import all oidc plugins
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	infrav1beta1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1beta1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta1"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// ControllerName is the name the doctor identifies itself with to the scopes, and
+// in the user agent of AWS API calls.
+const ControllerName = "clusterawsadm-doctor"
+
+var (
+	scheme = runtime.NewScheme()
+
+	// ErrReadOnly is returned by the Kubernetes client used by the doctor for any
+	// attempt to modify an object.
+	ErrReadOnly = errors.New("the doctor does not modify objects")
+)
+
+func init() {
+	_ = corev1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+	_ = clusterv1beta1.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+	_ = infrav1beta1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	_ = ekscontrolplanev1beta1.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+}
+
+// Doctor runs the checks for a single cluster.
+type Doctor struct {
+	client client.Client
+	// objects holds the objects loaded from a file that are not known to the
+	// scheme, such as control plane providers other than EKS.
+	objects []*unstructured.Unstructured
+	// fromFile is true when the objects were loaded from a file rather than a
+	// management cluster.
+	fromFile bool
+
+	clusterName string
+	namespace   string
+
+	newClients func(clusterScope) awsClients
+}
+
+// DoctorInput holds the configuration for the doctor.
+type DoctorInput struct {
+	ClusterName    string
+	Namespace      string
+	KubeconfigPath string
+}
+
+// DoctorOption is a function type to supply options when creating the doctor.
+type DoctorOption func(d *Doctor) error
+
+// WithClient is an option that enable you to explicitly supply a client. Writes made
+// through the client are rejected.
+func WithClient(c client.WithWatch) DoctorOption {
+	return func(d *Doctor) error {
+		d.client = readOnly(c)
+
+		return nil
+	}
+}
+
+// WithObjects is an option to check a cluster defined by the objects read from r,
+// rather than one from a management cluster. r may hold several YAML documents or a
+// List, as printed by clusterctl generate cluster.
+func WithObjects(r io.Reader) DoctorOption {
+	return func(d *Doctor) error {
+		typed, others, err := loadObjects(r, d.namespace)
+		if err != nil {
+			return fmt.Errorf("loading objects: %w", err)
+		}
+
+		d.client = readOnly(fake.NewClientBuilder().WithScheme(scheme).WithObjects(typed...).Build())
+		d.objects = others
+		d.fromFile = true
+
+		return nil
+	}
+}
+
+// New creates a new doctor.
+func New(input DoctorInput, opts ...DoctorOption) (*Doctor, error) {
+	d := &Doctor{
+		clusterName: input.ClusterName,
+		namespace:   input.Namespace,
+		newClients:  newAWSClients,
+	}
+
+	for _, opt := range opts {
+		if err := opt(d); err != nil {
+			return nil, fmt.Errorf("applying option: %w", err)
+		}
+	}
+
+	if d.client == nil {
+		config, err := clientcmd.BuildConfigFromFlags("", input.KubeconfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("building client config: %w", err)
+		}
+
+		cl, err := client.NewWithWatch(config, client.Options{Scheme: scheme})
+		if err != nil {
+			return nil, fmt.Errorf("creating new client: %w", err)
+		}
+
+		d.client = readOnly(cl)
+	}
+
+	return d, nil
+}
+
+// Run runs all checks against the cluster. An error is only returned when the
+// cluster cannot be loaded, problems found by the checks are part of the report.
+func (d *Doctor) Run(ctx context.Context) (*Report, error) {
+	t, err := d.resolveTarget(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Cluster: fmt.Sprintf("%s/%s", t.cluster.Namespace, t.cluster.Name),
+	}
+	report.add(t.results...)
+
+	sc, err := d.newScope(t)
+	if err != nil {
+		report.add(Result{
+			Check:       CheckIdentity,
+			Object:      identityName(t.identityRef()),
+			Status:      StatusFail,
+			Message:     fmt.Sprintf("Failed to create an AWS session for the cluster: %v", err),
+			Remediation: identityRemediation(t.identityRef(), d.fromFile),
+		})
+		return report, nil
+	}
+	report.Region = sc.Region()
+
+	c := &checker{
+		scope:    sc,
+		target:   t,
+		clients:  d.newClients(sc),
+		report:   report,
+		fromFile: d.fromFile,
+	}
+	c.run(ctx)
+
+	return report, nil
+}
+
+// readOnly wraps c so that every write fails with ErrReadOnly. The scopes and
+// services never write before Close or Patch is called, this guards against that
+// changing.
+func readOnly(c client.WithWatch) client.Client {
+	return interceptor.NewClient(c, interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+			return ErrReadOnly
+		},
+		Delete: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.DeleteOption) error {
+			return ErrReadOnly
+		},
+		DeleteAllOf: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.DeleteAllOfOption) error {
+			return ErrReadOnly
+		},
+		Update: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.UpdateOption) error {
+			return ErrReadOnly
+		},
+		Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+			return ErrReadOnly
+		},
+		Apply: func(_ context.Context, _ client.WithWatch, _ runtime.ApplyConfiguration, _ ...client.ApplyOption) error {
+			return ErrReadOnly
+		},
+		SubResourceCreate: func(_ context.Context, _ client.Client, _ string, _ client.Object, _ client.Object, _ ...client.SubResourceCreateOption) error {
+			return ErrReadOnly
+		},
+		SubResourceUpdate: func(_ context.Context, _ client.Client, _ string, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+			return ErrReadOnly
+		},
+		SubResourcePatch: func(_ context.Context, _ client.Client, _ string, _ client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+			return ErrReadOnly
+		},
+	})
+}
@@ -0,0 +1,568 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	testAccountID = "123456789012"
+
+	testCluster = `
+apiVersion: cluster.x-k8s.io/v1beta1
+kind: Cluster
+metadata:
+  name: test-cluster
+spec:
+  controlPlaneRef:
+    apiVersion: controlplane.cluster.x-k8s.io/v1beta1
+    kind: KubeadmControlPlane
+    name: test-cluster-control-plane
+  infrastructureRef:
+    apiVersion: infrastructure.cluster.x-k8s.io/v1beta2
+    kind: AWSCluster
+    name: test-cluster
+---
+apiVersion: infrastructure.cluster.x-k8s.io/v1beta2
+kind: AWSCluster
+metadata:
+  name: test-cluster
+spec:
+  region: us-west-2
+  network:
+    vpc:
+      id: vpc-1
+    subnets:
+    - id: subnet-public
+      isPublic: true
+    - id: subnet-private
+---
+apiVersion: controlplane.cluster.x-k8s.io/v1beta1
+kind: KubeadmControlPlane
+metadata:
+  name: test-cluster-control-plane
+spec:
+  replicas: 3
+  version: v1.32.0
+  machineTemplate:
+    infrastructureRef:
+      apiVersion: infrastructure.cluster.x-k8s.io/v1beta2
+      kind: AWSMachineTemplate
+      name: test-cluster-control-plane
+---
+apiVersion: infrastructure.cluster.x-k8s.io/v1beta2
+kind: AWSMachineTemplate
+metadata:
+  name: test-cluster-control-plane
+spec:
+  template:
+    spec:
+      instanceType: t3.large
+      iamInstanceProfile: control-plane.cluster-api-provider-aws.sigs.k8s.io
+---
+apiVersion: cluster.x-k8s.io/v1beta1
+kind: MachineDeployment
+metadata:
+  name: test-cluster-md-0
+spec:
+  clusterName: test-cluster
+  template:
+    spec:
+      clusterName: test-cluster
+      version: v1.32.0
+      bootstrap:
+        configRef:
+          apiVersion: bootstrap.cluster.x-k8s.io/v1beta1
+          kind: KubeadmConfigTemplate
+          name: test-cluster-md-0
+      infrastructureRef:
+        apiVersion: infrastructure.cluster.x-k8s.io/v1beta2
+        kind: AWSMachineTemplate
+        name: test-cluster-md-0
+`
+
+	testWorkerTemplate = `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: infrastructure.cluster.x-k8s.io/v1beta2
+  kind: AWSMachineTemplate
+  metadata:
+    name: test-cluster-md-0
+  spec:
+    template:
+      spec:
+        instanceType: m6g.large
+        iamInstanceProfile: nodes.cluster-api-provider-aws.sigs.k8s.io
+        ami:
+          id: ami-private
+`
+)
+
+func TestLoadObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	typed, others, err := loadObjects(strings.NewReader(testCluster+"---"+testWorkerTemplate), "test-namespace")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	kinds := map[string]client.Object{}
+	for _, obj := range typed {
+		kinds[obj.GetObjectKind().GroupVersionKind().Kind+"/"+obj.GetName()] = obj
+	}
+	g.Expect(kinds).To(HaveLen(6))
+
+	cluster, ok := kinds["Cluster/test-cluster"].(*clusterv1.Cluster)
+	g.Expect(ok).To(BeTrue(), "the v1beta1 Cluster should be converted to the hub version")
+	g.Expect(cluster.Namespace).To(Equal("test-namespace"))
+	g.Expect(cluster.Spec.InfrastructureRef.Kind).To(Equal("AWSCluster"))
+	g.Expect(cluster.Spec.ControlPlaneRef.APIGroup).To(Equal("controlplane.cluster.x-k8s.io"))
+
+	g.Expect(kinds).To(HaveKey("AWSMachineTemplate/test-cluster-md-0"), "items of a List should be loaded")
+
+	identity, ok := kinds["AWSClusterControllerIdentity/default"]
+	g.Expect(ok).To(BeTrue(), "a default controller identity should be added")
+	g.Expect(identity.GetNamespace()).To(BeEmpty())
+
+	g.Expect(others).To(HaveLen(1))
+	g.Expect(others[0].GetKind()).To(Equal("KubeadmControlPlane"))
+	g.Expect(others[0].GetNamespace()).To(Equal("test-namespace"))
+}
+
+func TestResolveTarget(t *testing.T) {
+	testCases := []struct {
+		name            string
+		objects         string
+		clusterName     string
+		expectErr       bool
+		expectMachines  []string
+		expectReference bool
+	}{
+		{
+			name:           "cluster name is found from the file",
+			objects:        testCluster + "---" + testWorkerTemplate,
+			expectMachines: []string{"test-cluster-control-plane", "test-cluster-md-0"},
+		},
+		{
+			name:        "cluster not in the file",
+			objects:     testCluster,
+			clusterName: "other-cluster",
+			expectErr:   true,
+		},
+		{
+			name:            "missing machine template",
+			objects:         testCluster,
+			clusterName:     "test-cluster",
+			expectMachines:  []string{"test-cluster-control-plane"},
+			expectReference: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			d, err := New(DoctorInput{ClusterName: tc.clusterName, Namespace: "default"}, WithObjects(strings.NewReader(tc.objects)))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			target, err := d.resolveTarget(context.TODO())
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(target.awsCluster.Spec.NetworkSpec.VPC.ID).To(Equal("vpc-1"))
+
+			names := []string{}
+			for _, m := range target.machines {
+				names = append(names, m.template.Name)
+				g.Expect(m.version).To(Equal("v1.32.0"))
+			}
+			g.Expect(names).To(Equal(tc.expectMachines))
+
+			if tc.expectReference {
+				g.Expect(target.results).To(ConsistOf(HaveField("Status", StatusFail)))
+			} else {
+				g.Expect(target.results).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	g := NewWithT(t)
+
+	d, err := New(DoctorInput{Namespace: "default"}, WithObjects(strings.NewReader(testCluster)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	g.Expect(d.client.Create(context.TODO(), secret)).To(MatchError(ErrReadOnly))
+
+	cluster := &clusterv1.Cluster{}
+	g.Expect(d.client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "test-cluster"}, cluster)).To(Succeed())
+	g.Expect(d.client.Update(context.TODO(), cluster)).To(MatchError(ErrReadOnly))
+	g.Expect(d.client.Status().Update(context.TODO(), cluster)).To(MatchError(ErrReadOnly))
+	g.Expect(d.client.Delete(context.TODO(), cluster)).To(MatchError(ErrReadOnly))
+}
+
+func TestRun(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	stsMock := mock_stsiface.NewMockSTSClient(mockCtrl)
+	stsMock.EXPECT().GetCallerIdentity(gomock.Any(), gomock.Any()).Return(&sts.GetCallerIdentityOutput{
+		Account: aws.String(testAccountID),
+		Arn:     aws.String("arn:aws:sts::" + testAccountID + ":assumed-role/admin/session"),
+	}, nil)
+
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeAvailabilityZones(gomock.Any(), gomock.Any()).Return(&ec2.DescribeAvailabilityZonesOutput{
+		AvailabilityZones: []ec2types.AvailabilityZone{
+			testZone("us-west-2a"),
+			testZone("us-west-2b"),
+			testZone("us-west-2c"),
+		},
+	}, nil)
+	ec2Mock.EXPECT().DescribeVpcs(gomock.Any(), &ec2.DescribeVpcsInput{VpcIds: []string{"vpc-1"}}).Return(&ec2.DescribeVpcsOutput{
+		Vpcs: []ec2types.Vpc{{VpcId: aws.String("vpc-1"), CidrBlock: aws.String("10.0.0.0/16"), State: ec2types.VpcStateAvailable}},
+	}, nil)
+	ec2Mock.EXPECT().DescribeSubnets(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ec2.DescribeSubnetsOutput{
+		Subnets: []ec2types.Subnet{
+			{
+				SubnetId:         aws.String("subnet-public"),
+				VpcId:            aws.String("vpc-1"),
+				AvailabilityZone: aws.String("us-west-2a"),
+				CidrBlock:        aws.String("10.0.0.0/24"),
+				Tags: []ec2types.Tag{
+					{Key: aws.String("kubernetes.io/cluster/test-cluster"), Value: aws.String("shared")},
+					{Key: aws.String("kubernetes.io/role/elb"), Value: aws.String("1")},
+				},
+			},
+			{
+				SubnetId:         aws.String("subnet-private"),
+				VpcId:            aws.String("vpc-1"),
+				AvailabilityZone: aws.String("us-west-2b"),
+				CidrBlock:        aws.String("10.0.1.0/24"),
+			},
+		},
+	}, nil)
+	ec2Mock.EXPECT().DescribeInstanceTypes(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *ec2.DescribeInstanceTypesInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstanceTypesOutput, error) {
+		architecture := ec2types.ArchitectureTypeX8664
+		if input.InstanceTypes[0] == "m6g.large" {
+			architecture = ec2types.ArchitectureTypeArm64
+		}
+		return &ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []ec2types.InstanceTypeInfo{{
+				InstanceType:  input.InstanceTypes[0],
+				ProcessorInfo: &ec2types.ProcessorInfo{SupportedArchitectures: []ec2types.ArchitectureType{architecture}},
+			}},
+		}, nil
+	}).Times(2)
+	ec2Mock.EXPECT().DescribeInstanceTypeOfferings(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *ec2.DescribeInstanceTypeOfferingsInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
+		zones := []string{"us-west-2a", "us-west-2b", "us-west-2c"}
+		if input.Filters[0].Values[0] == "m6g.large" {
+			zones = []string{"us-west-2a"}
+		}
+		out := &ec2.DescribeInstanceTypeOfferingsOutput{}
+		for _, zone := range zones {
+			out.InstanceTypeOfferings = append(out.InstanceTypeOfferings, ec2types.InstanceTypeOffering{
+				InstanceType: ec2types.InstanceType(input.Filters[0].Values[0]),
+				Location:     aws.String(zone),
+			})
+		}
+		return out, nil
+	}).Times(2)
+	ec2Mock.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *ec2.DescribeImagesInput, _ ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+		if len(input.ImageIds) > 0 {
+			return nil, &smithy.GenericAPIError{Code: "InvalidAMIID.NotFound", Message: "The image id '[ami-private]' does not exist"}
+		}
+		return &ec2.DescribeImagesOutput{
+			Images: []ec2types.Image{{
+				ImageId:      aws.String("ami-lookup"),
+				Name:         aws.String("capa-ami-ubuntu-24.04-v1.32.0-1700000000"),
+				CreationDate: aws.String(time.Now().UTC().Format("2006-01-02T15:04:05.000Z")),
+			}},
+		}, nil
+	}).Times(2)
+
+	d, err := New(DoctorInput{Namespace: "default"}, WithObjects(strings.NewReader(testCluster+"---"+testWorkerTemplate)))
+	g.Expect(err).NotTo(HaveOccurred())
+	d.newClients = func(clusterScope) awsClients {
+		return awsClients{
+			EC2: ec2Mock,
+			STS: stsMock,
+			IAM: &fakeIAM{
+				roles: map[string]string{"admin": "arn:aws:iam::" + testAccountID + ":role/admin"},
+				instanceProfiles: map[string][]string{
+					"control-plane.cluster-api-provider-aws.sigs.k8s.io": {"control-plane.cluster-api-provider-aws.sigs.k8s.io"},
+				},
+				denied: map[string]bool{"ec2:RunInstances": true},
+			},
+			ServiceQuotas: &fakeServiceQuotas{},
+		}
+	}
+
+	report, err := d.Run(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(report.Cluster).To(Equal("default/test-cluster"))
+	g.Expect(report.Region).To(Equal("us-west-2"))
+	g.Expect(report.Failed()).To(BeTrue())
+
+	results := map[string]Result{}
+	for _, result := range report.Results {
+		results[result.Check+" "+result.Object] = result
+	}
+
+	g.Expect(results).To(HaveKeyWithValue("Identity default credentials", HaveField("Status", StatusPass)))
+	g.Expect(results).To(HaveKeyWithValue("AvailabilityZones us-west-2", HaveField("Status", StatusPass)))
+	g.Expect(results).To(HaveKeyWithValue("VPC vpc-1", HaveField("Status", StatusPass)))
+	g.Expect(results).To(HaveKeyWithValue("Subnets subnet-public", HaveField("Status", StatusPass)))
+	g.Expect(results).To(HaveKeyWithValue("Subnets subnet-private", And(
+		HaveField("Status", StatusWarn),
+		HaveField("Message", ContainSubstring("kubernetes.io/cluster/test-cluster=shared, kubernetes.io/role/internal-elb=1")),
+	)))
+	g.Expect(results).To(HaveKeyWithValue("ServiceQuotas us-west-2", HaveField("Status", StatusSkip)))
+	g.Expect(results).To(HaveKeyWithValue("InstanceType AWSMachineTemplate/test-cluster-control-plane", HaveField("Status", StatusPass)))
+	g.Expect(results).To(HaveKeyWithValue("InstanceType AWSMachineTemplate/test-cluster-md-0", And(
+		HaveField("Status", StatusWarn),
+		HaveField("Message", ContainSubstring("not offered in us-west-2b")),
+	)))
+	g.Expect(results).To(HaveKeyWithValue("AMI AWSMachineTemplate/test-cluster-control-plane", And(
+		HaveField("Status", StatusPass),
+		HaveField("Message", ContainSubstring("ami-lookup")),
+	)))
+	g.Expect(results).To(HaveKeyWithValue("AMI AWSMachineTemplate/test-cluster-md-0", And(
+		HaveField("Status", StatusFail),
+		HaveField("Message", ContainSubstring("is not shared with account "+testAccountID)),
+	)))
+	g.Expect(results).To(HaveKeyWithValue("InstanceProfile AWSMachineTemplate/test-cluster-control-plane", HaveField("Status", StatusPass)))
+	g.Expect(results).To(HaveKeyWithValue("InstanceProfile AWSMachineTemplate/test-cluster-md-0", HaveField("Status", StatusFail)))
+	g.Expect(results).To(HaveKeyWithValue("IAMPermissions arn:aws:iam::"+testAccountID+":role/admin", And(
+		HaveField("Status", StatusFail),
+		HaveField("Message", HaveSuffix(": ec2:RunInstances")),
+	)))
+}
+
+func TestCheckServiceQuotas(t *testing.T) {
+	testCases := []struct {
+		name         string
+		subnets      infrav1.Subnets
+		addresses    int
+		expectStatus Status
+		expectMsg    string
+	}{
+		{
+			name:         "default subnets need an elastic IP per availability zone",
+			addresses:    2,
+			expectStatus: StatusFail,
+			expectMsg:    "2 of 4 in use, the cluster needs 3 more",
+		},
+		{
+			name: "only zones with public subnets need an elastic IP",
+			subnets: infrav1.Subnets{
+				{ID: "public-a", AvailabilityZone: "us-west-2a", IsPublic: true},
+				{ID: "private-a", AvailabilityZone: "us-west-2a"},
+				{ID: "private-b", AvailabilityZone: "us-west-2b"},
+			},
+			addresses:    2,
+			expectStatus: StatusPass,
+			expectMsg:    "2 of 4 in use, the cluster needs 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			ec2Mock.EXPECT().DescribeVpcs(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ec2.DescribeVpcsOutput{
+				Vpcs: []ec2types.Vpc{{VpcId: aws.String("vpc-other")}},
+			}, nil)
+			ec2Mock.EXPECT().DescribeInternetGateways(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ec2.DescribeInternetGatewaysOutput{}, nil)
+			addresses := make([]ec2types.Address, tc.addresses)
+			ec2Mock.EXPECT().DescribeAddresses(gomock.Any(), gomock.Any()).Return(&ec2.DescribeAddressesOutput{Addresses: addresses}, nil)
+			// The Elastic IP quota is read from the account attributes when the
+			// Service Quotas API cannot be used.
+			ec2Mock.EXPECT().DescribeAccountAttributes(gomock.Any(), gomock.Any()).Return(&ec2.DescribeAccountAttributesOutput{
+				AccountAttributes: []ec2types.AccountAttribute{{
+					AttributeName:   aws.String("vpc-max-elastic-ips"),
+					AttributeValues: []ec2types.AccountAttributeValue{{AttributeValue: aws.String("4")}},
+				}},
+			}, nil)
+
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				Spec: infrav1.AWSClusterSpec{
+					Region: "us-west-2",
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: tc.subnets,
+					},
+				},
+			}
+			c := newTestChecker(g, awsCluster, awsClients{
+				EC2: ec2Mock,
+				ServiceQuotas: &fakeServiceQuotas{
+					quotas: map[string]float64{"L-F678F1CE": 5, "L-A4707A72": 5},
+				},
+			})
+			c.zones = map[string]ec2types.AvailabilityZone{}
+			for _, zone := range []string{"us-west-2a", "us-west-2b", "us-west-2c", "us-west-2d"} {
+				c.zones[zone] = testZone(zone)
+			}
+
+			c.checkServiceQuotas(context.TODO())
+
+			g.Expect(c.report.Results).To(HaveLen(3))
+			g.Expect(c.report.Results[0]).To(HaveField("Message", "1 of 5 in use, the cluster needs 1"))
+			g.Expect(c.report.Results[1]).To(HaveField("Message", "0 of 5 in use, the cluster needs 1"))
+			g.Expect(c.report.Results[2].Object).To(Equal("EC2-VPC Elastic IPs"))
+			g.Expect(c.report.Results[2].Status).To(Equal(tc.expectStatus))
+			g.Expect(c.report.Results[2].Message).To(Equal(tc.expectMsg))
+		})
+	}
+}
+
+func TestResourceARNs(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newTestChecker(g, &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+		Spec:       infrav1.AWSClusterSpec{Region: "us-west-2"},
+	}, awsClients{})
+	c.accountID = testAccountID
+
+	g.Expect(c.resourceARNs([]string{"*"})).To(BeNil())
+	g.Expect(c.resourceARNs([]string{"arn:*:iam::*:role/*.cluster-api-provider-aws.sigs.k8s.io", "arn:*:s3:::cluster-api-provider-aws-*"})).To(Equal([]string{
+		"arn:aws:iam::" + testAccountID + ":role/*.cluster-api-provider-aws.sigs.k8s.io",
+		"arn:aws:s3:::cluster-api-provider-aws-*",
+	}))
+	g.Expect(c.resourceARNs([]string{"arn:*:ssm:*:*:parameter/aws/service/eks/optimized-ami/*"})).To(Equal([]string{
+		"arn:aws:ssm:us-west-2:" + testAccountID + ":parameter/aws/service/eks/optimized-ami/*",
+	}))
+}
+
+func newTestChecker(g *WithT, awsCluster *infrav1.AWSCluster, clients awsClients) *checker {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: awsCluster.Name, Namespace: awsCluster.Namespace},
+	}
+	d, err := New(DoctorInput{Namespace: awsCluster.Namespace}, WithObjects(strings.NewReader("")))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	t := &target{cluster: cluster, awsCluster: awsCluster}
+	sc, err := d.newScope(t)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return &checker{
+		scope:   sc,
+		target:  t,
+		clients: clients,
+		report:  &Report{},
+	}
+}
+
+func testZone(name string) ec2types.AvailabilityZone {
+	return ec2types.AvailabilityZone{
+		ZoneName:    aws.String(name),
+		ZoneType:    aws.String("availability-zone"),
+		State:       ec2types.AvailabilityZoneStateAvailable,
+		OptInStatus: ec2types.AvailabilityZoneOptInStatusOptInNotRequired,
+	}
+}
+
+type fakeIAM struct {
+	roles            map[string]string
+	instanceProfiles map[string][]string
+	denied           map[string]bool
+}
+
+func (f *fakeIAM) GetInstanceProfile(_ context.Context, params *iam.GetInstanceProfileInput, _ ...func(*iam.Options)) (*iam.GetInstanceProfileOutput, error) {
+	roles, ok := f.instanceProfiles[aws.ToString(params.InstanceProfileName)]
+	if !ok {
+		return nil, &smithy.GenericAPIError{Code: awserrors.NoSuchEntity}
+	}
+	profile := &iamtypes.InstanceProfile{InstanceProfileName: params.InstanceProfileName}
+	for _, role := range roles {
+		profile.Roles = append(profile.Roles, iamtypes.Role{RoleName: aws.String(role)})
+	}
+	return &iam.GetInstanceProfileOutput{InstanceProfile: profile}, nil
+}
+
+func (f *fakeIAM) GetRole(_ context.Context, params *iam.GetRoleInput, _ ...func(*iam.Options)) (*iam.GetRoleOutput, error) {
+	roleARN, ok := f.roles[aws.ToString(params.RoleName)]
+	if !ok {
+		return nil, &smithy.GenericAPIError{Code: awserrors.NoSuchEntity}
+	}
+	return &iam.GetRoleOutput{Role: &iamtypes.Role{RoleName: params.RoleName, Arn: aws.String(roleARN)}}, nil
+}
+
+func (f *fakeIAM) SimulatePrincipalPolicy(_ context.Context, params *iam.SimulatePrincipalPolicyInput, _ ...func(*iam.Options)) (*iam.SimulatePrincipalPolicyOutput, error) {
+	out := &iam.SimulatePrincipalPolicyOutput{}
+	for _, action := range params.ActionNames {
+		decision := iamtypes.PolicyEvaluationDecisionTypeAllowed
+		if f.denied[action] {
+			decision = iamtypes.PolicyEvaluationDecisionTypeImplicitDeny
+		}
+		out.EvaluationResults = append(out.EvaluationResults, iamtypes.EvaluationResult{
+			EvalActionName: aws.String(action),
+			EvalDecision:   decision,
+		})
+	}
+	return out, nil
+}
+
+type fakeServiceQuotas struct {
+	quotas map[string]float64
+}
+
+func (f *fakeServiceQuotas) GetServiceQuota(_ context.Context, params *servicequotas.GetServiceQuotaInput, _ ...func(*servicequotas.Options)) (*servicequotas.GetServiceQuotaOutput, error) {
+	value, ok := f.quotas[aws.ToString(params.QuotaCode)]
+	if !ok {
+		return nil, &smithy.GenericAPIError{Code: "AccessDeniedException"}
+	}
+	return &servicequotas.GetServiceQuotaOutput{Quota: &servicequotastypes.ServiceQuota{Value: aws.Float64(value)}}, nil
+}
+
+func (f *fakeServiceQuotas) GetAWSDefaultServiceQuota(_ context.Context, _ *servicequotas.GetAWSDefaultServiceQuotaInput, _ ...func(*servicequotas.Options)) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "AccessDeniedException"}
+}
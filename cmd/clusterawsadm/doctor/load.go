@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// clusterScopedKinds are the kinds known to the scheme that are not namespaced.
+var clusterScopedKinds = map[string]bool{
+	"AWSClusterControllerIdentity":    true,
+	"AWSClusterRoleIdentity":          true,
+	"AWSClusterStaticIdentity":        true,
+	"AWSClusterRolesAnywhereIdentity": true,
+	"CustomResourceDefinition":        true,
+	"Namespace":                       true,
+}
+
+// loadObjects reads the YAML documents in r. Objects known to the scheme are
+// converted to their hub version and returned as typed objects, others are returned
+// as unstructured. Namespaced objects without a namespace are put in namespace.
+//
+// A default AWSClusterControllerIdentity is added if r does not define one, so that
+// clusters referencing it are checked with the local default credentials in place
+// of the controller's.
+func loadObjects(r io.Reader, namespace string) ([]client.Object, []*unstructured.Unstructured, error) {
+	var (
+		typed  []client.Object
+		others []*unstructured.Unstructured
+	)
+
+	hasControllerIdentity := false
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading document: %w", err)
+		}
+
+		items, err := decodeDocument(doc)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, u := range items {
+			if u.GetNamespace() == "" && !clusterScopedKinds[u.GetKind()] {
+				u.SetNamespace(namespace)
+			}
+			if u.GetKind() == string(infrav1.ControllerIdentityKind) {
+				hasControllerIdentity = true
+			}
+
+			obj, err := toHub(u)
+			if err != nil {
+				return nil, nil, fmt.Errorf("converting %s %s: %w", u.GetKind(), u.GetName(), err)
+			}
+			if obj == nil {
+				others = append(others, u)
+				continue
+			}
+			typed = append(typed, obj)
+		}
+	}
+
+	if !hasControllerIdentity {
+		typed = append(typed, &infrav1.AWSClusterControllerIdentity{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: infrav1.GroupVersion.String(),
+				Kind:       string(infrav1.ControllerIdentityKind),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: infrav1.AWSClusterControllerIdentityName,
+			},
+			Spec: infrav1.AWSClusterControllerIdentitySpec{
+				AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+					AllowedNamespaces: &infrav1.AllowedNamespaces{},
+				},
+			},
+		})
+	}
+
+	return typed, others, nil
+}
+
+// decodeDocument decodes a single YAML document, expanding Lists.
+func decodeDocument(doc []byte) ([]*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	if err := utilyaml.Unmarshal(doc, &obj.Object); err != nil {
+		return nil, fmt.Errorf("decoding document: %w", err)
+	}
+	if len(obj.Object) == 0 {
+		return nil, nil
+	}
+	if obj.GetKind() == "" {
+		return nil, fmt.Errorf("document without a kind: %q", truncate(string(doc), 80))
+	}
+
+	if !obj.IsList() {
+		return []*unstructured.Unstructured{obj}, nil
+	}
+
+	list, err := obj.ToList()
+	if err != nil {
+		return nil, fmt.Errorf("decoding list: %w", err)
+	}
+	items := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		items = append(items, &list.Items[i])
+	}
+	return items, nil
+}
+
+// toHub converts u to the typed hub version of its kind. nil is returned if the kind
+// is not known to the scheme.
+func toHub(u *unstructured.Unstructured) (client.Object, error) {
+	gvk := u.GroupVersionKind()
+	if !scheme.Recognizes(gvk) {
+		return nil, nil
+	}
+
+	obj, err := scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		return nil, err
+	}
+
+	if convertible, ok := obj.(conversion.Convertible); ok {
+		hub, err := hubFor(gvk.GroupKind())
+		if err != nil {
+			return nil, err
+		}
+		if err := convertible.ConvertTo(hub); err != nil {
+			return nil, err
+		}
+		obj = hub
+	}
+
+	clientObj, ok := obj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an object", gvk)
+	}
+	hubGVK, err := apiutil.GVKForObject(clientObj, scheme)
+	if err != nil {
+		return nil, err
+	}
+	clientObj.GetObjectKind().SetGroupVersionKind(hubGVK)
+
+	return clientObj, nil
+}
+
+// hubFor returns a new instance of the hub version of a kind.
+func hubFor(gk schema.GroupKind) (conversion.Hub, error) {
+	for _, version := range scheme.VersionsForGroupKind(gk) {
+		obj, err := scheme.New(version.WithKind(gk.Kind))
+		if err != nil {
+			continue
+		}
+		if hub, ok := obj.(conversion.Hub); ok {
+			return hub, nil
+		}
+	}
+	return nil, fmt.Errorf("no hub version for %s", gk)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
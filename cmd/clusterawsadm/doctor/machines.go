@@ -0,0 +1,314 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	ec2service "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
+)
+
+func (c *checker) checkMachineTemplates(ctx context.Context) {
+	if len(c.target.machines) == 0 {
+		c.add(Result{
+			Check:   CheckInstanceType,
+			Object:  c.report.Cluster,
+			Status:  StatusSkip,
+			Message: "No AWSMachineTemplates are referenced by the control plane or the machine deployments of the cluster",
+		})
+		return
+	}
+
+	for _, m := range c.target.machines {
+		architecture := c.checkInstanceType(ctx, m)
+		c.checkAMI(ctx, m, architecture)
+		c.checkInstanceProfile(ctx, m)
+	}
+}
+
+// checkInstanceType checks the instance type of a machine template is offered in the
+// availability zones its machines are placed in. The architecture of the instance
+// type is returned, or an empty string if it is not known.
+func (c *checker) checkInstanceType(ctx context.Context, m machineTemplate) string {
+	instanceType := m.template.Spec.Template.Spec.InstanceType
+	result := Result{
+		Check:  CheckInstanceType,
+		Object: m.name(),
+		Status: StatusFail,
+	}
+
+	out, err := c.clients.EC2.DescribeInstanceTypes(ctx, &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: []ec2types.InstanceType{ec2types.InstanceType(instanceType)},
+	})
+	switch {
+	case isCode(err, "InvalidInstanceType") || (err == nil && len(out.InstanceTypes) == 0):
+		result.Message = fmt.Sprintf("Instance type %q does not exist in %s", instanceType, c.scope.Region())
+		result.Remediation = "Fix spec.template.spec.instanceType"
+		c.add(result)
+		return ""
+	case err != nil:
+		result.Message = fmt.Sprintf("Failed to describe instance type %q: %v", instanceType, err)
+		c.add(result)
+		return ""
+	}
+	architecture := pickArchitecture(out.InstanceTypes[0])
+
+	offered := map[string]bool{}
+	paginator := ec2.NewDescribeInstanceTypeOfferingsPaginator(c.clients.EC2, &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: ec2types.LocationTypeAvailabilityZone,
+		Filters:      []ec2types.Filter{{Name: aws.String("instance-type"), Values: []string{instanceType}}},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			result.Message = fmt.Sprintf("Failed to describe the offerings of instance type %s: %v", instanceType, err)
+			c.add(result)
+			return architecture
+		}
+		for _, offering := range page.InstanceTypeOfferings {
+			offered[aws.ToString(offering.Location)] = true
+		}
+	}
+
+	if len(offered) == 0 {
+		result.Message = fmt.Sprintf("Instance type %s is not offered in any availability zone of %s", instanceType, c.scope.Region())
+		result.Remediation = "Choose an instance type offered in the region, see aws ec2 describe-instance-type-offerings --location-type availability-zone"
+		c.add(result)
+		return architecture
+	}
+
+	zones := c.clusterZones
+	if m.failureDomain != "" {
+		zones = []string{m.failureDomain}
+	}
+	missing := []string{}
+	for _, zone := range zones {
+		if !offered[zone] {
+			missing = append(missing, zone)
+		}
+	}
+
+	remediation := fmt.Sprintf("Choose another instance type, or place machines in availability zones offering it: %s", strings.Join(sortedKeys(offered), ", "))
+	switch {
+	case len(missing) > 0 && len(missing) == len(zones):
+		result.Message = fmt.Sprintf("Instance type %s is not offered in the availability zones of the cluster: %s", instanceType, strings.Join(missing, ", "))
+		result.Remediation = remediation
+	case len(missing) > 0:
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("Instance type %s is not offered in %s, machines placed there will fail to launch", instanceType, strings.Join(missing, ", "))
+		result.Remediation = remediation
+	case len(zones) == 0:
+		result.Status = StatusPass
+		result.Message = fmt.Sprintf("Instance type %s (%s) is offered in %s", instanceType, architecture, c.scope.Region())
+	default:
+		result.Status = StatusPass
+		result.Message = fmt.Sprintf("Instance type %s (%s) is offered in %s", instanceType, architecture, strings.Join(zones, ", "))
+	}
+	c.add(result)
+
+	return architecture
+}
+
+// pickArchitecture picks the architecture of an instance type the same way the
+// instance service does.
+func pickArchitecture(info ec2types.InstanceTypeInfo) string {
+	if info.ProcessorInfo == nil {
+		return ""
+	}
+	for _, architecture := range info.ProcessorInfo.SupportedArchitectures {
+		switch architecture {
+		case ec2service.Amd64ArchitectureTag, ec2service.Arm64ArchitectureTag:
+			return string(architecture)
+		}
+	}
+	return ""
+}
+
+// checkAMI checks that the AMI of a machine template exists and can be used by the
+// account, or that the lookup the instance service does finds one.
+func (c *checker) checkAMI(ctx context.Context, m machineTemplate, architecture string) {
+	spec := m.template.Spec.Template.Spec
+	if spec.AMI.ID != nil {
+		c.checkAMIID(ctx, m, *spec.AMI.ID, architecture)
+		return
+	}
+
+	result := Result{
+		Check:  CheckAMI,
+		Object: m.name(),
+		Status: StatusFail,
+	}
+
+	if m.version == "" {
+		result.Message = fmt.Sprintf("Neither spec.template.spec.ami.id nor the Kubernetes version of %s is set", strings.Join(m.owners, ", "))
+		result.Remediation = "Set spec.template.spec.ami.id, or the Kubernetes version the AMI is looked up for"
+		c.add(result)
+		return
+	}
+
+	imageLookupFormat := spec.ImageLookupFormat
+	if imageLookupFormat == "" {
+		imageLookupFormat = c.scope.ImageLookupFormat()
+	}
+	imageLookupOrg := spec.ImageLookupOrg
+	if imageLookupOrg == "" {
+		imageLookupOrg = c.scope.ImageLookupOrg()
+	}
+	imageLookupBaseOS := spec.ImageLookupBaseOS
+	if imageLookupBaseOS == "" {
+		imageLookupBaseOS = c.scope.ImageLookupBaseOS()
+	}
+
+	if c.target.isEKS() && imageLookupFormat == "" && imageLookupOrg == "" && imageLookupBaseOS == "" {
+		result.Status = StatusSkip
+		result.Message = "The AMI is looked up from the SSM parameter of the EKS optimized AMI when machines are created"
+		c.add(result)
+		return
+	}
+
+	if architecture == "" {
+		architecture = ec2service.DefaultArchitectureTag
+	}
+
+	image, err := ec2service.DefaultAMILookup(c.clients.EC2, imageLookupOrg, imageLookupBaseOS, m.version, architecture, imageLookupFormat)
+	if err != nil {
+		result.Message = fmt.Sprintf("No AMI found: %v", err)
+		result.Remediation = fmt.Sprintf("Set spec.template.spec.ami.id, or imageLookupOrg and imageLookupFormat to find an AMI built for Kubernetes %s. clusterawsadm ami list --kubernetes-version %s --region %s lists the AMIs published by the project", m.version, m.version, c.scope.Region())
+		c.add(result)
+		return
+	}
+
+	result.Status = StatusPass
+	result.Message = fmt.Sprintf("Resolved AMI %s (%s)", aws.ToString(image.ImageId), aws.ToString(image.Name))
+	c.add(result)
+}
+
+func (c *checker) checkAMIID(ctx context.Context, m machineTemplate, id, architecture string) {
+	result := Result{
+		Check:  CheckAMI,
+		Object: m.name(),
+		Status: StatusFail,
+	}
+
+	out, err := c.clients.EC2.DescribeImages(ctx, &ec2.DescribeImagesInput{
+		ImageIds: []string{id},
+	})
+	code, _ := awserrors.Code(err)
+	switch {
+	case strings.HasPrefix(code, "InvalidAMIID.") || (err == nil && len(out.Images) == 0):
+		result.Message = fmt.Sprintf("AMI %s does not exist in %s or is not shared with account %s", id, c.scope.Region(), c.accountID)
+		result.Remediation = fmt.Sprintf("Share the AMI with account %s (aws ec2 modify-image-attribute --launch-permission), copy it to %s, or fix spec.template.spec.ami.id", c.accountID, c.scope.Region())
+	case err != nil:
+		result.Message = fmt.Sprintf("Failed to describe AMI %s: %v", id, err)
+	case out.Images[0].State != ec2types.ImageStateAvailable:
+		result.Message = fmt.Sprintf("AMI %s is %s", id, out.Images[0].State)
+		result.Remediation = "Use an available AMI"
+	case architecture != "" && string(out.Images[0].Architecture) != architecture:
+		result.Message = fmt.Sprintf("AMI %s is built for %s, instance type %s is %s", id, out.Images[0].Architecture, m.template.Spec.Template.Spec.InstanceType, architecture)
+		result.Remediation = fmt.Sprintf("Use an AMI built for %s, or an instance type of the architecture of the AMI", architecture)
+	default:
+		result.Status = StatusPass
+		result.Message = fmt.Sprintf("AMI %s (%s) is available", id, aws.ToString(out.Images[0].Name))
+	}
+	c.add(result)
+}
+
+func (c *checker) checkInstanceProfile(ctx context.Context, m machineTemplate) {
+	name := m.template.Spec.Template.Spec.IAMInstanceProfile
+	result := Result{
+		Check:  CheckInstanceProfile,
+		Object: m.name(),
+	}
+
+	if name == "" {
+		result.Status = StatusSkip
+		result.Message = "No instance profile is set"
+		c.add(result)
+		return
+	}
+
+	out, err := c.clients.IAM.GetInstanceProfile(ctx, &iam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+	})
+	switch {
+	case isCode(err, awserrors.NoSuchEntity):
+		result.Status = StatusFail
+		result.Message = fmt.Sprintf("Instance profile %s does not exist in account %s", name, c.accountID)
+		result.Remediation = "Create it with clusterawsadm bootstrap iam create-cloudformation-stack, or fix spec.template.spec.iamInstanceProfile"
+	case err != nil:
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("Unable to get instance profile %s: %v", name, err)
+		result.Remediation = "Allow iam:GetInstanceProfile for the identity of the cluster to check the instance profile"
+	case len(out.InstanceProfile.Roles) == 0:
+		result.Status = StatusFail
+		result.Message = fmt.Sprintf("Instance profile %s has no role", name)
+		result.Remediation = "Add the role of the machines to the instance profile"
+	default:
+		roles := make([]string, 0, len(out.InstanceProfile.Roles))
+		for _, role := range out.InstanceProfile.Roles {
+			roles = append(roles, aws.ToString(role.RoleName))
+		}
+		sort.Strings(roles)
+		result.Status = StatusPass
+		result.Message = fmt.Sprintf("Instance profile %s exists with role %s", name, strings.Join(roles, ", "))
+	}
+	c.add(result)
+}
+
+// checkControlPlaneRole checks the IAM role of an EKS control plane exists.
+func (c *checker) checkControlPlaneRole(ctx context.Context) {
+	if !c.target.isEKS() {
+		return
+	}
+
+	name := ekscontrolplanev1.DefaultEKSControlPlaneRole
+	if c.target.controlPlane.Spec.RoleName != nil {
+		name = *c.target.controlPlane.Spec.RoleName
+	}
+	result := Result{
+		Check:  CheckControlPlaneRole,
+		Object: name,
+	}
+
+	_, err := c.clients.IAM.GetRole(ctx, &iam.GetRoleInput{
+		RoleName: aws.String(name),
+	})
+	switch {
+	case isCode(err, awserrors.NoSuchEntity):
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("IAM role %s does not exist, the controller only creates it with the EKSEnableIAM feature gate enabled", name)
+		result.Remediation = "Create the role with clusterawsadm bootstrap iam create-cloudformation-stack, or enable the EKSEnableIAM feature gate"
+	case err != nil:
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("Unable to get IAM role %s: %v", name, err)
+		result.Remediation = "Allow iam:GetRole for the identity of the cluster to check the role"
+	default:
+		result.Status = StatusPass
+		result.Message = fmt.Sprintf("IAM role %s exists", name)
+	}
+	c.add(result)
+}
@@ -0,0 +1,426 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+)
+
+const (
+	internalLoadBalancerTag = "kubernetes.io/role/internal-elb"
+	externalLoadBalancerTag = "kubernetes.io/role/elb"
+)
+
+// quota is a service quota of a resource the controller creates for the cluster.
+type quota struct {
+	name        string
+	serviceCode string
+	quotaCode   string
+	// accountAttribute is the EC2 account attribute holding the quota, read when the
+	// Service Quotas API cannot be queried.
+	accountAttribute string
+	// needed is the number of resources the controller creates.
+	needed int
+	// usage counts the resources in use in the region.
+	usage func(ctx context.Context) (int, error)
+}
+
+func (c *checker) checkNetwork(ctx context.Context) {
+	vpc := c.scope.VPC()
+	if !vpc.IsUnmanaged(c.scope.Name()) {
+		c.add(Result{
+			Check:   CheckVPC,
+			Object:  c.scope.InfraClusterName(),
+			Status:  StatusPass,
+			Message: "The VPC and its subnets are managed by the controller",
+		})
+
+		for _, subnet := range c.scope.Subnets() {
+			if subnet.AvailabilityZone != "" {
+				c.clusterZones = appendUnique(c.clusterZones, subnet.AvailabilityZone)
+			}
+		}
+		if len(c.clusterZones) == 0 {
+			c.clusterZones = c.defaultZones()
+		}
+		return
+	}
+
+	out, err := c.clients.EC2.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+		VpcIds: []string{vpc.ID},
+	})
+	if err != nil && !isCode(err, awserrors.VPCNotFound) {
+		c.add(Result{
+			Check:   CheckVPC,
+			Object:  vpc.ID,
+			Status:  StatusFail,
+			Message: fmt.Sprintf("Failed to describe VPC %s: %v", vpc.ID, err),
+		})
+		return
+	}
+	if err != nil || len(out.Vpcs) == 0 {
+		c.add(Result{
+			Check:       CheckVPC,
+			Object:      vpc.ID,
+			Status:      StatusFail,
+			Message:     fmt.Sprintf("VPC %s does not exist in %s or is not visible to account %s", vpc.ID, c.scope.Region(), c.accountID),
+			Remediation: "Fix spec.network.vpc.id, or remove it to let the controller create a VPC",
+		})
+		return
+	}
+
+	existing := out.Vpcs[0]
+	if existing.State != ec2types.VpcStateAvailable {
+		c.add(Result{
+			Check:   CheckVPC,
+			Object:  vpc.ID,
+			Status:  StatusWarn,
+			Message: fmt.Sprintf("VPC %s is %s", vpc.ID, existing.State),
+		})
+	} else {
+		c.add(Result{
+			Check:   CheckVPC,
+			Object:  vpc.ID,
+			Status:  StatusPass,
+			Message: fmt.Sprintf("VPC %s (%s) exists", vpc.ID, aws.ToString(existing.CidrBlock)),
+		})
+	}
+
+	c.checkSubnets(ctx, vpc.ID)
+}
+
+// checkSubnets checks the subnets of an existing VPC. When the cluster does not list
+// subnets, all subnets of the VPC are checked, as the controller uses all of them.
+func (c *checker) checkSubnets(ctx context.Context, vpcID string) {
+	specSubnets := c.scope.Subnets()
+
+	ids := []string{}
+	for _, subnet := range specSubnets {
+		if id := subnet.GetResourceID(); id != "" {
+			ids = appendUnique(ids, id)
+		}
+	}
+	for _, m := range c.target.machines {
+		if ref := m.template.Spec.Template.Spec.Subnet; ref != nil && ref.ID != nil {
+			ids = appendUnique(ids, *ref.ID)
+		}
+	}
+	sort.Strings(ids)
+
+	input := &ec2.DescribeSubnetsInput{}
+	if len(ids) > 0 {
+		input.Filters = []ec2types.Filter{{Name: aws.String("subnet-id"), Values: ids}}
+	} else {
+		input.Filters = []ec2types.Filter{{Name: aws.String("vpc-id"), Values: []string{vpcID}}}
+	}
+
+	found := map[string]ec2types.Subnet{}
+	paginator := ec2.NewDescribeSubnetsPaginator(c.clients.EC2, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			c.add(Result{
+				Check:   CheckSubnets,
+				Object:  vpcID,
+				Status:  StatusFail,
+				Message: fmt.Sprintf("Failed to describe subnets: %v", err),
+			})
+			return
+		}
+		for _, subnet := range page.Subnets {
+			found[aws.ToString(subnet.SubnetId)] = subnet
+		}
+	}
+
+	if len(ids) == 0 {
+		if len(found) == 0 {
+			c.add(Result{
+				Check:       CheckSubnets,
+				Object:      vpcID,
+				Status:      StatusFail,
+				Message:     fmt.Sprintf("VPC %s has no subnets", vpcID),
+				Remediation: "Create subnets in the VPC, or list the subnets to use in spec.network.subnets",
+			})
+			return
+		}
+		for id := range found {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+	}
+
+	clusterTag := infrav1.ClusterAWSCloudProviderTagKey(c.scope.KubernetesClusterName())
+	for _, id := range ids {
+		subnet, ok := found[id]
+		if !ok {
+			c.add(Result{
+				Check:       CheckSubnets,
+				Object:      id,
+				Status:      StatusFail,
+				Message:     fmt.Sprintf("Subnet %s does not exist in %s or is not visible to account %s", id, c.scope.Region(), c.accountID),
+				Remediation: "Fix the subnet ID in spec.network.subnets or in the machine templates",
+			})
+			continue
+		}
+
+		if aws.ToString(subnet.VpcId) != vpcID {
+			c.add(Result{
+				Check:       CheckSubnets,
+				Object:      id,
+				Status:      StatusFail,
+				Message:     fmt.Sprintf("Subnet %s is in VPC %s, not in the VPC of the cluster %s", id, aws.ToString(subnet.VpcId), vpcID),
+				Remediation: fmt.Sprintf("Use subnets of VPC %s", vpcID),
+			})
+			continue
+		}
+
+		zone := aws.ToString(subnet.AvailabilityZone)
+		c.clusterZones = appendUnique(c.clusterZones, zone)
+
+		tags := map[string]string{}
+		for _, tag := range subnet.Tags {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+
+		missing := []string{}
+		if _, ok := tags[clusterTag]; !ok {
+			missing = append(missing, clusterTag+"="+string(infrav1.ResourceLifecycleShared))
+		}
+		_, external := tags[externalLoadBalancerTag]
+		_, internal := tags[internalLoadBalancerTag]
+		switch spec := specSubnets.FindByID(id); {
+		case spec != nil && spec.IsPublic && !external:
+			missing = append(missing, externalLoadBalancerTag+"=1")
+		case spec != nil && !spec.IsPublic && !internal:
+			missing = append(missing, internalLoadBalancerTag+"=1")
+		case spec == nil && !external && !internal:
+			missing = append(missing, fmt.Sprintf("%s=1 or %s=1", externalLoadBalancerTag, internalLoadBalancerTag))
+		}
+
+		if len(missing) > 0 {
+			c.add(Result{
+				Check:       CheckSubnets,
+				Object:      id,
+				Status:      StatusWarn,
+				Message:     fmt.Sprintf("Subnet %s (%s) is missing tags: %s", id, zone, strings.Join(missing, ", ")),
+				Remediation: "Tag the subnet, or keep --tag-unmanaged-network-resources enabled on the controller so that it does. Without the tags the cloud provider cannot place load balancers for Services in the subnet",
+			})
+			continue
+		}
+		c.add(Result{
+			Check:   CheckSubnets,
+			Object:  id,
+			Status:  StatusPass,
+			Message: fmt.Sprintf("Subnet %s (%s, %s) is tagged for the cluster", id, zone, aws.ToString(subnet.CidrBlock)),
+		})
+	}
+	sort.Strings(c.clusterZones)
+}
+
+// checkServiceQuotas checks the quotas of the resources created along with a
+// managed VPC.
+func (c *checker) checkServiceQuotas(ctx context.Context) {
+	vpc := c.scope.VPC()
+	if vpc.ID != "" {
+		c.add(Result{
+			Check:   CheckServiceQuotas,
+			Object:  c.scope.Region(),
+			Status:  StatusSkip,
+			Message: fmt.Sprintf("The cluster uses existing VPC %s, the controller does not create VPCs, internet gateways or Elastic IPs", vpc.ID),
+		})
+		return
+	}
+
+	// A NAT gateway, each with an Elastic IP, is created per availability zone with
+	// public subnets.
+	natGatewayZones := []string{}
+	if subnets := c.scope.Subnets(); len(subnets) > 0 {
+		natGatewayZones = subnets.FilterPublic().GetUniqueZones()
+	} else {
+		natGatewayZones = c.defaultZones()
+	}
+
+	quotas := []quota{
+		{
+			name:        "VPCs per Region",
+			serviceCode: "vpc",
+			quotaCode:   "L-F678F1CE",
+			needed:      1,
+			usage:       c.countVPCs,
+		},
+		{
+			name:        "Internet gateways per Region",
+			serviceCode: "vpc",
+			quotaCode:   "L-A4707A72",
+			needed:      1,
+			usage:       c.countInternetGateways,
+		},
+		{
+			name:             "EC2-VPC Elastic IPs",
+			serviceCode:      "ec2",
+			quotaCode:        "L-0263D0A3",
+			accountAttribute: "vpc-max-elastic-ips",
+			needed:           len(natGatewayZones),
+			usage:            c.countElasticIPs,
+		},
+	}
+
+	for _, q := range quotas {
+		c.add(c.checkQuota(ctx, q))
+	}
+}
+
+func (c *checker) checkQuota(ctx context.Context, q quota) Result {
+	result := Result{
+		Check:  CheckServiceQuotas,
+		Object: q.name,
+	}
+
+	limit, err := c.quotaValue(ctx, q)
+	if err != nil {
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("Unable to read quota %s: %v", q.quotaCode, err)
+		result.Remediation = "Allow servicequotas:GetServiceQuota for the identity of the cluster, or check the quota in the Service Quotas console"
+		return result
+	}
+
+	used, err := q.usage(ctx)
+	if err != nil {
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("Unable to count the resources in use: %v", err)
+		return result
+	}
+
+	if used+q.needed > limit {
+		result.Status = StatusFail
+		result.Message = fmt.Sprintf("%d of %d in use, the cluster needs %d more", used, limit, q.needed)
+		result.Remediation = fmt.Sprintf("Release unused resources, or request an increase of quota %s (%s) of service %s in the Service Quotas console", q.quotaCode, q.name, q.serviceCode)
+		return result
+	}
+
+	result.Status = StatusPass
+	result.Message = fmt.Sprintf("%d of %d in use, the cluster needs %d", used, limit, q.needed)
+	return result
+}
+
+// quotaValue returns the value of a quota applied to the account, falling back to
+// the AWS default and then to the EC2 account attributes.
+func (c *checker) quotaValue(ctx context.Context, q quota) (int, error) {
+	out, err := c.clients.ServiceQuotas.GetServiceQuota(ctx, &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(q.serviceCode),
+		QuotaCode:   aws.String(q.quotaCode),
+	})
+	if err == nil && out.Quota != nil && out.Quota.Value != nil {
+		return int(*out.Quota.Value), nil
+	}
+
+	var noSuchResource *servicequotastypes.NoSuchResourceException
+	if errors.As(err, &noSuchResource) {
+		defaultOut, defaultErr := c.clients.ServiceQuotas.GetAWSDefaultServiceQuota(ctx, &servicequotas.GetAWSDefaultServiceQuotaInput{
+			ServiceCode: aws.String(q.serviceCode),
+			QuotaCode:   aws.String(q.quotaCode),
+		})
+		if defaultErr == nil && defaultOut.Quota != nil && defaultOut.Quota.Value != nil {
+			return int(*defaultOut.Quota.Value), nil
+		}
+	}
+
+	if q.accountAttribute == "" {
+		if err == nil {
+			err = errors.New("quota has no value")
+		}
+		return 0, err
+	}
+
+	attributes, attributeErr := c.clients.EC2.DescribeAccountAttributes(ctx, &ec2.DescribeAccountAttributesInput{
+		AttributeNames: []ec2types.AccountAttributeName{ec2types.AccountAttributeName(q.accountAttribute)},
+	})
+	if attributeErr != nil {
+		return 0, attributeErr
+	}
+	for _, attribute := range attributes.AccountAttributes {
+		if aws.ToString(attribute.AttributeName) != q.accountAttribute || len(attribute.AttributeValues) == 0 {
+			continue
+		}
+		return strconv.Atoi(aws.ToString(attribute.AttributeValues[0].AttributeValue))
+	}
+	return 0, fmt.Errorf("account attribute %s not found", q.accountAttribute)
+}
+
+func (c *checker) countVPCs(ctx context.Context) (int, error) {
+	count := 0
+	paginator := ec2.NewDescribeVpcsPaginator(c.clients.EC2, &ec2.DescribeVpcsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, err
+		}
+		count += len(page.Vpcs)
+	}
+	return count, nil
+}
+
+func (c *checker) countInternetGateways(ctx context.Context) (int, error) {
+	count := 0
+	paginator := ec2.NewDescribeInternetGatewaysPaginator(c.clients.EC2, &ec2.DescribeInternetGatewaysInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, err
+		}
+		count += len(page.InternetGateways)
+	}
+	return count, nil
+}
+
+func (c *checker) countElasticIPs(ctx context.Context) (int, error) {
+	out, err := c.clients.EC2.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+		Filters: []ec2types.Filter{{Name: aws.String("domain"), Values: []string{"vpc"}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(out.Addresses), nil
+}
+
+func isCode(err error, code string) bool {
+	actual, ok := awserrors.Code(err)
+	return ok && actual == code
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
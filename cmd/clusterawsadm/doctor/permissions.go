@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/cloudformation/bootstrap"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+)
+
+// checkPermissions simulates the actions of the controller policies generated by
+// clusterawsadm for the principal of the cluster identity. Actions are simulated
+// against the resources of the statements granting them, with the partition and
+// account substituted, but statement conditions are not evaluated.
+func (c *checker) checkPermissions(ctx context.Context) {
+	result := Result{
+		Check:  CheckPermissions,
+		Object: c.callerARN,
+	}
+
+	principal, err := c.principalARN(ctx)
+	if err != nil {
+		result.Status = StatusWarn
+		result.Message = err.Error()
+		result.Remediation = fmt.Sprintf("Allow iam:GetRole for %s to simulate its policies", c.callerARN)
+		c.add(result)
+		return
+	}
+	if principal == "" {
+		result.Status = StatusSkip
+		result.Message = fmt.Sprintf("The policies of %s cannot be simulated", c.callerARN)
+		c.add(result)
+		return
+	}
+	result.Object = principal
+
+	template := bootstrap.NewTemplate()
+	template.Spec.EKS.Disable = !c.target.isEKS()
+
+	denied := map[string]bool{}
+	conditional := map[string]bool{}
+	total := map[string]bool{}
+	for _, policy := range template.ControllersPolicies() {
+		for _, statement := range policy.Statement {
+			if statement.Effect != iamv1.EffectAllow {
+				continue
+			}
+
+			actions := []string{}
+			for _, action := range statement.Action {
+				// Wildcards cannot be simulated.
+				if !strings.Contains(action, "*") {
+					actions = append(actions, action)
+					total[action] = true
+				}
+			}
+			if len(actions) == 0 {
+				continue
+			}
+
+			notAllowed, err := c.simulate(ctx, principal, actions, c.resourceARNs(statement.Resource))
+			if err != nil {
+				result.Status = StatusWarn
+				result.Message = fmt.Sprintf("Unable to simulate the policies of %s: %v", principal, err)
+				result.Remediation = fmt.Sprintf("Allow iam:SimulatePrincipalPolicy for %s to check its permissions", c.callerARN)
+				c.add(result)
+				return
+			}
+			for _, action := range notAllowed {
+				if len(statement.Condition) > 0 {
+					conditional[action] = true
+				} else {
+					denied[action] = true
+				}
+			}
+		}
+	}
+
+	remediation := fmt.Sprintf("Attach the policies created by clusterawsadm bootstrap iam create-cloudformation-stack, or printed by clusterawsadm bootstrap iam print-policy, to %s", principal)
+	switch {
+	case len(denied) > 0:
+		result.Status = StatusFail
+		result.Message = fmt.Sprintf("%d of %d controller actions are not allowed: %s", len(denied), len(total), strings.Join(sortedKeys(denied), ", "))
+		result.Remediation = remediation
+	case len(conditional) > 0:
+		result.Status = StatusWarn
+		result.Message = fmt.Sprintf("%d of %d controller actions are not allowed without the conditions of the controller policies, which cannot be simulated: %s", len(conditional), len(total), strings.Join(sortedKeys(conditional), ", "))
+		result.Remediation = remediation
+	default:
+		result.Status = StatusPass
+		result.Message = fmt.Sprintf("All %d controller actions are allowed", len(total))
+	}
+	c.add(result)
+}
+
+// principalARN returns the ARN of the IAM principal whose policies apply to the
+// caller. An empty string is returned for principals without simulatable policies,
+// such as the root user.
+func (c *checker) principalARN(ctx context.Context) (string, error) {
+	parsed, err := arn.Parse(c.callerARN)
+	if err != nil {
+		return "", fmt.Errorf("parsing caller ARN %q: %w", c.callerARN, err)
+	}
+
+	switch {
+	case strings.HasPrefix(parsed.Resource, "user/"):
+		return c.callerARN, nil
+	case strings.HasPrefix(parsed.Resource, "assumed-role/"):
+		// assumed-role/<role name>/<session name>, the path of the role is only
+		// known to IAM.
+		parts := strings.Split(parsed.Resource, "/")
+		if len(parts) < 2 {
+			return "", fmt.Errorf("unexpected assumed role ARN %q", c.callerARN)
+		}
+		out, err := c.clients.IAM.GetRole(ctx, &iam.GetRoleInput{
+			RoleName: aws.String(parts[1]),
+		})
+		if err != nil {
+			return "", fmt.Errorf("getting role %s: %w", parts[1], err)
+		}
+		return aws.ToString(out.Role.Arn), nil
+	default:
+		return "", nil
+	}
+}
+
+// resourceARNs returns the resources of a statement to simulate actions against.
+// nil is returned for statements applying to all resources.
+func (c *checker) resourceARNs(resources iamv1.Resources) []string {
+	arns := []string{}
+	for _, resource := range resources {
+		if resource == iamv1.Any {
+			return nil
+		}
+
+		parts := strings.SplitN(resource, ":", 6)
+		if len(parts) == 6 {
+			if parts[1] == iamv1.Any {
+				parts[1] = c.scope.Partition()
+			}
+			if parts[3] == iamv1.Any {
+				parts[3] = c.scope.Region()
+			}
+			if parts[4] == iamv1.Any {
+				parts[4] = c.accountID
+			}
+			resource = strings.Join(parts, ":")
+		}
+		arns = append(arns, resource)
+	}
+	sort.Strings(arns)
+	return arns
+}
+
+// simulate returns the actions not allowed for the principal.
+func (c *checker) simulate(ctx context.Context, principal string, actions, resources []string) ([]string, error) {
+	notAllowed := map[string]bool{}
+
+	paginator := iam.NewSimulatePrincipalPolicyPaginator(c.clients.IAM, &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principal),
+		ActionNames:     actions,
+		ResourceArns:    resources,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range page.EvaluationResults {
+			if result.EvalDecision != iamtypes.PolicyEvaluationDecisionTypeAllowed {
+				notAllowed[aws.ToString(result.EvalActionName)] = true
+			}
+		}
+	}
+
+	return sortedKeys(notAllowed), nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Status is the outcome of a single check.
+type Status string
+
+const (
+	// StatusPass means the check found nothing preventing the cluster from being created.
+	StatusPass = Status("Pass")
+	// StatusWarn means the check found something that may prevent parts of the cluster
+	// from working, or that could not be verified.
+	StatusWarn = Status("Warn")
+	// StatusFail means the check found something that will prevent the cluster from
+	// being created.
+	StatusFail = Status("Fail")
+	// StatusSkip means the check does not apply to the cluster.
+	StatusSkip = Status("Skip")
+)
+
+// Result is the result of a single check against a single object.
+type Result struct {
+	// Check is the name of the check that produced the result.
+	Check string `json:"check"`
+	// Object identifies what was checked, e.g. a subnet ID or a machine template.
+	Object string `json:"object,omitempty"`
+	// Status is the outcome of the check.
+	Status Status `json:"status"`
+	// Message describes what was found.
+	Message string `json:"message"`
+	// Remediation describes how to address a warning or failure.
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// Report holds the results of all checks run against a cluster.
+type Report struct {
+	// Cluster is the namespaced name of the checked cluster.
+	Cluster string `json:"cluster"`
+	// Region is the AWS region the cluster is checked against.
+	Region string `json:"region,omitempty"`
+	// Results holds the check results in the order they were run.
+	Results []Result `json:"results"`
+}
+
+// Failed returns true if any check failed.
+func (r *Report) Failed() bool {
+	for _, result := range r.Results {
+		if result.Status == StatusFail {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of results with the given status.
+func (r *Report) Count(status Status) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *Report) add(results ...Result) {
+	r.Results = append(r.Results, results...)
+}
+
+// ToTable converts the report to a metav1.Table.
+func (r *Report) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "Check",
+				Type: "string",
+			},
+			{
+				Name: "Object",
+				Type: "string",
+			},
+			{
+				Name: "Status",
+				Type: "string",
+			},
+			{
+				Name: "Message",
+				Type: "string",
+			},
+			{
+				Name: "Remediation",
+				Type: "string",
+			},
+		},
+	}
+
+	for _, result := range r.Results {
+		row := metav1.TableRow{
+			Cells: []interface{}{result.Check, result.Object, string(result.Status), result.Message, result.Remediation},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/external"
+)
+
+const (
+	awsClusterKind             = "AWSCluster"
+	awsManagedClusterKind      = "AWSManagedCluster"
+	awsManagedControlPlaneKind = "AWSManagedControlPlane"
+	awsMachineTemplateKind     = "AWSMachineTemplate"
+)
+
+// target is the cluster being checked along with the objects it references.
+type target struct {
+	cluster      *clusterv1.Cluster
+	awsCluster   *infrav1.AWSCluster
+	controlPlane *ekscontrolplanev1.AWSManagedControlPlane
+	machines     []machineTemplate
+
+	// results holds problems found while resolving the references of the cluster.
+	results []Result
+}
+
+// machineTemplate is an AWSMachineTemplate along with the details of the object
+// using it that matter to the checks.
+type machineTemplate struct {
+	template *infrav1.AWSMachineTemplate
+	// owners lists the objects referencing the template.
+	owners []string
+	// version is the Kubernetes version machines are created with, used for AMI lookups.
+	version string
+	// failureDomain is the availability zone machines are placed in, if fixed.
+	failureDomain string
+}
+
+func (m machineTemplate) name() string {
+	return fmt.Sprintf("%s/%s", awsMachineTemplateKind, m.template.Name)
+}
+
+func (t *target) identityRef() *infrav1.AWSIdentityReference {
+	if t.controlPlane != nil {
+		return t.controlPlane.Spec.IdentityRef
+	}
+	return t.awsCluster.Spec.IdentityRef
+}
+
+func (t *target) isEKS() bool {
+	return t.controlPlane != nil
+}
+
+// resolveTarget loads the cluster and the AWS objects it references.
+func (d *Doctor) resolveTarget(ctx context.Context) (*target, error) {
+	cluster, err := d.getCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &target{cluster: cluster}
+
+	infraRef := cluster.Spec.InfrastructureRef
+	switch infraRef.Kind {
+	case awsClusterKind:
+		t.awsCluster = &infrav1.AWSCluster{}
+		if err := d.client.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: infraRef.Name}, t.awsCluster); err != nil {
+			return nil, fmt.Errorf("getting AWSCluster %s/%s: %w", cluster.Namespace, infraRef.Name, err)
+		}
+	case awsManagedClusterKind:
+		if cluster.Spec.ControlPlaneRef.Kind != awsManagedControlPlaneKind {
+			return nil, fmt.Errorf("cluster %s/%s uses an AWSManagedCluster without an AWSManagedControlPlane", cluster.Namespace, cluster.Name)
+		}
+	default:
+		return nil, fmt.Errorf("cluster %s/%s has unsupported infrastructure kind %q, expected %s or %s", cluster.Namespace, cluster.Name, infraRef.Kind, awsClusterKind, awsManagedClusterKind)
+	}
+
+	templates := map[string]*machineTemplate{}
+	addTemplate := func(ref clusterv1.ContractVersionedObjectReference, owner, version, failureDomain string) {
+		if ref.Kind != awsMachineTemplateKind {
+			return
+		}
+		if m, ok := templates[ref.Name]; ok {
+			m.owners = append(m.owners, owner)
+			return
+		}
+		templates[ref.Name] = &machineTemplate{
+			owners:        []string{owner},
+			version:       version,
+			failureDomain: failureDomain,
+		}
+	}
+
+	controlPlaneVersion, err := d.resolveControlPlane(ctx, t, addTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	machineDeployments := &clusterv1.MachineDeploymentList{}
+	if err := d.client.List(ctx, machineDeployments, client.InNamespace(cluster.Namespace)); err != nil {
+		return nil, fmt.Errorf("listing machine deployments: %w", err)
+	}
+	for _, md := range machineDeployments.Items {
+		if md.Spec.ClusterName != cluster.Name {
+			continue
+		}
+		spec := md.Spec.Template.Spec
+		version := spec.Version
+		if version == "" {
+			version = controlPlaneVersion
+		}
+		addTemplate(spec.InfrastructureRef, "MachineDeployment/"+md.Name, version, spec.FailureDomain)
+	}
+
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		m := templates[name]
+		m.template = &infrav1.AWSMachineTemplate{}
+		if err := d.client.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: name}, m.template); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("getting AWSMachineTemplate %s/%s: %w", cluster.Namespace, name, err)
+			}
+			t.results = append(t.results, Result{
+				Check:       CheckReferences,
+				Object:      fmt.Sprintf("%s/%s", awsMachineTemplateKind, name),
+				Status:      StatusFail,
+				Message:     fmt.Sprintf("AWSMachineTemplate %s referenced by %v does not exist", name, m.owners),
+				Remediation: "Create the AWSMachineTemplate or fix the infrastructureRef of the objects referencing it",
+			})
+			continue
+		}
+		t.machines = append(t.machines, *m)
+	}
+
+	return t, nil
+}
+
+// getCluster gets the cluster to check. When no name is given, the namespace must
+// hold a single cluster.
+func (d *Doctor) getCluster(ctx context.Context) (*clusterv1.Cluster, error) {
+	if d.clusterName != "" {
+		cluster := &clusterv1.Cluster{}
+		if err := d.client.Get(ctx, client.ObjectKey{Namespace: d.namespace, Name: d.clusterName}, cluster); err != nil {
+			return nil, fmt.Errorf("getting capi cluster %s/%s: %w", d.namespace, d.clusterName, err)
+		}
+		return cluster, nil
+	}
+
+	clusters := &clusterv1.ClusterList{}
+	if err := d.client.List(ctx, clusters, client.InNamespace(d.namespace)); err != nil {
+		return nil, fmt.Errorf("listing capi clusters: %w", err)
+	}
+	if len(clusters.Items) != 1 {
+		return nil, fmt.Errorf("found %d clusters in namespace %s, a cluster name is required", len(clusters.Items), d.namespace)
+	}
+	return &clusters.Items[0], nil
+}
+
+// resolveControlPlane loads the control plane of the cluster, adding the machine
+// template it references. The Kubernetes version of the control plane is returned.
+func (d *Doctor) resolveControlPlane(ctx context.Context, t *target, addTemplate func(clusterv1.ContractVersionedObjectReference, string, string, string)) (string, error) {
+	cluster := t.cluster
+	ref := cluster.Spec.ControlPlaneRef
+	if !ref.IsDefined() {
+		return "", nil
+	}
+
+	if ref.Kind == awsManagedControlPlaneKind {
+		t.controlPlane = &ekscontrolplanev1.AWSManagedControlPlane{}
+		if err := d.client.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: ref.Name}, t.controlPlane); err != nil {
+			return "", fmt.Errorf("getting AWSManagedControlPlane %s/%s: %w", cluster.Namespace, ref.Name, err)
+		}
+		if t.controlPlane.Spec.Version != nil {
+			return *t.controlPlane.Spec.Version, nil
+		}
+		return "", nil
+	}
+
+	controlPlane, err := d.getUnstructured(ctx, ref, cluster.Namespace)
+	if err != nil {
+		return "", fmt.Errorf("getting control plane %s/%s: %w", cluster.Namespace, ref.Name, err)
+	}
+
+	version, _, _ := unstructured.NestedString(controlPlane.Object, "spec", "version")
+
+	// The machine template moved under spec.machineTemplate.spec with the v1beta2
+	// contract.
+	for _, path := range [][]string{
+		{"spec", "machineTemplate", "spec", "infrastructureRef"},
+		{"spec", "machineTemplate", "infrastructureRef"},
+	} {
+		infraRef, found, _ := unstructured.NestedStringMap(controlPlane.Object, path...)
+		if !found {
+			continue
+		}
+		addTemplate(clusterv1.ContractVersionedObjectReference{
+			Kind: infraRef["kind"],
+			Name: infraRef["name"],
+		}, fmt.Sprintf("%s/%s", ref.Kind, ref.Name), version, "")
+		break
+	}
+
+	return version, nil
+}
+
+// getUnstructured gets an object unknown to the scheme, either from the objects
+// loaded from a file or from the management cluster.
+func (d *Doctor) getUnstructured(ctx context.Context, ref clusterv1.ContractVersionedObjectReference, namespace string) (*unstructured.Unstructured, error) {
+	if !d.fromFile {
+		return external.GetObjectFromContractVersionedRef(ctx, d.client, ref, namespace)
+	}
+
+	for _, obj := range d.objects {
+		gvk := obj.GroupVersionKind()
+		if gvk.Group == ref.APIGroup && gvk.Kind == ref.Kind && obj.GetName() == ref.Name && obj.GetNamespace() == namespace {
+			return obj, nil
+		}
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Group: ref.APIGroup, Resource: ref.Kind}, ref.Name)
+}
+
+// newScope creates the scope the controllers would use to reconcile the cluster.
+func (d *Doctor) newScope(t *target) (clusterScope, error) {
+	if t.isEKS() {
+		return scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+			Client:         d.client,
+			Cluster:        t.cluster.DeepCopy(),
+			ControlPlane:   t.controlPlane.DeepCopy(),
+			ControllerName: ControllerName,
+		})
+	}
+
+	return scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:         d.client,
+		Cluster:        t.cluster.DeepCopy(),
+		AWSCluster:     t.awsCluster.DeepCopy(),
+		ControllerName: ControllerName,
+	})
+}
@@ -26,6 +26,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
@@ -61,6 +62,9 @@ import (
 
 const (
 	deleteRequeueAfter = 20 * time.Second
+	// transitGatewayAttachmentRequeueAfter is how often a transit gateway attachment being created or modified is checked,
+	// to reconcile the routes through the transit gateway once it is available.
+	transitGatewayAttachmentRequeueAfter = 30 * time.Second
 )
 
 var defaultAWSSecurityGroupRoles = []infrav1.SecurityGroupRole{
@@ -82,6 +86,7 @@ type AWSClusterReconciler struct {
 	ExternalResourceGC           bool
 	AlternativeGCStrategy        bool
 	TagUnmanagedNetworkResources bool
+	UnmanagedVPCFlowLogs         bool
 	MaxWaitActiveUpdateDelete    time.Duration
 }
 
@@ -132,7 +137,7 @@ func (r *AWSClusterReconciler) getSecurityGroupService(scope scope.ClusterScope)
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
-// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities;awsclusterwebidentities;awsclusterrolesanywhereidentities,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclustercontrolleridentities,verbs=get;list;watch;create
 
 func (r *AWSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -180,6 +185,7 @@ func (r *AWSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		AWSCluster:                   awsCluster,
 		ControllerName:               "awscluster",
 		TagUnmanagedNetworkResources: r.TagUnmanagedNetworkResources,
+		UnmanagedVPCFlowLogs:         r.UnmanagedVPCFlowLogs,
 		MaxWaitActiveUpdateDelete:    r.MaxWaitActiveUpdateDelete,
 	})
 	if err != nil {
@@ -290,6 +296,16 @@ func (r *AWSClusterReconciler) reconcileLoadBalancer(ctx context.Context, cluste
 		return r.checkForExternalControlPlaneLoadBalancer(clusterScope, awsCluster), nil
 	}
 
+	// The log buckets have to exist before the load balancer logs can be enabled.
+	s3Service := s3.NewService(clusterScope)
+	for _, bucketName := range loadBalancerLogsBuckets(clusterScope.ControlPlaneLoadBalancers()) {
+		if err := s3Service.ReconcileLoadBalancerLogsBucket(ctx, bucketName); err != nil {
+			clusterScope.Error(err, "failed to reconcile load balancer logs bucket", "bucket", bucketName)
+			v1beta1conditions.MarkFalse(awsCluster, infrav1.LoadBalancerReadyCondition, infrav1.LoadBalancerFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), "%s", err.Error())
+			return nil, err
+		}
+	}
+
 	elbService := r.getELBService(clusterScope)
 
 	if err := elbService.ReconcileLoadbalancers(ctx); err != nil {
@@ -304,11 +320,22 @@ func (r *AWSClusterReconciler) reconcileLoadBalancer(ctx context.Context, cluste
 		return &retryAfterDuration, nil
 	}
 
+	// When a Route53 record is configured, it is used as the control plane endpoint instead of the load balancer DNS name.
 	lbSpec := awsCluster.Spec.ControlPlaneLoadBalancer
+	endpointHost := awsCluster.Status.Network.APIServerELB.DNSName
+	if lbSpec.DNS != nil {
+		if awsCluster.Status.Network.APIServerDNSRecord == nil {
+			v1beta1conditions.MarkFalse(awsCluster, infrav1.LoadBalancerReadyCondition, infrav1.WaitForDNSNameReason, clusterv1beta1.ConditionSeverityInfo, "")
+			clusterScope.Info("Waiting on API server DNS record")
+			return &retryAfterDuration, nil
+		}
+		endpointHost = lbSpec.DNS.FQDN()
+	}
+
 	if lbSpec.DNSResolutionCheck == nil || *lbSpec.DNSResolutionCheck != infrav1.AWSLoadBalancerDNSResolutionCheckNone {
-		clusterScope.Debug("Looking up IP address for DNS", "dns", awsCluster.Status.Network.APIServerELB.DNSName)
-		if _, err := net.DefaultResolver.LookupIPAddr(ctx, awsCluster.Status.Network.APIServerELB.DNSName); err != nil {
-			clusterScope.Error(err, "failed to get IP address for dns name", "dns", awsCluster.Status.Network.APIServerELB.DNSName)
+		clusterScope.Debug("Looking up IP address for DNS", "dns", endpointHost)
+		if _, err := net.DefaultResolver.LookupIPAddr(ctx, endpointHost); err != nil {
+			clusterScope.Error(err, "failed to get IP address for dns name", "dns", endpointHost)
 			v1beta1conditions.MarkFalse(awsCluster, infrav1.LoadBalancerReadyCondition, infrav1.WaitForDNSNameResolveReason, clusterv1beta1.ConditionSeverityInfo, "")
 			clusterScope.Info("Waiting on API server ELB DNS name to resolve")
 			return &retryAfterDuration, nil
@@ -318,7 +345,7 @@ func (r *AWSClusterReconciler) reconcileLoadBalancer(ctx context.Context, cluste
 	v1beta1conditions.MarkTrue(awsCluster, infrav1.LoadBalancerReadyCondition)
 
 	awsCluster.Spec.ControlPlaneEndpoint = clusterv1beta1.APIEndpoint{
-		Host: awsCluster.Status.Network.APIServerELB.DNSName,
+		Host: endpointHost,
 		Port: clusterScope.APIServerPort(),
 	}
 
@@ -395,9 +422,31 @@ func (r *AWSClusterReconciler) reconcileNormal(ctx context.Context, clusterScope
 	}
 
 	awsCluster.Status.Ready = true
+
+	if awsCluster.Status.Network.TransitGatewayAttachment.IsTransitional() {
+		clusterScope.Debug("Transit gateway attachment is not settled, requeueing", "state", awsCluster.Status.Network.TransitGatewayAttachment.State)
+		return reconcile.Result{RequeueAfter: transitGatewayAttachmentRequeueAfter}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
+// loadBalancerLogsBuckets returns the S3 buckets CAPA creates for the logs of the given load balancers.
+func loadBalancerLogsBuckets(lbSpecs []*infrav1.AWSLoadBalancerSpec) []string {
+	buckets := sets.New[string]()
+	for _, lbSpec := range lbSpecs {
+		if lbSpec == nil {
+			continue
+		}
+		for _, logs := range []*infrav1.LoadBalancerLogsSpec{lbSpec.AccessLogs, lbSpec.ConnectionLogs} {
+			if logs != nil && logs.CreateBucket {
+				buckets.Insert(logs.Bucket)
+			}
+		}
+	}
+	return sets.List(buckets)
+}
+
 func (r *AWSClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
 	log := logger.FromContext(ctx)
 	controller, err := ctrl.NewControllerManagedBy(mgr).
@@ -287,6 +287,42 @@ func TestAWSClusterReconcileOperations(t *testing.T) {
 				_, err = reconciler.reconcileNormal(context.TODO(), cs)
 				g.Expect(err).To(Not(HaveOccurred()))
 			})
+
+			t.Run("Should requeue while the transit gateway attachment is pending", func(t *testing.T) {
+				g := NewWithT(t)
+				runningCluster := func() {
+					ec2Svc.EXPECT().ReconcileBastion().Return(nil)
+					networkSvc.EXPECT().ReconcileNetwork().Return(nil)
+					sgSvc.EXPECT().ReconcileSecurityGroups().Return(nil)
+				}
+
+				awsCluster := getAWSCluster("test", "test")
+				// The load balancer is provided externally, so the reconciliation doesn't wait on it.
+				awsCluster.Spec.ControlPlaneLoadBalancer.LoadBalancerType = infrav1.LoadBalancerTypeDisabled
+				awsCluster.Spec.ControlPlaneEndpoint = clusterv1beta1.APIEndpoint{
+					Host: "api.example.com",
+					Port: 6443,
+				}
+				csClient := setup(t, &awsCluster)
+				defer teardown()
+				runningCluster()
+				cs, err := scope.NewClusterScope(
+					scope.ClusterScopeParams{
+						Client:     csClient,
+						Cluster:    &clusterv1.Cluster{},
+						AWSCluster: &awsCluster,
+					},
+				)
+				g.Expect(err).To(BeNil())
+				awsCluster.Status.Network.TransitGatewayAttachment = &infrav1.TransitGatewayAttachment{
+					ID:               "tgw-attach-1",
+					TransitGatewayID: "tgw-1",
+					State:            "pending",
+				}
+				result, err := reconciler.reconcileNormal(context.TODO(), cs)
+				g.Expect(err).To(Not(HaveOccurred()))
+				g.Expect(result.RequeueAfter).To(Equal(transitGatewayAttachmentRequeueAfter))
+			})
 		})
 		t.Run("Reconcile failure", func(t *testing.T) {
 			expectedErr := errors.New("failed to get resource")
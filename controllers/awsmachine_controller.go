@@ -154,7 +154,7 @@ func (r *AWSMachineReconciler) getObjectStoreService(scope scope.S3Scope) servic
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=create;get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepools/finalizers,verbs=update
-// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
@@ -634,6 +634,10 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 	case infrav1.InstanceStateRunning:
 		machineScope.SetReady()
 		v1beta1conditions.MarkTrue(machineScope.AWSMachine, infrav1.InstanceReadyCondition)
+		clearPassedInterruption(machineScope.AWSMachine, time.Now())
+		if err := r.clearMachineInterruption(ctx, machineScope); err != nil {
+			return ctrl.Result{}, err
+		}
 	case infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated:
 		machineScope.SetNotReady()
 
@@ -657,6 +661,12 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 		v1beta1conditions.MarkUnknown(machineScope.AWSMachine, infrav1.InstanceReadyCondition, "", "")
 	}
 
+	powerStateRequeue, err := r.reconcilePowerState(ctx, ec2svc, machineScope, instance)
+	if err != nil {
+		machineScope.Error(err, "failed to reconcile power state")
+		return ctrl.Result{}, err
+	}
+
 	// reconcile the deletion of the bootstrap data secret now that we have updated instance state
 	if !machineScope.IsMachinePoolMachine() {
 		if deleteSecretErr := r.deleteBootstrapData(ctx, machineScope, clusterScope, objectStoreScope); deleteSecretErr != nil {
@@ -709,9 +719,46 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 		machineScope.Debug("but find the instance is pending, requeue", "instance", instance.ID)
 		return ctrl.Result{RequeueAfter: PendingInstanceRequeue}, nil
 	}
+	if powerStateRequeue {
+		machineScope.Debug("but the instance didn't reach its desired power state, requeue", "instance", instance.ID, "powerState", machineScope.DesiredPowerState())
+		return ctrl.Result{RequeueAfter: DefaultReconcilerRequeue}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
+// clearPassedInterruption clears the InstanceInterrupted condition of an AWSMachine whose instance is still
+// running once the notified interruption is over, e.g. after a rebalance recommendation or a scheduled reboot.
+func clearPassedInterruption(awsMachine *infrav1.AWSMachine, now time.Time) {
+	if !v1beta1conditions.IsTrue(awsMachine, infrav1.InstanceInterruptedCondition) {
+		return
+	}
+
+	// the end is unknown for interruptions notified by earlier versions, they are cleared right away
+	end, err := time.Parse(time.RFC3339, awsMachine.GetAnnotations()[infrav1.InstanceInterruptionEndAnnotation])
+	if err == nil && now.Before(end) {
+		return
+	}
+
+	v1beta1conditions.MarkFalseWithNegativePolarity(awsMachine, infrav1.InstanceInterruptedCondition)
+	delete(awsMachine.Annotations, infrav1.InstanceInterruptionEndAnnotation)
+}
+
+// clearMachineInterruption removes the interruption annotation from the Machine owning an AWSMachine
+// whose InstanceInterrupted condition is no longer true.
+func (r *AWSMachineReconciler) clearMachineInterruption(ctx context.Context, machineScope *scope.MachineScope) error {
+	machine := machineScope.Machine
+	if v1beta1conditions.IsTrue(machineScope.AWSMachine, infrav1.InstanceInterruptedCondition) {
+		return nil
+	}
+	if _, ok := machine.GetAnnotations()[infrav1.InstanceInterruptionAnnotation]; !ok {
+		return nil
+	}
+
+	patchBase := client.MergeFrom(machine.DeepCopy())
+	delete(machine.Annotations, infrav1.InstanceInterruptionAnnotation)
+	return r.Client.Patch(ctx, machine, patchBase)
+}
+
 func (r *AWSMachineReconciler) reconcileOperationalState(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
 	machineScope.SetAddresses(instance.Addresses)
 
@@ -34,6 +34,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
@@ -56,6 +57,7 @@ import (
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
 const providerID = "aws:////myMachine"
@@ -2997,6 +2999,299 @@ func TestAWSMachineReconcilerReconcileDefaultsToLoadBalancerTypeClassic(t *testi
 	g.Expect(err).To(BeNil())
 }
 
+func TestAWSMachineReconcilerReconcilePowerState(t *testing.T) {
+	nodeReady := []metav1.Condition{{Type: clusterv1.MachineNodeReadyCondition, Status: metav1.ConditionTrue}}
+
+	testCases := []struct {
+		name                   string
+		powerState             infrav1.InstancePowerState
+		instanceState          infrav1.InstanceState
+		hibernationEnabled     bool
+		machineAnnotations     map[string]string
+		machineConditions      []metav1.Condition
+		expect                 func(m *mock_services.MockEC2InterfaceMockRecorder)
+		wantErr                bool
+		wantRequeue            bool
+		wantReason             string
+		wantSkipRemediation    bool
+		wantSkipRemediationVal string
+	}{
+		{
+			name:          "power state not managed",
+			instanceState: infrav1.InstanceStateStopped,
+			expect:        func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+		},
+		{
+			name:          "stops a running instance",
+			powerState:    infrav1.InstancePowerStateStopped,
+			instanceState: infrav1.InstanceStateRunning,
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.StopInstance("i-1", false).Return(nil)
+			},
+			wantRequeue:            true,
+			wantReason:             infrav1.InstancePoweredOffReason,
+			wantSkipRemediation:    true,
+			wantSkipRemediationVal: PowerStateSkipRemediationValue,
+		},
+		{
+			name:               "hibernates a running instance",
+			powerState:         infrav1.InstancePowerStateHibernated,
+			instanceState:      infrav1.InstanceStateRunning,
+			hibernationEnabled: true,
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.StopInstance("i-1", true).Return(nil)
+			},
+			wantRequeue:            true,
+			wantReason:             infrav1.InstancePoweredOffReason,
+			wantSkipRemediation:    true,
+			wantSkipRemediationVal: PowerStateSkipRemediationValue,
+		},
+		{
+			name:                   "fails to hibernate an instance launched without hibernation",
+			powerState:             infrav1.InstancePowerStateHibernated,
+			instanceState:          infrav1.InstanceStateRunning,
+			expect:                 func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+			wantErr:                true,
+			wantReason:             infrav1.InstancePowerStateFailedReason,
+			wantSkipRemediation:    true,
+			wantSkipRemediationVal: PowerStateSkipRemediationValue,
+		},
+		{
+			name:                   "keeps the skip remediation annotation set by users",
+			powerState:             infrav1.InstancePowerStateStopped,
+			instanceState:          infrav1.InstanceStateStopped,
+			machineAnnotations:     map[string]string{clusterv1.MachineSkipRemediationAnnotation: ""},
+			expect:                 func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+			wantReason:             infrav1.InstancePoweredOffReason,
+			wantSkipRemediation:    true,
+			wantSkipRemediationVal: "",
+		},
+		{
+			name:               "starts a stopped instance",
+			powerState:         infrav1.InstancePowerStateRunning,
+			instanceState:      infrav1.InstanceStateStopped,
+			machineAnnotations: map[string]string{clusterv1.MachineSkipRemediationAnnotation: PowerStateSkipRemediationValue},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.StartInstance("i-1").Return(nil)
+			},
+			wantRequeue:            true,
+			wantReason:             infrav1.InstanceStartingReason,
+			wantSkipRemediation:    true,
+			wantSkipRemediationVal: PowerStateSkipRemediationValue,
+		},
+		{
+			name:                   "keeps the skip remediation annotation until the node is ready",
+			powerState:             infrav1.InstancePowerStateRunning,
+			instanceState:          infrav1.InstanceStateRunning,
+			machineAnnotations:     map[string]string{clusterv1.MachineSkipRemediationAnnotation: PowerStateSkipRemediationValue},
+			expect:                 func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+			wantSkipRemediation:    true,
+			wantSkipRemediationVal: PowerStateSkipRemediationValue,
+		},
+		{
+			name:               "removes the skip remediation annotation once the node is ready",
+			powerState:         infrav1.InstancePowerStateRunning,
+			instanceState:      infrav1.InstanceStateRunning,
+			machineAnnotations: map[string]string{clusterv1.MachineSkipRemediationAnnotation: PowerStateSkipRemediationValue},
+			machineConditions:  nodeReady,
+			expect:             func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Namespace:   "default",
+					Annotations: tc.machineAnnotations,
+				},
+				Status: clusterv1.MachineStatus{
+					Conditions: tc.machineConditions,
+				},
+			}
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: infrav1.AWSMachineSpec{
+					PowerState: tc.powerState,
+				},
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(machine, awsMachine).Build()
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     fakeClient,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+			ms, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       fakeClient,
+				Cluster:      &clusterv1.Cluster{},
+				Machine:      machine,
+				AWSMachine:   awsMachine,
+				InfraCluster: cs,
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			tc.expect(ec2Svc.EXPECT())
+			reconciler := AWSMachineReconciler{
+				Client:   fakeClient,
+				Recorder: record.NewFakeRecorder(10),
+			}
+			instance := &infrav1.Instance{
+				ID:                 "i-1",
+				State:              tc.instanceState,
+				HibernationEnabled: tc.hibernationEnabled,
+			}
+
+			requeue, err := reconciler.reconcilePowerState(context.Background(), ec2Svc, ms, instance)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(requeue).To(Equal(tc.wantRequeue))
+			if tc.wantReason != "" {
+				g.Expect(v1beta1conditions.GetReason(ms.AWSMachine, infrav1.InstanceReadyCondition)).To(Equal(tc.wantReason))
+			}
+
+			updated := &clusterv1.Machine{}
+			g.Expect(fakeClient.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "test"}, updated)).To(Succeed())
+			value, ok := updated.Annotations[clusterv1.MachineSkipRemediationAnnotation]
+			g.Expect(ok).To(Equal(tc.wantSkipRemediation))
+			g.Expect(value).To(Equal(tc.wantSkipRemediationVal))
+		})
+	}
+}
+
+func TestClearPassedInterruption(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		interrupted     bool
+		end             string
+		wantInterrupted bool
+	}{
+		{
+			name:            "keeps an interruption that isn't over",
+			interrupted:     true,
+			end:             now.Add(time.Minute).Format(time.RFC3339),
+			wantInterrupted: true,
+		},
+		{
+			name:            "clears an interruption that is over",
+			interrupted:     true,
+			end:             now.Add(-time.Minute).Format(time.RFC3339),
+			wantInterrupted: false,
+		},
+		{
+			name:            "clears an interruption without end",
+			interrupted:     true,
+			wantInterrupted: false,
+		},
+		{
+			name:            "does nothing without interruption",
+			interrupted:     false,
+			wantInterrupted: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			awsMachine := &infrav1.AWSMachine{}
+			if tc.interrupted {
+				v1beta1conditions.MarkTrueWithNegativePolarity(awsMachine, infrav1.InstanceInterruptedCondition, infrav1.ScheduledEventReason, clusterv1beta1.ConditionSeverityWarning, "")
+			}
+			if tc.end != "" {
+				awsMachine.Annotations = map[string]string{infrav1.InstanceInterruptionEndAnnotation: tc.end}
+			}
+
+			clearPassedInterruption(awsMachine, now)
+
+			g.Expect(v1beta1conditions.IsTrue(awsMachine, infrav1.InstanceInterruptedCondition)).To(Equal(tc.wantInterrupted))
+			if tc.interrupted && !tc.wantInterrupted {
+				g.Expect(v1beta1conditions.IsFalse(awsMachine, infrav1.InstanceInterruptedCondition)).To(BeTrue())
+				g.Expect(awsMachine.Annotations).ToNot(HaveKey(infrav1.InstanceInterruptionEndAnnotation))
+			}
+		})
+	}
+}
+
+func TestClearMachineInterruption(t *testing.T) {
+	testCases := []struct {
+		name           string
+		interrupted    bool
+		annotations    map[string]string
+		wantAnnotation bool
+	}{
+		{
+			name:           "keeps the annotation while interrupted",
+			interrupted:    true,
+			annotations:    map[string]string{infrav1.InstanceInterruptionAnnotation: infrav1.ScheduledEventReason},
+			wantAnnotation: true,
+		},
+		{
+			name:           "removes the annotation once the interruption is over",
+			annotations:    map[string]string{infrav1.InstanceInterruptionAnnotation: infrav1.ScheduledEventReason, "foo": "bar"},
+			wantAnnotation: false,
+		},
+		{
+			name:           "does nothing without annotation",
+			wantAnnotation: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Namespace:   "default",
+					Annotations: tc.annotations,
+				},
+			}
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+			if tc.interrupted {
+				v1beta1conditions.MarkTrueWithNegativePolarity(awsMachine, infrav1.InstanceInterruptedCondition, infrav1.ScheduledEventReason, clusterv1beta1.ConditionSeverityWarning, "")
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(machine, awsMachine).Build()
+
+			reconciler := AWSMachineReconciler{Client: fakeClient}
+			ms := &scope.MachineScope{Machine: machine, AWSMachine: awsMachine}
+			g.Expect(reconciler.clearMachineInterruption(context.Background(), ms)).To(Succeed())
+
+			updated := &clusterv1.Machine{}
+			g.Expect(fakeClient.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "test"}, updated)).To(Succeed())
+			g.Expect(updated.Annotations).To(Equal(machine.Annotations))
+			_, ok := updated.Annotations[infrav1.InstanceInterruptionAnnotation]
+			g.Expect(ok).To(Equal(tc.wantAnnotation))
+		})
+	}
+}
+
 func createObject(g *WithT, obj client.Object, namespace string) {
 	if obj.DeepCopyObject() != nil {
 		obj.SetNamespace(namespace)
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+const (
+	// PowerStateSkipRemediationValue is the value of the skip remediation annotation set on a Machine while
+	// its instance is stopped or hibernated. It tells the annotation apart from one set by users, which is
+	// never removed.
+	PowerStateSkipRemediationValue = "awsmachine-power-state"
+)
+
+// reconcilePowerState stops, hibernates or starts the instance to match the power state of the AWSMachine,
+// and reports it in the InstanceReady condition.
+// Returns true if the instance didn't reach its desired power state yet.
+func (r *AWSMachineReconciler) reconcilePowerState(ctx context.Context, ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) (bool, error) {
+	desired := machineScope.DesiredPowerState()
+
+	// Exclude the Machine from remediation before stopping the instance, so it isn't replaced
+	// as soon as its node stops reporting.
+	if err := r.reconcileSkipRemediation(ctx, machineScope, instance, desired); err != nil {
+		return false, errors.Wrap(err, "failed to update skip remediation annotation of machine")
+	}
+
+	switch desired {
+	case infrav1.InstancePowerStateStopped, infrav1.InstancePowerStateHibernated:
+		switch instance.State {
+		case infrav1.InstanceStateRunning:
+			hibernate := desired == infrav1.InstancePowerStateHibernated
+			if hibernate && !instance.HibernationEnabled {
+				err := errors.Errorf("instance %q was not launched with hibernation enabled", instance.ID)
+				v1beta1conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstancePowerStateFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+				return false, err
+			}
+
+			machineScope.Info("Stopping EC2 instance", "instance-id", instance.ID, "hibernate", hibernate)
+			if err := ec2svc.StopInstance(instance.ID, hibernate); err != nil {
+				r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedStop", "Failed to stop instance %q: %v", instance.ID, err)
+				v1beta1conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstancePowerStateFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+				return false, err
+			}
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulStop", "Stopped instance %q (hibernate: %t)", instance.ID, hibernate)
+			machineScope.SetNotReady()
+			v1beta1conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstancePoweredOffReason, clusterv1beta1.ConditionSeverityInfo, "instance is being %s", strings.ToLower(string(desired)))
+			return true, nil
+		case infrav1.InstanceStateStopping, infrav1.InstanceStateStopped:
+			machineScope.SetNotReady()
+			v1beta1conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstancePoweredOffReason, clusterv1beta1.ConditionSeverityInfo, "instance is %s", strings.ToLower(string(desired)))
+			return instance.State == infrav1.InstanceStateStopping, nil
+		}
+	case infrav1.InstancePowerStateRunning:
+		switch instance.State {
+		case infrav1.InstanceStateStopped:
+			machineScope.Info("Starting EC2 instance", "instance-id", instance.ID)
+			if err := ec2svc.StartInstance(instance.ID); err != nil {
+				r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedStart", "Failed to start instance %q: %v", instance.ID, err)
+				v1beta1conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstancePowerStateFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+				return false, err
+			}
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulStart", "Started instance %q", instance.ID)
+			v1beta1conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStartingReason, clusterv1beta1.ConditionSeverityInfo, "")
+			return true, nil
+		case infrav1.InstanceStateStopping:
+			// A stopping instance cannot be started, wait for it to be stopped.
+			v1beta1conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStartingReason, clusterv1beta1.ConditionSeverityInfo, "waiting for instance to stop before starting it")
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// reconcileSkipRemediation sets the skip remediation annotation on the Machine while its instance is requested
+// to be stopped or hibernated, so MachineHealthChecks don't remediate parked machines. The annotation is kept
+// after the instance is started again until its node is ready.
+func (r *AWSMachineReconciler) reconcileSkipRemediation(ctx context.Context, machineScope *scope.MachineScope, instance *infrav1.Instance, desired infrav1.InstancePowerState) error {
+	machine := machineScope.Machine
+	value, ok := machine.GetAnnotations()[clusterv1.MachineSkipRemediationAnnotation]
+	parked := desired == infrav1.InstancePowerStateStopped || desired == infrav1.InstancePowerStateHibernated
+
+	patchBase := client.MergeFrom(machine.DeepCopy())
+	switch {
+	case parked && !ok:
+		if machine.Annotations == nil {
+			machine.Annotations = map[string]string{}
+		}
+		machine.Annotations[clusterv1.MachineSkipRemediationAnnotation] = PowerStateSkipRemediationValue
+	case !parked && ok && value == PowerStateSkipRemediationValue &&
+		instance.State == infrav1.InstanceStateRunning && conditions.IsTrue(machine, clusterv1.MachineNodeReadyCondition):
+		delete(machine.Annotations, clusterv1.MachineSkipRemediationAnnotation)
+	default:
+		return nil
+	}
+
+	return r.Client.Patch(ctx, machine, patchBase)
+}
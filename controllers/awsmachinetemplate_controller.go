@@ -18,7 +18,6 @@ package controllers
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -26,7 +25,6 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
@@ -261,21 +259,8 @@ func (r *AWSMachineTemplateReconciler) getInstanceTypeCapacity(ctx context.Conte
 		return nil, errors.Errorf("no information found for instance type %q", instanceType)
 	}
 
-	// Extract capacity information
-	info := result.InstanceTypes[0]
-	resourceList := corev1.ResourceList{}
-
-	// CPU
-	if info.VCpuInfo != nil && info.VCpuInfo.DefaultVCpus != nil {
-		resourceList[corev1.ResourceCPU] = *resource.NewQuantity(int64(*info.VCpuInfo.DefaultVCpus), resource.DecimalSI)
-	}
-
-	// Memory
-	if info.MemoryInfo != nil && info.MemoryInfo.SizeInMiB != nil {
-		resourceList[corev1.ResourceMemory] = resource.MustParse(fmt.Sprintf("%dMi", *info.MemoryInfo.SizeInMiB))
-	}
-
-	return resourceList, nil
+	// Extract capacity information (CPU, Memory)
+	return ec2service.InstanceTypeCapacity(result.InstanceTypes[0]), nil
 }
 
 // getNodeInfo queries node information (architecture and OS) for the AWSMachineTemplate.
@@ -122,9 +122,27 @@ func (r *AWSManagedControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Status.Version = restored.Status.Version
 	dst.Spec.BootstrapSelfManagedAddons = restored.Spec.BootstrapSelfManagedAddons
 	dst.Spec.UpgradePolicy = restored.Spec.UpgradePolicy
+	dst.Spec.PodIdentityAssociations = restored.Spec.PodIdentityAssociations
+	dst.Spec.AutoMode = restored.Spec.AutoMode
+	dst.Status.PodIdentityAssociations = restored.Status.PodIdentityAssociations
+	restoreAddonPodIdentityAssociations(dst.Spec.Addons, restored.Spec.Addons)
 	return nil
 }
 
+// restoreAddonPodIdentityAssociations restores the pod identity association references of the addons, matched by name.
+func restoreAddonPodIdentityAssociations(dst, restored *[]ekscontrolplanev1.Addon) {
+	if dst == nil || restored == nil {
+		return
+	}
+	for i := range *dst {
+		for _, addon := range *restored {
+			if addon.Name == (*dst)[i].Name {
+				(*dst)[i].PodIdentityAssociations = addon.PodIdentityAssociations
+			}
+		}
+	}
+}
+
 // ConvertFrom converts the v1beta2 AWSManagedControlPlane receiver to a v1beta1 AWSManagedControlPlane.
 func (r *AWSManagedControlPlane) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*ekscontrolplanev1.AWSManagedControlPlane)
@@ -247,6 +265,11 @@ func Convert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPl
 	return autoConvert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPlaneStatus(in, out, s)
 }
 
+// Convert_v1beta2_Addon_To_v1beta1_Addon is a conversion function.
+func Convert_v1beta2_Addon_To_v1beta1_Addon(in *ekscontrolplanev1.Addon, out *Addon, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_Addon_To_v1beta1_Addon(in, out, s)
+}
+
 func Convert_v1beta1_EKSTokenMethod_To_v1beta2_EKSTokenMethod(src *EKSTokenMethod, dst **ekscontrolplanev1.EKSTokenMethod) {
 	if src == nil {
 		*dst = nil
@@ -264,3 +287,35 @@ func Convert_v1beta2_EKSTokenMethod_To_v1beta1_EKSTokenMethod(src *ekscontrolpla
 	tokenMethod := EKSTokenMethod(*src)
 	*dst = &tokenMethod
 }
+
+// Convert_Pointer_Slice_v1beta1_Addon_To_Pointer_Slice_v1beta2_Addon is a conversion function.
+func Convert_Pointer_Slice_v1beta1_Addon_To_Pointer_Slice_v1beta2_Addon(in **[]Addon, out **[]ekscontrolplanev1.Addon, s apiconversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	addons := make([]ekscontrolplanev1.Addon, len(**in))
+	for i := range **in {
+		if err := Convert_v1beta1_Addon_To_v1beta2_Addon(&(**in)[i], &addons[i], s); err != nil {
+			return err
+		}
+	}
+	*out = &addons
+	return nil
+}
+
+// Convert_Pointer_Slice_v1beta2_Addon_To_Pointer_Slice_v1beta1_Addon is a conversion function.
+func Convert_Pointer_Slice_v1beta2_Addon_To_Pointer_Slice_v1beta1_Addon(in **[]ekscontrolplanev1.Addon, out **[]Addon, s apiconversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	addons := make([]Addon, len(**in))
+	for i := range **in {
+		if err := Convert_v1beta2_Addon_To_v1beta1_Addon(&(**in)[i], &addons[i], s); err != nil {
+			return err
+		}
+	}
+	*out = &addons
+	return nil
+}
@@ -201,6 +201,15 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	AccessEntries []AccessEntry `json:"accessEntries,omitempty"`
 
+	// PodIdentityAssociations specifies the EKS Pod Identity associations for the cluster,
+	// binding Kubernetes service accounts to IAM roles. Associations require the
+	// eks-pod-identity-agent addon to be installed in the cluster.
+	// +optional
+	// +listType=map
+	// +listMapKey=namespace
+	// +listMapKey=serviceAccount
+	PodIdentityAssociations []PodIdentityAssociation `json:"podIdentityAssociations,omitempty"`
+
 	// VpcCni is used to set configuration options for the VPC CNI plugin
 	// +optional
 	VpcCni VpcCni `json:"vpcCni,omitempty"`
@@ -218,6 +227,12 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// KubeProxy defines managed attributes of the kube-proxy daemonset
 	KubeProxy KubeProxy `json:"kubeProxy,omitempty"`
 
+	// AutoMode configures EKS Auto Mode for the cluster. With Auto Mode, EKS manages the compute,
+	// block storage and load balancing capabilities of the cluster, including the networking addons.
+	// Auto Mode requires AuthenticationMode to be either "api" or "api_and_config_map".
+	// +optional
+	AutoMode *AutoMode `json:"autoMode,omitempty"`
+
 	// The cluster upgrade policy to use for the cluster.
 	// (Official AWS docs for this policy: https://docs.aws.amazon.com/eks/latest/userguide/view-upgrade-policy.html)
 	// `extended` upgrade policy indicates that the cluster will enter into extended support once the Kubernetes version reaches end of standard support. You will incur extended support charges with this setting. You can upgrade your cluster to a standard supported Kubernetes version to stop incurring extended support charges.
@@ -398,6 +413,9 @@ type AWSManagedControlPlaneStatus struct {
 	// associated identity provider
 	// +optional
 	IdentityProviderStatus IdentityProviderStatus `json:"identityProviderStatus,omitempty"`
+	// PodIdentityAssociations holds the current status of the EKS Pod Identity associations
+	// +optional
+	PodIdentityAssociations []PodIdentityAssociationStatus `json:"podIdentityAssociations,omitempty"`
 	// Version represents the minimum Kubernetes version for the control plane machines
 	// in the cluster.
 	// +optional
@@ -36,6 +36,9 @@ const (
 	IAMControlPlaneRolesReadyCondition clusterv1beta1.ConditionType = "IAMControlPlaneRolesReady"
 	// IAMControlPlaneRolesReconciliationFailedReason used to report failures while reconciling EKS control plane iam roles.
 	IAMControlPlaneRolesReconciliationFailedReason = "IAMControlPlaneRolesReconciliationFailed"
+	// IAMControlPlaneRoleMissingAutoModePermissionsReason used when the unmanaged control plane iam role lacks
+	// the trust or the policies required by EKS Auto Mode.
+	IAMControlPlaneRoleMissingAutoModePermissionsReason = "IAMControlPlaneRoleMissingAutoModePermissions"
 )
 
 const (
@@ -52,6 +55,22 @@ const (
 	EKSAddonsConfiguredFailedReason = "EKSAddonsConfiguredFailed"
 )
 
+const (
+	// EKSAutoModeConfiguredCondition condition reports on the successful reconciliation of the EKS Auto Mode configuration.
+	EKSAutoModeConfiguredCondition clusterv1beta1.ConditionType = "EKSAutoModeConfigured"
+	// EKSAutoModeUpdatingReason used when the EKS Auto Mode configuration of the cluster is being updated.
+	EKSAutoModeUpdatingReason = "EKSAutoModeUpdating"
+	// EKSAutoModeConfigurationFailedReason used to report failures while reconciling the EKS Auto Mode configuration.
+	EKSAutoModeConfigurationFailedReason = "EKSAutoModeConfigurationFailed"
+)
+
+const (
+	// EKSPodIdentityAssociationsConfiguredCondition condition reports on the successful reconciliation of EKS Pod Identity associations.
+	EKSPodIdentityAssociationsConfiguredCondition clusterv1beta1.ConditionType = "EKSPodIdentityAssociationsConfigured"
+	// EKSPodIdentityAssociationsConfiguredFailedReason used to report failures while reconciling the EKS Pod Identity associations.
+	EKSPodIdentityAssociationsConfiguredFailedReason = "EKSPodIdentityAssociationsConfiguredFailed"
+)
+
 const (
 	// EKSIdentityProviderConfiguredCondition condition reports on the successful association of identity provider config.
 	EKSIdentityProviderConfiguredCondition clusterv1beta1.ConditionType = "EKSIdentityProviderConfigured"
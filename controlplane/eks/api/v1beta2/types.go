@@ -200,8 +200,118 @@ type Addon struct {
 	// preserved in the cluster on delete.
 	// +optional
 	PreserveOnDelete bool `json:"preserveOnDelete,omitempty"`
+	// PodIdentityAssociations references pod identity associations of the control plane, by namespace
+	// and service account, whose roles are bound to the addon service accounts instead of ServiceAccountRoleArn.
+	// The referenced associations are created and owned by the addon. Removing all the references
+	// leaves the associations of the addon in place.
+	// +optional
+	PodIdentityAssociations []AddonPodIdentityAssociationReference `json:"podIdentityAssociations,omitempty"`
+}
+
+// AddonPodIdentityAssociationReference references a pod identity association of the control plane.
+type AddonPodIdentityAssociationReference struct {
+	// Namespace is the namespace of the service account of the association. EKS creates the
+	// associations of an addon in the namespace the addon is installed in, so it must be that namespace.
+	// +kubebuilder:validation:MinLength:=1
+	Namespace string `json:"namespace"`
+	// ServiceAccount is the name of the service account of the association.
+	// +kubebuilder:validation:MinLength:=1
+	ServiceAccount string `json:"serviceAccount"`
 }
 
+// PodIdentityAssociation represents an EKS Pod Identity association between a Kubernetes service account
+// and an IAM role.
+// +kubebuilder:validation:XValidation:rule="has(self.roleARN) != has(self.role)",message="exactly one of roleARN or role must be set"
+type PodIdentityAssociation struct {
+	// Namespace is the namespace of the service account.
+	// +kubebuilder:validation:MinLength:=1
+	Namespace string `json:"namespace"`
+	// ServiceAccount is the name of the service account.
+	// +kubebuilder:validation:MinLength:=1
+	ServiceAccount string `json:"serviceAccount"`
+	// RoleARN is the ARN of an existing IAM role to associate with the service account.
+	// The role must trust the pods.eks.amazonaws.com service principal.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+	// Role is an IAM role to create for the association, trusting the pods.eks.amazonaws.com
+	// service principal. The role is deleted when the association is removed.
+	// Creating roles requires the EKSEnableIAM feature flag.
+	// +optional
+	Role *PodIdentityRole `json:"role,omitempty"`
+}
+
+// PodIdentityRole defines an IAM role created for a pod identity association.
+type PodIdentityRole struct {
+	// Name is the name of the role. If not specified, a name is generated from the
+	// cluster name, namespace and service account.
+	// +kubebuilder:validation:MaxLength:=64
+	// +optional
+	Name string `json:"name,omitempty"`
+	// PolicyARNs are the ARNs of the managed policies to attach to the role.
+	// +optional
+	PolicyARNs []string `json:"policyARNs,omitempty"`
+}
+
+// PodIdentityAssociationStatus represents the state of a pod identity association.
+type PodIdentityAssociationStatus struct {
+	// Namespace is the namespace of the service account.
+	Namespace string `json:"namespace"`
+	// ServiceAccount is the name of the service account.
+	ServiceAccount string `json:"serviceAccount"`
+	// AssociationID is the ID of the association.
+	// +optional
+	AssociationID string `json:"associationID,omitempty"`
+	// AssociationARN is the ARN of the association.
+	// +optional
+	AssociationARN string `json:"associationARN,omitempty"`
+	// RoleARN is the ARN of the IAM role associated with the service account.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+	// RoleName is the name of the IAM role created for the association, if any.
+	// +optional
+	RoleName string `json:"roleName,omitempty"`
+	// OwnerARN is the ARN of the addon owning the association, if any.
+	// +optional
+	OwnerARN string `json:"ownerARN,omitempty"`
+}
+
+// AutoMode defines the EKS Auto Mode configuration of a cluster.
+type AutoMode struct {
+	// Enabled specifies whether EKS Auto Mode is enabled for the cluster.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled"`
+
+	// Compute defines the compute capability of EKS Auto Mode.
+	// +optional
+	Compute *AutoModeCompute `json:"compute,omitempty"`
+}
+
+// AutoModeCompute defines the compute capability of EKS Auto Mode.
+type AutoModeCompute struct {
+	// NodePools are the built-in node pools to create in the cluster. If no node pools are
+	// specified, only custom node pools can be used to run workloads.
+	// +optional
+	NodePools []AutoModeNodePool `json:"nodePools,omitempty"`
+
+	// NodeRoleArn is the ARN of the IAM role assigned to the nodes of the built-in node pools.
+	// It is required when node pools are specified and cannot be changed once set.
+	// +optional
+	NodeRoleArn *string `json:"nodeRoleArn,omitempty"`
+}
+
+// AutoModeNodePool is a built-in node pool of EKS Auto Mode.
+// +kubebuilder:validation:Enum=general-purpose;system
+type AutoModeNodePool string
+
+const (
+	// AutoModeNodePoolGeneralPurpose is the built-in node pool for general purpose workloads.
+	AutoModeNodePoolGeneralPurpose = AutoModeNodePool("general-purpose")
+
+	// AutoModeNodePoolSystem is the built-in node pool for critical add-ons, tolerating only
+	// workloads with the CriticalAddonsOnly taint toleration.
+	AutoModeNodePoolSystem = AutoModeNodePool("system")
+)
+
 // AddonResolution defines the method for resolving parameter conflicts.
 type AddonResolution string
 
@@ -106,6 +106,7 @@ type AWSManagedControlPlaneReconciler struct {
 	WaitInfraPeriod              time.Duration
 	MaxWaitActiveUpdateDelete    time.Duration
 	TagUnmanagedNetworkResources bool
+	UnmanagedVPCFlowLogs         bool
 }
 
 // getAWSNodeService factory func is added for testing purpose so that we can inject mocked AWSNodeInterface to the AWSManagedControlPlaneReconciler.
@@ -209,7 +210,7 @@ func (r *AWSManagedControlPlaneReconciler) SetupWithManager(ctx context.Context,
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=awsmanagedcontrolplanes,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=awsmanagedcontrolplanes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=awsmanagedcontrolplanes/finalizers,verbs=update
-// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities;awsclustercontrolleridentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities;awsclusterwebidentities;awsclusterrolesanywhereidentities;awsclustercontrolleridentities,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmanagedclusters;awsmanagedclusters/status,verbs=get;list;watch
 
 // Reconcile will reconcile AWSManagedControlPlane Resources.
@@ -253,6 +254,7 @@ func (r *AWSManagedControlPlaneReconciler) Reconcile(ctx context.Context, req ct
 		EnableIAM:                    r.EnableIAM,
 		AllowAdditionalRoles:         r.AllowAdditionalRoles,
 		TagUnmanagedNetworkResources: r.TagUnmanagedNetworkResources,
+		UnmanagedVPCFlowLogs:         r.UnmanagedVPCFlowLogs,
 		Logger:                       log,
 	})
 	if err != nil {
@@ -266,6 +268,8 @@ func (r *AWSManagedControlPlaneReconciler) Reconcile(ctx context.Context, req ct
 			ekscontrolplanev1.IAMControlPlaneRolesReadyCondition,
 			ekscontrolplanev1.IAMAuthenticatorConfiguredCondition,
 			ekscontrolplanev1.EKSAddonsConfiguredCondition,
+			ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition,
+			ekscontrolplanev1.EKSAutoModeConfiguredCondition,
 			infrav1.VpcReadyCondition,
 			infrav1.SubnetsReadyCondition,
 			infrav1.ClusterSecurityGroupsReadyCondition,
@@ -338,8 +342,6 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 	ekssvc := r.getEKSService(managedScope)
 	sgService := r.getSecurityGroupService(managedScope)
 	authService := r.getIAMAuthenticatorService(managedScope, iamauth.BackendTypeConfigMap, managedScope.Client)
-	awsnodeService := r.getAWSNodeService(managedScope)
-	kubeproxyService := r.getKubeProxyService(managedScope)
 
 	if err := networkSvc.ReconcileNetwork(); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile network for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
@@ -359,13 +361,8 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
 
-	if err := awsnodeService.ReconcileCNI(ctx); err != nil {
-		v1beta1conditions.MarkFalse(managedScope.InfraCluster(), infrav1.SecondaryCidrsReadyCondition, infrav1.SecondaryCidrReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
-		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
-	}
-
-	if err := kubeproxyService.ReconcileKubeProxy(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	if err := r.reconcileCNIAndKubeProxy(ctx, managedScope); err != nil {
+		return reconcile.Result{}, err
 	}
 
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
@@ -394,9 +391,35 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		return reconcile.Result{RequeueAfter: r.WaitInfraPeriod}, nil
 	}
 
+	// Routes through the transit gateway are reconciled once its attachment is available.
+	if awsManagedControlPlane.Status.Network.TransitGatewayAttachment.IsTransitional() {
+		managedScope.Debug("Transit gateway attachment is not settled, requeueing", "state", awsManagedControlPlane.Status.Network.TransitGatewayAttachment.State)
+		return reconcile.Result{RequeueAfter: r.WaitInfraPeriod}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
+// reconcileCNIAndKubeProxy reconciles the aws-node DaemonSet and kube-proxy of the cluster.
+// With EKS Auto Mode, EKS runs both outside of the cluster, so there is nothing to reconcile.
+func (r *AWSManagedControlPlaneReconciler) reconcileCNIAndKubeProxy(ctx context.Context, managedScope *scope.ManagedControlPlaneScope) error {
+	awsManagedControlPlane := managedScope.ControlPlane
+	if managedScope.AutoModeEnabled() {
+		managedScope.Debug("Skipping VPC CNI and kube-proxy reconciliation, they are managed by EKS Auto Mode")
+		return nil
+	}
+
+	if err := r.getAWSNodeService(managedScope).ReconcileCNI(ctx); err != nil {
+		v1beta1conditions.MarkFalse(managedScope.InfraCluster(), infrav1.SecondaryCidrsReadyCondition, infrav1.SecondaryCidrReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	}
+
+	if err := r.getKubeProxyService(managedScope).ReconcileKubeProxy(ctx); err != nil {
+		return fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+	}
+	return nil
+}
+
 func (r *AWSManagedControlPlaneReconciler) reconcileDelete(ctx context.Context, managedScope *scope.ManagedControlPlaneScope) (_ ctrl.Result, reterr error) {
 	log := logger.FromContext(ctx)
 
@@ -17,11 +17,17 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
 )
 
 func TestSecurityGroupRolesForCluster(t *testing.T) {
@@ -61,3 +67,52 @@ func TestSecurityGroupRolesForCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileCNIAndKubeProxy(t *testing.T) {
+	tests := []struct {
+		name     string
+		autoMode *ekscontrolplanev1.AutoMode
+		expect   func(awsNode *mock_services.MockAWSNodeInterfaceMockRecorder, kubeProxy *mock_services.MockKubeProxyInterfaceMockRecorder)
+	}{
+		{
+			name: "Should reconcile the VPC CNI and kube-proxy",
+			expect: func(awsNode *mock_services.MockAWSNodeInterfaceMockRecorder, kubeProxy *mock_services.MockKubeProxyInterfaceMockRecorder) {
+				awsNode.ReconcileCNI(gomock.Any()).Return(nil)
+				kubeProxy.ReconcileKubeProxy(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "Should skip the VPC CNI and kube-proxy when EKS Auto Mode is enabled",
+			autoMode: &ekscontrolplanev1.AutoMode{Enabled: true},
+			expect: func(_ *mock_services.MockAWSNodeInterfaceMockRecorder, _ *mock_services.MockKubeProxyInterfaceMockRecorder) {
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			awsNodeMock := mock_services.NewMockAWSNodeInterface(mockCtrl)
+			kubeProxyMock := mock_services.NewMockKubeProxyInterface(mockCtrl)
+			tt.expect(awsNodeMock.EXPECT(), kubeProxyMock.EXPECT())
+
+			_, _, awsManagedControlPlane := getManagedClusterObjects("test", "test")
+			awsManagedControlPlane.Spec.AutoMode = tt.autoMode
+			s, err := getManagedControlPlaneScope(awsManagedControlPlane)
+			g.Expect(err).To(BeNil(), "failed to create cluster scope for test")
+
+			r := &AWSManagedControlPlaneReconciler{
+				awsNodeServiceFactory: func(scope.AWSNodeScope) services.AWSNodeInterface {
+					return awsNodeMock
+				},
+				kubeProxyServiceFactory: func(scope.KubeProxyScope) services.KubeProxyInterface {
+					return kubeProxyMock
+				},
+			}
+			g.Expect(r.reconcileCNIAndKubeProxy(context.TODO(), s)).To(Succeed())
+		})
+	}
+}
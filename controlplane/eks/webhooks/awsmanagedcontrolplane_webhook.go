@@ -20,10 +20,13 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/version"
@@ -110,6 +113,8 @@ func (w *AWSManagedControlPlane) ValidateCreate(_ context.Context, obj runtime.O
 	allErrs = append(allErrs, w.validatePrivateDNSHostnameTypeOnLaunch(r)...)
 	allErrs = append(allErrs, w.validateAccessConfigCreate(r)...)
 	allErrs = append(allErrs, w.validateAccessEntries(r)...)
+	allErrs = append(allErrs, w.validatePodIdentityAssociations(r)...)
+	allErrs = append(allErrs, w.validateAutoMode(r)...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -153,6 +158,8 @@ func (w *AWSManagedControlPlane) ValidateUpdate(ctx context.Context, oldObj, new
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, w.validatePrivateDNSHostnameTypeOnLaunch(r)...)
 	allErrs = append(allErrs, w.validateAccessEntries(r)...)
+	allErrs = append(allErrs, w.validatePodIdentityAssociations(r)...)
+	allErrs = append(allErrs, w.validateAutoMode(r)...)
 
 	if r.Spec.Region != oldAWSManagedControlplane.Spec.Region {
 		allErrs = append(allErrs,
@@ -186,6 +193,14 @@ func (w *AWSManagedControlPlane) ValidateUpdate(ctx context.Context, oldObj, new
 		)
 	}
 
+	// The node role of the built-in node pools cannot be changed once set.
+	if oldNodeRoleArn := autoModeNodeRoleArn(oldAWSManagedControlplane.Spec.AutoMode); oldNodeRoleArn != "" &&
+		oldNodeRoleArn != autoModeNodeRoleArn(r.Spec.AutoMode) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "autoMode", "compute", "nodeRoleArn"), autoModeNodeRoleArn(r.Spec.AutoMode), "field is immutable"),
+		)
+	}
+
 	if oldAWSManagedControlplane.Spec.NetworkSpec.VPC.IsIPv6Enabled() != r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("spec", "network", "vpc", "enableIPv6"), r.Spec.NetworkSpec.VPC.IsIPv6Enabled(), "changing IP family is not allowed after it has been set"))
@@ -427,6 +442,137 @@ func (w *AWSManagedControlPlane) validateAccessEntries(r *ekscontrolplanev1.AWSM
 	return allErrs
 }
 
+func (w *AWSManagedControlPlane) validatePodIdentityAssociations(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	associationsPath := field.NewPath("spec", "podIdentityAssociations")
+	associations := map[string]bool{}
+	for i, association := range r.Spec.PodIdentityAssociations {
+		associations[association.Namespace+"/"+association.ServiceAccount] = true
+
+		if association.RoleARN != "" {
+			if parsed, err := arn.Parse(association.RoleARN); err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "role/") {
+				allErrs = append(allErrs,
+					field.Invalid(associationsPath.Index(i).Child("roleARN"), association.RoleARN, "must be the ARN of an IAM role"),
+				)
+			}
+		}
+
+		if association.Role != nil {
+			for j, policyARN := range association.Role.PolicyARNs {
+				if !arn.IsARN(policyARN) {
+					allErrs = append(allErrs,
+						field.Invalid(associationsPath.Index(i).Child("role", "policyARNs").Index(j), policyARN, "must be the ARN of an IAM policy"),
+					)
+				}
+			}
+		}
+	}
+
+	if r.Spec.Addons == nil {
+		return allErrs
+	}
+
+	referenced := map[string]bool{}
+	for i, addon := range *r.Spec.Addons {
+		addonPath := field.NewPath("spec", "addons").Index(i)
+		if len(addon.PodIdentityAssociations) > 0 && addon.ServiceAccountRoleArn != nil {
+			allErrs = append(allErrs,
+				field.Invalid(addonPath.Child("podIdentityAssociations"), addon.PodIdentityAssociations, "podIdentityAssociations cannot be used together with serviceAccountRoleARN"),
+			)
+		}
+
+		for j, ref := range addon.PodIdentityAssociations {
+			key := ref.Namespace + "/" + ref.ServiceAccount
+			refPath := addonPath.Child("podIdentityAssociations").Index(j)
+			// EKS creates the associations of an addon in the namespace the addon is installed in.
+			if namespace := addonNamespace(addon.Name); ref.Namespace != namespace {
+				allErrs = append(allErrs,
+					field.Invalid(refPath.Child("namespace"), ref.Namespace, fmt.Sprintf("must be the namespace of the addon, %q", namespace)),
+				)
+			}
+			if !associations[key] {
+				allErrs = append(allErrs,
+					field.Invalid(refPath, ref, "must reference a pod identity association of spec.podIdentityAssociations"),
+				)
+			}
+			if referenced[key] {
+				allErrs = append(allErrs,
+					field.Duplicate(refPath, ref),
+				)
+			}
+			referenced[key] = true
+		}
+	}
+
+	return allErrs
+}
+
+func (w *AWSManagedControlPlane) validateAutoMode(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	return validateAutoMode(r.Spec.AutoMode, r.Spec.AccessConfig, r.Spec.VpcCni, r.Spec.Addons, field.NewPath("spec"))
+}
+
+func validateAutoMode(autoMode *ekscontrolplanev1.AutoMode, accessConfig *ekscontrolplanev1.AccessConfig, vpcCni ekscontrolplanev1.VpcCni, addons *[]ekscontrolplanev1.Addon, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if autoMode == nil || !autoMode.Enabled {
+		return allErrs
+	}
+
+	if autoMode.Compute != nil && len(autoMode.Compute.NodePools) > 0 && autoModeNodeRoleArn(autoMode) == "" {
+		allErrs = append(allErrs, field.Required(path.Child("autoMode", "compute", "nodeRoleArn"), "nodeRoleArn is required when node pools are specified"))
+	}
+
+	if accessConfig == nil ||
+		(accessConfig.AuthenticationMode != ekscontrolplanev1.EKSAuthenticationModeAPI && accessConfig.AuthenticationMode != ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap) {
+		var authenticationMode ekscontrolplanev1.EKSAuthenticationMode
+		if accessConfig != nil {
+			authenticationMode = accessConfig.AuthenticationMode
+		}
+		allErrs = append(allErrs, field.Invalid(path.Child("accessConfig", "authenticationMode"), authenticationMode, "auto mode requires authentication mode to be api or api_and_config_map"))
+	}
+
+	// Auto Mode manages pod networking and service load balancing itself, so CAPA must not also manage them.
+	if addons != nil {
+		for i, addon := range *addons {
+			if addon.Name == vpcCniAddon || addon.Name == kubeProxyAddon {
+				allErrs = append(allErrs, field.Invalid(path.Child("addons").Index(i).Child("name"), addon.Name, "addon cannot be specified when auto mode is enabled"))
+			}
+		}
+	}
+
+	if len(vpcCni.Env) > 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("vpcCni", "env"), vpcCni.Env, "cannot configure vpc cni when auto mode is enabled"))
+	}
+
+	return allErrs
+}
+
+func autoModeNodeRoleArn(autoMode *ekscontrolplanev1.AutoMode) string {
+	if autoMode == nil || autoMode.Compute == nil || autoMode.Compute.NodeRoleArn == nil {
+		return ""
+	}
+	return *autoMode.Compute.NodeRoleArn
+}
+
+// addonNamespaces are the namespaces of the EKS addons not installed in kube-system.
+var addonNamespaces = map[string]string{
+	"adot":                            "opentelemetry-operator-system",
+	"amazon-cloudwatch-observability": "amazon-cloudwatch",
+	"aws-guardduty-agent":             "amazon-guardduty",
+	"cert-manager":                    "cert-manager",
+	"external-dns":                    "external-dns",
+}
+
+// addonNamespace returns the namespace the EKS addon is installed in.
+func addonNamespace(name string) string {
+	if namespace, ok := addonNamespaces[name]; ok {
+		return namespace
+	}
+
+	return metav1.NamespaceSystem
+}
+
 func (w *AWSManagedControlPlane) validateIAMAuthConfig(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
 	return validateIAMAuthConfig(r.Spec.IAMAuthenticatorConfig, field.NewPath("spec.iamAuthenticatorConfig"))
 }
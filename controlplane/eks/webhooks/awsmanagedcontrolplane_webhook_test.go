@@ -24,6 +24,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -1220,3 +1221,373 @@ func TestWebhookValidateAccessEntries(t *testing.T) {
 		})
 	}
 }
+
+func TestWebhookValidatePodIdentityAssociations(t *testing.T) {
+	tests := []struct {
+		name         string
+		associations []ekscontrolplanev1.PodIdentityAssociation
+		addons       *[]ekscontrolplanev1.Addon
+		expectError  bool
+		errorSubstr  string
+	}{
+		{
+			name: "valid association with role ARN",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					Namespace:      "kube-system",
+					ServiceAccount: "ebs-csi-controller-sa",
+					RoleARN:        "arn:aws:iam::123456789012:role/ebs-csi",
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid role ARN",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					Namespace:      "kube-system",
+					ServiceAccount: "ebs-csi-controller-sa",
+					RoleARN:        "arn:aws:iam::123456789012:user/ebs-csi",
+				},
+			},
+			expectError: true,
+			errorSubstr: "must be the ARN of an IAM role",
+		},
+		{
+			name: "invalid policy ARN of inline role",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					Namespace:      "default",
+					ServiceAccount: "app",
+					Role: &ekscontrolplanev1.PodIdentityRole{
+						PolicyARNs: []string{"AmazonS3ReadOnlyAccess"},
+					},
+				},
+			},
+			expectError: true,
+			errorSubstr: "must be the ARN of an IAM policy",
+		},
+		{
+			name: "valid addon referencing an association",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					Namespace:      "kube-system",
+					ServiceAccount: "ebs-csi-controller-sa",
+					Role: &ekscontrolplanev1.PodIdentityRole{
+						PolicyARNs: []string{"arn:aws:iam::aws:policy/service-role/AmazonEBSCSIDriverPolicy"},
+					},
+				},
+			},
+			addons: &[]ekscontrolplanev1.Addon{
+				{
+					Name:    "aws-ebs-csi-driver",
+					Version: "v1.30.0-eksbuild.1",
+					PodIdentityAssociations: []ekscontrolplanev1.AddonPodIdentityAssociationReference{
+						{Namespace: "kube-system", ServiceAccount: "ebs-csi-controller-sa"},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "addon referencing a missing association",
+			addons: &[]ekscontrolplanev1.Addon{
+				{
+					Name:    "aws-ebs-csi-driver",
+					Version: "v1.30.0-eksbuild.1",
+					PodIdentityAssociations: []ekscontrolplanev1.AddonPodIdentityAssociationReference{
+						{Namespace: "kube-system", ServiceAccount: "ebs-csi-controller-sa"},
+					},
+				},
+			},
+			expectError: true,
+			errorSubstr: "must reference a pod identity association of spec.podIdentityAssociations",
+		},
+		{
+			name: "addon referencing an association outside of its namespace",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					Namespace:      "default",
+					ServiceAccount: "ebs-csi-controller-sa",
+					RoleARN:        "arn:aws:iam::123456789012:role/ebs-csi",
+				},
+			},
+			addons: &[]ekscontrolplanev1.Addon{
+				{
+					Name:    "aws-ebs-csi-driver",
+					Version: "v1.30.0-eksbuild.1",
+					PodIdentityAssociations: []ekscontrolplanev1.AddonPodIdentityAssociationReference{
+						{Namespace: "default", ServiceAccount: "ebs-csi-controller-sa"},
+					},
+				},
+			},
+			expectError: true,
+			errorSubstr: `must be the namespace of the addon, "kube-system"`,
+		},
+		{
+			name: "addon installed outside of kube-system referencing an association in its namespace",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					Namespace:      "amazon-cloudwatch",
+					ServiceAccount: "cloudwatch-agent",
+					RoleARN:        "arn:aws:iam::123456789012:role/cloudwatch-agent",
+				},
+			},
+			addons: &[]ekscontrolplanev1.Addon{
+				{
+					Name:    "amazon-cloudwatch-observability",
+					Version: "v2.1.0-eksbuild.1",
+					PodIdentityAssociations: []ekscontrolplanev1.AddonPodIdentityAssociationReference{
+						{Namespace: "amazon-cloudwatch", ServiceAccount: "cloudwatch-agent"},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "addon with both service account role and associations",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					Namespace:      "kube-system",
+					ServiceAccount: "ebs-csi-controller-sa",
+					RoleARN:        "arn:aws:iam::123456789012:role/ebs-csi",
+				},
+			},
+			addons: &[]ekscontrolplanev1.Addon{
+				{
+					Name:                  "aws-ebs-csi-driver",
+					Version:               "v1.30.0-eksbuild.1",
+					ServiceAccountRoleArn: aws.String("arn:aws:iam::123456789012:role/ebs-csi"),
+					PodIdentityAssociations: []ekscontrolplanev1.AddonPodIdentityAssociationReference{
+						{Namespace: "kube-system", ServiceAccount: "ebs-csi-controller-sa"},
+					},
+				},
+			},
+			expectError: true,
+			errorSubstr: "podIdentityAssociations cannot be used together with serviceAccountRoleARN",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:          "default_cluster1",
+					PodIdentityAssociations: tc.associations,
+					Addons:                  tc.addons,
+				},
+			}
+
+			warn, err := (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				if tc.errorSubstr != "" {
+					g.Expect(err.Error()).To(ContainSubstring(tc.errorSubstr))
+				}
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
+
+func TestWebhookValidateAutoMode(t *testing.T) {
+	nodeRoleArn := "arn:aws:iam::123456789012:role/auto-mode-nodes"
+	apiAccessConfig := &ekscontrolplanev1.AccessConfig{
+		AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeAPI,
+	}
+
+	tests := []struct {
+		name         string
+		autoMode     *ekscontrolplanev1.AutoMode
+		accessConfig *ekscontrolplanev1.AccessConfig
+		addons       *[]ekscontrolplanev1.Addon
+		vpcCni       ekscontrolplanev1.VpcCni
+		expectError  bool
+		errorSubstr  string
+	}{
+		{
+			name: "auto mode disabled with config_map authentication",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: false,
+			},
+			accessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+			},
+			expectError: false,
+		},
+		{
+			name: "auto mode enabled with built-in node pools",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+				Compute: &ekscontrolplanev1.AutoModeCompute{
+					NodePools:   []ekscontrolplanev1.AutoModeNodePool{ekscontrolplanev1.AutoModeNodePoolGeneralPurpose, ekscontrolplanev1.AutoModeNodePoolSystem},
+					NodeRoleArn: aws.String(nodeRoleArn),
+				},
+			},
+			accessConfig: apiAccessConfig,
+			expectError:  false,
+		},
+		{
+			name: "auto mode enabled without node pools",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+			},
+			accessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap,
+			},
+			expectError: false,
+		},
+		{
+			name: "node pools without node role",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+				Compute: &ekscontrolplanev1.AutoModeCompute{
+					NodePools: []ekscontrolplanev1.AutoModeNodePool{ekscontrolplanev1.AutoModeNodePoolSystem},
+				},
+			},
+			accessConfig: apiAccessConfig,
+			expectError:  true,
+			errorSubstr:  "nodeRoleArn is required when node pools are specified",
+		},
+		{
+			name: "auto mode without access config",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+			},
+			expectError: true,
+			errorSubstr: "auto mode requires authentication mode to be api or api_and_config_map",
+		},
+		{
+			name: "auto mode with config_map authentication",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+			},
+			accessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+			},
+			expectError: true,
+			errorSubstr: "auto mode requires authentication mode to be api or api_and_config_map",
+		},
+		{
+			name: "auto mode with vpc-cni addon",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+			},
+			accessConfig: apiAccessConfig,
+			addons: &[]ekscontrolplanev1.Addon{
+				{
+					Name:    "vpc-cni",
+					Version: "v1.18.1-eksbuild.1",
+				},
+			},
+			expectError: true,
+			errorSubstr: "addon cannot be specified when auto mode is enabled",
+		},
+		{
+			name: "auto mode with kube-proxy addon",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+			},
+			accessConfig: apiAccessConfig,
+			addons: &[]ekscontrolplanev1.Addon{
+				{
+					Name:    "kube-proxy",
+					Version: "v1.30.0-eksbuild.3",
+				},
+			},
+			expectError: true,
+			errorSubstr: "addon cannot be specified when auto mode is enabled",
+		},
+		{
+			name: "auto mode with other addons",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+			},
+			accessConfig: apiAccessConfig,
+			addons: &[]ekscontrolplanev1.Addon{
+				{
+					Name:    "aws-efs-csi-driver",
+					Version: "v2.0.7-eksbuild.1",
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "auto mode with vpc cni env",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+			},
+			accessConfig: apiAccessConfig,
+			vpcCni: ekscontrolplanev1.VpcCni{
+				Env: []corev1.EnvVar{{Name: "ENABLE_PREFIX_DELEGATION", Value: "true"}},
+			},
+			expectError: true,
+			errorSubstr: "cannot configure vpc cni when auto mode is enabled",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					Version:        aws.String("v1.30.0"),
+					AutoMode:       tc.autoMode,
+					AccessConfig:   tc.accessConfig,
+					Addons:         tc.addons,
+					VpcCni:         tc.vpcCni,
+				},
+			}
+
+			warn, err := (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				if tc.errorSubstr != "" {
+					g.Expect(err.Error()).To(ContainSubstring(tc.errorSubstr))
+				}
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(warn).To(BeEmpty())
+		})
+	}
+}
+
+func TestWebhookUpdateAutoModeNodeRole(t *testing.T) {
+	g := NewWithT(t)
+
+	newControlPlane := func(nodeRoleArn string) *ekscontrolplanev1.AWSManagedControlPlane {
+		return &ekscontrolplanev1.AWSManagedControlPlane{
+			Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				AccessConfig: &ekscontrolplanev1.AccessConfig{
+					AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeAPI,
+				},
+				AutoMode: &ekscontrolplanev1.AutoMode{
+					Enabled: true,
+					Compute: &ekscontrolplanev1.AutoModeCompute{
+						NodePools:   []ekscontrolplanev1.AutoModeNodePool{ekscontrolplanev1.AutoModeNodePoolSystem},
+						NodeRoleArn: aws.String(nodeRoleArn),
+					},
+				},
+			},
+		}
+	}
+
+	oldMCP := newControlPlane("arn:aws:iam::123456789012:role/auto-mode-nodes")
+
+	_, err := (&AWSManagedControlPlane{}).ValidateUpdate(context.Background(), oldMCP, newControlPlane("arn:aws:iam::123456789012:role/auto-mode-nodes"))
+	g.Expect(err).To(BeNil())
+
+	_, err = (&AWSManagedControlPlane{}).ValidateUpdate(context.Background(), oldMCP, newControlPlane("arn:aws:iam::123456789012:role/other-nodes"))
+	g.Expect(err).ToNot(BeNil())
+	g.Expect(err.Error()).To(ContainSubstring("spec.autoMode.compute.nodeRoleArn"))
+}
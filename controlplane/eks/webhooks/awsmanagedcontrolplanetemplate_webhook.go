@@ -76,6 +76,7 @@ func (w *AWSManagedControlPlaneTemplate) ValidateCreate(_ context.Context, obj r
 	allErrs = append(allErrs, r.Spec.Template.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, w.validateNetwork(r)...)
 	allErrs = append(allErrs, w.validatePrivateDNSHostnameTypeOnLaunch(r)...)
+	allErrs = append(allErrs, w.validateAutoMode(r)...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -117,6 +118,7 @@ func (w *AWSManagedControlPlaneTemplate) ValidateUpdate(ctx context.Context, old
 	allErrs = append(allErrs, w.validateKubeProxy(r)...)
 	allErrs = append(allErrs, r.Spec.Template.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, w.validatePrivateDNSHostnameTypeOnLaunch(r)...)
+	allErrs = append(allErrs, w.validateAutoMode(r)...)
 
 	if r.Spec.Template.Spec.Region != oldAWSManagedControlplaneTemplate.Spec.Template.Spec.Region {
 		allErrs = append(allErrs,
@@ -240,3 +242,7 @@ func (w *AWSManagedControlPlaneTemplate) validateNetwork(r *ekscontrolplanev1.AW
 func (w *AWSManagedControlPlaneTemplate) validatePrivateDNSHostnameTypeOnLaunch(r *ekscontrolplanev1.AWSManagedControlPlaneTemplate) field.ErrorList {
 	return validatePrivateDNSHostnameTypeOnLaunch(r.Spec.Template.Spec.NetworkSpec, field.NewPath("spec.template.spec"))
 }
+
+func (w *AWSManagedControlPlaneTemplate) validateAutoMode(r *ekscontrolplanev1.AWSManagedControlPlaneTemplate) field.ErrorList {
+	return validateAutoMode(r.Spec.Template.Spec.AutoMode, r.Spec.Template.Spec.AccessConfig, r.Spec.Template.Spec.VpcCni, r.Spec.Template.Spec.Addons, field.NewPath("spec.template.spec"))
+}
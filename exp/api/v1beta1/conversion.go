@@ -79,6 +79,27 @@ func (src *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.DefaultInstanceWarmup = restored.Spec.DefaultInstanceWarmup
 	dst.Spec.AWSLaunchTemplate.NonRootVolumes = restored.Spec.AWSLaunchTemplate.NonRootVolumes
+	dst.Spec.AWSLaunchTemplate.InstanceRequirements = restored.Spec.AWSLaunchTemplate.InstanceRequirements
+	if restored.Spec.MixedInstancesPolicy != nil && dst.Spec.MixedInstancesPolicy != nil &&
+		len(restored.Spec.MixedInstancesPolicy.Overrides) == len(dst.Spec.MixedInstancesPolicy.Overrides) {
+		for i := range dst.Spec.MixedInstancesPolicy.Overrides {
+			dst.Spec.MixedInstancesPolicy.Overrides[i].InstanceRequirements = restored.Spec.MixedInstancesPolicy.Overrides[i].InstanceRequirements
+		}
+	}
+	dst.Status.Capacity = restored.Status.Capacity
+	dst.Spec.WarmPool = restored.Spec.WarmPool
+	dst.Spec.ScheduledActions = restored.Spec.ScheduledActions
+	dst.Spec.ScalingPolicies = restored.Spec.ScalingPolicies
+	dst.Spec.Strategy = restored.Spec.Strategy
+	dst.Status.ASGName = restored.Status.ASGName
+	dst.Status.BlueGreen = restored.Status.BlueGreen
+	dst.Status.ManagedScheduledActions = restored.Status.ManagedScheduledActions
+	dst.Status.ManagedScalingPolicies = restored.Status.ManagedScalingPolicies
+	if len(restored.Status.Instances) == len(dst.Status.Instances) {
+		for i := range dst.Status.Instances {
+			dst.Status.Instances[i].WarmPool = restored.Status.Instances[i].WarmPool
+		}
+	}
 	return nil
 }
 
@@ -123,6 +144,7 @@ func (src *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		}
 		dst.Spec.AWSLaunchTemplate.InstanceMetadataOptions = restored.Spec.AWSLaunchTemplate.InstanceMetadataOptions
 		dst.Spec.AWSLaunchTemplate.NonRootVolumes = restored.Spec.AWSLaunchTemplate.NonRootVolumes
+		dst.Spec.AWSLaunchTemplate.InstanceRequirements = restored.Spec.AWSLaunchTemplate.InstanceRequirements
 
 		if restored.Spec.AWSLaunchTemplate.EnclaveOptions != nil {
 			dst.Spec.AWSLaunchTemplate.EnclaveOptions = restored.Spec.AWSLaunchTemplate.EnclaveOptions
@@ -244,12 +266,24 @@ func Convert_v1beta2_AWSLaunchTemplate_To_v1beta1_AWSLaunchTemplate(in *expinfra
 	return autoConvert_v1beta2_AWSLaunchTemplate_To_v1beta1_AWSLaunchTemplate(in, out, s)
 }
 
+// Convert_v1beta2_AWSMachinePoolInstanceStatus_To_v1beta1_AWSMachinePoolInstanceStatus converts the v1beta2 AWSMachinePoolInstanceStatus receiver to a v1beta1 AWSMachinePoolInstanceStatus.
+func Convert_v1beta2_AWSMachinePoolInstanceStatus_To_v1beta1_AWSMachinePoolInstanceStatus(in *expinfrav1.AWSMachinePoolInstanceStatus, out *AWSMachinePoolInstanceStatus, s apiconversion.Scope) error {
+	// status.instances.warmPool has been added to v1beta2.
+	return autoConvert_v1beta2_AWSMachinePoolInstanceStatus_To_v1beta1_AWSMachinePoolInstanceStatus(in, out, s)
+}
+
+// Convert_v1beta2_Overrides_To_v1beta1_Overrides converts the v1beta2 Overrides receiver to a v1beta1 Overrides.
+func Convert_v1beta2_Overrides_To_v1beta1_Overrides(in *expinfrav1.Overrides, out *Overrides, s apiconversion.Scope) error {
+	// spec.mixedInstancesPolicy.overrides.instanceRequirements has been added to v1beta2.
+	return autoConvert_v1beta2_Overrides_To_v1beta1_Overrides(in, out, s)
+}
+
 func Convert_v1beta2_AWSMachinePoolSpec_To_v1beta1_AWSMachinePoolSpec(in *expinfrav1.AWSMachinePoolSpec, out *AWSMachinePoolSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_AWSMachinePoolSpec_To_v1beta1_AWSMachinePoolSpec(in, out, s)
 }
 
 func Convert_v1beta2_AutoScalingGroup_To_v1beta1_AutoScalingGroup(in *expinfrav1.AutoScalingGroup, out *AutoScalingGroup, s apiconversion.Scope) error {
-	// explicitly ignore CurrentlySuspended.
+	// explicitly ignore CurrentlySuspended, WarmPool and WarmPoolInstances.
 	return autoConvert_v1beta2_AutoScalingGroup_To_v1beta1_AutoScalingGroup(in, out, s)
 }
 
@@ -19,6 +19,7 @@ package v1beta2
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -93,6 +94,11 @@ type AWSMachinePoolSpec struct {
 	// +optional
 	RefreshPreferences *RefreshPreferences `json:"refreshPreferences,omitempty"`
 
+	// Strategy describes how the instances of the pool are replaced when the launch template changes.
+	// Defaults to an ASG instance refresh, configured with RefreshPreferences.
+	// +optional
+	Strategy *AWSMachinePoolStrategy `json:"strategy,omitempty"`
+
 	// Enable or disable the capacity rebalance autoscaling group feature
 	// +optional
 	CapacityRebalance bool `json:"capacityRebalance,omitempty"`
@@ -108,6 +114,27 @@ type AWSMachinePoolSpec struct {
 	// AWSLifecycleHooks specifies lifecycle hooks for the autoscaling group.
 	// +optional
 	AWSLifecycleHooks []AWSLifecycleHook `json:"lifecycleHooks,omitempty"`
+
+	// WarmPool specifies a warm pool of pre-initialized instances for the autoscaling group, to reduce
+	// the time it takes to scale out. The warm pool is deleted when this field is removed.
+	// +optional
+	WarmPool *WarmPool `json:"warmPool,omitempty"`
+
+	// ScheduledActions specifies scheduled actions changing the size of the autoscaling group.
+	// When set, CAPA manages all the scheduled actions of the group and deletes the ones not listed here.
+	// When not set, the scheduled actions CAPA created are deleted and the others are left untouched.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ScheduledActions []ScheduledAction `json:"scheduledActions,omitempty"`
+
+	// ScalingPolicies specifies scaling policies of the autoscaling group.
+	// When set, CAPA manages all the scaling policies of the group and deletes the ones not listed here.
+	// When not set, the scaling policies CAPA created are deleted and the others are left untouched.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ScalingPolicies []ScalingPolicy `json:"scalingPolicies,omitempty"`
 }
 
 // SuspendProcessesTypes contains user friendly auto-completable values for suspended process names.
@@ -192,6 +219,84 @@ type RefreshPreferences struct {
 	MaxHealthyPercentage *int64 `json:"maxHealthyPercentage,omitempty"`
 }
 
+// AWSMachinePoolStrategyType is the strategy used to replace the instances of an AWSMachinePool.
+type AWSMachinePoolStrategyType string
+
+const (
+	// AWSMachinePoolStrategyTypeInstanceRefresh replaces the instances with an ASG instance refresh.
+	AWSMachinePoolStrategyTypeInstanceRefresh AWSMachinePoolStrategyType = "InstanceRefresh"
+	// AWSMachinePoolStrategyTypeBlueGreen replaces the instances by moving the pool to a new ASG.
+	AWSMachinePoolStrategyTypeBlueGreen AWSMachinePoolStrategyType = "BlueGreen"
+)
+
+// AWSMachinePoolStrategy describes how the instances of an AWSMachinePool are replaced.
+type AWSMachinePoolStrategy struct {
+	// Type is the replacement strategy. InstanceRefresh starts an ASG instance refresh. BlueGreen creates
+	// a new ASG from the new launch template version, waits for its nodes to be ready, then drains and
+	// removes the instances of the old ASG, and requires the MachinePoolMachines feature gate.
+	// +kubebuilder:validation:Enum=InstanceRefresh;BlueGreen
+	// +kubebuilder:default=InstanceRefresh
+	Type AWSMachinePoolStrategyType `json:"type"`
+
+	// BlueGreen configures the BlueGreen strategy.
+	// +optional
+	BlueGreen *BlueGreenStrategy `json:"blueGreen,omitempty"`
+}
+
+// BlueGreenStrategy configures the BlueGreen replacement strategy.
+type BlueGreenStrategy struct {
+	// ScaleDownBatchSize is the number of instances of the old ASG drained and terminated at a time.
+	// Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ScaleDownBatchSize *int32 `json:"scaleDownBatchSize,omitempty"`
+
+	// NodeReadyTimeout is how long to wait for the nodes of the new ASG to become ready, from the creation
+	// of the new ASG. The replacement is rolled back when they are not ready in time. Defaults to 10 minutes.
+	// Once the old ASG is being scaled down, the replacement is no longer rolled back: the scale down
+	// pauses until the nodes of the new ASG are ready again.
+	// +optional
+	NodeReadyTimeout *metav1.Duration `json:"nodeReadyTimeout,omitempty"`
+}
+
+// BlueGreenPhase is the phase of a blue/green replacement.
+type BlueGreenPhase string
+
+const (
+	// BlueGreenPhaseScalingUp is the phase during which the new ASG is created and its nodes become ready.
+	BlueGreenPhaseScalingUp BlueGreenPhase = "ScalingUp"
+	// BlueGreenPhaseScalingDown is the phase during which the instances of the old ASG are drained and terminated.
+	BlueGreenPhaseScalingDown BlueGreenPhase = "ScalingDown"
+	// BlueGreenPhaseRolledBack means that the nodes of the new ASG did not become ready and it was deleted.
+	BlueGreenPhaseRolledBack BlueGreenPhase = "RolledBack"
+)
+
+// BlueGreenStatus describes a blue/green replacement of an AWSMachinePool.
+type BlueGreenStatus struct {
+	// Phase is the phase of the replacement.
+	Phase BlueGreenPhase `json:"phase"`
+
+	// ASGName is the name of the ASG replacing the current one.
+	// +optional
+	ASGName string `json:"asgName,omitempty"`
+
+	// LaunchTemplateVersion is the launch template version of the new ASG.
+	// +optional
+	LaunchTemplateVersion string `json:"launchTemplateVersion,omitempty"`
+
+	// PreviousLaunchTemplateVersion is the launch template version the current ASG is pinned to
+	// while it is being replaced, or after the replacement was rolled back.
+	PreviousLaunchTemplateVersion string `json:"previousLaunchTemplateVersion"`
+
+	// StartTime is the time at which the replacement started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// ASGCreationTime is the time at which the ASG replacing the current one was created.
+	// The node ready timeout counts from it.
+	// +optional
+	ASGCreationTime metav1.Time `json:"asgCreationTime,omitempty"`
+}
+
 // AWSMachinePoolStatus defines the observed state of AWSMachinePool.
 type AWSMachinePoolStatus struct {
 	// Ready is true when the provider resource is ready.
@@ -221,6 +326,13 @@ type AWSMachinePoolStatus struct {
 	// +optional
 	InfrastructureMachineKind string `json:"infrastructureMachineKind,omitempty"`
 
+	// Capacity defines the resource capacity of the minimum shape matching the instance requirements
+	// of the pool, the smallest instance the pool can launch. Only set when instance requirements are used.
+	// This value is used for autoscaling from zero operations as defined in:
+	// https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20210310-opt-in-autoscaling-from-zero.md
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
@@ -260,6 +372,25 @@ type AWSMachinePoolStatus struct {
 	FailureMessage *string `json:"failureMessage,omitempty"`
 
 	ASGStatus *ASGStatus `json:"asgStatus,omitempty"`
+
+	// ASGName is the name of the ASG the instances of the pool are launched into. It is only set once the
+	// pool has been replaced with the BlueGreen strategy, the ASG is named after the AWSMachinePool otherwise.
+	// +optional
+	ASGName string `json:"asgName,omitempty"`
+
+	// BlueGreen describes the ongoing blue/green replacement of the pool, or the last one when it was rolled back.
+	// +optional
+	BlueGreen *BlueGreenStatus `json:"blueGreen,omitempty"`
+
+	// ManagedScheduledActions are the names of the scheduled actions CAPA created on the ASG. They are
+	// deleted when ScheduledActions is removed from the spec.
+	// +optional
+	ManagedScheduledActions []string `json:"managedScheduledActions,omitempty"`
+
+	// ManagedScalingPolicies are the names of the scaling policies CAPA created on the ASG. They are
+	// deleted when ScalingPolicies is removed from the spec.
+	// +optional
+	ManagedScalingPolicies []string `json:"managedScalingPolicies,omitempty"`
 }
 
 // AWSMachinePoolInstanceStatus defines the status of the AWSMachinePoolInstance.
@@ -271,6 +402,11 @@ type AWSMachinePoolInstanceStatus struct {
 	// Version defines the Kubernetes version for the Machine Instance
 	// +optional
 	Version *string `json:"version,omitempty"`
+
+	// WarmPool is true when the instance is in the warm pool of the autoscaling group. No MachinePool Machine
+	// is created for the instance until it moves from the warm pool into the group.
+	// +optional
+	WarmPool bool `json:"warmPool,omitempty"`
 }
 
 // +kubebuilder:object:root=true
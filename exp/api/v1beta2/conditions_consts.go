@@ -72,6 +72,28 @@ const (
 	LifecycleHookUpdateFailedReason = "LifecycleHookUpdateFailed"
 	// LifecycleHookDeletionFailedReason used for failures during lifecycle hook deletion.
 	LifecycleHookDeletionFailedReason = "LifecycleHookDeletionFailed"
+	// WarmPoolReadyCondition reports on the status of the warm pool of the ASG.
+	WarmPoolReadyCondition clusterv1beta1.ConditionType = "WarmPoolReady"
+	// WarmPoolReconcileFailedReason used for failures while creating or updating the warm pool.
+	WarmPoolReconcileFailedReason = "WarmPoolReconcileFailed"
+	// WarmPoolDeletionFailedReason used for failures during warm pool deletion.
+	WarmPoolDeletionFailedReason = "WarmPoolDeletionFailed"
+	// ScheduledActionsReadyCondition reports on the status of the scheduled actions of the ASG.
+	ScheduledActionsReadyCondition clusterv1beta1.ConditionType = "ScheduledActionsReady"
+	// ScheduledActionReconcileFailedReason used for failures while creating, updating or deleting scheduled actions.
+	ScheduledActionReconcileFailedReason = "ScheduledActionReconcileFailed"
+	// ScalingPoliciesReadyCondition reports on the status of the scaling policies of the ASG.
+	ScalingPoliciesReadyCondition clusterv1beta1.ConditionType = "ScalingPoliciesReady"
+	// ScalingPolicyReconcileFailedReason used for failures while creating, updating or deleting scaling policies.
+	ScalingPolicyReconcileFailedReason = "ScalingPolicyReconcileFailed"
+	// BlueGreenReplacementReadyCondition reports on the status of the blue/green replacement of the ASG.
+	BlueGreenReplacementReadyCondition clusterv1beta1.ConditionType = "BlueGreenReplacementReady"
+	// BlueGreenReplacementInProgressReason used while the instances are being moved to a new ASG.
+	BlueGreenReplacementInProgressReason = "BlueGreenReplacementInProgress"
+	// BlueGreenReplacementRolledBackReason used when the nodes of the new ASG did not become ready and it was deleted.
+	BlueGreenReplacementRolledBackReason = "BlueGreenReplacementRolledBack"
+	// BlueGreenReplacementFailedReason used for failures while moving the instances to a new ASG.
+	BlueGreenReplacementFailedReason = "BlueGreenReplacementFailed"
 )
 
 const (
@@ -97,6 +97,11 @@ type AWSLaunchTemplate struct {
 	// InstanceType is the type of instance to create. Example: m4.xlarge
 	InstanceType string `json:"instanceType,omitempty"`
 
+	// InstanceRequirements are the attributes of the instance types the Auto Scaling group can
+	// launch, instead of a single InstanceType. Not supported by AWSManagedMachinePool.
+	// +optional
+	InstanceRequirements *InstanceRequirements `json:"instanceRequirements,omitempty"`
+
 	// RootVolume encapsulates the configuration options for the root volume
 	// +optional
 	RootVolume *infrav1.Volume `json:"rootVolume,omitempty"`
@@ -162,8 +167,169 @@ type AWSLaunchTemplate struct {
 
 // Overrides are used to override the instance type specified by the launch template with multiple
 // instance types that can be used to launch On-Demand Instances and Spot Instances.
+// +kubebuilder:validation:XValidation:rule="has(self.instanceType) != has(self.instanceRequirements)",message="exactly one of instanceType or instanceRequirements must be set"
 type Overrides struct {
-	InstanceType string `json:"instanceType"`
+	// InstanceType is the type of instance to launch. Example: m5.large
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// InstanceRequirements are the attributes of the instance types to launch, instead of an InstanceType.
+	// +optional
+	InstanceRequirements *InstanceRequirements `json:"instanceRequirements,omitempty"`
+}
+
+// CPUManufacturer is a manufacturer of the CPU of an instance type.
+// +kubebuilder:validation:Enum=intel;amd;amazon-web-services;apple
+type CPUManufacturer string
+
+var (
+	// CPUManufacturerIntel selects instance types with Intel CPUs.
+	CPUManufacturerIntel = CPUManufacturer("intel")
+
+	// CPUManufacturerAMD selects instance types with AMD CPUs.
+	CPUManufacturerAMD = CPUManufacturer("amd")
+
+	// CPUManufacturerAmazonWebServices selects instance types with AWS Graviton CPUs.
+	CPUManufacturerAmazonWebServices = CPUManufacturer("amazon-web-services")
+
+	// CPUManufacturerApple selects instance types with Apple CPUs.
+	CPUManufacturerApple = CPUManufacturer("apple")
+)
+
+// BurstablePerformance indicates whether burstable performance instance types are selected.
+// +kubebuilder:validation:Enum=included;excluded;required
+type BurstablePerformance string
+
+var (
+	// BurstablePerformanceIncluded includes burstable performance instance types.
+	BurstablePerformanceIncluded = BurstablePerformance("included")
+
+	// BurstablePerformanceExcluded excludes burstable performance instance types.
+	BurstablePerformanceExcluded = BurstablePerformance("excluded")
+
+	// BurstablePerformanceRequired selects only burstable performance instance types.
+	BurstablePerformanceRequired = BurstablePerformance("required")
+)
+
+// AcceleratorType is a type of accelerator of an instance type.
+// +kubebuilder:validation:Enum=gpu;fpga;inference
+type AcceleratorType string
+
+var (
+	// AcceleratorTypeGPU selects instance types with GPU accelerators.
+	AcceleratorTypeGPU = AcceleratorType("gpu")
+
+	// AcceleratorTypeFPGA selects instance types with FPGA accelerators.
+	AcceleratorTypeFPGA = AcceleratorType("fpga")
+
+	// AcceleratorTypeInference selects instance types with inference accelerators.
+	AcceleratorTypeInference = AcceleratorType("inference")
+)
+
+// AcceleratorManufacturer is a manufacturer of the accelerators of an instance type.
+// +kubebuilder:validation:Enum=amazon-web-services;amd;nvidia;xilinx;habana
+type AcceleratorManufacturer string
+
+var (
+	// AcceleratorManufacturerAmazonWebServices selects instance types with AWS accelerators.
+	AcceleratorManufacturerAmazonWebServices = AcceleratorManufacturer("amazon-web-services")
+
+	// AcceleratorManufacturerAMD selects instance types with AMD accelerators.
+	AcceleratorManufacturerAMD = AcceleratorManufacturer("amd")
+
+	// AcceleratorManufacturerNVIDIA selects instance types with NVIDIA accelerators.
+	AcceleratorManufacturerNVIDIA = AcceleratorManufacturer("nvidia")
+
+	// AcceleratorManufacturerXilinx selects instance types with Xilinx accelerators.
+	AcceleratorManufacturerXilinx = AcceleratorManufacturer("xilinx")
+
+	// AcceleratorManufacturerHabana selects instance types with Habana accelerators.
+	AcceleratorManufacturerHabana = AcceleratorManufacturer("habana")
+)
+
+// InstanceGeneration is a generation of instance types.
+// +kubebuilder:validation:Enum=current;previous
+type InstanceGeneration string
+
+var (
+	// InstanceGenerationCurrent selects current generation instance types.
+	InstanceGenerationCurrent = InstanceGeneration("current")
+
+	// InstanceGenerationPrevious selects previous generation instance types.
+	InstanceGenerationPrevious = InstanceGeneration("previous")
+)
+
+// InstanceRequirementsRange is an inclusive range of values of an instance type attribute.
+type InstanceRequirementsRange struct {
+	// Min is the minimum value.
+	// +kubebuilder:validation:Minimum=0
+	Min int32 `json:"min"`
+
+	// Max is the maximum value. No maximum when omitted.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Max *int32 `json:"max,omitempty"`
+}
+
+// InstanceRequirements are the attributes of the instance types an Auto Scaling group can launch,
+// used for attribute-based instance type selection.
+// See https://docs.aws.amazon.com/autoscaling/ec2/userguide/create-mixed-instances-group-attribute-based-instance-type-selection.html
+type InstanceRequirements struct {
+	// VCPUCount is the range of the number of vCPUs.
+	VCPUCount InstanceRequirementsRange `json:"vCpuCount"`
+
+	// MemoryMiB is the range of the amount of memory, in MiB.
+	MemoryMiB InstanceRequirementsRange `json:"memoryMiB"`
+
+	// CPUManufacturers are the manufacturers of the CPUs. Any manufacturer when omitted.
+	// +optional
+	// +listType=set
+	CPUManufacturers []CPUManufacturer `json:"cpuManufacturers,omitempty"`
+
+	// BurstablePerformance indicates whether burstable performance instance types, such as T3,
+	// are included, excluded or required. Defaults to excluded.
+	// +optional
+	BurstablePerformance BurstablePerformance `json:"burstablePerformance,omitempty"`
+
+	// AcceleratorTypes are the types of accelerators. Any type when omitted.
+	// +optional
+	// +listType=set
+	AcceleratorTypes []AcceleratorType `json:"acceleratorTypes,omitempty"`
+
+	// AcceleratorManufacturers are the manufacturers of the accelerators. Any manufacturer when omitted.
+	// +optional
+	// +listType=set
+	AcceleratorManufacturers []AcceleratorManufacturer `json:"acceleratorManufacturers,omitempty"`
+
+	// AcceleratorCount is the range of the number of accelerators. Set max to 0 to exclude
+	// instance types with accelerators. No limits when omitted.
+	// +optional
+	AcceleratorCount *InstanceRequirementsRange `json:"acceleratorCount,omitempty"`
+
+	// InstanceGenerations are the generations of instance types. Any generation when omitted.
+	// +optional
+	// +listType=set
+	InstanceGenerations []InstanceGeneration `json:"instanceGenerations,omitempty"`
+
+	// ExcludedInstanceTypes are instance types to exclude. Wildcards are supported, for example m5a.* or r*.
+	// +kubebuilder:validation:MaxItems=400
+	// +optional
+	// +listType=set
+	ExcludedInstanceTypes []string `json:"excludedInstanceTypes,omitempty"`
+
+	// SpotMaxPricePercentageOverLowestPrice is the price protection threshold for Spot Instances, as a
+	// percentage above the price of the cheapest current generation instance type matching the requirements.
+	// Defaults to 100 when omitted.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SpotMaxPricePercentageOverLowestPrice *int32 `json:"spotMaxPricePercentageOverLowestPrice,omitempty"`
+
+	// OnDemandMaxPricePercentageOverLowestPrice is the price protection threshold for On-Demand Instances, as a
+	// percentage above the price of the cheapest current generation instance type matching the requirements.
+	// Defaults to 20 when omitted.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	OnDemandMaxPricePercentageOverLowestPrice *int32 `json:"onDemandMaxPricePercentageOverLowestPrice,omitempty"`
 }
 
 // OnDemandAllocationStrategy indicates how to allocate instance types to fulfill On-Demand capacity.
@@ -249,6 +415,8 @@ type AutoScalingGroup struct {
 	Status                    ASGStatus
 	Instances                 []infrav1.Instance `json:"instances,omitempty"`
 	CurrentlySuspendProcesses []string           `json:"currentlySuspendProcesses,omitempty"`
+	WarmPool                  *WarmPool          `json:"warmPool,omitempty"`
+	WarmPoolInstances         []infrav1.Instance `json:"warmPoolInstances,omitempty"`
 }
 
 // AWSLifecycleHook describes an AWS lifecycle hook
@@ -316,6 +484,200 @@ func (d LifecycleHookDefaultResult) String() string {
 	return string(d)
 }
 
+// WarmPool describes a pool of pre-initialized instances kept next to an Auto Scaling group, from which
+// the group draws new instances when it scales out.
+type WarmPool struct {
+	// MinSize is the minimum number of instances to maintain in the warm pool. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinSize *int32 `json:"minSize,omitempty"`
+
+	// MaxGroupPreparedCapacity is the maximum number of instances allowed to be in the warm pool
+	// and the Auto Scaling group together. The size of the warm pool is this value minus the desired capacity of the
+	// group, and at least MinSize. When not set, the maximum size of the group is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxGroupPreparedCapacity *int32 `json:"maxGroupPreparedCapacity,omitempty"`
+
+	// PoolState is the state the instances are kept in while in the warm pool. Defaults to Stopped.
+	// Running is rejected, as the instances join the cluster when they are launched into the warm pool.
+	// +optional
+	// +kubebuilder:validation:Enum=Stopped;Hibernated;Running
+	PoolState WarmPoolState `json:"poolState,omitempty"`
+
+	// ReuseOnScaleIn returns instances to the warm pool when the group scales in, instead of terminating them.
+	// +optional
+	ReuseOnScaleIn bool `json:"reuseOnScaleIn,omitempty"`
+}
+
+// WarmPoolState is the state of the instances in a warm pool.
+type WarmPoolState string
+
+const (
+	// WarmPoolStateStopped keeps the instances of the warm pool stopped.
+	WarmPoolStateStopped WarmPoolState = "Stopped"
+	// WarmPoolStateHibernated keeps the instances of the warm pool hibernated.
+	WarmPoolStateHibernated WarmPoolState = "Hibernated"
+	// WarmPoolStateRunning keeps the instances of the warm pool running.
+	WarmPoolStateRunning WarmPoolState = "Running"
+)
+
+// ScheduledAction describes a recurring change of the size of an Auto Scaling group.
+type ScheduledAction struct {
+	// Name is the name of the scheduled action.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// Recurrence is the schedule of the action, in unix cron syntax, for example "0 8 * * 1-5".
+	// +kubebuilder:validation:MinLength=1
+	Recurrence string `json:"recurrence"`
+
+	// TimeZone is the IANA time zone of the recurrence, for example "Europe/Berlin". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// MinSize is the minimum size of the group after the action runs.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinSize *int32 `json:"minSize,omitempty"`
+
+	// MaxSize is the maximum size of the group after the action runs.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxSize *int32 `json:"maxSize,omitempty"`
+
+	// DesiredCapacity is the desired capacity of the group after the action runs.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	DesiredCapacity *int32 `json:"desiredCapacity,omitempty"`
+}
+
+// ScalingPolicyType is the type of an Auto Scaling group scaling policy.
+type ScalingPolicyType string
+
+const (
+	// ScalingPolicyTypeTargetTracking scales the group to keep a metric at a target value.
+	ScalingPolicyTypeTargetTracking ScalingPolicyType = "TargetTrackingScaling"
+	// ScalingPolicyTypePredictive scales the group ahead of the load forecast from the history of a metric.
+	ScalingPolicyTypePredictive ScalingPolicyType = "PredictiveScaling"
+)
+
+// ScalingPolicy describes a scaling policy of an Auto Scaling group.
+// +kubebuilder:validation:XValidation:rule="self.policyType == 'TargetTrackingScaling' ? has(self.targetTracking) && !has(self.predictive) : has(self.predictive) && !has(self.targetTracking)",message="targetTracking must be set for TargetTrackingScaling policies and predictive for PredictiveScaling policies"
+type ScalingPolicy struct {
+	// Name is the name of the scaling policy.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// PolicyType is the type of the scaling policy.
+	// +kubebuilder:validation:Enum=TargetTrackingScaling;PredictiveScaling
+	PolicyType ScalingPolicyType `json:"policyType"`
+
+	// TargetTracking configures a TargetTrackingScaling policy.
+	// +optional
+	TargetTracking *TargetTrackingConfiguration `json:"targetTracking,omitempty"`
+
+	// Predictive configures a PredictiveScaling policy.
+	// +optional
+	Predictive *PredictiveScalingConfiguration `json:"predictive,omitempty"`
+}
+
+// TargetTrackingMetricType is a predefined metric of a target tracking scaling policy.
+type TargetTrackingMetricType string
+
+const (
+	// TargetTrackingMetricTypeCPUUtilization is the average CPU utilization of the group, in percent.
+	TargetTrackingMetricTypeCPUUtilization TargetTrackingMetricType = "ASGAverageCPUUtilization"
+	// TargetTrackingMetricTypeNetworkIn is the average number of bytes received by an instance of the group.
+	TargetTrackingMetricTypeNetworkIn TargetTrackingMetricType = "ASGAverageNetworkIn"
+	// TargetTrackingMetricTypeNetworkOut is the average number of bytes sent by an instance of the group.
+	TargetTrackingMetricTypeNetworkOut TargetTrackingMetricType = "ASGAverageNetworkOut"
+	// TargetTrackingMetricTypeALBRequestCountPerTarget is the number of requests completed per target
+	// in an Application Load Balancer target group.
+	TargetTrackingMetricTypeALBRequestCountPerTarget TargetTrackingMetricType = "ALBRequestCountPerTarget"
+)
+
+// TargetTrackingConfiguration configures a target tracking scaling policy.
+type TargetTrackingConfiguration struct {
+	// PredefinedMetricType is the metric to track.
+	// +kubebuilder:validation:Enum=ASGAverageCPUUtilization;ASGAverageNetworkIn;ASGAverageNetworkOut;ALBRequestCountPerTarget
+	PredefinedMetricType TargetTrackingMetricType `json:"predefinedMetricType"`
+
+	// ResourceLabel identifies the target group of an ALBRequestCountPerTarget metric, in the format
+	// app/<load-balancer-name>/<load-balancer-id>/targetgroup/<target-group-name>/<target-group-id>.
+	// +optional
+	ResourceLabel string `json:"resourceLabel,omitempty"`
+
+	// TargetValue is the value to keep the metric at.
+	// +kubebuilder:validation:Minimum=1
+	TargetValue int32 `json:"targetValue"`
+
+	// DisableScaleIn prevents the policy from scaling in the group.
+	// +optional
+	DisableScaleIn bool `json:"disableScaleIn,omitempty"`
+}
+
+// PredictiveScalingMetricType is a predefined metric pair of a predictive scaling policy.
+type PredictiveScalingMetricType string
+
+const (
+	// PredictiveScalingMetricTypeCPUUtilization forecasts from the CPU utilization of the group.
+	PredictiveScalingMetricTypeCPUUtilization PredictiveScalingMetricType = "ASGCPUUtilization"
+	// PredictiveScalingMetricTypeNetworkIn forecasts from the bytes received by the group.
+	PredictiveScalingMetricTypeNetworkIn PredictiveScalingMetricType = "ASGNetworkIn"
+	// PredictiveScalingMetricTypeNetworkOut forecasts from the bytes sent by the group.
+	PredictiveScalingMetricTypeNetworkOut PredictiveScalingMetricType = "ASGNetworkOut"
+	// PredictiveScalingMetricTypeALBRequestCount forecasts from the requests of an Application Load Balancer target group.
+	PredictiveScalingMetricTypeALBRequestCount PredictiveScalingMetricType = "ALBRequestCount"
+)
+
+// PredictiveScalingMode is the mode of a predictive scaling policy.
+type PredictiveScalingMode string
+
+const (
+	// PredictiveScalingModeForecastOnly only produces forecasts, without scaling the group.
+	PredictiveScalingModeForecastOnly PredictiveScalingMode = "ForecastOnly"
+	// PredictiveScalingModeForecastAndScale produces forecasts and scales the group from them.
+	PredictiveScalingModeForecastAndScale PredictiveScalingMode = "ForecastAndScale"
+)
+
+// PredictiveScalingConfiguration configures a predictive scaling policy.
+type PredictiveScalingConfiguration struct {
+	// PredefinedMetricType is the metric pair to forecast from.
+	// +kubebuilder:validation:Enum=ASGCPUUtilization;ASGNetworkIn;ASGNetworkOut;ALBRequestCount
+	PredefinedMetricType PredictiveScalingMetricType `json:"predefinedMetricType"`
+
+	// ResourceLabel identifies the target group of an ALBRequestCount metric, in the format
+	// app/<load-balancer-name>/<load-balancer-id>/targetgroup/<target-group-name>/<target-group-id>.
+	// +optional
+	ResourceLabel string `json:"resourceLabel,omitempty"`
+
+	// TargetValue is the value to keep the scaling metric at.
+	// +kubebuilder:validation:Minimum=1
+	TargetValue int32 `json:"targetValue"`
+
+	// Mode is the mode of the policy. Defaults to ForecastOnly.
+	// +optional
+	// +kubebuilder:validation:Enum=ForecastOnly;ForecastAndScale
+	Mode PredictiveScalingMode `json:"mode,omitempty"`
+
+	// SchedulingBufferTime is the number of seconds by which instances are launched before the forecast time.
+	// Defaults to 300.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	SchedulingBufferTime *int32 `json:"schedulingBufferTime,omitempty"`
+
+	// MaxCapacityBuffer allows the forecast to raise the capacity of the group above its maximum size,
+	// by this percentage of the forecast capacity. When not set, the maximum size of the group is honored.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxCapacityBuffer *int32 `json:"maxCapacityBuffer,omitempty"`
+}
+
 // ASGStatus is a status string returned by the autoscaling API.
 type ASGStatus string
 
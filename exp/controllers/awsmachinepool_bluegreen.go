@@ -0,0 +1,296 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+const (
+	// defaultBlueGreenScaleDownBatchSize is the number of instances of the old ASG drained at a time by default.
+	defaultBlueGreenScaleDownBatchSize = 1
+	// defaultBlueGreenNodeReadyTimeout is how long to wait for the nodes of the new ASG to become ready by default.
+	defaultBlueGreenNodeReadyTimeout = 10 * time.Minute
+)
+
+// blueGreenEnabled returns true if the instances of the pool are replaced with the BlueGreen strategy.
+// The strategy relies on MachinePool Machines to drain the nodes and is ignored without them.
+func blueGreenEnabled(awsMachinePool *expinfrav1.AWSMachinePool) bool {
+	strategy := awsMachinePool.Spec.Strategy
+	return feature.Gates.Enabled(feature.MachinePoolMachines) &&
+		strategy != nil && strategy.Type == expinfrav1.AWSMachinePoolStrategyTypeBlueGreen
+}
+
+// blueGreenInProgress returns true if the pool is being moved to a new ASG.
+func blueGreenInProgress(awsMachinePool *expinfrav1.AWSMachinePool) bool {
+	blueGreen := awsMachinePool.Status.BlueGreen
+	return blueGreen != nil && blueGreen.Phase != expinfrav1.BlueGreenPhaseRolledBack
+}
+
+// replacementASGName returns the name of the ASG replacing the current ASG of the pool, for the given
+// launch template version. Launch template versions only ever increase, so the name is unique.
+func replacementASGName(machinePoolScope *scope.MachinePoolScope, launchTemplateVersion string) string {
+	return fmt.Sprintf("%s-%s", machinePoolScope.Name(), launchTemplateVersion)
+}
+
+// startBlueGreen records the start of a blue/green replacement of the current ASG and pins the current ASG
+// to the launch template version it runs, so it doesn't launch instances from the new version.
+func (r *AWSMachinePoolReconciler) startBlueGreen(machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface, previousLaunchTemplateVersion string) error {
+	// After a rollback the current ASG is still pinned to the version it was replaced from.
+	if blueGreen := machinePoolScope.AWSMachinePool.Status.BlueGreen; blueGreen != nil && blueGreen.PreviousLaunchTemplateVersion != "" {
+		previousLaunchTemplateVersion = blueGreen.PreviousLaunchTemplateVersion
+	}
+	if previousLaunchTemplateVersion == "" {
+		return errors.New("unable to start blue/green replacement: the launch template version of the current ASG is unknown")
+	}
+
+	launchTemplateVersion := machinePoolScope.GetLaunchTemplateLatestVersionStatus()
+	machinePoolScope.AWSMachinePool.Status.BlueGreen = &expinfrav1.BlueGreenStatus{
+		Phase:                         expinfrav1.BlueGreenPhaseScalingUp,
+		ASGName:                       replacementASGName(machinePoolScope, launchTemplateVersion),
+		LaunchTemplateVersion:         launchTemplateVersion,
+		PreviousLaunchTemplateVersion: previousLaunchTemplateVersion,
+		StartTime:                     metav1.Now(),
+	}
+	// Persist the replacement right away, it isn't triggered again if the controller restarts.
+	if err := machinePoolScope.PatchObject(); err != nil {
+		return err
+	}
+
+	machinePoolScope.Info("Starting blue/green replacement", "asg", machinePoolScope.AWSMachinePool.Status.BlueGreen.ASGName, "launchTemplateVersion", launchTemplateVersion)
+	return asgsvc.UpdateASG(machinePoolScope)
+}
+
+// findReplacementASG returns the ASG replacing the current ASG of the pool, or nothing if there is none.
+func (r *AWSMachinePoolReconciler) findReplacementASG(machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) (*expinfrav1.AutoScalingGroup, error) {
+	if !blueGreenInProgress(machinePoolScope.AWSMachinePool) {
+		return nil, nil
+	}
+
+	asg, err := asgsvc.ASGIfExists(&machinePoolScope.AWSMachinePool.Status.BlueGreen.ASGName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query replacement ASG")
+	}
+
+	return asg, nil
+}
+
+// reconcileBlueGreen moves the pool from the current ASG to the replacement ASG. The replacement ASG is
+// scaled up to the capacity of the current ASG first. Once all its nodes are ready, the instances of the
+// current ASG are detached in batches, so their Machines are deleted and their nodes drained, before the
+// current ASG is deleted. The replacement ASG is deleted instead if its nodes are not ready in time after
+// its creation. Once instances were detached from the current ASG, the replacement is no longer rolled back,
+// and the scale down pauses while nodes of the replacement ASG are not ready.
+func (r *AWSMachinePoolReconciler) reconcileBlueGreen(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface, currentASG, replacementASG *expinfrav1.AutoScalingGroup, awsMachineList *infrav1.AWSMachineList) (ctrl.Result, error) {
+	blueGreen := machinePoolScope.AWSMachinePool.Status.BlueGreen
+	strategy := ptr.Deref(machinePoolScope.AWSMachinePool.Spec.Strategy.BlueGreen, expinfrav1.BlueGreenStrategy{})
+
+	instances := slices.Clone(currentASG.Instances)
+	if replacementASG != nil {
+		instances = append(instances, replacementASG.Instances...)
+	}
+	providerIDList := make([]string, len(instances))
+	for i, instance := range instances {
+		providerIDList[i] = fmt.Sprintf("aws:///%s/%s", instance.AvailabilityZone, instance.ID)
+	}
+	machinePoolScope.AWSMachinePool.Spec.ProviderIDList = providerIDList
+	machinePoolScope.AWSMachinePool.Status.Replicas = int32(len(providerIDList)) //#nosec G115
+	if err := machinePoolScope.UpdateInstanceStatuses(ctx, instances, nil); err != nil {
+		machinePoolScope.Error(err, "failed updating instances", "instances", instances)
+	}
+
+	switch blueGreen.Phase {
+	case expinfrav1.BlueGreenPhaseScalingUp:
+		if replacementASG == nil {
+			machinePoolScope.Info("Creating replacement ASG", "name", blueGreen.ASGName)
+			if err := asgsvc.CreateReplacementASG(machinePoolScope, blueGreen.ASGName, ptr.Deref(currentASG.DesiredCapacity, 0)); err != nil {
+				return ctrl.Result{}, errors.Wrap(err, "failed to create replacement ASG")
+			}
+			blueGreen.ASGCreationTime = metav1.Now()
+			v1beta1conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.BlueGreenReplacementReadyCondition, expinfrav1.BlueGreenReplacementInProgressReason, clusterv1beta1.ConditionSeverityInfo, "creating ASG %s", blueGreen.ASGName)
+			return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+
+		ready, err := replacementNodesReady(ctx, r.Client, replacementASG, awsMachineList)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if ready {
+			machinePoolScope.Info("Nodes of the replacement ASG are ready, scaling down the current ASG", "current", currentASG.Name, "replacement", replacementASG.Name)
+			blueGreen.Phase = expinfrav1.BlueGreenPhaseScalingDown
+			v1beta1conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.BlueGreenReplacementReadyCondition, expinfrav1.BlueGreenReplacementInProgressReason, clusterv1beta1.ConditionSeverityInfo, "scaling down ASG %s", currentASG.Name)
+			return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+
+		timeout := defaultBlueGreenNodeReadyTimeout
+		if strategy.NodeReadyTimeout != nil {
+			timeout = strategy.NodeReadyTimeout.Duration
+		}
+		if blueGreen.ASGCreationTime.IsZero() {
+			// The creation of the replacement ASG was not recorded, e.g. the status failed to be patched.
+			blueGreen.ASGCreationTime = metav1.Now()
+		}
+		if time.Since(blueGreen.ASGCreationTime.Time) > timeout {
+			machinePoolScope.Info("Nodes of the replacement ASG are not ready in time, rolling back", "replacement", replacementASG.Name, "timeout", timeout)
+			if err := asgsvc.DeleteASG(replacementASG.Name); err != nil {
+				return ctrl.Result{}, errors.Wrap(err, "failed to delete replacement ASG")
+			}
+			r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, expinfrav1.BlueGreenReplacementRolledBackReason, "Nodes of ASG %q were not ready after %s, rolled back to launch template version %s", replacementASG.Name, timeout, blueGreen.PreviousLaunchTemplateVersion)
+			v1beta1conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.BlueGreenReplacementReadyCondition, expinfrav1.BlueGreenReplacementRolledBackReason, clusterv1beta1.ConditionSeverityError, "nodes of ASG %s were not ready after %s", replacementASG.Name, timeout)
+			blueGreen.Phase = expinfrav1.BlueGreenPhaseRolledBack
+			blueGreen.ASGName = ""
+			return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+
+		machinePoolScope.Debug("Waiting for the nodes of the replacement ASG to be ready", "replacement", replacementASG.Name)
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+
+	case expinfrav1.BlueGreenPhaseScalingDown:
+		if replacementASG == nil {
+			return ctrl.Result{}, errors.Errorf("replacement ASG %q not found", blueGreen.ASGName)
+		}
+
+		// Wait for the nodes of the previous batch to be drained and their instances terminated.
+		if draining := drainingInstances(currentASG, awsMachineList, providerIDList); draining > 0 {
+			machinePoolScope.Debug("Waiting for instances of the current ASG to be drained", "current", currentASG.Name, "draining", draining)
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+
+		if len(currentASG.Instances) == 0 {
+			machinePoolScope.Info("Deleting replaced ASG", "name", currentASG.Name)
+			if err := asgsvc.DeleteASG(currentASG.Name); err != nil {
+				return ctrl.Result{}, errors.Wrap(err, "failed to delete replaced ASG")
+			}
+			r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeNormal, "SuccessfulBlueGreenReplacement", "Replaced ASG %q with ASG %q", currentASG.Name, replacementASG.Name)
+			v1beta1conditions.MarkTrue(machinePoolScope.AWSMachinePool, expinfrav1.BlueGreenReplacementReadyCondition)
+			machinePoolScope.AWSMachinePool.Status.ASGName = replacementASG.Name
+			machinePoolScope.AWSMachinePool.Status.BlueGreen = nil
+			return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+
+		// Instances of the current ASG are already gone, so it's too late to roll back. Keep the remaining ones
+		// until the nodes of the replacement ASG are ready again.
+		ready, err := replacementNodesReady(ctx, r.Client, replacementASG, awsMachineList)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !ready {
+			machinePoolScope.Info("Nodes of the replacement ASG are not ready, pausing the scale down of the current ASG", "current", currentASG.Name, "replacement", replacementASG.Name)
+			v1beta1conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.BlueGreenReplacementReadyCondition, expinfrav1.BlueGreenReplacementInProgressReason, clusterv1beta1.ConditionSeverityWarning, "waiting for the nodes of ASG %s to be ready to scale down ASG %s", replacementASG.Name, currentASG.Name)
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+		v1beta1conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.BlueGreenReplacementReadyCondition, expinfrav1.BlueGreenReplacementInProgressReason, clusterv1beta1.ConditionSeverityInfo, "scaling down ASG %s", currentASG.Name)
+
+		batchSize := ptr.Deref(strategy.ScaleDownBatchSize, defaultBlueGreenScaleDownBatchSize)
+		batch := scaleDownBatch(currentASG, int(batchSize))
+		machinePoolScope.Info("Detaching instances from the replaced ASG", "name", currentASG.Name, "instances", batch)
+		if err := asgsvc.DetachASGInstances(currentASG.Name, batch); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "failed to detach instances from replaced ASG")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	return ctrl.Result{}, errors.Errorf("unknown blue/green replacement phase %q", blueGreen.Phase)
+}
+
+// replacementNodesReady returns true if the replacement ASG reached its desired capacity and the Machines
+// of all its instances have a ready node.
+func replacementNodesReady(ctx context.Context, c client.Client, replacementASG *expinfrav1.AutoScalingGroup, awsMachineList *infrav1.AWSMachineList) (bool, error) {
+	if int32(len(replacementASG.Instances)) < ptr.Deref(replacementASG.DesiredCapacity, 0) { //#nosec G115
+		return false, nil
+	}
+
+	providerIDToAWSMachine := make(map[string]*infrav1.AWSMachine, len(awsMachineList.Items))
+	for i := range awsMachineList.Items {
+		if providerID := ptr.Deref(awsMachineList.Items[i].Spec.ProviderID, ""); providerID != "" {
+			providerIDToAWSMachine[providerID] = &awsMachineList.Items[i]
+		}
+	}
+
+	for _, instance := range replacementASG.Instances {
+		awsMachine, ok := providerIDToAWSMachine[fmt.Sprintf("aws:///%s/%s", instance.AvailabilityZone, instance.ID)]
+		if !ok {
+			return false, nil
+		}
+		machine, err := util.GetOwnerMachine(ctx, c, awsMachine.ObjectMeta)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get owner Machine for %s/%s", awsMachine.Namespace, awsMachine.Name)
+		}
+		if machine == nil || !conditions.IsTrue(machine, clusterv1.MachineNodeReadyCondition) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// drainingInstances returns the number of instances detached from the current ASG whose Machines are
+// still being deleted, or that are still being detached.
+func drainingInstances(currentASG *expinfrav1.AutoScalingGroup, awsMachineList *infrav1.AWSMachineList, providerIDList []string) int {
+	draining := 0
+	for _, instance := range currentASG.Instances {
+		if isDetaching(instance) {
+			draining++
+		}
+	}
+	for i := range awsMachineList.Items {
+		providerID := ptr.Deref(awsMachineList.Items[i].Spec.ProviderID, "")
+		if providerID != "" && !slices.Contains(providerIDList, providerID) {
+			draining++
+		}
+	}
+	return draining
+}
+
+// scaleDownBatch returns the IDs of the next instances to detach from the current ASG.
+func scaleDownBatch(currentASG *expinfrav1.AutoScalingGroup, batchSize int) []string {
+	var instanceIDs []string
+	for _, instance := range currentASG.Instances {
+		if !isDetaching(instance) {
+			instanceIDs = append(instanceIDs, instance.ID)
+		}
+	}
+	slices.Sort(instanceIDs)
+	return instanceIDs[:min(batchSize, len(instanceIDs))]
+}
+
+func isDetaching(instance infrav1.Instance) bool {
+	return instance.State == infrav1.InstanceState(autoscalingtypes.LifecycleStateDetaching) ||
+		instance.State == infrav1.InstanceState(autoscalingtypes.LifecycleStateDetached)
+}
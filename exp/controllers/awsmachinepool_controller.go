@@ -311,6 +311,8 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		return ctrl.Result{}, err
 	}
 
+	// previousLaunchTemplateVersion is the launch template version before the change replacing the instances.
+	var previousLaunchTemplateVersion string
 	canStartInstanceRefresh := func() (bool, *autoscalingtypes.InstanceRefreshStatus, error) {
 		// If there is a change: before changing the template, check if there exist an ongoing instance refresh,
 		// because only 1 instance refresh can be "InProgress". If template is updated when refresh cannot be started,
@@ -320,6 +322,11 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 			// But we want to update the LaunchTemplate because an error in the LaunchTemplate may be blocking the ASG creation.
 			return true, nil, nil
 		}
+		if blueGreenEnabled(machinePoolScope.AWSMachinePool) {
+			// Likewise, only one blue/green replacement can be in progress.
+			previousLaunchTemplateVersion = machinePoolScope.GetLaunchTemplateLatestVersionStatus()
+			return !blueGreenInProgress(machinePoolScope.AWSMachinePool), nil, nil
+		}
 		return asgsvc.CanStartASGInstanceRefresh(machinePoolScope)
 	}
 	cancelInstanceRefresh := func() error {
@@ -332,6 +339,17 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 			machinePoolScope.Debug("ASG does not exist yet, skipping instance refresh")
 			return nil
 		}
+		if blueGreenEnabled(machinePoolScope.AWSMachinePool) {
+			return r.startBlueGreen(machinePoolScope, asgsvc, previousLaunchTemplateVersion)
+		}
+		// Unpin the ASG from the launch template version of a rolled back blue/green replacement, so the
+		// instance refresh rolls out the latest version.
+		if machinePoolScope.AWSMachinePool.Status.BlueGreen != nil {
+			machinePoolScope.AWSMachinePool.Status.BlueGreen = nil
+			if err := asgsvc.UpdateASG(machinePoolScope); err != nil {
+				return err
+			}
+		}
 		// skip instance refresh if explicitly disabled
 		if machinePoolScope.AWSMachinePool.Spec.RefreshPreferences != nil && machinePoolScope.AWSMachinePool.Spec.RefreshPreferences.Disable {
 			machinePoolScope.Debug("instance refresh disabled, skipping instance refresh")
@@ -373,31 +391,75 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		}, nil
 	}
 
+	replacementASG, err := r.findReplacementASG(machinePoolScope, asgsvc)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var awsMachineList *infrav1.AWSMachineList
 	if feature.Gates.Enabled(feature.MachinePoolMachines) {
-		awsMachineList, err := getAWSMachines(ctx, machinePoolScope.MachinePool, r.Client)
+		awsMachineList, err = getAWSMachines(ctx, machinePoolScope.MachinePool, r.Client)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 
-		if err := createAWSMachinesIfNotExists(ctx, awsMachineList, machinePoolScope.MachinePool, &machinePoolScope.AWSMachinePool.ObjectMeta, &machinePoolScope.AWSMachinePool.TypeMeta, asg, machinePoolScope.GetLogger(), r.Client, ec2Svc); err != nil {
-			machinePoolScope.SetNotReady()
-			v1beta1conditions.MarkFalse(machinePoolScope.AWSMachinePool, clusterv1beta1.ReadyCondition, expinfrav1.AWSMachineCreationFailed, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
-			return ctrl.Result{}, fmt.Errorf("failed to create awsmachines: %w", err)
+		// While the pool is being moved to a replacement ASG, the instances of both ASGs belong to the pool.
+		machinesASG := asg
+		if replacementASG != nil {
+			machinesASG = asg.DeepCopy()
+			machinesASG.Instances = append(machinesASG.Instances, replacementASG.Instances...)
+		}
+
+		for _, existingASG := range []*expinfrav1.AutoScalingGroup{asg, replacementASG} {
+			if existingASG == nil {
+				continue
+			}
+			if err := createAWSMachinesIfNotExists(ctx, awsMachineList, machinePoolScope.MachinePool, &machinePoolScope.AWSMachinePool.ObjectMeta, &machinePoolScope.AWSMachinePool.TypeMeta, existingASG, machinePoolScope.GetLogger(), r.Client, ec2Svc); err != nil {
+				machinePoolScope.SetNotReady()
+				v1beta1conditions.MarkFalse(machinePoolScope.AWSMachinePool, clusterv1beta1.ReadyCondition, expinfrav1.AWSMachineCreationFailed, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+				return ctrl.Result{}, fmt.Errorf("failed to create awsmachines: %w", err)
+			}
 		}
 
-		if err := deleteOrphanedAWSMachines(ctx, awsMachineList, asg, machinePoolScope.GetLogger(), r.Client); err != nil {
+		if err := deleteOrphanedAWSMachines(ctx, awsMachineList, machinesASG, machinePoolScope.GetLogger(), r.Client); err != nil {
 			machinePoolScope.SetNotReady()
 			v1beta1conditions.MarkFalse(machinePoolScope.AWSMachinePool, clusterv1beta1.ReadyCondition, expinfrav1.AWSMachineDeletionFailed, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
 			return ctrl.Result{}, fmt.Errorf("failed to clean up awsmachines: %w", err)
 		}
 	}
 
+	if blueGreenInProgress(machinePoolScope.AWSMachinePool) && awsMachineList != nil {
+		// The ASG is not updated while it is being replaced, the replacement ASG gets the latest spec.
+		res, err := r.reconcileBlueGreen(ctx, machinePoolScope, asgsvc, asg, replacementASG, awsMachineList)
+		if err != nil {
+			r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedBlueGreenReplacement", "Failed to replace ASG: %v", err)
+			v1beta1conditions.MarkFalse(machinePoolScope.AWSMachinePool, expinfrav1.BlueGreenReplacementReadyCondition, expinfrav1.BlueGreenReplacementFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			return ctrl.Result{}, err
+		}
+		return res, nil
+	}
+
 	if err := r.reconcileLifecycleHooks(ctx, machinePoolScope, asgsvc); err != nil {
 		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedLifecycleHooksReconcile", "Failed to reconcile lifecycle hooks: %v", err)
 		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile lifecycle hooks")
 	}
 
-	if annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	if err := r.reconcileWarmPool(ctx, machinePoolScope, asgsvc, asg); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedWarmPoolReconcile", "Failed to reconcile warm pool: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile warm pool")
+	}
+
+	if err := r.reconcileScheduledActions(ctx, machinePoolScope, asgsvc); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedScheduledActionsReconcile", "Failed to reconcile scheduled actions: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile scheduled actions")
+	}
+
+	if err := r.reconcileScalingPolicies(ctx, machinePoolScope, asgsvc); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedScalingPoliciesReconcile", "Failed to reconcile scaling policies: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile scaling policies")
+	}
+
+	if machinePoolScope.ReplicasExternallyManaged() {
 		// Set MachinePool replicas to the ASG DesiredCapacity
 		if *machinePoolScope.MachinePool.Spec.Replicas != *asg.DesiredCapacity {
 			machinePoolScope.Info("Setting MachinePool replicas to ASG DesiredCapacity",
@@ -416,7 +478,7 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 	}
 
 	launchTemplateID := machinePoolScope.GetLaunchTemplateIDStatus()
-	asgName := machinePoolScope.Name()
+	asgName := machinePoolScope.ASGName()
 	resourceServiceToUpdate := []scope.ResourceServiceToUpdate{
 		{
 			ResourceID:      &launchTemplateID,
@@ -444,10 +506,11 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 
 	machinePoolScope.AWSMachinePool.Spec.ProviderIDList = providerIDList
 	machinePoolScope.AWSMachinePool.Status.Replicas = int32(len(providerIDList)) //#nosec G115
+	machinePoolScope.AWSMachinePool.Status.Capacity = instanceRequirementsCapacity(machinePoolScope.AWSMachinePool)
 	machinePoolScope.AWSMachinePool.Status.Ready = true
 	v1beta1conditions.MarkTrue(machinePoolScope.AWSMachinePool, expinfrav1.ASGReadyCondition)
 
-	err = machinePoolScope.UpdateInstanceStatuses(ctx, asg.Instances)
+	err = machinePoolScope.UpdateInstanceStatuses(ctx, asg.Instances, asg.WarmPoolInstances)
 	if err != nil {
 		machinePoolScope.Error(err, "failed updating instances", "instances", asg.Instances)
 	}
@@ -465,6 +528,27 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 	return ctrl.Result{}, nil
 }
 
+// instanceRequirementsCapacity returns the capacity of the minimum shape matching the instance requirements
+// of the launch template and of the mixed instances policy overrides, or nil when no instance requirements are used.
+func instanceRequirementsCapacity(awsMachinePool *expinfrav1.AWSMachinePool) corev1.ResourceList {
+	var requirements []*expinfrav1.InstanceRequirements
+	if awsMachinePool.Spec.AWSLaunchTemplate.InstanceRequirements != nil {
+		requirements = append(requirements, awsMachinePool.Spec.AWSLaunchTemplate.InstanceRequirements)
+	}
+	if awsMachinePool.Spec.MixedInstancesPolicy != nil {
+		for _, override := range awsMachinePool.Spec.MixedInstancesPolicy.Overrides {
+			if override.InstanceRequirements != nil {
+				requirements = append(requirements, override.InstanceRequirements)
+			}
+		}
+	}
+
+	if len(requirements) == 0 {
+		return nil
+	}
+	return ec2.InstanceRequirementsCapacity(requirements...)
+}
+
 // validateEnclaveOptionsForEdgeZones returns an error if enclaveOptions is enabled
 // and any subnet or availability zone on the AWSMachinePool resolves to a Local Zone
 // or Wavelength Zone. AWS does not support Nitro Enclaves in edge zones.
@@ -498,6 +582,18 @@ func (r *AWSMachinePoolReconciler) reconcileDelete(ctx context.Context, machineP
 	ec2Svc := r.getEC2Service(ec2Scope)
 	asgSvc := r.getASGService(clusterScope)
 
+	replacementASG, err := r.findReplacementASG(machinePoolScope, asgSvc)
+	if err != nil {
+		return err
+	}
+	if replacementASG != nil && replacementASG.Status != expinfrav1.ASGStatusDeleteInProgress {
+		machinePoolScope.Info("Deleting replacement ASG", "id", replacementASG.Name)
+		if err := asgSvc.DeleteASGAndWait(replacementASG.Name); err != nil {
+			r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedDelete", "Failed to delete ASG %q: %v", replacementASG.Name, err)
+			return errors.Wrap(err, "failed to delete replacement ASG")
+		}
+	}
+
 	asg, err := r.findASG(machinePoolScope, asgSvc)
 	if err != nil {
 		return err
@@ -662,7 +758,7 @@ func (r *AWSMachinePoolReconciler) findASG(machinePoolScope *scope.MachinePoolSc
 func diffASG(machinePoolScope *scope.MachinePoolScope, existingASG *expinfrav1.AutoScalingGroup) string {
 	detectedMachinePoolSpec := machinePoolScope.MachinePool.Spec.DeepCopy()
 
-	if !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	if !machinePoolScope.ReplicasExternallyManaged() {
 		detectedMachinePoolSpec.Replicas = existingASG.DesiredCapacity
 	}
 	if diff := cmp.Diff(machinePoolScope.MachinePool.Spec, *detectedMachinePoolSpec); diff != "" {
@@ -745,11 +841,34 @@ func machinePoolToInfrastructureMapFunc(gvk schema.GroupVersionKind) handler.Map
 
 // reconcileLifecycleHooks periodically reconciles a lifecycle hook for the ASG.
 func (r *AWSMachinePoolReconciler) reconcileLifecycleHooks(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) error {
-	asgName := machinePoolScope.Name()
+	asgName := machinePoolScope.ASGName()
 
 	return asg.ReconcileLifecycleHooks(ctx, asgsvc, asgName, machinePoolScope.GetLifecycleHooks(), map[string]bool{}, machinePoolScope.GetMachinePool(), machinePoolScope)
 }
 
+// reconcileWarmPool reconciles the warm pool of the ASG.
+func (r *AWSMachinePoolReconciler) reconcileWarmPool(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface, existingASG *expinfrav1.AutoScalingGroup) error {
+	return asg.ReconcileWarmPool(ctx, asgsvc, machinePoolScope.ASGName(), machinePoolScope.AWSMachinePool.Spec.WarmPool, existingASG.WarmPool, machinePoolScope.GetMachinePool(), machinePoolScope)
+}
+
+// reconcileScheduledActions reconciles the scheduled actions of the ASG and records the ones CAPA created,
+// so they are deleted when the scheduled actions are removed from the spec.
+func (r *AWSMachinePoolReconciler) reconcileScheduledActions(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) error {
+	awsMachinePool := machinePoolScope.AWSMachinePool
+	managed, err := asg.ReconcileScheduledActions(ctx, asgsvc, machinePoolScope.ASGName(), awsMachinePool.Spec.ScheduledActions, awsMachinePool.Status.ManagedScheduledActions, machinePoolScope.GetMachinePool(), machinePoolScope)
+	awsMachinePool.Status.ManagedScheduledActions = managed
+	return err
+}
+
+// reconcileScalingPolicies reconciles the scaling policies of the ASG and records the ones CAPA created,
+// so they are deleted when the scaling policies are removed from the spec.
+func (r *AWSMachinePoolReconciler) reconcileScalingPolicies(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) error {
+	awsMachinePool := machinePoolScope.AWSMachinePool
+	managed, err := asg.ReconcileScalingPolicies(ctx, asgsvc, machinePoolScope.ASGName(), awsMachinePool.Spec.ScalingPolicies, awsMachinePool.Status.ManagedScalingPolicies, machinePoolScope.GetMachinePool(), machinePoolScope)
+	awsMachinePool.Status.ManagedScalingPolicies = managed
+	return err
+}
+
 func (r *AWSMachinePoolReconciler) getInfraCluster(ctx context.Context, log *logger.Logger, cluster *clusterv1.Cluster, awsMachinePool *expinfrav1.AWSMachinePool) (scope.EC2Scope, scope.S3Scope, error) {
 	var clusterScope *scope.ClusterScope
 	var managedControlPlaneScope *scope.ManagedControlPlaneScope
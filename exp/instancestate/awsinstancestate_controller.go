@@ -15,12 +15,13 @@ limitations under the License.
 */
 
 // Package instancestate provides a controller that listens
-// for EC2 instance state change notifications and updates the corresponding AWSMachine's status.
+// for EC2 instance state change and interruption notifications and updates the corresponding AWSMachine's status.
 package instancestate
 
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"sync"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,12 +43,29 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
-// Ec2InstanceStateLabelKey defines an ec2 instance state label.
-const Ec2InstanceStateLabelKey = "ec2-instance-state"
+const (
+	// Ec2InstanceStateLabelKey defines an ec2 instance state label.
+	Ec2InstanceStateLabelKey = "ec2-instance-state"
+
+	// InstanceInterruptionAnnotationKey defines the annotation set on the Machine owning an AWSMachine
+	// whose EC2 instance is about to be interrupted. Its value is the reason of the interruption.
+	InstanceInterruptionAnnotationKey = infrav1.InstanceInterruptionAnnotation
+
+	// spotInterruptionNotice is the time between a Spot interruption warning and the interruption.
+	spotInterruptionNotice = 2 * time.Minute
+
+	// closedStatusCode is the status code of an AWS Health event that has ended.
+	closedStatusCode = "closed"
+)
 
 // AwsInstanceStateReconciler reconciles a AwsInstanceState object.
 type AwsInstanceStateReconciler struct {
@@ -55,10 +74,16 @@ type AwsInstanceStateReconciler struct {
 	sqsServiceFactory func() instancestate.SQSAPI
 	queueURLs         sync.Map
 	WatchFilterValue  string
+
+	// ReplaceInterruptedMachines enables the deletion of the Machines owned by a MachineSet whose instances are
+	// about to be interrupted, so they are drained and replaced before AWS reclaims the instances.
+	ReplaceInterruptedMachines bool
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;patch;delete
 
 func (r *AwsInstanceStateReconciler) getSQSService(region string) (instancestate.SQSAPI, error) {
 	if r.sqsServiceFactory != nil {
@@ -174,43 +199,165 @@ func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents() {
 	}
 }
 
-// processMessage triggers a reconcile on an AWSMachine if its EC2 instance state changed.
+// processMessage handles the EC2 instance state change and interruption notifications.
 func (r *AwsInstanceStateReconciler) processMessage(ctx context.Context, msg message) {
-	if msg.Source != "aws.ec2" || msg.DetailType != instancestate.Ec2StateChangeNotification || msg.MessageDetail == nil {
+	if msg.MessageDetail == nil {
+		return
+	}
+
+	switch {
+	case msg.Source == "aws.ec2" && msg.DetailType == instancestate.Ec2StateChangeNotification:
+		r.processStateChange(ctx, msg.MessageDetail)
+	case msg.Source == "aws.ec2" && msg.DetailType == instancestate.Ec2SpotInterruptionWarning:
+		r.processInterruption(ctx, msg.MessageDetail.InstanceID, infrav1.SpotInterruptionWarningReason,
+			fmt.Sprintf("Spot instance is about to be reclaimed, instance action: %s", msg.MessageDetail.InstanceAction),
+			msg.time().Add(spotInterruptionNotice))
+	case msg.Source == "aws.ec2" && msg.DetailType == instancestate.Ec2RebalanceRecommendation:
+		// a rebalance recommendation doesn't schedule anything, it is kept for as long as the Spot
+		// interruption warning that may follow it
+		r.processInterruption(ctx, msg.MessageDetail.InstanceID, infrav1.RebalanceRecommendationReason,
+			"Spot instance is at an elevated risk of interruption", msg.time().Add(spotInterruptionNotice))
+	case msg.Source == "aws.health" && msg.DetailType == instancestate.HealthEvent && msg.MessageDetail.StatusCode != closedStatusCode:
+		// the affected instances are listed in the resources of the event
+		for _, instanceID := range msg.Resources {
+			r.processInterruption(ctx, instanceID, infrav1.ScheduledEventReason,
+				fmt.Sprintf("Scheduled event %s starting at %s", msg.MessageDetail.EventTypeCode, msg.MessageDetail.StartTime),
+				msg.MessageDetail.endTime(msg.time()))
+		}
+	}
+}
+
+// processStateChange triggers a reconcile on an AWSMachine if its EC2 instance state changed.
+func (r *AwsInstanceStateReconciler) processStateChange(ctx context.Context, detail *messageDetail) {
+	machine := r.getAWSMachine(ctx, detail.InstanceID)
+	if machine == nil {
+		return
+	}
+
+	patchHelper, err := patch.NewHelper(machine, r.Client)
+	if err != nil {
+		r.Log.Error(err, "unable to create patch helper")
+	}
+	// Trigger an update on the machine
+	labels := machine.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	labels[Ec2InstanceStateLabelKey] = string(detail.State)
+	machine.SetLabels(labels)
+
+	err = patchHelper.Patch(ctx, machine)
+	if err != nil {
+		r.Log.Error(err, "unable to patch AWS machine")
+	}
+}
+
+// processInterruption marks an AWSMachine whose EC2 instance is about to be interrupted, annotates its owning
+// Machine and, if enabled, deletes the Machine so it is drained and replaced. The AWSMachine controller clears
+// the mark once the interruption is over, at the given end time, if the instance is still running.
+func (r *AwsInstanceStateReconciler) processInterruption(ctx context.Context, instanceID, reason, message string, end time.Time) {
+	awsMachine := r.getAWSMachine(ctx, instanceID)
+	if awsMachine == nil {
 		return
 	}
 
+	log := r.Log.WithValues("instanceID", instanceID, "awsMachine", klog.KObj(awsMachine), "reason", reason)
+
+	// interruptions can be notified several times, e.g. a rebalance recommendation followed by a Spot interruption warning
+	endValue := end.UTC().Format(time.RFC3339)
+	if v1beta1conditions.IsTrue(awsMachine, infrav1.InstanceInterruptedCondition) && v1beta1conditions.GetReason(awsMachine, infrav1.InstanceInterruptedCondition) == reason &&
+		awsMachine.GetAnnotations()[infrav1.InstanceInterruptionEndAnnotation] == endValue {
+		return
+	}
+
+	log.Info("EC2 instance is about to be interrupted")
+
+	awsMachinePatchHelper, err := v1beta1patch.NewHelper(awsMachine, r.Client)
+	if err != nil {
+		log.Error(err, "unable to create patch helper")
+		return
+	}
+	v1beta1conditions.MarkTrueWithNegativePolarity(awsMachine, infrav1.InstanceInterruptedCondition, reason, clusterv1beta1.ConditionSeverityWarning, "%s", message)
+	awsMachineAnnotations := awsMachine.GetAnnotations()
+	if awsMachineAnnotations == nil {
+		awsMachineAnnotations = make(map[string]string)
+	}
+	awsMachineAnnotations[infrav1.InstanceInterruptionEndAnnotation] = endValue
+	awsMachine.SetAnnotations(awsMachineAnnotations)
+	if err := awsMachinePatchHelper.Patch(ctx, awsMachine, v1beta1patch.WithOwnedConditions{Conditions: []clusterv1beta1.ConditionType{
+		infrav1.InstanceInterruptedCondition,
+	}}); err != nil {
+		log.Error(err, "unable to patch AWS machine")
+		return
+	}
+
+	machine, err := util.GetOwnerMachine(ctx, r.Client, awsMachine.ObjectMeta)
+	if err != nil {
+		log.Error(err, "unable to get owner machine")
+		return
+	}
+	if machine == nil || !machine.DeletionTimestamp.IsZero() {
+		return
+	}
+
+	machinePatchHelper, err := patch.NewHelper(machine, r.Client)
+	if err != nil {
+		log.Error(err, "unable to create patch helper")
+		return
+	}
+	annotations := machine.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[InstanceInterruptionAnnotationKey] = reason
+	machine.SetAnnotations(annotations)
+	if err := machinePatchHelper.Patch(ctx, machine); err != nil {
+		log.Error(err, "unable to patch machine")
+		return
+	}
+
+	if !r.ReplaceInterruptedMachines || !isReplaceable(machine) {
+		return
+	}
+
+	// Deleting the Machine drains its node, the owning MachineSet then creates a replacement.
+	log.Info("Deleting interrupted machine to replace it", "machine", klog.KObj(machine))
+	if err := r.Client.Delete(ctx, machine); err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "unable to delete interrupted machine", "machine", klog.KObj(machine))
+	}
+}
+
+// getAWSMachine returns the AWSMachine of an EC2 instance, unless the AWSMachine is deleted.
+func (r *AwsInstanceStateReconciler) getAWSMachine(ctx context.Context, instanceID string) *infrav1.AWSMachine {
 	// Fetch the awsMachine instance by InstanceID
 	awsMachines := &infrav1.AWSMachineList{}
-	err := r.List(ctx, awsMachines, client.MatchingFields{controllers.InstanceIDIndex: msg.MessageDetail.InstanceID})
+	err := r.List(ctx, awsMachines, client.MatchingFields{controllers.InstanceIDIndex: instanceID})
 
 	if err != nil {
-		r.Log.Error(err, "unable to list machines by instance ID", "instanceID", msg.MessageDetail.InstanceID)
+		r.Log.Error(err, "unable to list machines by instance ID", "instanceID", instanceID)
 	}
 
-	if len(awsMachines.Items) > 0 {
-		machine := awsMachines.Items[0]
-		if !machine.ObjectMeta.DeletionTimestamp.IsZero() {
-			return
-		}
-		patchHelper, err := patch.NewHelper(&machine, r.Client)
-		if err != nil {
-			r.Log.Error(err, "unable to create patch helper")
-		}
-		// Trigger an update on the machine
-		labels := machine.GetLabels()
-		if labels == nil {
-			labels = make(map[string]string)
-		}
+	if len(awsMachines.Items) == 0 {
+		return nil
+	}
 
-		labels[Ec2InstanceStateLabelKey] = string(msg.MessageDetail.State)
-		machine.SetLabels(labels)
+	machine := &awsMachines.Items[0]
+	if !machine.ObjectMeta.DeletionTimestamp.IsZero() {
+		return nil
+	}
 
-		err = patchHelper.Patch(ctx, &machine)
-		if err != nil {
-			r.Log.Error(err, "unable to patch AWS machine")
-		}
+	return machine
+}
+
+// isReplaceable returns true if the Machine is a worker controlled by a MachineSet, which replaces it once deleted.
+func isReplaceable(machine *clusterv1.Machine) bool {
+	if util.IsControlPlaneMachine(machine) {
+		return false
 	}
+
+	owner := metav1.GetControllerOf(machine)
+	return owner != nil && owner.Kind == "MachineSet"
 }
 
 // getQueueURL retrieves the SQS queue URL for a given cluster.
@@ -245,10 +392,37 @@ type queueParams struct {
 type message struct {
 	Source        string         `json:"source"`
 	DetailType    string         `json:"detail-type,omitempty"`
+	Time          string         `json:"time,omitempty"`
+	Resources     []string       `json:"resources,omitempty"`
 	MessageDetail *messageDetail `json:"detail,omitempty"`
 }
 
+// time returns the time the event was emitted, or now if it can't be parsed.
+func (m message) time() time.Time {
+	t, err := time.Parse(time.RFC3339, m.Time)
+	if err != nil {
+		return time.Now()
+	}
+	return t
+}
+
 type messageDetail struct {
-	InstanceID string                `json:"instance-id,omitempty"`
-	State      infrav1.InstanceState `json:"state,omitempty"`
+	InstanceID     string                `json:"instance-id,omitempty"`
+	State          infrav1.InstanceState `json:"state,omitempty"`
+	InstanceAction string                `json:"instance-action,omitempty"`
+	EventTypeCode  string                `json:"eventTypeCode,omitempty"`
+	StatusCode     string                `json:"statusCode,omitempty"`
+	StartTime      string                `json:"startTime,omitempty"`
+	EndTime        string                `json:"endTime,omitempty"`
+}
+
+// endTime returns the end of a scheduled event, or its start if it has no end. AWS Health events
+// carry RFC 1123 times. If neither time can be parsed, the given default is returned.
+func (d messageDetail) endTime(defaultTime time.Time) time.Time {
+	for _, value := range []string{d.EndTime, d.StartTime} {
+		if t, err := time.Parse(time.RFC1123, value); err == nil {
+			return t
+		}
+	}
+	return defaultTime
 }
@@ -27,6 +27,7 @@ import (
 	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
@@ -38,6 +39,8 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/controllers"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate/mock_sqsiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
 func TestAWSInstanceStateController(t *testing.T) {
@@ -59,6 +62,10 @@ func TestAWSInstanceStateController(t *testing.T) {
 			Name:      "aws-cluster-1-instance-1",
 			Namespace: "default",
 		}
+		interruptedMachineMeta := metav1.ObjectMeta{
+			Name:      "aws-cluster-3-instance-1",
+			Namespace: "default",
+		}
 		sqsSvs.EXPECT().GetQueueUrl(gomock.Any(), &sqs.GetQueueUrlInput{QueueName: aws.String("aws-cluster-1-queue")}).AnyTimes().
 			Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("aws-cluster-1-url")}, nil)
 		sqsSvs.EXPECT().GetQueueUrl(gomock.Any(), &sqs.GetQueueUrlInput{QueueName: aws.String("aws-cluster-2-queue")}).AnyTimes().
@@ -89,9 +96,29 @@ func TestAWSInstanceStateController(t *testing.T) {
 		sqsSvs.EXPECT().ReceiveMessage(gomock.Any(), &sqs.ReceiveMessageInput{QueueUrl: aws.String("aws-cluster-2-url")}).AnyTimes().
 			Return(&sqs.ReceiveMessageOutput{Messages: []sqstypes.Message{}}, nil)
 		sqsSvs.EXPECT().ReceiveMessage(gomock.Any(), &sqs.ReceiveMessageInput{QueueUrl: aws.String("aws-cluster-3-url")}).AnyTimes().
-			Return(&sqs.ReceiveMessageOutput{Messages: []sqstypes.Message{}}, nil)
+			DoAndReturn(func(ctx context.Context, arg *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+				m := &infrav1.AWSMachine{}
+				lookupKey := types.NamespacedName{
+					Namespace: interruptedMachineMeta.Namespace,
+					Name:      interruptedMachineMeta.Name,
+				}
+				err := k8sClient.Get(ctx, lookupKey, m)
+				// start returning a message once the AWSMachine is available
+				if err == nil {
+					return &sqs.ReceiveMessageOutput{
+						Messages: []sqstypes.Message{{
+							ReceiptHandle: aws.String("interruption-message-receipt-handle"),
+							Body:          aws.String(spotInterruptionMessageBodyJSON),
+						}},
+					}, nil
+				}
+
+				return &sqs.ReceiveMessageOutput{Messages: []sqstypes.Message{}}, nil
+			})
 		sqsSvs.EXPECT().DeleteMessage(gomock.Any(), &sqs.DeleteMessageInput{QueueUrl: aws.String("aws-cluster-1-url"), ReceiptHandle: aws.String("message-receipt-handle")}).AnyTimes().
 			Return(nil, nil)
+		sqsSvs.EXPECT().DeleteMessage(gomock.Any(), &sqs.DeleteMessageInput{QueueUrl: aws.String("aws-cluster-3-url"), ReceiptHandle: aws.String("interruption-message-receipt-handle")}).AnyTimes().
+			Return(nil, nil)
 
 		g.Expect(testEnv.Manager.GetFieldIndexer().IndexField(context.Background(), &infrav1.AWSMachine{},
 			controllers.InstanceIDIndex,
@@ -168,9 +195,189 @@ func TestAWSInstanceStateController(t *testing.T) {
 			val := labels[Ec2InstanceStateLabelKey]
 			return val == "shutting-down"
 		}, 10*time.Second).Should(BeTrue(), "Eventually failed ensuring machine is labelled with correct instance state")
+
+		machine2 := &infrav1.AWSMachine{
+			Spec: infrav1.AWSMachineSpec{
+				InstanceID:   ptr.To[string]("i-interrupted-instance-1"),
+				InstanceType: "test",
+			},
+			ObjectMeta: interruptedMachineMeta,
+		}
+		persistObject(g, machine2)
+
+		t.Log("Ensuring interrupted machine is marked with the interruption condition")
+		g.Eventually(func() bool {
+			m := &infrav1.AWSMachine{}
+			key := types.NamespacedName{
+				Namespace: interruptedMachineMeta.Namespace,
+				Name:      interruptedMachineMeta.Name,
+			}
+			g.Expect(k8sClient.Get(context.TODO(), key, m)).NotTo(HaveOccurred())
+			return v1beta1conditions.IsTrue(m, infrav1.InstanceInterruptedCondition) &&
+				v1beta1conditions.GetReason(m, infrav1.InstanceInterruptedCondition) == infrav1.SpotInterruptionWarningReason
+		}, 10*time.Second).Should(BeTrue(), "Eventually failed ensuring interrupted machine is marked with the interruption condition")
+	})
+
+	t.Run("should annotate the machines of instances affected by a scheduled event and replace workers", func(t *testing.T) {
+		g := NewWithT(t)
+
+		reconciler := &AwsInstanceStateReconciler{
+			Client:                     testEnv.Client,
+			Log:                        ctrl.Log.WithName("controllers").WithName("AWSInstanceState"),
+			ReplaceInterruptedMachines: true,
+		}
+
+		workerMachine := createMachine(g, "scheduled-worker", "i-scheduled-instance-1", false)
+		controlPlaneMachine := createMachine(g, "scheduled-control-plane", "i-scheduled-instance-2", true)
+		for _, instanceID := range []string{"i-scheduled-instance-1", "i-scheduled-instance-2"} {
+			g.Eventually(func() bool {
+				return reconciler.getAWSMachine(ctx, instanceID) != nil
+			}, 10*time.Second).Should(BeTrue(), "Eventually failed listing the AWSMachine of instance %s", instanceID)
+		}
+
+		t.Log("Ensuring closed scheduled events are ignored")
+		reconciler.processMessage(ctx, scheduledEventMessage("closed", "i-scheduled-instance-1", "i-scheduled-instance-2"))
+		for _, name := range []string{"scheduled-worker", "scheduled-control-plane"} {
+			m := &infrav1.AWSMachine{}
+			g.Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, m)).To(Succeed())
+			g.Expect(v1beta1conditions.Has(m, infrav1.InstanceInterruptedCondition)).To(BeFalse())
+		}
+
+		t.Log("Ensuring every instance of the scheduled event is marked")
+		reconciler.processMessage(ctx, scheduledEventMessage("upcoming", "i-scheduled-instance-1", "i-scheduled-instance-2"))
+		for _, name := range []string{"scheduled-worker", "scheduled-control-plane"} {
+			g.Eventually(func() bool {
+				m := &infrav1.AWSMachine{}
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, m)).To(Succeed())
+				return v1beta1conditions.IsTrue(m, infrav1.InstanceInterruptedCondition) &&
+					v1beta1conditions.GetReason(m, infrav1.InstanceInterruptedCondition) == infrav1.ScheduledEventReason &&
+					m.Annotations[infrav1.InstanceInterruptionEndAnnotation] == "2026-03-01T12:00:00Z"
+			}, 10*time.Second).Should(BeTrue(), "Eventually failed ensuring AWSMachine %s is marked with the interruption condition", name)
+		}
+
+		t.Log("Ensuring the control plane machine is annotated and kept")
+		g.Eventually(func() bool {
+			m := &clusterv1.Machine{}
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(controlPlaneMachine), m); err != nil {
+				return false
+			}
+			return m.Annotations[InstanceInterruptionAnnotationKey] == infrav1.ScheduledEventReason && m.DeletionTimestamp.IsZero()
+		}, 10*time.Second).Should(BeTrue(), "Eventually failed ensuring the control plane machine is annotated")
+
+		t.Log("Ensuring the worker machine is deleted to be replaced")
+		g.Eventually(func() bool {
+			m := &clusterv1.Machine{}
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(workerMachine), m)
+			return apierrors.IsNotFound(err) || (err == nil && !m.DeletionTimestamp.IsZero())
+		}, 10*time.Second).Should(BeTrue(), "Eventually failed ensuring the worker machine is deleted")
 	})
 }
 
+func TestIsReplaceable(t *testing.T) {
+	machineSetOwner := metav1.OwnerReference{
+		APIVersion: clusterv1.GroupVersion.String(),
+		Kind:       "MachineSet",
+		Name:       "machine-set",
+		Controller: ptr.To(true),
+	}
+
+	testCases := []struct {
+		name    string
+		machine *clusterv1.Machine
+		want    bool
+	}{
+		{
+			name: "worker controlled by a MachineSet",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{machineSetOwner}},
+			},
+			want: true,
+		},
+		{
+			name: "control plane machine",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:          map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+					OwnerReferences: []metav1.OwnerReference{machineSetOwner},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "machine not owned by a MachineSet",
+			machine: &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "infrastructure.cluster.x-k8s.io/v1beta2",
+					Kind:       "AWSMachinePool",
+					Name:       "machine-pool",
+					Controller: ptr.To(true),
+				}}},
+			},
+			want: false,
+		},
+		{
+			name:    "machine without owner",
+			machine: &clusterv1.Machine{},
+			want:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(isReplaceable(tc.machine)).To(Equal(tc.want))
+		})
+	}
+}
+
+func TestMessageDetailEndTime(t *testing.T) {
+	defaultTime := time.Date(2026, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name   string
+		detail messageDetail
+		want   time.Time
+	}{
+		{
+			name:   "end of the event",
+			detail: messageDetail{StartTime: "Sun, 01 Mar 2026 11:00:00 GMT", EndTime: "Sun, 01 Mar 2026 12:00:00 GMT"},
+			want:   time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "start of an event without end",
+			detail: messageDetail{StartTime: "Sun, 01 Mar 2026 11:00:00 GMT"},
+			want:   time.Date(2026, time.March, 1, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "default without times",
+			detail: messageDetail{},
+			want:   defaultTime,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tc.detail.endTime(defaultTime).Equal(tc.want)).To(BeTrue())
+		})
+	}
+}
+
+func scheduledEventMessage(statusCode string, instanceIDs ...string) message {
+	return message{
+		Source:     "aws.health",
+		DetailType: instancestate.HealthEvent,
+		Time:       "2026-03-01T10:00:00Z",
+		Resources:  instanceIDs,
+		MessageDetail: &messageDetail{
+			EventTypeCode: "AWS_EC2_INSTANCE_REBOOT_MAINTENANCE_SCHEDULED",
+			StatusCode:    statusCode,
+			StartTime:     "Sun, 01 Mar 2026 11:00:00 GMT",
+			EndTime:       "Sun, 01 Mar 2026 12:00:00 GMT",
+		},
+	}
+}
+
 const messageBodyJSON = `{
 	"source": "aws.ec2",
 	"detail-type": "EC2 Instance State-change Notification",
@@ -179,3 +386,12 @@ const messageBodyJSON = `{
 		"state": "shutting-down"
 	}
 }`
+
+const spotInterruptionMessageBodyJSON = `{
+	"source": "aws.ec2",
+	"detail-type": "EC2 Spot Instance Interruption Warning",
+	"detail": {
+		"instance-id": "i-interrupted-instance-1",
+		"instance-action": "terminate"
+	}
+}`
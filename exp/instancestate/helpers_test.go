@@ -27,9 +27,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
 
 func createAWSCluster(name string) *infrav1.AWSCluster {
@@ -68,3 +70,55 @@ func deleteAWSCluster(g *WithT, name string) {
 	}
 	g.Expect(k8sClient.Delete(ctx, awsCluster)).To(Succeed())
 }
+
+// createMachine persists a Machine controlled by a MachineSet and the AWSMachine of the given instance it owns.
+func createMachine(g *WithT, name, instanceID string, controlPlane bool) *clusterv1.Machine {
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineSet",
+				Name:       "test-machine-set",
+				UID:        "test-machine-set-uid",
+				Controller: ptr.To(true),
+			}},
+		},
+		Spec: clusterv1.MachineSpec{
+			ClusterName: "test-cluster",
+			Bootstrap: clusterv1.Bootstrap{
+				DataSecretName: ptr.To("bootstrap-data"),
+			},
+			InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: infrav1.GroupVersion.Group,
+				Kind:     "AWSMachine",
+				Name:     name,
+			},
+		},
+	}
+	if controlPlane {
+		machine.Labels[clusterv1.MachineControlPlaneLabel] = ""
+	}
+	persistObject(g, machine)
+
+	persistObject(g, &infrav1.AWSMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "Machine",
+				Name:       machine.Name,
+				UID:        machine.UID,
+			}},
+		},
+		Spec: infrav1.AWSMachineSpec{
+			InstanceID:   ptr.To(instanceID),
+			InstanceType: "test",
+		},
+	})
+
+	return machine
+}
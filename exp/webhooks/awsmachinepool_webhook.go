@@ -85,6 +85,10 @@ func (w *AWSMachinePool) validateRootVolume(r *expinfrav1.AWSMachinePool) field.
 		}
 	}
 
+	if r.Spec.AWSLaunchTemplate.RootVolume.SnapshotID != "" {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.awsLaunchTemplate.rootVolume.snapshotId"), "root volume is created from the snapshot of the AMI and cannot set a snapshot"))
+	}
+
 	if r.Spec.AWSLaunchTemplate.RootVolume.DeviceName != "" {
 		log.Info("root volume shouldn't have a device name (this can be ignored if performing a `clusterctl move`)")
 	}
@@ -112,6 +116,10 @@ func (w *AWSMachinePool) validateNonRootVolumes(r *expinfrav1.AWSMachinePool) fi
 		if volume.DeviceName == "" {
 			allErrs = append(allErrs, field.Required(field.NewPath("spec.template.spec.nonRootVolumes.deviceName"), "non root volume should have device name"))
 		}
+
+		if volume.VolumeInitializationRate != nil && volume.SnapshotID == "" {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec.template.spec.nonRootVolumes.snapshotId"), "snapshotId required if volumeInitializationRate is set"))
+		}
 	}
 
 	return allErrs
@@ -192,6 +200,165 @@ func (w *AWSMachinePool) validateIgnition(r *expinfrav1.AWSMachinePool) field.Er
 	return allErrs
 }
 
+func (w *AWSMachinePool) validateWarmPool(r *expinfrav1.AWSMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+	warmPool := r.Spec.WarmPool
+	if warmPool == nil {
+		return allErrs
+	}
+
+	if r.Spec.MixedInstancesPolicy != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.warmPool"), "warm pools are not supported with spec.mixedInstancesPolicy"))
+	}
+	if r.Spec.AWSLaunchTemplate.SpotMarketOptions != nil || r.Spec.AWSLaunchTemplate.MarketType == infrav1.MarketTypeSpot {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.warmPool"), "warm pools are not supported with spot instances"))
+	}
+	// Instances run the bootstrap data when they are launched into the warm pool, so running ones would be
+	// ready nodes of the cluster while not being part of the group.
+	if warmPool.PoolState == expinfrav1.WarmPoolStateRunning {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("spec.warmPool.poolState"), warmPool.PoolState,
+			[]expinfrav1.WarmPoolState{expinfrav1.WarmPoolStateStopped, expinfrav1.WarmPoolStateHibernated}))
+	}
+	if warmPool.MinSize != nil && warmPool.MaxGroupPreparedCapacity != nil && *warmPool.MinSize > *warmPool.MaxGroupPreparedCapacity {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.warmPool.minSize"), *warmPool.MinSize, "minSize must be less than or equal to maxGroupPreparedCapacity"))
+	}
+	if warmPool.MaxGroupPreparedCapacity != nil && *warmPool.MaxGroupPreparedCapacity < r.Spec.MinSize {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.warmPool.maxGroupPreparedCapacity"), *warmPool.MaxGroupPreparedCapacity, "maxGroupPreparedCapacity must be greater than or equal to spec.minSize"))
+	}
+
+	return allErrs
+}
+
+func (w *AWSMachinePool) validateScheduledActions(r *expinfrav1.AWSMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, action := range r.Spec.ScheduledActions {
+		fldPath := field.NewPath("spec.scheduledActions").Index(i)
+		if action.MinSize == nil && action.MaxSize == nil && action.DesiredCapacity == nil {
+			allErrs = append(allErrs, field.Required(fldPath, "at least one of minSize, maxSize or desiredCapacity must be set"))
+		}
+		if action.MinSize != nil && action.MaxSize != nil && *action.MinSize > *action.MaxSize {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minSize"), *action.MinSize, "minSize must be less than or equal to maxSize"))
+		}
+		if action.DesiredCapacity != nil {
+			if action.MinSize != nil && *action.DesiredCapacity < *action.MinSize {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("desiredCapacity"), *action.DesiredCapacity, "desiredCapacity must be greater than or equal to minSize"))
+			}
+			if action.MaxSize != nil && *action.DesiredCapacity > *action.MaxSize {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("desiredCapacity"), *action.DesiredCapacity, "desiredCapacity must be less than or equal to maxSize"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func (w *AWSMachinePool) validateScalingPolicies(r *expinfrav1.AWSMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, policy := range r.Spec.ScalingPolicies {
+		fldPath := field.NewPath("spec.scalingPolicies").Index(i)
+		if tt := policy.TargetTracking; tt != nil {
+			allErrs = append(allErrs, validateScalingPolicyResourceLabel(tt.ResourceLabel, tt.PredefinedMetricType == expinfrav1.TargetTrackingMetricTypeALBRequestCountPerTarget, fldPath.Child("targetTracking", "resourceLabel"))...)
+		}
+		if p := policy.Predictive; p != nil {
+			allErrs = append(allErrs, validateScalingPolicyResourceLabel(p.ResourceLabel, p.PredefinedMetricType == expinfrav1.PredictiveScalingMetricTypeALBRequestCount, fldPath.Child("predictive", "resourceLabel"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateScalingPolicyResourceLabel(resourceLabel string, albMetric bool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if albMetric && resourceLabel == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "resourceLabel is required for Application Load Balancer metrics"))
+	}
+	if !albMetric && resourceLabel != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "resourceLabel is only supported for Application Load Balancer metrics"))
+	}
+	return allErrs
+}
+
+func (w *AWSMachinePool) validateStrategy(r *expinfrav1.AWSMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+	strategy := r.Spec.Strategy
+	if strategy == nil {
+		return allErrs
+	}
+
+	if strategy.Type != expinfrav1.AWSMachinePoolStrategyTypeBlueGreen {
+		if strategy.BlueGreen != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "strategy", "blueGreen"), "can be set only if spec.strategy.type is BlueGreen"))
+		}
+		return allErrs
+	}
+
+	// Nodes are drained through MachinePool Machines.
+	if !feature.Gates.Enabled(feature.MachinePoolMachines) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "strategy", "type"),
+			"BlueGreen can be used only if the MachinePoolMachines feature gate is enabled"))
+	}
+	if r.Spec.WarmPool != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "strategy", "type"), "BlueGreen is not supported with spec.warmPool"))
+	}
+
+	return allErrs
+}
+
+func (w *AWSMachinePool) validateInstanceRequirements(r *expinfrav1.AWSMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.AWSLaunchTemplate.InstanceRequirements != nil {
+		if r.Spec.AWSLaunchTemplate.InstanceType != "" {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.awsLaunchTemplate.instanceRequirements"), "either spec.awsLaunchTemplate.instanceType or spec.awsLaunchTemplate.instanceRequirements should be used"))
+		}
+		if r.Spec.MixedInstancesPolicy == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec.mixedInstancesPolicy"), "mixedInstancesPolicy is required when spec.awsLaunchTemplate.instanceRequirements is set"))
+		}
+		allErrs = append(allErrs, validateInstanceRequirementsRanges(r.Spec.AWSLaunchTemplate.InstanceRequirements, field.NewPath("spec.awsLaunchTemplate.instanceRequirements"))...)
+	}
+
+	if r.Spec.MixedInstancesPolicy == nil {
+		return allErrs
+	}
+
+	var withInstanceType, withInstanceRequirements bool
+	for i, override := range r.Spec.MixedInstancesPolicy.Overrides {
+		if override.InstanceRequirements == nil {
+			withInstanceType = true
+			continue
+		}
+		withInstanceRequirements = true
+		allErrs = append(allErrs, validateInstanceRequirementsRanges(override.InstanceRequirements, field.NewPath("spec.mixedInstancesPolicy.overrides").Index(i).Child("instanceRequirements"))...)
+	}
+	if withInstanceType && withInstanceRequirements {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.mixedInstancesPolicy.overrides"), "overrides should either all set instanceType or all set instanceRequirements"))
+	}
+
+	return allErrs
+}
+
+func validateInstanceRequirementsRanges(requirements *expinfrav1.InstanceRequirements, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	ranges := []struct {
+		name string
+		rng  *expinfrav1.InstanceRequirementsRange
+	}{
+		{"vCpuCount", &requirements.VCPUCount},
+		{"memoryMiB", &requirements.MemoryMiB},
+		{"acceleratorCount", requirements.AcceleratorCount},
+	}
+	for _, r := range ranges {
+		if r.rng != nil && r.rng.Max != nil && *r.rng.Max < r.rng.Min {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(r.name, "max"), *r.rng.Max, "max must be greater than or equal to min"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateCreate will do any extra validation when creating a AWSMachinePool.
 func (w *AWSMachinePool) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
 	r, ok := obj.(*expinfrav1.AWSMachinePool)
@@ -210,7 +377,12 @@ func (w *AWSMachinePool) ValidateCreate(_ context.Context, obj runtime.Object) (
 	allErrs = append(allErrs, w.validateSubnets(r)...)
 	allErrs = append(allErrs, w.validateAdditionalSecurityGroups(r)...)
 	allErrs = append(allErrs, w.validateSpotInstances(r)...)
+	allErrs = append(allErrs, w.validateInstanceRequirements(r)...)
+	allErrs = append(allErrs, w.validateWarmPool(r)...)
+	allErrs = append(allErrs, w.validateScheduledActions(r)...)
+	allErrs = append(allErrs, w.validateScalingPolicies(r)...)
 	allErrs = append(allErrs, w.validateRefreshPreferences(r)...)
+	allErrs = append(allErrs, w.validateStrategy(r)...)
 	allErrs = append(allErrs, w.validateInstanceMarketType(r)...)
 	allErrs = append(allErrs, w.validateCapacityReservation(r)...)
 	allErrs = append(allErrs, w.validateLifecycleHooks(r)...)
@@ -279,7 +451,12 @@ func (w *AWSMachinePool) ValidateUpdate(_ context.Context, oldObj, newObj runtim
 	allErrs = append(allErrs, w.validateSubnets(r)...)
 	allErrs = append(allErrs, w.validateAdditionalSecurityGroups(r)...)
 	allErrs = append(allErrs, w.validateSpotInstances(r)...)
+	allErrs = append(allErrs, w.validateInstanceRequirements(r)...)
+	allErrs = append(allErrs, w.validateWarmPool(r)...)
+	allErrs = append(allErrs, w.validateScheduledActions(r)...)
+	allErrs = append(allErrs, w.validateScalingPolicies(r)...)
 	allErrs = append(allErrs, w.validateRefreshPreferences(r)...)
+	allErrs = append(allErrs, w.validateStrategy(r)...)
 	allErrs = append(allErrs, w.validateLifecycleHooks(r)...)
 
 	if len(allErrs) == 0 {
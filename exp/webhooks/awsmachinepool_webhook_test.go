@@ -25,10 +25,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
 	utildefaulting "sigs.k8s.io/cluster-api-provider-aws/v2/util/defaulting"
 )
 
@@ -142,6 +144,37 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErrToContain: nil,
 		},
+		{
+			name: "Should fail if root volume sets a snapshot",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+						RootVolume: &infrav1.Volume{
+							Size:       *aws.Int64(8),
+							SnapshotID: "snap-0123456789abcdef0",
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("snapshotId"),
+		},
+		{
+			name: "Should fail if non root volume sets an initialization rate without a snapshot",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+						NonRootVolumes: []infrav1.Volume{
+							{
+								DeviceName:               "/dev/sdb",
+								Size:                     *aws.Int64(100),
+								VolumeInitializationRate: aws.Int64(200),
+							},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("snapshotId"),
+		},
 		{
 			name: "Should fail if both spot market options or mixed instances policy are set",
 			pool: &expinfrav1.AWSMachinePool{
@@ -156,6 +189,269 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErrToContain: ptr.To[string]("spotMarketOptions"),
 		},
+		{
+			name: "Should fail if both instance type and instance requirements are set",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{},
+					AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+						InstanceType: "t3.medium",
+						InstanceRequirements: &expinfrav1.InstanceRequirements{
+							VCPUCount: expinfrav1.InstanceRequirementsRange{Min: 2},
+							MemoryMiB: expinfrav1.InstanceRequirementsRange{Min: 4096},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.awsLaunchTemplate.instanceRequirements: Forbidden"),
+		},
+		{
+			name: "Should fail if instance requirements are set without a mixed instances policy",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+						InstanceRequirements: &expinfrav1.InstanceRequirements{
+							VCPUCount: expinfrav1.InstanceRequirementsRange{Min: 2},
+							MemoryMiB: expinfrav1.InstanceRequirementsRange{Min: 4096},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.mixedInstancesPolicy"),
+		},
+		{
+			name: "Should fail if a warm pool is set with a mixed instances policy",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
+						Overrides: []expinfrav1.Overrides{{InstanceType: "t3.medium"}},
+					},
+					WarmPool: &expinfrav1.WarmPool{},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.warmPool"),
+		},
+		{
+			name: "Should fail if a warm pool is set with spot instances",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+						SpotMarketOptions: &infrav1.SpotMarketOptions{},
+					},
+					WarmPool: &expinfrav1.WarmPool{},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.warmPool"),
+		},
+		{
+			name: "Should fail if the warm pool min size is greater than its max group prepared capacity",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					WarmPool: &expinfrav1.WarmPool{
+						MinSize:                  aws.Int32(5),
+						MaxGroupPreparedCapacity: aws.Int32(3),
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.warmPool.minSize"),
+		},
+		{
+			name: "Should fail if the warm pool instances are kept running",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					WarmPool: &expinfrav1.WarmPool{
+						PoolState: expinfrav1.WarmPoolStateRunning,
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.warmPool.poolState"),
+		},
+		{
+			name: "Should accept a warm pool",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					MinSize: 1,
+					WarmPool: &expinfrav1.WarmPool{
+						MinSize:                  aws.Int32(1),
+						MaxGroupPreparedCapacity: aws.Int32(5),
+						PoolState:                expinfrav1.WarmPoolStateHibernated,
+						ReuseOnScaleIn:           true,
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if a scheduled action sets no size",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					ScheduledActions: []expinfrav1.ScheduledAction{
+						{Name: "scale-up", Recurrence: "0 8 * * 1-5"},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scheduledActions[0]"),
+		},
+		{
+			name: "Should fail if a scheduled action desired capacity is above its max size",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					ScheduledActions: []expinfrav1.ScheduledAction{
+						{Name: "scale-up", Recurrence: "0 8 * * 1-5", MaxSize: aws.Int32(5), DesiredCapacity: aws.Int32(10)},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scheduledActions[0].desiredCapacity"),
+		},
+		{
+			name: "Should accept scheduled actions",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					ScheduledActions: []expinfrav1.ScheduledAction{
+						{Name: "scale-up", Recurrence: "0 8 * * 1-5", TimeZone: "Europe/Berlin", MinSize: aws.Int32(3), MaxSize: aws.Int32(10), DesiredCapacity: aws.Int32(5)},
+						{Name: "scale-down", Recurrence: "0 20 * * 1-5", TimeZone: "Europe/Berlin", MinSize: aws.Int32(1)},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if an ALB request count scaling policy has no resource label",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					ScalingPolicies: []expinfrav1.ScalingPolicy{
+						{
+							Name:       "requests",
+							PolicyType: expinfrav1.ScalingPolicyTypeTargetTracking,
+							TargetTracking: &expinfrav1.TargetTrackingConfiguration{
+								PredefinedMetricType: expinfrav1.TargetTrackingMetricTypeALBRequestCountPerTarget,
+								TargetValue:          1000,
+							},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scalingPolicies[0].targetTracking.resourceLabel"),
+		},
+		{
+			name: "Should fail if a CPU scaling policy has a resource label",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					ScalingPolicies: []expinfrav1.ScalingPolicy{
+						{
+							Name:       "cpu",
+							PolicyType: expinfrav1.ScalingPolicyTypePredictive,
+							Predictive: &expinfrav1.PredictiveScalingConfiguration{
+								PredefinedMetricType: expinfrav1.PredictiveScalingMetricTypeCPUUtilization,
+								ResourceLabel:        "app/my-alb/1234567890abcdef/targetgroup/my-tg/1234567890abcdef",
+								TargetValue:          50,
+							},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scalingPolicies[0].predictive.resourceLabel"),
+		},
+		{
+			name: "Should accept scaling policies",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					ScalingPolicies: []expinfrav1.ScalingPolicy{
+						{
+							Name:       "cpu",
+							PolicyType: expinfrav1.ScalingPolicyTypeTargetTracking,
+							TargetTracking: &expinfrav1.TargetTrackingConfiguration{
+								PredefinedMetricType: expinfrav1.TargetTrackingMetricTypeCPUUtilization,
+								TargetValue:          60,
+							},
+						},
+						{
+							Name:       "requests-forecast",
+							PolicyType: expinfrav1.ScalingPolicyTypePredictive,
+							Predictive: &expinfrav1.PredictiveScalingConfiguration{
+								PredefinedMetricType: expinfrav1.PredictiveScalingMetricTypeALBRequestCount,
+								ResourceLabel:        "app/my-alb/1234567890abcdef/targetgroup/my-tg/1234567890abcdef",
+								TargetValue:          1000,
+								Mode:                 expinfrav1.PredictiveScalingModeForecastAndScale,
+							},
+						},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if the BlueGreen strategy is used without the MachinePoolMachines feature gate",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					Strategy: &expinfrav1.AWSMachinePoolStrategy{
+						Type: expinfrav1.AWSMachinePoolStrategyTypeBlueGreen,
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.strategy.type"),
+		},
+		{
+			name: "Should fail if the BlueGreen strategy is configured for the InstanceRefresh strategy",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					Strategy: &expinfrav1.AWSMachinePoolStrategy{
+						Type: expinfrav1.AWSMachinePoolStrategyTypeInstanceRefresh,
+						BlueGreen: &expinfrav1.BlueGreenStrategy{
+							ScaleDownBatchSize: aws.Int32(2),
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.strategy.blueGreen"),
+		},
+		{
+			name: "Should fail if an instance requirements range has a max lower than its min",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+						InstanceRequirements: &expinfrav1.InstanceRequirements{
+							VCPUCount: expinfrav1.InstanceRequirementsRange{Min: 4, Max: aws.Int32(2)},
+							MemoryMiB: expinfrav1.InstanceRequirementsRange{Min: 4096},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("vCpuCount.max"),
+		},
+		{
+			name: "Should fail if overrides mix instance types and instance requirements",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
+						Overrides: []expinfrav1.Overrides{
+							{InstanceType: "t3.medium"},
+							{InstanceRequirements: &expinfrav1.InstanceRequirements{
+								VCPUCount: expinfrav1.InstanceRequirementsRange{Min: 2},
+								MemoryMiB: expinfrav1.InstanceRequirementsRange{Min: 4096},
+							}},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.mixedInstancesPolicy.overrides"),
+		},
+		{
+			name: "Should accept instance requirements",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{},
+					AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+						InstanceRequirements: &expinfrav1.InstanceRequirements{
+							VCPUCount:        expinfrav1.InstanceRequirementsRange{Min: 2, Max: aws.Int32(8)},
+							MemoryMiB:        expinfrav1.InstanceRequirementsRange{Min: 4096},
+							CPUManufacturers: []expinfrav1.CPUManufacturer{expinfrav1.CPUManufacturerIntel, expinfrav1.CPUManufacturerAMD},
+						},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
 		{
 			name: "Should fail if MaxHealthyPercentage is set, but MinHealthyPercentage is not set",
 			pool: &expinfrav1.AWSMachinePool{
@@ -381,6 +677,56 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 	}
 }
 
+func TestAWSMachinePoolValidateCreateBlueGreen(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePoolMachines, true)
+
+	tests := []struct {
+		name             string
+		pool             *expinfrav1.AWSMachinePool
+		wantErrToContain *string
+	}{
+		{
+			name: "Should accept the BlueGreen strategy",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					Strategy: &expinfrav1.AWSMachinePoolStrategy{
+						Type: expinfrav1.AWSMachinePoolStrategyTypeBlueGreen,
+						BlueGreen: &expinfrav1.BlueGreenStrategy{
+							ScaleDownBatchSize: aws.Int32(2),
+							NodeReadyTimeout:   &metav1.Duration{Duration: 15 * time.Minute},
+						},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if the BlueGreen strategy is used with a warm pool",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					Strategy: &expinfrav1.AWSMachinePoolStrategy{
+						Type: expinfrav1.AWSMachinePoolStrategyTypeBlueGreen,
+					},
+					WarmPool: &expinfrav1.WarmPool{},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.strategy.type"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := (&AWSMachinePool{}).ValidateCreate(context.Background(), tt.pool)
+			if tt.wantErrToContain != nil {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(*tt.wantErrToContain))
+			} else {
+				g.Expect(err).To(Succeed())
+			}
+		})
+	}
+}
+
 func TestAWSMachinePoolValidateUpdate(t *testing.T) {
 	g := NewWithT(t)
 
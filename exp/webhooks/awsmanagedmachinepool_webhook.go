@@ -142,6 +142,10 @@ func (w *AWSManagedMachinePool) validateLaunchTemplate(r *expinfrav1.AWSManagedM
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "AWSLaunchTemplate", "IamInstanceProfile"), r.Spec.AWSLaunchTemplate.IamInstanceProfile, "IAM instance profile in launch template is prohibited in EKS managed node group"))
 	}
 
+	if r.Spec.AWSLaunchTemplate.InstanceRequirements != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "AWSLaunchTemplate", "InstanceRequirements"), "instance requirements are not supported by EKS managed node groups"))
+	}
+
 	return allErrs
 }
 
@@ -88,6 +88,10 @@ const (
 	// alpha: v2.0
 	TagUnmanagedNetworkResources featuregate.Feature = "TagUnmanagedNetworkResources"
 
+	// UnmanagedVPCFlowLogs is used to enable the reconciliation of flow logs for unmanaged VPCs.
+	// alpha: v2.9
+	UnmanagedVPCFlowLogs featuregate.Feature = "UnmanagedVPCFlowLogs"
+
 	// ROSA is used to enable ROSA support
 	// owner: @enxebre
 	// alpha: v2.2
@@ -114,5 +118,6 @@ var defaultCAPAFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	ExternalResourceGC:            {Default: true, PreRelease: featuregate.Beta},
 	AlternativeGCStrategy:         {Default: false, PreRelease: featuregate.Beta},
 	TagUnmanagedNetworkResources:  {Default: true, PreRelease: featuregate.Alpha},
+	UnmanagedVPCFlowLogs:          {Default: false, PreRelease: featuregate.Alpha},
 	ROSA:                          {Default: false, PreRelease: featuregate.Alpha},
 }
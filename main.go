@@ -30,6 +30,7 @@ import (
 
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	cgscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	cgrecord "k8s.io/client-go/tools/record"
@@ -65,6 +66,7 @@ import (
 	expwebhooks "sigs.k8s.io/cluster-api-provider-aws/v2/exp/webhooks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
+	awsmetrics "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/metrics"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/version"
@@ -106,6 +108,7 @@ var (
 	profilerAddress             string
 	awsClusterConcurrency       int
 	instanceStateConcurrency    int
+	replaceInterruptedMachines  bool
 	awsMachineConcurrency       int
 	waitInfraPeriod             time.Duration
 	maxWaitActiveUpdateDelete   time.Duration
@@ -116,6 +119,10 @@ var (
 	serviceEndpoints            string
 	disabledControllers         []string
 
+	permissionRecordingConfigMap string
+	permissionRecordingFile      string
+	permissionRecordingInterval  time.Duration
+
 	// maxEKSSyncPeriod is the maximum allowed duration for the sync-period flag when using EKS. It is set to 10 minutes
 	// because during resync it will create a new AWS auth token which can a maximum life of 15 minutes and this ensures
 	// the token (and kubeconfig secret) is refreshed before token expiration.
@@ -239,6 +246,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	if permissionRecordingConfigMap != "" || permissionRecordingFile != "" {
+		if err := setupPermissionRecording(mgr); err != nil {
+			setupLog.Error(err, "unable to set up AWS permission recording")
+			os.Exit(1)
+		}
+	}
+
 	setupReconcilersAndWebhooks(ctx, mgr, externalResourceGC, alternativeGCStrategy)
 	if feature.Gates.Enabled(feature.EKS) {
 		setupEKSReconcilersAndWebhooks(ctx, mgr, externalResourceGC, alternativeGCStrategy, waitInfraPeriod)
@@ -360,6 +374,7 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 			ExternalResourceGC:           externalResourceGC,
 			AlternativeGCStrategy:        alternativeGCStrategy,
 			TagUnmanagedNetworkResources: feature.Gates.Enabled(feature.TagUnmanagedNetworkResources),
+			UnmanagedVPCFlowLogs:         feature.Gates.Enabled(feature.UnmanagedVPCFlowLogs),
 			MaxWaitActiveUpdateDelete:    maxWaitActiveUpdateDelete,
 		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsClusterConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "AWSCluster")
@@ -401,9 +416,10 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
 		setupLog.Info("EventBridge notifications enabled. enabling AWSInstanceStateController")
 		if err := (&instancestate.AwsInstanceStateReconciler{
-			Client:           mgr.GetClient(),
-			Log:              ctrl.Log.WithName("controllers").WithName("AWSInstanceStateController"),
-			WatchFilterValue: watchFilterValue,
+			Client:                     mgr.GetClient(),
+			Log:                        ctrl.Log.WithName("controllers").WithName("AWSInstanceStateController"),
+			WatchFilterValue:           watchFilterValue,
+			ReplaceInterruptedMachines: replaceInterruptedMachines,
 		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: instanceStateConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "AWSInstanceStateController")
 			os.Exit(1)
@@ -446,6 +462,14 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 		setupLog.Error(err, "unable to create webhook", "webhook", "AWSClusterStaticIdentity")
 		os.Exit(1)
 	}
+	if err := (&capawebhooks.AWSClusterWebIdentity{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AWSClusterWebIdentity")
+		os.Exit(1)
+	}
+	if err := (&capawebhooks.AWSClusterRolesAnywhereIdentity{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AWSClusterRolesAnywhereIdentity")
+		os.Exit(1)
+	}
 	if err := (&capawebhooks.AWSMachine{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "AWSMachine")
 		os.Exit(1)
@@ -482,6 +506,7 @@ func setupEKSReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 		WaitInfraPeriod:              waitInfraPeriod,
 		MaxWaitActiveUpdateDelete:    maxWaitActiveUpdateDelete,
 		TagUnmanagedNetworkResources: feature.Gates.Enabled(feature.TagUnmanagedNetworkResources),
+		UnmanagedVPCFlowLogs:         feature.Gates.Enabled(feature.UnmanagedVPCFlowLogs),
 	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsClusterConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AWSManagedControlPlane")
 		os.Exit(1)
@@ -504,6 +529,14 @@ func setupEKSReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 		os.Exit(1)
 	}
 
+	if err := (&eksbootstrapcontrollers.BottlerocketConfigReconciler{
+		Client:           mgr.GetClient(),
+		WatchFilterValue: watchFilterValue,
+	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsClusterConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BottlerocketConfig")
+		os.Exit(1)
+	}
+
 	setupLog.Debug("enabling EKS managed cluster controller")
 	if err := (&controllers.AWSManagedClusterReconciler{
 		Client:           mgr.GetClient(),
@@ -563,6 +596,29 @@ func setupEKSReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 	}
 }
 
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;update
+
+func setupPermissionRecording(mgr ctrl.Manager) error {
+	writer := &awsmetrics.PermissionRecordingWriter{
+		Recorder: awsmetrics.NewPermissionRecorder(),
+		Client:   mgr.GetClient(),
+		Reader:   mgr.GetAPIReader(),
+		Path:     permissionRecordingFile,
+		Interval: permissionRecordingInterval,
+	}
+	if permissionRecordingConfigMap != "" {
+		namespace, name, ok := strings.Cut(permissionRecordingConfigMap, "/")
+		if !ok || namespace == "" || name == "" {
+			return fmt.Errorf("invalid ConfigMap %q, expected <namespace>/<name>", permissionRecordingConfigMap)
+		}
+		writer.ConfigMap = types.NamespacedName{Namespace: namespace, Name: name}
+	}
+
+	setupLog.Info("Recording the AWS permissions used by the controllers", "configmap", permissionRecordingConfigMap, "file", permissionRecordingFile)
+	awsmetrics.EnablePermissionRecording(writer.Recorder)
+	return mgr.Add(writer)
+}
+
 func initFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(
 		&enableLeaderElection,
@@ -625,6 +681,12 @@ func initFlags(fs *pflag.FlagSet) {
 		"Number of concurrent watches for instance state changes",
 	)
 
+	fs.BoolVar(&replaceInterruptedMachines,
+		"instance-state-replace-interrupted-machines",
+		false,
+		"Delete the Machines owned by a MachineSet when a Spot interruption, rebalance recommendation or scheduled event is notified for their instances, so they are drained and replaced ahead of the interruption. Requires the EventBridgeInstanceState feature gate.",
+	)
+
 	fs.IntVar(&awsMachineConcurrency,
 		"awsmachine-concurrency",
 		10,
@@ -684,6 +746,24 @@ func initFlags(fs *pflag.FlagSet) {
 		fmt.Sprintf("Sets of controllers that should be disabled for this instance of the controller manager in a comma-separated list. Options are: %q", strings.Join(controllers.GetValidNames(), ",")),
 	)
 
+	fs.StringVar(&permissionRecordingConfigMap,
+		"record-aws-permissions-configmap",
+		"",
+		"Record the IAM actions used by the controllers into this ConfigMap, given as <namespace>/<name>. Use 'clusterawsadm bootstrap iam generate-policy --from-recording' to turn the recording into a policy.",
+	)
+
+	fs.StringVar(&permissionRecordingFile,
+		"record-aws-permissions-file",
+		"",
+		"Record the IAM actions used by the controllers into this file.",
+	)
+
+	fs.DurationVar(&permissionRecordingInterval,
+		"record-aws-permissions-interval",
+		time.Minute,
+		"How often the recording of IAM actions used by the controllers is written out.",
+	)
+
 	logs.AddFlags(fs, logs.SkipLoggingConfigurationFlags())
 	v1.AddFlags(logOptions, fs)
 
@@ -31,7 +31,9 @@ const (
 	AssociationIDNotFound             = "InvalidAssociationID.NotFound"
 	AuthFailure                       = "AuthFailure"
 	BucketAlreadyOwnedByYou           = "BucketAlreadyOwnedByYou"
+	DependencyViolation               = "DependencyViolation"
 	EIPNotFound                       = "InvalidElasticIpID.NotFound"
+	FlowLogNotFound                   = "InvalidFlowLogId.NotFound"
 	GatewayNotFound                   = "InvalidGatewayID.NotFound"
 	GroupNotFound                     = "InvalidGroup.NotFound"
 	InternetGatewayNotFound           = "InvalidInternetGatewayID.NotFound"
@@ -47,15 +49,19 @@ const (
 	NATGatewayNotFound                = "InvalidNatGatewayID.NotFound"
 	//nolint:gosec
 	NoCredentialProviders                   = "NoCredentialProviders"
+	NoSuchEntity                            = "NoSuchEntity"
+	NoSuchBucketPolicy                      = "NoSuchBucketPolicy"
 	NoSuchKey                               = "NoSuchKey"
 	PermissionNotFound                      = "InvalidPermission.NotFound"
 	ResourceExists                          = "ResourceExistsException"
 	ResourceNotFound                        = "InvalidResourceID.NotFound"
 	RouteTableNotFound                      = "InvalidRouteTableID.NotFound"
 	SubnetNotFound                          = "InvalidSubnetID.NotFound"
+	TransitGatewayAttachmentNotFound        = "InvalidTransitGatewayAttachmentID.NotFound"
 	UnrecognizedClientException             = "UnrecognizedClientException"
 	UnauthorizedOperation                   = "UnauthorizedOperation"
 	VPCNotFound                             = "InvalidVpcID.NotFound"
+	VPCPeeringConnectionNotFound            = "InvalidVpcPeeringConnectionID.NotFound"
 	VPCMissingParameter                     = "MissingParameter"
 	ErrCodeRepositoryAlreadyExistsException = "RepositoryAlreadyExistsException"
 	ASGNotFound                             = "AutoScalingGroup.NotFound"
@@ -22,13 +22,17 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/gob"
+	"net/http"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -104,6 +108,33 @@ func NewAWSRolePrincipalTypeProvider(identity *infrav1.AWSClusterRoleIdentity, s
 	}
 }
 
+// NewAWSWebIdentityPrincipalTypeProvider will create a new AWSWebIdentityPrincipalTypeProvider from an AWSClusterWebIdentity.
+// The token is read from the identity's token file, unless a token read from the identity's token secret is given.
+func NewAWSWebIdentityPrincipalTypeProvider(identity *infrav1.AWSClusterWebIdentity, token []byte, region string, log logger.Wrapper) *AWSWebIdentityPrincipalTypeProvider {
+	return &AWSWebIdentityPrincipalTypeProvider{
+		credentials: nil,
+		stsClient:   nil,
+		region:      region,
+		Principal:   identity,
+		Token:       token,
+		log:         log.WithName("AWSWebIdentityPrincipalTypeProvider"),
+	}
+}
+
+// NewAWSRolesAnywherePrincipalTypeProvider will create a new AWSRolesAnywherePrincipalTypeProvider from an AWSClusterRolesAnywhereIdentity
+// and the secret containing its certificate and private key.
+func NewAWSRolesAnywherePrincipalTypeProvider(identity *infrav1.AWSClusterRolesAnywhereIdentity, secret *corev1.Secret, region string, log logger.Wrapper) *AWSRolesAnywherePrincipalTypeProvider {
+	return &AWSRolesAnywherePrincipalTypeProvider{
+		Principal:        identity,
+		Certificate:      secret.Data[corev1.TLSCertKey],
+		PrivateKey:       secret.Data[corev1.TLSPrivateKeyKey],
+		CertificateChain: secret.Data["ca.crt"],
+		credentials:      nil,
+		region:           region,
+		log:              log.WithName("AWSRolesAnywherePrincipalTypeProvider"),
+	}
+}
+
 // AWSStaticPrincipalTypeProvider defines the specs for a static AWSPrincipalTypeProvider.
 type AWSStaticPrincipalTypeProvider struct {
 	Principal   *infrav1.AWSClusterStaticIdentity
@@ -182,3 +213,167 @@ func (p *AWSRolePrincipalTypeProvider) Retrieve(ctx context.Context) (aws.Creden
 	}
 	return p.credentials.Retrieve(ctx)
 }
+
+// AWSWebIdentityPrincipalTypeProvider defines the specs for a AWSPrincipalTypeProvider assuming a role with a web identity token.
+type AWSWebIdentityPrincipalTypeProvider struct {
+	Principal *infrav1.AWSClusterWebIdentity
+	// Token is the web identity token read from the token secret, if any.
+	// It is part of the hash so that a rotated token results in a new provider.
+	Token       []byte
+	credentials *aws.CredentialsCache
+	region      string
+	log         logger.Wrapper
+	stsClient   stsservice.STSClient
+}
+
+// Hash returns the byte encoded AWSWebIdentityPrincipalTypeProvider.
+func (p *AWSWebIdentityPrincipalTypeProvider) Hash() (string, error) {
+	var webIdentityValue bytes.Buffer
+	err := gob.NewEncoder(&webIdentityValue).Encode(p)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	return string(hash.Sum(webIdentityValue.Bytes())), nil
+}
+
+// Name returns the name of the AWSWebIdentityPrincipalTypeProvider.
+func (p *AWSWebIdentityPrincipalTypeProvider) Name() string {
+	return p.Principal.Name
+}
+
+// Retrieve returns the credential values for the AWSWebIdentityPrincipalTypeProvider.
+func (p *AWSWebIdentityPrincipalTypeProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	if p.credentials == nil {
+		var stsClient stscreds.AssumeRoleWithWebIdentityAPIClient
+		if p.stsClient != nil {
+			// For testing
+			stsClient = p.stsClient
+		} else {
+			cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(p.region))
+			if err != nil {
+				return aws.Credentials{}, err
+			}
+			stsOpts := sts.WithAPIOptions(
+				awsmetrics.WithMiddlewares("identity provider", p.Principal),
+				awsmetrics.WithCAPAUserAgentMiddleware())
+			stsClient = sts.NewFromConfig(cfg, stsOpts)
+		}
+
+		var tokenRetriever stscreds.IdentityTokenRetriever = stscreds.IdentityTokenFile(p.Principal.Spec.TokenFile)
+		if len(p.Token) > 0 {
+			tokenRetriever = staticIdentityToken(p.Token)
+		}
+
+		credsProvider := stscreds.NewWebIdentityRoleProvider(stsClient, p.Principal.Spec.RoleArn, tokenRetriever, func(o *stscreds.WebIdentityRoleOptions) {
+			o.RoleSessionName = p.Principal.Spec.SessionName
+			if p.Principal.Spec.InlinePolicy != "" {
+				o.Policy = aws.String(p.Principal.Spec.InlinePolicy)
+			}
+			o.PolicyARNs = policyDescriptors(p.Principal.Spec.PolicyARNs)
+			o.Duration = time.Duration(p.Principal.Spec.DurationSeconds) * time.Second
+		})
+		// Update credentials
+		p.credentials = aws.NewCredentialsCache(credsProvider)
+	}
+	return p.credentials.Retrieve(ctx)
+}
+
+// AWSRolesAnywherePrincipalTypeProvider defines the specs for a AWSPrincipalTypeProvider using IAM Roles Anywhere.
+type AWSRolesAnywherePrincipalTypeProvider struct {
+	Principal *infrav1.AWSClusterRolesAnywhereIdentity
+	// Certificate, PrivateKey and CertificateChain are the PEM encoded contents of the identity secret.
+	// They are part of the hash so that a rotated certificate results in a new provider.
+	Certificate      []byte
+	PrivateKey       []byte
+	CertificateChain []byte
+	credentials      *aws.CredentialsCache
+	region           string
+	log              logger.Wrapper
+	// For testing
+	endpoint   string
+	httpClient *http.Client
+}
+
+// Hash returns the byte encoded AWSRolesAnywherePrincipalTypeProvider.
+func (p *AWSRolesAnywherePrincipalTypeProvider) Hash() (string, error) {
+	var rolesAnywhereValue bytes.Buffer
+	err := gob.NewEncoder(&rolesAnywhereValue).Encode(p)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	return string(hash.Sum(rolesAnywhereValue.Bytes())), nil
+}
+
+// Name returns the name of the AWSRolesAnywherePrincipalTypeProvider.
+func (p *AWSRolesAnywherePrincipalTypeProvider) Name() string {
+	return p.Principal.Name
+}
+
+// Retrieve returns the credential values for the AWSRolesAnywherePrincipalTypeProvider.
+func (p *AWSRolesAnywherePrincipalTypeProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	if p.credentials == nil {
+		certs, err := parseCertificates(p.Certificate)
+		if err != nil {
+			return aws.Credentials{}, errors.Wrap(err, "failed to parse certificate")
+		}
+		if len(certs) == 0 {
+			return aws.Credentials{}, errors.New("no PEM encoded certificate found")
+		}
+		chain, err := parseCertificates(p.CertificateChain)
+		if err != nil {
+			return aws.Credentials{}, errors.Wrap(err, "failed to parse certificate chain")
+		}
+		signer, err := parsePrivateKey(p.PrivateKey)
+		if err != nil {
+			return aws.Credentials{}, errors.Wrap(err, "failed to parse private key")
+		}
+
+		// Trust anchors are regional, sessions are created in the region of the trust anchor.
+		region := p.region
+		if trustAnchorARN, err := arn.Parse(p.Principal.Spec.TrustAnchorARN); err == nil && trustAnchorARN.Region != "" {
+			region = trustAnchorARN.Region
+		}
+		endpoint := p.endpoint
+		if endpoint == "" {
+			endpoint = rolesAnywhereEndpoint(region)
+		}
+
+		credsProvider := &rolesAnywhereCredentialsProvider{
+			httpClient:      p.httpClient,
+			endpoint:        endpoint,
+			region:          region,
+			certificate:     certs[0],
+			chain:           append(certs[1:], chain...),
+			signer:          signer,
+			trustAnchorARN:  p.Principal.Spec.TrustAnchorARN,
+			profileARN:      p.Principal.Spec.ProfileARN,
+			roleARN:         p.Principal.Spec.RoleARN,
+			sessionName:     p.Principal.Spec.SessionName,
+			durationSeconds: p.Principal.Spec.DurationSeconds,
+		}
+		// Update credentials
+		p.credentials = aws.NewCredentialsCache(credsProvider)
+	}
+	return p.credentials.Retrieve(ctx)
+}
+
+// staticIdentityToken is an IdentityTokenRetriever returning a token read beforehand.
+type staticIdentityToken []byte
+
+// GetIdentityToken returns the web identity token.
+func (t staticIdentityToken) GetIdentityToken() ([]byte, error) {
+	return t, nil
+}
+
+func policyDescriptors(arns []string) []ststypes.PolicyDescriptorType {
+	if len(arns) == 0 {
+		return nil
+	}
+	policies := make([]ststypes.PolicyDescriptorType, 0, len(arns))
+	for _, policyARN := range arns {
+		policies = append(policies, ststypes.PolicyDescriptorType{Arn: aws.String(policyARN)})
+	}
+	return policies
+}
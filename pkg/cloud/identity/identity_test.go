@@ -18,19 +18,41 @@ package identity
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 )
 
 func TestAWSStaticPrincipalTypeProvider(t *testing.T) {
@@ -193,3 +215,293 @@ func TestAWSStaticPrincipalTypeProvider(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSWebIdentityPrincipalTypeProvider(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	expiresAt := time.Now()
+
+	testCases := []struct {
+		name      string
+		identity  *infrav1.AWSClusterWebIdentity
+		token     []byte
+		expect    func(m *mock_stsiface.MockSTSClientMockRecorder)
+		expectErr bool
+	}{
+		{
+			name: "Web identity provider successfully retrieves with a token file",
+			identity: &infrav1.AWSClusterWebIdentity{
+				Spec: infrav1.AWSClusterWebIdentitySpec{
+					AWSRoleSpec: infrav1.AWSRoleSpec{
+						RoleArn:         "arn:aws:iam::123456789012:role/web-identity",
+						SessionName:     "web-identity-session",
+						DurationSeconds: 900,
+						PolicyARNs:      []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+					},
+					TokenFile: tokenFile,
+				},
+			},
+			expect: func(m *mock_stsiface.MockSTSClientMockRecorder) {
+				m.AssumeRoleWithWebIdentity(gomock.Any(), &sts.AssumeRoleWithWebIdentityInput{
+					RoleArn:          aws.String("arn:aws:iam::123456789012:role/web-identity"),
+					RoleSessionName:  aws.String("web-identity-session"),
+					DurationSeconds:  aws.Int32(900),
+					WebIdentityToken: aws.String("file-token"),
+					PolicyArns: []ststypes.PolicyDescriptorType{
+						{Arn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess")},
+					},
+				}, gomock.Any()).Return(&sts.AssumeRoleWithWebIdentityOutput{
+					Credentials: &ststypes.Credentials{
+						AccessKeyId:     aws.String("assumedAccessKeyId"),
+						SecretAccessKey: aws.String("assumedSecretAccessKey"),
+						SessionToken:    aws.String("assumedSessionToken"),
+						Expiration:      aws.Time(expiresAt),
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Web identity provider successfully retrieves with a token from a secret",
+			identity: &infrav1.AWSClusterWebIdentity{
+				Spec: infrav1.AWSClusterWebIdentitySpec{
+					AWSRoleSpec: infrav1.AWSRoleSpec{
+						RoleArn:     "arn:aws:iam::123456789012:role/web-identity",
+						SessionName: "web-identity-session",
+					},
+					TokenSecretRef: "web-identity-token",
+				},
+			},
+			token: []byte("secret-token"),
+			expect: func(m *mock_stsiface.MockSTSClientMockRecorder) {
+				m.AssumeRoleWithWebIdentity(gomock.Any(), &sts.AssumeRoleWithWebIdentityInput{
+					RoleArn:          aws.String("arn:aws:iam::123456789012:role/web-identity"),
+					RoleSessionName:  aws.String("web-identity-session"),
+					WebIdentityToken: aws.String("secret-token"),
+				}, gomock.Any()).Return(&sts.AssumeRoleWithWebIdentityOutput{
+					Credentials: &ststypes.Credentials{
+						AccessKeyId:     aws.String("assumedAccessKeyId"),
+						SecretAccessKey: aws.String("assumedSecretAccessKey"),
+						SessionToken:    aws.String("assumedSessionToken"),
+						Expiration:      aws.Time(expiresAt),
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Web identity provider fails when the token file cannot be read",
+			identity: &infrav1.AWSClusterWebIdentity{
+				Spec: infrav1.AWSClusterWebIdentitySpec{
+					AWSRoleSpec: infrav1.AWSRoleSpec{
+						RoleArn: "arn:aws:iam::123456789012:role/web-identity",
+					},
+					TokenFile: filepath.Join(t.TempDir(), "missing"),
+				},
+			},
+			expect:    func(m *mock_stsiface.MockSTSClientMockRecorder) {},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			stsMock := mock_stsiface.NewMockSTSClient(mockCtrl)
+
+			provider := &AWSWebIdentityPrincipalTypeProvider{
+				Principal: tc.identity,
+				Token:     tc.token,
+				region:    "us-west-2",
+				stsClient: stsMock,
+			}
+
+			tc.expect(stsMock.EXPECT())
+			value, err := provider.Retrieve(context.TODO())
+			if tc.expectErr {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+
+			g.Expect(err).To(BeNil())
+			g.Expect(value.AccessKeyID).To(Equal("assumedAccessKeyId"))
+			g.Expect(value.SecretAccessKey).To(Equal("assumedSecretAccessKey"))
+			g.Expect(value.SessionToken).To(Equal("assumedSessionToken"))
+			g.Expect(value.Source).To(Equal(stscreds.WebIdentityProviderName))
+			g.Expect(value.CanExpire).To(BeTrue())
+		})
+	}
+}
+
+func TestAWSRolesAnywherePrincipalTypeProvider(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identity := &infrav1.AWSClusterRolesAnywhereIdentity{
+		Spec: infrav1.AWSClusterRolesAnywhereIdentitySpec{
+			SecretRef:       "roles-anywhere-certificate",
+			TrustAnchorARN:  "arn:aws:rolesanywhere:eu-west-1:123456789012:trust-anchor/4579702c-8d1d-4dbc-a4ce-9a5b2c4c5d4e",
+			ProfileARN:      "arn:aws:rolesanywhere:eu-west-1:123456789012:profile/6f4a2c6e-1f5a-4e0e-9f3c-2a7f9b8f1c2d",
+			RoleARN:         "arn:aws:iam::123456789012:role/capa",
+			SessionName:     "capa",
+			DurationSeconds: 3600,
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		key        crypto.Signer
+		algorithm  string
+		statusCode int
+		expectErr  bool
+	}{
+		{
+			name:       "Roles Anywhere provider successfully retrieves with an RSA key",
+			key:        rsaKey,
+			algorithm:  "AWS4-X509-RSA-SHA256",
+			statusCode: http.StatusCreated,
+		},
+		{
+			name:       "Roles Anywhere provider successfully retrieves with an ECDSA key",
+			key:        ecdsaKey,
+			algorithm:  "AWS4-X509-ECDSA-SHA256",
+			statusCode: http.StatusCreated,
+		},
+		{
+			name:       "Roles Anywhere provider fails when the session is denied",
+			key:        rsaKey,
+			algorithm:  "AWS4-X509-RSA-SHA256",
+			statusCode: http.StatusForbidden,
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			certPEM, keyPEM, cert := newTestCertificate(t, tc.key)
+			expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.Method).To(Equal(http.MethodPost))
+				g.Expect(r.URL.Path).To(Equal("/sessions"))
+				g.Expect(r.Header.Get("X-Amz-X509")).To(Equal(base64.StdEncoding.EncodeToString(cert.Raw)))
+				body, err := io.ReadAll(r.Body)
+				g.Expect(err).To(BeNil())
+				g.Expect(string(body)).To(MatchJSON(`{
+					"durationSeconds": 3600,
+					"profileArn": "arn:aws:rolesanywhere:eu-west-1:123456789012:profile/6f4a2c6e-1f5a-4e0e-9f3c-2a7f9b8f1c2d",
+					"roleArn": "arn:aws:iam::123456789012:role/capa",
+					"roleSessionName": "capa",
+					"trustAnchorArn": "arn:aws:rolesanywhere:eu-west-1:123456789012:trust-anchor/4579702c-8d1d-4dbc-a4ce-9a5b2c4c5d4e"
+				}`))
+				verifyRolesAnywhereSignature(g, r, body, cert, tc.algorithm, "eu-west-1")
+
+				w.WriteHeader(tc.statusCode)
+				if tc.statusCode != http.StatusCreated {
+					_, _ = w.Write([]byte(`{"message":"Untrusted certificate."}`))
+					return
+				}
+				_, _ = fmt.Fprintf(w, `{"credentialSet":[{"credentials":{"accessKeyId":"assumedAccessKeyId","secretAccessKey":"assumedSecretAccessKey","sessionToken":"assumedSessionToken","expiration":%q}}]}`, expiresAt.Format(time.RFC3339))
+			}))
+			defer server.Close()
+
+			provider := NewAWSRolesAnywherePrincipalTypeProvider(identity, &corev1.Secret{
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       certPEM,
+					corev1.TLSPrivateKeyKey: keyPEM,
+				},
+			}, "us-west-2", logger.NewLogger(klog.Background()))
+			provider.endpoint = server.URL
+			provider.httpClient = server.Client()
+
+			value, err := provider.Retrieve(context.TODO())
+			if tc.expectErr {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+
+			g.Expect(err).To(BeNil())
+			g.Expect(value.AccessKeyID).To(Equal("assumedAccessKeyId"))
+			g.Expect(value.SecretAccessKey).To(Equal("assumedSecretAccessKey"))
+			g.Expect(value.SessionToken).To(Equal("assumedSessionToken"))
+			g.Expect(value.CanExpire).To(BeTrue())
+			g.Expect(value.Expires).To(BeTemporally("==", expiresAt))
+		})
+	}
+}
+
+func newTestCertificate(t *testing.T, key crypto.Signer) ([]byte, []byte, *x509.Certificate) {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1234567890),
+		Subject:      pkix.Name{CommonName: "management-cluster"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, cert
+}
+
+// verifyRolesAnywhereSignature checks the signature of a CreateSession request against the certificate public key.
+func verifyRolesAnywhereSignature(g *WithT, r *http.Request, body []byte, cert *x509.Certificate, algorithm, region string) {
+	authorization := r.Header.Get("Authorization")
+	g.Expect(authorization).To(HavePrefix(algorithm + " "))
+
+	parts := map[string]string{}
+	for _, part := range strings.Split(strings.TrimPrefix(authorization, algorithm+" "), ", ") {
+		kv := strings.SplitN(part, "=", 2)
+		g.Expect(kv).To(HaveLen(2))
+		parts[kv[0]] = kv[1]
+	}
+
+	amzDate := r.Header.Get("X-Amz-Date")
+	scope := amzDate[:8] + "/" + region + "/rolesanywhere/aws4_request"
+	g.Expect(parts["Credential"]).To(Equal(cert.SerialNumber.String() + "/" + scope))
+
+	var canonicalHeaders strings.Builder
+	for _, name := range strings.Split(parts["SignedHeaders"], ";") {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + value + "\n")
+	}
+	canonicalRequest := strings.Join([]string{r.Method, r.URL.Path, "", canonicalHeaders.String(), parts["SignedHeaders"], hexSHA256(body)}, "\n")
+	stringToSign := strings.Join([]string{algorithm, amzDate, scope, hexSHA256([]byte(canonicalRequest))}, "\n")
+	digest := sha256.Sum256([]byte(stringToSign))
+
+	signature, err := hex.DecodeString(parts["Signature"])
+	g.Expect(err).To(BeNil())
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		g.Expect(rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature)).To(Succeed())
+	case *ecdsa.PublicKey:
+		g.Expect(ecdsa.VerifyASN1(pub, digest[:], signature)).To(BeTrue())
+	}
+}
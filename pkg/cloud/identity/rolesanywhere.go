@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identity
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+)
+
+const (
+	rolesAnywhereService        = "rolesanywhere"
+	rolesAnywhereRSAAlgorithm   = "AWS4-X509-RSA-SHA256"
+	rolesAnywhereECDSAAlgorithm = "AWS4-X509-ECDSA-SHA256"
+	rolesAnywhereTimeFormat     = "20060102T150405Z"
+	rolesAnywhereDateFormat     = "20060102"
+)
+
+// rolesAnywhereCredentialsProvider is an aws.CredentialsProvider exchanging an X.509 certificate
+// for temporary credentials with the IAM Roles Anywhere CreateSession API.
+type rolesAnywhereCredentialsProvider struct {
+	httpClient *http.Client
+	endpoint   string
+	region     string
+
+	certificate *x509.Certificate
+	chain       []*x509.Certificate
+	signer      crypto.Signer
+
+	trustAnchorARN  string
+	profileARN      string
+	roleARN         string
+	sessionName     string
+	durationSeconds int32
+}
+
+type createSessionRequest struct {
+	DurationSeconds int32  `json:"durationSeconds,omitempty"`
+	ProfileArn      string `json:"profileArn"`
+	RoleArn         string `json:"roleArn"`
+	RoleSessionName string `json:"roleSessionName,omitempty"`
+	TrustAnchorArn  string `json:"trustAnchorArn"`
+}
+
+type createSessionResponse struct {
+	CredentialSet []struct {
+		Credentials struct {
+			AccessKeyID     string `json:"accessKeyId"`
+			SecretAccessKey string `json:"secretAccessKey"`
+			SessionToken    string `json:"sessionToken"`
+			Expiration      string `json:"expiration"`
+		} `json:"credentials"`
+	} `json:"credentialSet"`
+}
+
+// Retrieve calls CreateSession, signed with the private key of the certificate, and returns the
+// credentials of the resulting role session.
+func (p *rolesAnywhereCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	body, err := json.Marshal(createSessionRequest{
+		DurationSeconds: p.durationSeconds,
+		ProfileArn:      p.profileARN,
+		RoleArn:         p.roleARN,
+		RoleSessionName: p.sessionName,
+		TrustAnchorArn:  p.trustAnchorARN,
+	})
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/sessions", bytes.NewReader(body))
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if err := p.sign(req, body, time.Now().UTC()); err != nil {
+		return aws.Credentials{}, errors.Wrap(err, "failed to sign CreateSession request")
+	}
+
+	httpClient := p.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return aws.Credentials{}, errors.Wrap(err, "failed to call CreateSession")
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return aws.Credentials{}, errors.Wrap(err, "failed to read CreateSession response")
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return aws.Credentials{}, errors.Errorf("CreateSession failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	out := &createSessionResponse{}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return aws.Credentials{}, errors.Wrap(err, "failed to decode CreateSession response")
+	}
+	if len(out.CredentialSet) == 0 {
+		return aws.Credentials{}, errors.New("CreateSession returned no credentials")
+	}
+
+	creds := out.CredentialSet[0].Credentials
+	expiration, err := time.Parse(time.RFC3339, creds.Expiration)
+	if err != nil {
+		return aws.Credentials{}, errors.Wrapf(err, "failed to parse credentials expiration %q", creds.Expiration)
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		Source:          "RolesAnywhereProvider",
+		CanExpire:       true,
+		Expires:         expiration,
+	}, nil
+}
+
+// sign adds the Roles Anywhere X.509 signature version 4 headers to the request.
+func (p *rolesAnywhereCredentialsProvider) sign(req *http.Request, body []byte, now time.Time) error {
+	var algorithm string
+	switch p.signer.Public().(type) {
+	case *rsa.PublicKey:
+		algorithm = rolesAnywhereRSAAlgorithm
+	case *ecdsa.PublicKey:
+		algorithm = rolesAnywhereECDSAAlgorithm
+	default:
+		return errors.Errorf("unsupported private key type %T", p.signer.Public())
+	}
+
+	amzDate := now.Format(rolesAnywhereTimeFormat)
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-X509", base64.StdEncoding.EncodeToString(p.certificate.Raw))
+	if len(p.chain) > 0 {
+		chain := make([]string, 0, len(p.chain))
+		for _, cert := range p.chain {
+			chain = append(chain, base64.StdEncoding.EncodeToString(cert.Raw))
+		}
+		req.Header.Set("X-Amz-X509-Chain", strings.Join(chain, ","))
+	}
+
+	canonicalHeaders, signedHeaders := rolesAnywhereCanonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		rolesAnywhereCanonicalURI(req.URL),
+		req.URL.Query().Encode(),
+		canonicalHeaders,
+		signedHeaders,
+		hexSHA256(body),
+	}, "\n")
+
+	scope := strings.Join([]string{now.Format(rolesAnywhereDateFormat), p.region, rolesAnywhereService, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{algorithm, amzDate, scope, hexSHA256([]byte(canonicalRequest))}, "\n")
+
+	digest := sha256.Sum256([]byte(stringToSign))
+	signature, err := p.signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, p.certificate.SerialNumber.String(), scope, signedHeaders, hex.EncodeToString(signature)))
+	return nil
+}
+
+// rolesAnywhereCanonicalHeaders returns the canonical headers and the signed headers list of the request.
+func rolesAnywhereCanonicalHeaders(req *http.Request) (string, string) {
+	names := make([]string, 0, len(req.Header))
+	values := map[string]string{}
+	for name, value := range req.Header {
+		lower := strings.ToLower(name)
+		names = append(names, lower)
+		values[lower] = strings.TrimSpace(strings.Join(value, ","))
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name + ":" + values[name] + "\n")
+	}
+	return canonical.String(), strings.Join(names, ";")
+}
+
+func rolesAnywhereCanonicalURI(u *url.URL) string {
+	if u.EscapedPath() == "" {
+		return "/"
+	}
+	return u.EscapedPath()
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// rolesAnywhereEndpoint returns the Roles Anywhere endpoint of the given region.
+func rolesAnywhereEndpoint(region string) string {
+	if strings.HasPrefix(region, "cn-") {
+		return fmt.Sprintf("https://%s.%s.amazonaws.com.cn", rolesAnywhereService, region)
+	}
+	return fmt.Sprintf("https://%s.%s.amazonaws.com", rolesAnywhereService, region)
+}
+
+// parseCertificates parses all the PEM encoded certificates of data.
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+}
+
+// parsePrivateKey parses a PEM encoded PKCS #8, PKCS #1 or SEC 1 private key.
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, errors.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("private key is neither a PKCS #8, PKCS #1 nor SEC 1 key")
+}
@@ -137,6 +137,9 @@ func getMetricCollectionMiddleware(controller string, target runtime.Object) mid
 			request.RequestEndTime = time.Now().UTC()
 		}
 		request.CaptureRequestMetrics()
+		if recorder := permissionRecorder.Load(); recorder != nil && !request.IsIncomplete() {
+			recorder.recordRequest(request, input.Parameters)
+		}
 		return out, metadata, err
 	})
 }
@@ -0,0 +1,520 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsv2
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
+)
+
+// PermissionRecordingKey is the ConfigMap data key and file content holding a PermissionRecording.
+const PermissionRecordingKey = "recording.json"
+
+// maxResourcesPerAction bounds the number of distinct resources kept for a single
+// action. Actions touching more resources than this are recorded against "*".
+const maxResourcesPerAction = 50
+
+// anyResource is the IAM wildcard resource.
+const anyResource = "*"
+
+// iamServicePrefixes maps AWS SDK service IDs to their IAM action prefix.
+var iamServicePrefixes = map[string]string{
+	"Auto Scaling":                "autoscaling",
+	"EC2":                         "ec2",
+	"EKS":                         "eks",
+	"Elastic Load Balancing":      "elasticloadbalancing",
+	"Elastic Load Balancing v2":   "elasticloadbalancing",
+	"EventBridge":                 "events",
+	"IAM":                         "iam",
+	"Resource Groups Tagging API": "tag",
+	"Route 53":                    "route53",
+	"S3":                          "s3",
+	"SQS":                         "sqs",
+	"SSM":                         "ssm",
+	"STS":                         "sts",
+	"Secrets Manager":             "secretsmanager",
+}
+
+// iamActionOverrides maps API operations to the IAM action authorizing them, where
+// the two are named differently.
+var iamActionOverrides = map[string]string{
+	"s3:CompleteMultipartUpload":         "s3:PutObject",
+	"s3:CreateMultipartUpload":           "s3:PutObject",
+	"s3:DeleteBucketEncryption":          "s3:PutEncryptionConfiguration",
+	"s3:DeleteBucketLifecycle":           "s3:PutLifecycleConfiguration",
+	"s3:DeleteBucketOwnershipControls":   "s3:PutBucketOwnershipControls",
+	"s3:DeleteObjects":                   "s3:DeleteObject",
+	"s3:DeletePublicAccessBlock":         "s3:PutBucketPublicAccessBlock",
+	"s3:GetBucketEncryption":             "s3:GetEncryptionConfiguration",
+	"s3:GetBucketLifecycleConfiguration": "s3:GetLifecycleConfiguration",
+	"s3:GetPublicAccessBlock":            "s3:GetBucketPublicAccessBlock",
+	"s3:HeadBucket":                      "s3:ListBucket",
+	"s3:HeadObject":                      "s3:GetObject",
+	"s3:ListObjects":                     "s3:ListBucket",
+	"s3:ListObjectsV2":                   "s3:ListBucket",
+	"s3:PutBucketEncryption":             "s3:PutEncryptionConfiguration",
+	"s3:PutBucketLifecycleConfiguration": "s3:PutLifecycleConfiguration",
+	"s3:PutPublicAccessBlock":            "s3:PutBucketPublicAccessBlock",
+	"s3:UploadPart":                      "s3:PutObject",
+}
+
+// s3ObjectActions are the S3 actions authorized against object rather than bucket ARNs.
+var s3ObjectActions = map[string]bool{
+	"s3:AbortMultipartUpload": true,
+	"s3:DeleteObject":         true,
+	"s3:GetObject":            true,
+	"s3:PutObject":            true,
+}
+
+// resourceARNFields are the request parameters holding the ARN of the resource an action is
+// called against. Other *Arn parameters, e.g. the RoleArn of eks:CreateCluster, name a resource
+// the action merely references, so actions not listed here are recorded against "*".
+var resourceARNFields = map[string][]string{
+	"elasticloadbalancing:AddListenerCertificates":        {"ListenerArn"},
+	"elasticloadbalancing:CreateListener":                 {"LoadBalancerArn"},
+	"elasticloadbalancing:DeleteListener":                 {"ListenerArn"},
+	"elasticloadbalancing:DeleteLoadBalancer":             {"LoadBalancerArn"},
+	"elasticloadbalancing:DeleteTargetGroup":              {"TargetGroupArn"},
+	"elasticloadbalancing:DeregisterTargets":              {"TargetGroupArn"},
+	"elasticloadbalancing:DescribeListenerCertificates":   {"ListenerArn"},
+	"elasticloadbalancing:DescribeListeners":              {"LoadBalancerArn"},
+	"elasticloadbalancing:DescribeLoadBalancerAttributes": {"LoadBalancerArn"},
+	"elasticloadbalancing:DescribeTargetHealth":           {"TargetGroupArn"},
+	"elasticloadbalancing:ModifyListener":                 {"ListenerArn"},
+	"elasticloadbalancing:ModifyLoadBalancerAttributes":   {"LoadBalancerArn"},
+	"elasticloadbalancing:ModifyTargetGroupAttributes":    {"TargetGroupArn"},
+	"elasticloadbalancing:RegisterTargets":                {"TargetGroupArn"},
+	"elasticloadbalancing:RemoveListenerCertificates":     {"ListenerArn"},
+	"elasticloadbalancing:SetSecurityGroups":              {"LoadBalancerArn"},
+	"elasticloadbalancing:SetSubnets":                     {"LoadBalancerArn"},
+}
+
+// impliedAction is an action AWS authorizes as part of another API operation, e.g.
+// iam:PassRole for the instance profile of ec2:RunInstances. These never show up as
+// calls of their own.
+type impliedAction struct {
+	action string
+	// when reports whether the request needs the action. nil means always.
+	when func(params reflect.Value) bool
+	// resource returns the ARN the action is authorized against. nil, or an empty
+	// result, means "*".
+	resource func(params reflect.Value) string
+}
+
+// kmsEBSActions are the actions EC2 takes with the caller's permissions to encrypt
+// EBS volumes with a KMS key.
+var kmsEBSActions = []string{"kms:CreateGrant", "kms:Decrypt", "kms:DescribeKey", "kms:GenerateDataKeyWithoutPlaintext"}
+
+// impliedActions lists, per IAM action of an API operation, the other actions AWS
+// authorizes for the same request.
+var impliedActions = map[string][]impliedAction{
+	"autoscaling:CreateAutoScalingGroup": {
+		{action: "ec2:RunInstances"},
+		{action: "iam:PassRole"},
+		{action: "iam:CreateServiceLinkedRole"},
+	},
+	"autoscaling:UpdateAutoScalingGroup": {
+		{action: "ec2:RunInstances"},
+		{action: "iam:PassRole"},
+	},
+	"ec2:RunInstances": append([]impliedAction{
+		{action: "iam:PassRole", when: hasField("IamInstanceProfile")},
+		{action: "iam:CreateServiceLinkedRole", when: hasField("InstanceMarketOptions")},
+	}, kmsImpliedActions(encryptedBlockDevices, blockDeviceKMSKey)...),
+	"ec2:CreateLaunchTemplate":        kmsImpliedActions(encryptedLaunchTemplateBlockDevices, launchTemplateKMSKey),
+	"ec2:CreateLaunchTemplateVersion": kmsImpliedActions(encryptedLaunchTemplateBlockDevices, launchTemplateKMSKey),
+	"eks:CreateAddon": {
+		{action: "iam:PassRole", when: hasField("ServiceAccountRoleArn"), resource: field("ServiceAccountRoleArn")},
+	},
+	"eks:CreateCluster": {
+		{action: "iam:PassRole", resource: field("RoleArn")},
+		{action: "iam:CreateServiceLinkedRole"},
+		{action: "kms:CreateGrant", when: hasField("EncryptionConfig")},
+		{action: "kms:DescribeKey", when: hasField("EncryptionConfig")},
+	},
+	"eks:CreateFargateProfile": {
+		{action: "iam:PassRole", resource: field("PodExecutionRoleArn")},
+	},
+	"eks:CreateNodegroup": {
+		{action: "iam:PassRole", resource: field("NodeRole")},
+		{action: "iam:CreateServiceLinkedRole"},
+	},
+	"elasticloadbalancing:CreateLoadBalancer": {
+		{action: "iam:CreateServiceLinkedRole"},
+	},
+}
+
+// tagOnCreateActions maps services to the action authorizing tags passed when creating
+// a resource, the request field holding the tags, and the prefix of the operations
+// creating resources. EC2 only accepts TagSpecifications when creating resources, e.g.
+// RunInstances or AllocateAddress, so any of its operations qualifies.
+var tagOnCreateActions = map[string]struct{ action, field, operationPrefix string }{
+	"autoscaling":          {action: "autoscaling:CreateOrUpdateTags", field: "Tags", operationPrefix: "Create"},
+	"ec2":                  {action: "ec2:CreateTags", field: "TagSpecifications"},
+	"eks":                  {action: "eks:TagResource", field: "Tags", operationPrefix: "Create"},
+	"elasticloadbalancing": {action: "elasticloadbalancing:AddTags", field: "Tags", operationPrefix: "Create"},
+}
+
+var permissionRecorder atomic.Pointer[PermissionRecorder]
+
+// EnablePermissionRecording makes every AWS client instrumented with WithMiddlewares
+// report the IAM actions it exercises to the given recorder.
+func EnablePermissionRecording(r *PermissionRecorder) {
+	permissionRecorder.Store(r)
+}
+
+// PermissionRecording is the set of IAM actions the controllers exercised.
+type PermissionRecording struct {
+	Actions []RecordedAction `json:"actions"`
+}
+
+// RecordedAction is a single IAM action and where it was used.
+type RecordedAction struct {
+	// Action is the IAM action, e.g. ec2:RunInstances.
+	Action string `json:"action"`
+
+	// Resources are the ARNs the action was called against, or "*" when the
+	// resource could not be derived from the request.
+	Resources []string `json:"resources,omitempty"`
+
+	// Controllers are the controllers that issued the call.
+	Controllers []string `json:"controllers,omitempty"`
+
+	// Denied is true when at least one call was rejected for lack of permissions.
+	Denied bool `json:"denied,omitempty"`
+}
+
+// PermissionRecorder accumulates the IAM actions exercised by AWS clients.
+type PermissionRecorder struct {
+	mu      sync.Mutex
+	actions map[string]*recordedAction
+}
+
+type recordedAction struct {
+	resources   map[string]struct{}
+	controllers map[string]struct{}
+	denied      bool
+}
+
+// NewPermissionRecorder returns an empty PermissionRecorder.
+func NewPermissionRecorder() *PermissionRecorder {
+	return &PermissionRecorder{
+		actions: map[string]*recordedAction{},
+	}
+}
+
+// Record adds a call of action against resource by controller to the recording.
+func (r *PermissionRecorder) Record(action, resource, controller string, denied bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.actions[action]
+	if !ok {
+		a = &recordedAction{
+			resources:   map[string]struct{}{},
+			controllers: map[string]struct{}{},
+		}
+		r.actions[action] = a
+	}
+
+	if resource == "" {
+		resource = anyResource
+	}
+	if _, wildcard := a.resources[anyResource]; !wildcard {
+		if resource == anyResource || len(a.resources) >= maxResourcesPerAction {
+			a.resources = map[string]struct{}{anyResource: {}}
+		} else {
+			a.resources[resource] = struct{}{}
+		}
+	}
+	if controller != "" {
+		a.controllers[controller] = struct{}{}
+	}
+	a.denied = a.denied || denied
+}
+
+// Merge adds a previously persisted recording, e.g. from before a restart.
+func (r *PermissionRecorder) Merge(recording PermissionRecording) {
+	for _, a := range recording.Actions {
+		resources := a.Resources
+		if len(resources) == 0 {
+			resources = []string{anyResource}
+		}
+		controllers := a.Controllers
+		if len(controllers) == 0 {
+			controllers = []string{""}
+		}
+		for _, resource := range resources {
+			for _, controller := range controllers {
+				r.Record(a.Action, resource, controller, a.Denied)
+			}
+		}
+	}
+}
+
+// Recording returns a sorted snapshot of the recorded actions.
+func (r *PermissionRecorder) Recording() PermissionRecording {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	recording := PermissionRecording{
+		Actions: make([]RecordedAction, 0, len(r.actions)),
+	}
+	for action, a := range r.actions {
+		recording.Actions = append(recording.Actions, RecordedAction{
+			Action:      action,
+			Resources:   sortedKeys(a.resources),
+			Controllers: sortedKeys(a.controllers),
+			Denied:      a.denied,
+		})
+	}
+	sort.Slice(recording.Actions, func(i, j int) bool {
+		return recording.Actions[i].Action < recording.Actions[j].Action
+	})
+	return recording
+}
+
+// recordRequest records the IAM action behind a completed request, and the actions
+// AWS authorizes implicitly for it.
+func (r *PermissionRecorder) recordRequest(request *RequestData, params interface{}) {
+	action := IAMAction(request.Service, request.OperationName)
+	r.Record(action, requestResource(action, request.Region, params), request.Controller, isAccessDeniedErrorCode(request.ErrorCode))
+	for _, implied := range requestImpliedActions(action, params) {
+		r.Record(implied.Action, implied.Resources[0], request.Controller, false)
+	}
+}
+
+// requestImpliedActions returns the actions AWS authorizes in addition to action for a
+// request with the given parameters, such as iam:PassRole for an instance profile or
+// ec2:CreateTags for tags passed when creating a resource.
+func requestImpliedActions(action string, params interface{}) []RecordedAction {
+	v := reflect.ValueOf(params)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	implied := []RecordedAction{}
+	for _, a := range impliedActions[action] {
+		if a.when != nil && !a.when(v) {
+			continue
+		}
+		resource := ""
+		if a.resource != nil {
+			resource = a.resource(v)
+		}
+		if !strings.HasPrefix(resource, "arn:") {
+			resource = anyResource
+		}
+		implied = append(implied, RecordedAction{Action: a.action, Resources: []string{resource}})
+	}
+
+	prefix, operation, _ := strings.Cut(action, ":")
+	if tagging, ok := tagOnCreateActions[prefix]; ok && action != tagging.action &&
+		strings.HasPrefix(operation, tagging.operationPrefix) && hasField(tagging.field)(v) {
+		implied = append(implied, RecordedAction{Action: tagging.action, Resources: []string{anyResource}})
+	}
+	return implied
+}
+
+// hasField returns a condition on the named field of a request being set.
+func hasField(name string) func(reflect.Value) bool {
+	return func(v reflect.Value) bool {
+		f := v.FieldByName(name)
+		switch {
+		case !f.IsValid() || f.IsZero():
+			return false
+		case f.Kind() == reflect.Slice || f.Kind() == reflect.Map:
+			return f.Len() > 0
+		}
+		return true
+	}
+}
+
+// field returns the value of the named string field of a request.
+func field(name string) func(reflect.Value) string {
+	return func(v reflect.Value) string {
+		return stringField(v, name)
+	}
+}
+
+// kmsImpliedActions returns the KMS actions needed when encrypted reports the request
+// encrypts EBS volumes, against the key returned by key.
+func kmsImpliedActions(encrypted func(reflect.Value) bool, key func(reflect.Value) string) []impliedAction {
+	actions := make([]impliedAction, 0, len(kmsEBSActions))
+	for _, action := range kmsEBSActions {
+		actions = append(actions, impliedAction{action: action, when: encrypted, resource: key})
+	}
+	return actions
+}
+
+// encryptedBlockDevices reports whether a RunInstancesInput encrypts an EBS volume.
+func encryptedBlockDevices(v reflect.Value) bool {
+	return blockDeviceKMSKey(v) != "" || anyEncryptedEBS(v.FieldByName("BlockDeviceMappings"))
+}
+
+// blockDeviceKMSKey returns the first KMS key of the EBS volumes of a RunInstancesInput.
+func blockDeviceKMSKey(v reflect.Value) string {
+	return firstEBSKMSKey(v.FieldByName("BlockDeviceMappings"))
+}
+
+// encryptedLaunchTemplateBlockDevices reports whether a launch template request
+// encrypts an EBS volume.
+func encryptedLaunchTemplateBlockDevices(v reflect.Value) bool {
+	data := launchTemplateData(v)
+	return data.IsValid() && (firstEBSKMSKey(data.FieldByName("BlockDeviceMappings")) != "" || anyEncryptedEBS(data.FieldByName("BlockDeviceMappings")))
+}
+
+// launchTemplateKMSKey returns the first KMS key of the EBS volumes of a launch template request.
+func launchTemplateKMSKey(v reflect.Value) string {
+	data := launchTemplateData(v)
+	if !data.IsValid() {
+		return ""
+	}
+	return firstEBSKMSKey(data.FieldByName("BlockDeviceMappings"))
+}
+
+func launchTemplateData(v reflect.Value) reflect.Value {
+	data := v.FieldByName("LaunchTemplateData")
+	if !data.IsValid() || data.Kind() != reflect.Pointer || data.IsNil() {
+		return reflect.Value{}
+	}
+	return data.Elem()
+}
+
+// anyEncryptedEBS reports whether a slice of block device mappings has an EBS volume
+// with Encrypted set.
+func anyEncryptedEBS(mappings reflect.Value) bool {
+	return eachEBS(mappings, func(ebs reflect.Value) bool {
+		encrypted := ebs.FieldByName("Encrypted")
+		return encrypted.IsValid() && encrypted.Kind() == reflect.Pointer && !encrypted.IsNil() && encrypted.Elem().Bool()
+	})
+}
+
+// firstEBSKMSKey returns the first KMS key ID set on the EBS volumes of a slice of
+// block device mappings.
+func firstEBSKMSKey(mappings reflect.Value) string {
+	key := ""
+	eachEBS(mappings, func(ebs reflect.Value) bool {
+		key = stringField(ebs, "KmsKeyId")
+		return key != ""
+	})
+	return key
+}
+
+// eachEBS calls fn with the EBS settings of each block device mapping until it returns true.
+func eachEBS(mappings reflect.Value, fn func(ebs reflect.Value) bool) bool {
+	if !mappings.IsValid() || mappings.Kind() != reflect.Slice {
+		return false
+	}
+	for i := range mappings.Len() {
+		ebs := mappings.Index(i).FieldByName("Ebs")
+		if !ebs.IsValid() || ebs.Kind() != reflect.Pointer || ebs.IsNil() {
+			continue
+		}
+		if fn(ebs.Elem()) {
+			return true
+		}
+	}
+	return false
+}
+
+// IAMAction returns the IAM action authorizing an API operation of the given AWS SDK service.
+func IAMAction(serviceID, operation string) string {
+	prefix, ok := iamServicePrefixes[serviceID]
+	if !ok {
+		prefix = strings.ToLower(strings.ReplaceAll(serviceID, " ", ""))
+	}
+	action := prefix + ":" + operation
+	if override, ok := iamActionOverrides[action]; ok {
+		return override
+	}
+	return action
+}
+
+// requestResource derives the ARN an action was called against from the request
+// parameters. S3 buckets are named rather than addressed by ARN, and object keys are
+// collapsed to the whole bucket as they are specific to a single machine. Otherwise
+// the ARN held by the resourceARNFields of the action is used.
+func requestResource(action, region string, params interface{}) string {
+	v := reflect.ValueOf(params)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return anyResource
+	}
+
+	if strings.HasPrefix(action, "s3:") {
+		bucket := stringField(v, "Bucket")
+		if bucket == "" {
+			return anyResource
+		}
+		arn := "arn:" + endpoints.GetPartitionFromRegion(region) + ":s3:::" + bucket
+		if s3ObjectActions[action] {
+			arn += "/*"
+		}
+		return arn
+	}
+
+	for _, name := range resourceARNFields[action] {
+		if arn := stringField(v, name); strings.HasPrefix(arn, "arn:") {
+			return arn
+		}
+	}
+	return anyResource
+}
+
+// stringField returns the value of the named *string or string field of v.
+func stringField(v reflect.Value, name string) string {
+	f := v.FieldByName(name)
+	switch {
+	case !f.IsValid():
+		return ""
+	case f.Kind() == reflect.String:
+		return f.String()
+	case f.Kind() == reflect.Pointer && !f.IsNil() && f.Elem().Kind() == reflect.String:
+		return f.Elem().String()
+	}
+	return ""
+}
+
+func isAccessDeniedErrorCode(code string) bool {
+	switch code {
+	case "AccessDenied", "AccessDeniedException", "UnauthorizedOperation":
+		return true
+	}
+	return false
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		if k != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
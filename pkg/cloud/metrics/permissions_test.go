@@ -0,0 +1,346 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIAMAction(t *testing.T) {
+	tests := []struct {
+		service   string
+		operation string
+		want      string
+	}{
+		{service: "EC2", operation: "RunInstances", want: "ec2:RunInstances"},
+		{service: "Elastic Load Balancing v2", operation: "CreateListener", want: "elasticloadbalancing:CreateListener"},
+		{service: "Resource Groups Tagging API", operation: "GetResources", want: "tag:GetResources"},
+		{service: "S3", operation: "PutBucketEncryption", want: "s3:PutEncryptionConfiguration"},
+		{service: "S3", operation: "ListObjectsV2", want: "s3:ListBucket"},
+		{service: "Service Quotas", operation: "GetServiceQuota", want: "servicequotas:GetServiceQuota"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(IAMAction(tt.service, tt.operation)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestRequestResource(t *testing.T) {
+	tests := []struct {
+		name   string
+		action string
+		region string
+		params interface{}
+		want   string
+	}{
+		{
+			name:   "s3 object actions are scoped to all objects in the bucket",
+			action: "s3:PutObject",
+			region: "us-east-1",
+			params: &s3.PutObjectInput{Bucket: aws.String("bucket"), Key: aws.String("control-plane/machine-1")},
+			want:   "arn:aws:s3:::bucket/*",
+		},
+		{
+			name:   "s3 bucket actions are scoped to the bucket",
+			action: "s3:CreateBucket",
+			region: "cn-north-1",
+			params: &s3.CreateBucketInput{Bucket: aws.String("bucket")},
+			want:   "arn:aws-cn:s3:::bucket",
+		},
+		{
+			name:   "arn parameters are used as the resource",
+			action: "elasticloadbalancing:DescribeListeners",
+			region: "us-east-1",
+			params: &elbv2.DescribeListenersInput{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/lb/1")},
+			want:   "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/lb/1",
+		},
+		{
+			name:   "arn parameters referencing other resources are ignored",
+			action: "eks:CreateCluster",
+			region: "us-east-1",
+			params: &eks.CreateClusterInput{Name: aws.String("cluster"), RoleArn: aws.String("arn:aws:iam::123456789012:role/eks-controlplane.cluster-api-provider-aws.sigs.k8s.io")},
+			want:   "*",
+		},
+		{
+			name:   "requests without a known resource use a wildcard",
+			action: "ec2:RunInstances",
+			region: "us-east-1",
+			params: &ec2.RunInstancesInput{ImageId: aws.String("ami-123")},
+			want:   "*",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(requestResource(tt.action, tt.region, tt.params)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestRequestImpliedActions(t *testing.T) {
+	keyARN := "arn:aws:kms:us-east-1:123456789012:key/1234"
+	tests := []struct {
+		name   string
+		action string
+		params interface{}
+		want   []RecordedAction
+	}{
+		{
+			name:   "instances without an instance profile, tags or encrypted volumes",
+			action: "ec2:RunInstances",
+			params: &ec2.RunInstancesInput{ImageId: aws.String("ami-123")},
+			want:   []RecordedAction{},
+		},
+		{
+			name:   "instances with an instance profile, tags and an encrypted volume",
+			action: "ec2:RunInstances",
+			params: &ec2.RunInstancesInput{
+				IamInstanceProfile: &ec2types.IamInstanceProfileSpecification{Name: aws.String("nodes")},
+				TagSpecifications:  []ec2types.TagSpecification{{ResourceType: ec2types.ResourceTypeInstance}},
+				BlockDeviceMappings: []ec2types.BlockDeviceMapping{
+					{DeviceName: aws.String("/dev/sda1"), Ebs: &ec2types.EbsBlockDevice{Encrypted: aws.Bool(true), KmsKeyId: aws.String(keyARN)}},
+				},
+			},
+			want: []RecordedAction{
+				{Action: "iam:PassRole", Resources: []string{"*"}},
+				{Action: "kms:CreateGrant", Resources: []string{keyARN}},
+				{Action: "kms:Decrypt", Resources: []string{keyARN}},
+				{Action: "kms:DescribeKey", Resources: []string{keyARN}},
+				{Action: "kms:GenerateDataKeyWithoutPlaintext", Resources: []string{keyARN}},
+				{Action: "ec2:CreateTags", Resources: []string{"*"}},
+			},
+		},
+		{
+			name:   "spot instances with the default KMS key",
+			action: "ec2:RunInstances",
+			params: &ec2.RunInstancesInput{
+				InstanceMarketOptions: &ec2types.InstanceMarketOptionsRequest{MarketType: ec2types.MarketTypeSpot},
+				BlockDeviceMappings: []ec2types.BlockDeviceMapping{
+					{DeviceName: aws.String("/dev/sda1"), Ebs: &ec2types.EbsBlockDevice{Encrypted: aws.Bool(true)}},
+				},
+			},
+			want: []RecordedAction{
+				{Action: "iam:CreateServiceLinkedRole", Resources: []string{"*"}},
+				{Action: "kms:CreateGrant", Resources: []string{"*"}},
+				{Action: "kms:Decrypt", Resources: []string{"*"}},
+				{Action: "kms:DescribeKey", Resources: []string{"*"}},
+				{Action: "kms:GenerateDataKeyWithoutPlaintext", Resources: []string{"*"}},
+			},
+		},
+		{
+			name:   "launch templates with encrypted volumes",
+			action: "ec2:CreateLaunchTemplateVersion",
+			params: &ec2.CreateLaunchTemplateVersionInput{
+				LaunchTemplateData: &ec2types.RequestLaunchTemplateData{
+					BlockDeviceMappings: []ec2types.LaunchTemplateBlockDeviceMappingRequest{
+						{Ebs: &ec2types.LaunchTemplateEbsBlockDeviceRequest{KmsKeyId: aws.String(keyARN)}},
+					},
+				},
+			},
+			want: []RecordedAction{
+				{Action: "kms:CreateGrant", Resources: []string{keyARN}},
+				{Action: "kms:Decrypt", Resources: []string{keyARN}},
+				{Action: "kms:DescribeKey", Resources: []string{keyARN}},
+				{Action: "kms:GenerateDataKeyWithoutPlaintext", Resources: []string{keyARN}},
+			},
+		},
+		{
+			name:   "vpcs with tags",
+			action: "ec2:CreateVpc",
+			params: &ec2.CreateVpcInput{TagSpecifications: []ec2types.TagSpecification{{ResourceType: ec2types.ResourceTypeVpc}}},
+			want:   []RecordedAction{{Action: "ec2:CreateTags", Resources: []string{"*"}}},
+		},
+		{
+			name:   "tags on their own are not implied",
+			action: "ec2:CreateTags",
+			params: &ec2.CreateTagsInput{Resources: []string{"vpc-1"}},
+			want:   []RecordedAction{},
+		},
+		{
+			name:   "eks clusters pass their role",
+			action: "eks:CreateCluster",
+			params: &eks.CreateClusterInput{Name: aws.String("cluster"), RoleArn: aws.String("arn:aws:iam::123456789012:role/eks-controlplane"), Tags: map[string]string{"a": "b"}},
+			want: []RecordedAction{
+				{Action: "iam:PassRole", Resources: []string{"arn:aws:iam::123456789012:role/eks-controlplane"}},
+				{Action: "iam:CreateServiceLinkedRole", Resources: []string{"*"}},
+				{Action: "eks:TagResource", Resources: []string{"*"}},
+			},
+		},
+		{
+			name:   "eks node groups pass their role",
+			action: "eks:CreateNodegroup",
+			params: &eks.CreateNodegroupInput{NodeRole: aws.String("arn:aws:iam::123456789012:role/nodes")},
+			want: []RecordedAction{
+				{Action: "iam:PassRole", Resources: []string{"arn:aws:iam::123456789012:role/nodes"}},
+				{Action: "iam:CreateServiceLinkedRole", Resources: []string{"*"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(requestImpliedActions(tt.action, tt.params)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestPermissionRecorder(t *testing.T) {
+	t.Run("groups calls by action", func(t *testing.T) {
+		g := NewWithT(t)
+		r := NewPermissionRecorder()
+		r.Record("s3:PutObject", "arn:aws:s3:::b/*", "awsmachine", false)
+		r.Record("ec2:RunInstances", "*", "awsmachine", false)
+		r.Record("s3:PutObject", "arn:aws:s3:::b/*", "awsmachinepool", true)
+
+		g.Expect(r.Recording()).To(Equal(PermissionRecording{
+			Actions: []RecordedAction{
+				{Action: "ec2:RunInstances", Resources: []string{"*"}, Controllers: []string{"awsmachine"}},
+				{Action: "s3:PutObject", Resources: []string{"arn:aws:s3:::b/*"}, Controllers: []string{"awsmachine", "awsmachinepool"}, Denied: true},
+			},
+		}))
+	})
+
+	t.Run("collapses to a wildcard", func(t *testing.T) {
+		g := NewWithT(t)
+		r := NewPermissionRecorder()
+		r.Record("sts:AssumeRole", "arn:aws:iam::123456789012:role/a", "", false)
+		r.Record("sts:AssumeRole", "*", "", false)
+		r.Record("sts:AssumeRole", "arn:aws:iam::123456789012:role/b", "", false)
+		for i := range maxResourcesPerAction + 1 {
+			r.Record("iam:GetRole", fmt.Sprintf("arn:aws:iam::123456789012:role/%d", i), "", false)
+		}
+
+		g.Expect(r.Recording().Actions).To(Equal([]RecordedAction{
+			{Action: "iam:GetRole", Resources: []string{"*"}, Controllers: []string{}},
+			{Action: "sts:AssumeRole", Resources: []string{"*"}, Controllers: []string{}},
+		}))
+	})
+
+	t.Run("records the actions implied by a request", func(t *testing.T) {
+		g := NewWithT(t)
+		r := NewPermissionRecorder()
+		r.recordRequest(&RequestData{Service: "EC2", OperationName: "RunInstances", Region: "us-east-1", Controller: "awsmachine", ErrorCode: "UnauthorizedOperation"}, &ec2.RunInstancesInput{
+			IamInstanceProfile: &ec2types.IamInstanceProfileSpecification{Name: aws.String("nodes")},
+		})
+
+		g.Expect(r.Recording().Actions).To(Equal([]RecordedAction{
+			{Action: "ec2:RunInstances", Resources: []string{"*"}, Controllers: []string{"awsmachine"}, Denied: true},
+			{Action: "iam:PassRole", Resources: []string{"*"}, Controllers: []string{"awsmachine"}},
+		}))
+	})
+
+	t.Run("merges an existing recording", func(t *testing.T) {
+		g := NewWithT(t)
+		r := NewPermissionRecorder()
+		r.Record("ec2:RunInstances", "*", "awsmachine", false)
+		r.Merge(PermissionRecording{Actions: []RecordedAction{
+			{Action: "ec2:RunInstances", Controllers: []string{"awsmachinepool"}, Denied: true},
+			{Action: "eks:DescribeCluster", Resources: []string{"*"}},
+		}})
+
+		g.Expect(r.Recording().Actions).To(Equal([]RecordedAction{
+			{Action: "ec2:RunInstances", Resources: []string{"*"}, Controllers: []string{"awsmachine", "awsmachinepool"}, Denied: true},
+			{Action: "eks:DescribeCluster", Resources: []string{"*"}, Controllers: []string{}},
+		}))
+	})
+}
+
+func TestPermissionRecordingWriter(t *testing.T) {
+	existing := PermissionRecording{Actions: []RecordedAction{
+		{Action: "eks:DescribeCluster", Resources: []string{"*"}, Controllers: []string{"awsmanagedcontrolplane"}},
+	}}
+	existingJSON, err := json.Marshal(existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("writes the recording to a ConfigMap", func(t *testing.T) {
+		g := NewWithT(t)
+		key := types.NamespacedName{Namespace: "capa-system", Name: "capa-permissions"}
+		c := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			Data:       map[string]string{PermissionRecordingKey: string(existingJSON)},
+		}).Build()
+		w := &PermissionRecordingWriter{Recorder: NewPermissionRecorder(), Client: c, Reader: c, ConfigMap: key}
+
+		g.Expect(w.load(context.Background())).To(Succeed())
+		w.Recorder.Record("ec2:RunInstances", "*", "awsmachine", false)
+		g.Expect(w.write(context.Background())).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		g.Expect(c.Get(context.Background(), key, cm)).To(Succeed())
+		recording := PermissionRecording{}
+		g.Expect(json.Unmarshal([]byte(cm.Data[PermissionRecordingKey]), &recording)).To(Succeed())
+		g.Expect(recording.Actions).To(HaveLen(2))
+		g.Expect(recording.Actions[0].Action).To(Equal("ec2:RunInstances"))
+		g.Expect(recording.Actions[1].Action).To(Equal("eks:DescribeCluster"))
+	})
+
+	t.Run("creates the ConfigMap", func(t *testing.T) {
+		g := NewWithT(t)
+		key := types.NamespacedName{Namespace: "capa-system", Name: "capa-permissions"}
+		c := fake.NewClientBuilder().Build()
+		w := &PermissionRecordingWriter{Recorder: NewPermissionRecorder(), Client: c, Reader: c, ConfigMap: key}
+
+		g.Expect(w.load(context.Background())).To(Succeed())
+		w.Recorder.Record("ec2:RunInstances", "*", "awsmachine", false)
+		g.Expect(w.write(context.Background())).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		g.Expect(c.Get(context.Background(), key, cm)).To(Succeed())
+		g.Expect(cm.Data[PermissionRecordingKey]).To(ContainSubstring("ec2:RunInstances"))
+	})
+
+	t.Run("writes the recording to a file", func(t *testing.T) {
+		g := NewWithT(t)
+		path := filepath.Join(t.TempDir(), "recording.json")
+		g.Expect(os.WriteFile(path, existingJSON, 0o600)).To(Succeed())
+		w := &PermissionRecordingWriter{Recorder: NewPermissionRecorder(), Path: path}
+
+		g.Expect(w.load(context.Background())).To(Succeed())
+		w.Recorder.Record("ec2:RunInstances", "*", "awsmachine", false)
+		g.Expect(w.write(context.Background())).To(Succeed())
+
+		data, err := os.ReadFile(path)
+		g.Expect(err).NotTo(HaveOccurred())
+		recording := PermissionRecording{}
+		g.Expect(json.Unmarshal(data, &recording)).To(Succeed())
+		g.Expect(recording).To(Equal(PermissionRecording{Actions: []RecordedAction{
+			{Action: "ec2:RunInstances", Resources: []string{"*"}, Controllers: []string{"awsmachine"}},
+			{Action: "eks:DescribeCluster", Resources: []string{"*"}, Controllers: []string{"awsmanagedcontrolplane"}},
+		}}))
+	})
+}
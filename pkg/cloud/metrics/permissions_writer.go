@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PermissionRecordingWriter periodically persists the actions held by a
+// PermissionRecorder to a ConfigMap and/or a file. Recordings already present
+// at start up are merged in, so restarts do not lose earlier observations.
+type PermissionRecordingWriter struct {
+	Recorder *PermissionRecorder
+
+	// Client writes the ConfigMap, and Reader reads it back. Reader should not be
+	// backed by the manager cache, which would otherwise start watching ConfigMaps.
+	Client client.Client
+	Reader client.Reader
+
+	// ConfigMap is the ConfigMap to write the recording to. Ignored when Name is empty.
+	ConfigMap types.NamespacedName
+
+	// Path is the file to write the recording to. Ignored when empty.
+	Path string
+
+	// Interval is how often the recording is written.
+	Interval time.Duration
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Only the leader
+// issues AWS calls, so standby replicas must not overwrite its recording.
+func (w *PermissionRecordingWriter) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable.
+func (w *PermissionRecordingWriter) Start(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx).WithName("permission-recording")
+
+	if err := w.load(ctx); err != nil {
+		return fmt.Errorf("failed to load existing permission recording: %w", err)
+	}
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := w.write(ctx); err != nil {
+				log.Error(err, "failed to write permission recording")
+			}
+		case <-ctx.Done():
+			// Flush what was recorded since the last tick.
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			return w.write(flushCtx)
+		}
+	}
+}
+
+func (w *PermissionRecordingWriter) load(ctx context.Context) error {
+	var data []byte
+	if w.ConfigMap.Name != "" {
+		cm := &corev1.ConfigMap{}
+		err := w.Reader.Get(ctx, w.ConfigMap, cm)
+		switch {
+		case apierrors.IsNotFound(err):
+		case err != nil:
+			return err
+		default:
+			data = []byte(cm.Data[PermissionRecordingKey])
+		}
+	}
+	if len(data) == 0 && w.Path != "" {
+		b, err := os.ReadFile(w.Path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		data = b
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	recording := PermissionRecording{}
+	if err := json.Unmarshal(data, &recording); err != nil {
+		return fmt.Errorf("failed to parse permission recording: %w", err)
+	}
+	w.Recorder.Merge(recording)
+	return nil
+}
+
+func (w *PermissionRecordingWriter) write(ctx context.Context) error {
+	data, err := json.MarshalIndent(w.Recorder.Recording(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if w.Path != "" {
+		if err := writeFileAtomically(w.Path, data); err != nil {
+			return fmt.Errorf("failed to write permission recording to %q: %w", w.Path, err)
+		}
+	}
+
+	if w.ConfigMap.Name != "" {
+		if err := w.writeConfigMap(ctx, string(data)); err != nil {
+			return fmt.Errorf("failed to write permission recording to ConfigMap %s: %w", w.ConfigMap, err)
+		}
+	}
+	return nil
+}
+
+func (w *PermissionRecordingWriter) writeConfigMap(ctx context.Context, data string) error {
+	cm := &corev1.ConfigMap{}
+	err := w.Reader.Get(ctx, w.ConfigMap, cm)
+	if apierrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      w.ConfigMap.Name,
+				Namespace: w.ConfigMap.Namespace,
+			},
+			Data: map[string]string{
+				PermissionRecordingKey: data,
+			},
+		}
+		return w.Client.Create(ctx, cm)
+	}
+	if err != nil {
+		return err
+	}
+
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[PermissionRecordingKey] = data
+	return w.Client.Update(ctx, cm)
+}
+
+// writeFileAtomically replaces path with data, so readers never observe a partial recording.
+func writeFileAtomically(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close() //nolint:errcheck
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
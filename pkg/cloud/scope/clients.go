@@ -25,8 +25,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	rgapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	stsv2 "github.com/aws/aws-sdk-go-v2/service/sts"
@@ -272,6 +274,24 @@ func NewIAMClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 	return iam.NewFromConfig(cfg, iamOpts...)
 }
 
+// NewRoute53Client creates a new Route53 API client for a given session.
+func NewRoute53Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *route53.Client {
+	cfg := session.Session()
+
+	route53Opts := []func(*route53.Options){
+		func(o *route53.Options) {
+			o.Logger = logger.GetAWSLogger()
+			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+		},
+		route53.WithAPIOptions(
+			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
+			awsmetrics.WithCAPAUserAgentMiddleware(),
+		),
+	}
+
+	return route53.NewFromConfig(cfg, route53Opts...)
+}
+
 // NewSTSClient creates a new STS API client for a given session.
 func NewSTSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) stsservice.STSClient {
 	cfg := session.Session()
@@ -335,6 +355,24 @@ func NewS3Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logge
 	return s3.NewFromConfig(cfg, s3Opts...)
 }
 
+// NewServiceQuotasClient creates a new Service Quotas API client for a given session.
+func NewServiceQuotasClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *servicequotas.Client {
+	cfg := session.Session()
+
+	serviceQuotasOpts := []func(*servicequotas.Options){
+		func(o *servicequotas.Options) {
+			o.Logger = logger.GetAWSLogger()
+			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+		},
+		servicequotas.WithAPIOptions(
+			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
+			awsmetrics.WithCAPAUserAgentMiddleware(),
+		),
+	}
+
+	return servicequotas.NewFromConfig(cfg, serviceQuotasOpts...)
+}
+
 // AWSClients contains all the aws clients used by the scopes.
 type AWSClients struct {
 	ELB             *elb.Client
@@ -48,6 +48,7 @@ type ClusterScopeParams struct {
 	ControllerName               string
 	Session                      aws.Config
 	TagUnmanagedNetworkResources bool
+	UnmanagedVPCFlowLogs         bool
 	MaxWaitActiveUpdateDelete    time.Duration
 }
 
@@ -73,6 +74,7 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		AWSCluster:                   params.AWSCluster,
 		controllerName:               params.ControllerName,
 		tagUnmanagedNetworkResources: params.TagUnmanagedNetworkResources,
+		unmanagedVPCFlowLogs:         params.UnmanagedVPCFlowLogs,
 		maxWaitActiveUpdateDelete:    params.MaxWaitActiveUpdateDelete,
 	}
 
@@ -107,6 +109,7 @@ type ClusterScope struct {
 	controllerName  string
 
 	tagUnmanagedNetworkResources bool
+	unmanagedVPCFlowLogs         bool
 	maxWaitActiveUpdateDelete    time.Duration
 }
 
@@ -120,6 +123,11 @@ func (s *ClusterScope) VPC() *infrav1.VPCSpec {
 	return &s.AWSCluster.Spec.NetworkSpec.VPC
 }
 
+// VPCPeerings returns the peering connections of the cluster VPC.
+func (s *ClusterScope) VPCPeerings() []infrav1.VPCPeeringSpec {
+	return s.AWSCluster.Spec.NetworkSpec.VPCPeerings
+}
+
 // Subnets returns the cluster subnets.
 func (s *ClusterScope) Subnets() infrav1.Subnets {
 	return s.AWSCluster.Spec.NetworkSpec.Subnets
@@ -381,6 +389,11 @@ func (s *ClusterScope) TagUnmanagedNetworkResources() bool {
 	return s.tagUnmanagedNetworkResources
 }
 
+// UnmanagedVPCFlowLogs returns if the feature flag unmanaged VPC flow logs is set.
+func (s *ClusterScope) UnmanagedVPCFlowLogs() bool {
+	return s.unmanagedVPCFlowLogs
+}
+
 // MaxWaitDuration returns time waiting for operation.
 func (s *ClusterScope) MaxWaitDuration() time.Duration {
 	return s.maxWaitActiveUpdateDelete
@@ -435,6 +448,21 @@ func (s *ClusterScope) AdditionalNodeIngressRules() []infrav1.IngressRule {
 	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalNodeIngressRules
 }
 
+// AdditionalControlPlaneEgressRules returns the additional egress rules for the control plane security group.
+func (s *ClusterScope) AdditionalControlPlaneEgressRules() []infrav1.EgressRule {
+	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalControlPlaneEgressRules
+}
+
+// AdditionalNodeEgressRules returns the additional egress rules for the node security group.
+func (s *ClusterScope) AdditionalNodeEgressRules() []infrav1.EgressRule {
+	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalNodeEgressRules
+}
+
+// SecurityGroupEgressRules returns the egress rules declared for each security group role.
+func (s *ClusterScope) SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules {
+	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().SecurityGroupEgressRules
+}
+
 // UnstructuredControlPlane returns the unstructured object for the control plane, if any.
 // When the reference is not set, it returns an empty object.
 func (s *ClusterScope) UnstructuredControlPlane() (*unstructured.Unstructured, error) {
@@ -364,6 +364,15 @@ func (m *MachineScope) InstanceIsOperational() bool {
 	return state != nil && infrav1.InstanceOperationalStates.Has(string(*state))
 }
 
+// DesiredPowerState returns the power state requested for the instance, or an empty string if the
+// power state of the instance is not managed. It is never managed for machine pool machines.
+func (m *MachineScope) DesiredPowerState() infrav1.InstancePowerState {
+	if m.IsMachinePoolMachine() {
+		return ""
+	}
+	return m.AWSMachine.Spec.PowerState
+}
+
 // InstanceIsInKnownState checks if the machine scope's instance state is known.
 func (m *MachineScope) InstanceIsInKnownState() bool {
 	state := m.GetInstanceState()
@@ -38,6 +38,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/remote"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"
 	"sigs.k8s.io/cluster-api/util/patch"
@@ -300,7 +301,8 @@ type NodeStatus struct {
 
 // UpdateInstanceStatuses ties ASG instances and Node status data together and updates AWSMachinePool
 // This updates if ASG instances ready and kubelet version running on the node..
-func (m *MachinePoolScope) UpdateInstanceStatuses(ctx context.Context, instances []infrav1.Instance) error {
+// Instances in the warm pool of the ASG are listed after the ASG instances.
+func (m *MachinePoolScope) UpdateInstanceStatuses(ctx context.Context, instances []infrav1.Instance, warmPoolInstances []infrav1.Instance) error {
 	providerIDs := make([]string, len(instances))
 	for i, instance := range instances {
 		providerIDs[i] = fmt.Sprintf("aws:////%s", instance.ID)
@@ -327,6 +329,13 @@ func (m *MachinePoolScope) UpdateInstanceStatuses(ctx context.Context, instances
 		}
 	}
 
+	for _, instance := range warmPoolInstances {
+		instanceStatuses = append(instanceStatuses, expinfrav1.AWSMachinePoolInstanceStatus{
+			InstanceID: instance.ID,
+			WarmPool:   true,
+		})
+	}
+
 	// TODO: readyReplicas can be used as status.replicas but this will delay machinepool to become ready. next reconcile updates this.
 	m.AWSMachinePool.Status.Instances = instanceStatuses
 	return nil
@@ -399,3 +408,35 @@ func (m *MachinePoolScope) GetRuntimeObject() runtime.Object {
 func (m *MachinePoolScope) GetLifecycleHooks() []expinfrav1.AWSLifecycleHook {
 	return m.AWSMachinePool.Spec.AWSLifecycleHooks
 }
+
+// ReplicasExternallyManaged returns true if the desired capacity of the ASG is not driven by the MachinePool
+// replicas, either because of the external autoscaler annotation or because the ASG has scheduled actions or
+// scaling policies, in which case the MachinePool replicas follow the ASG desired capacity instead.
+func (m *MachinePoolScope) ReplicasExternallyManaged() bool {
+	return annotations.ReplicasManagedByExternalAutoscaler(m.MachinePool) ||
+		len(m.AWSMachinePool.Spec.ScheduledActions) > 0 ||
+		len(m.AWSMachinePool.Spec.ScalingPolicies) > 0
+}
+
+// ASGName returns the name of the ASG the instances of the pool are launched into. It is the name of the
+// AWSMachinePool until the pool is replaced with the BlueGreen strategy.
+func (m *MachinePoolScope) ASGName() string {
+	if m.AWSMachinePool.Status.ASGName != "" {
+		return m.AWSMachinePool.Status.ASGName
+	}
+	// The status is lost when the AWSMachinePool is moved, but the provider ID holds the ASG ARN.
+	if _, name, found := strings.Cut(m.AWSMachinePool.Spec.ProviderID, ":autoScalingGroupName/"); found {
+		return name
+	}
+	return m.Name()
+}
+
+// ASGLaunchTemplateVersion returns the launch template version of the ASG the instances of the pool are launched
+// into. It is pinned to the previous version while the ASG is replaced with the BlueGreen strategy, or after the
+// replacement was rolled back.
+func (m *MachinePoolScope) ASGLaunchTemplateVersion() string {
+	if blueGreen := m.AWSMachinePool.Status.BlueGreen; blueGreen != nil && blueGreen.PreviousLaunchTemplateVersion != "" {
+		return blueGreen.PreviousLaunchTemplateVersion
+	}
+	return expinfrav1.LaunchTemplateLatestVersion
+}
@@ -67,6 +67,7 @@ type ManagedControlPlaneScopeParams struct {
 	EnableIAM                    bool
 	AllowAdditionalRoles         bool
 	TagUnmanagedNetworkResources bool
+	UnmanagedVPCFlowLogs         bool
 }
 
 // NewManagedControlPlaneScope creates a new Scope from the supplied parameters.
@@ -95,6 +96,7 @@ func NewManagedControlPlaneScope(params ManagedControlPlaneScopeParams) (*Manage
 		allowAdditionalRoles:         params.AllowAdditionalRoles,
 		enableIAM:                    params.EnableIAM,
 		tagUnmanagedNetworkResources: params.TagUnmanagedNetworkResources,
+		unmanagedVPCFlowLogs:         params.UnmanagedVPCFlowLogs,
 	}
 	session, serviceLimiters, err := sessionForClusterWithRegion(params.Client, managedScope, params.ControlPlane.Spec.Region, params.Logger)
 	if err != nil {
@@ -130,6 +132,7 @@ type ManagedControlPlaneScope struct {
 	enableIAM                    bool
 	allowAdditionalRoles         bool
 	tagUnmanagedNetworkResources bool
+	unmanagedVPCFlowLogs         bool
 }
 
 // RemoteClient returns the Kubernetes client for connecting to the workload cluster.
@@ -158,6 +161,11 @@ func (s *ManagedControlPlaneScope) VPC() *infrav1.VPCSpec {
 	return &s.ControlPlane.Spec.NetworkSpec.VPC
 }
 
+// VPCPeerings returns the peering connections of the control plane VPC.
+func (s *ManagedControlPlaneScope) VPCPeerings() []infrav1.VPCPeeringSpec {
+	return s.ControlPlane.Spec.NetworkSpec.VPCPeerings
+}
+
 // ServiceLimiter returns the AWS SDK session. Used for creating clients.
 func (s *ManagedControlPlaneScope) ServiceLimiter(service string) *throttle.ServiceLimiter {
 	if sl, ok := s.serviceLimiters[service]; ok {
@@ -357,6 +365,11 @@ func (s *ManagedControlPlaneScope) TagUnmanagedNetworkResources() bool {
 	return s.tagUnmanagedNetworkResources
 }
 
+// UnmanagedVPCFlowLogs returns if the feature flag unmanaged VPC flow logs is set.
+func (s *ManagedControlPlaneScope) UnmanagedVPCFlowLogs() bool {
+	return s.unmanagedVPCFlowLogs
+}
+
 // SetBastionInstance sets the bastion instance in the status of the cluster.
 func (s *ManagedControlPlaneScope) SetBastionInstance(instance *infrav1.Instance) {
 	s.ControlPlane.Status.Bastion = instance
@@ -439,6 +452,11 @@ func (s *ManagedControlPlaneScope) DisableVPCCNI() bool {
 	return s.ControlPlane.Spec.VpcCni.Disable
 }
 
+// AutoModeEnabled returns whether EKS Auto Mode is enabled for the cluster.
+func (s *ManagedControlPlaneScope) AutoModeEnabled() bool {
+	return s.ControlPlane.Spec.AutoMode != nil && s.ControlPlane.Spec.AutoMode.Enabled
+}
+
 // BootstrapSelfManagedAddons returns whether the AWS EKS networking addons should be disabled.
 func (s *ManagedControlPlaneScope) BootstrapSelfManagedAddons() *bool {
 	return &s.ControlPlane.Spec.BootstrapSelfManagedAddons
@@ -496,6 +514,21 @@ func (s *ManagedControlPlaneScope) AdditionalNodeIngressRules() []infrav1.Ingres
 	return s.ControlPlane.Spec.NetworkSpec.DeepCopy().AdditionalNodeIngressRules
 }
 
+// AdditionalControlPlaneEgressRules returns the additional egress rules for the control plane security group.
+func (s *ManagedControlPlaneScope) AdditionalControlPlaneEgressRules() []infrav1.EgressRule {
+	return s.ControlPlane.Spec.NetworkSpec.DeepCopy().AdditionalControlPlaneEgressRules
+}
+
+// AdditionalNodeEgressRules returns the additional egress rules for the node security group.
+func (s *ManagedControlPlaneScope) AdditionalNodeEgressRules() []infrav1.EgressRule {
+	return s.ControlPlane.Spec.NetworkSpec.DeepCopy().AdditionalNodeEgressRules
+}
+
+// SecurityGroupEgressRules returns the egress rules declared for each security group role.
+func (s *ManagedControlPlaneScope) SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules {
+	return s.ControlPlane.Spec.NetworkSpec.DeepCopy().SecurityGroupEgressRules
+}
+
 // UnstructuredControlPlane returns the unstructured object for the control plane, if any.
 // When the reference is not set, it returns an empty object.
 func (s *ManagedControlPlaneScope) UnstructuredControlPlane() (*unstructured.Unstructured, error) {
@@ -29,6 +29,8 @@ type NetworkScope interface {
 	Network() *infrav1.NetworkStatus
 	// VPC returns the cluster VPC.
 	VPC() *infrav1.VPCSpec
+	// VPCPeerings returns the peering connections of the cluster VPC.
+	VPCPeerings() []infrav1.VPCPeeringSpec
 	// Subnets returns the cluster subnets.
 	Subnets() infrav1.Subnets
 	// SetSubnets updates the clusters subnets.
@@ -55,6 +57,9 @@ type NetworkScope interface {
 	// TagUnmanagedNetworkResources returns is tagging unmanaged network resources is set.
 	TagUnmanagedNetworkResources() bool
 
+	// UnmanagedVPCFlowLogs returns if the flow logs of unmanaged VPCs should be reconciled.
+	UnmanagedVPCFlowLogs() bool
+
 	// SetNatGatewaysIPs sets the Nat Gateways Public IPs.
 	SetNatGatewaysIPs(ips []string)
 	// GetNatGatewaysIPs gets the Nat Gateways Public IPs.
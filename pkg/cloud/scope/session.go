@@ -287,6 +287,18 @@ func buildProvidersForRef(
 
 		provider = identity.NewAWSRolePrincipalTypeProvider(roleIdentity, sourceProvider, region, log)
 		providers = append(providers, provider)
+	case infrav1.ClusterWebIdentityKind:
+		provider, err := buildAWSClusterWebIdentity(ctx, identityObjectKey, k8sClient, clusterScoper, region, log)
+		if err != nil {
+			return providers, err
+		}
+		providers = append(providers, provider)
+	case infrav1.ClusterRolesAnywhereIdentityKind:
+		provider, err := buildAWSClusterRolesAnywhereIdentity(ctx, identityObjectKey, k8sClient, clusterScoper, region, log)
+		if err != nil {
+			return providers, err
+		}
+		providers = append(providers, provider)
 	default:
 		return providers, errors.Errorf("No such provider known: '%s'", ref.Kind)
 	}
@@ -350,6 +362,100 @@ func buildAWSClusterStaticIdentity(ctx context.Context, identityObjectKey client
 	return identity.NewAWSStaticPrincipalTypeProvider(staticPrincipal, secret), nil
 }
 
+func buildAWSClusterWebIdentity(ctx context.Context, identityObjectKey client.ObjectKey, k8sClient client.Client, clusterScoper cloud.SessionMetadata, region string, log logger.Wrapper) (*identity.AWSWebIdentityPrincipalTypeProvider, error) {
+	webIdentity := &infrav1.AWSClusterWebIdentity{}
+	err := k8sClient.Get(ctx, identityObjectKey, webIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	canUse, err := isClusterPermittedToUsePrincipal(k8sClient, webIdentity.Spec.AllowedNamespaces, clusterScoper.Namespace())
+	if err != nil {
+		return nil, err
+	}
+	if !canUse {
+		setPrincipalUsageNotAllowedCondition(infrav1.ClusterWebIdentityKind, identityObjectKey, clusterScoper)
+		return nil, errors.Errorf(notPermittedError, infrav1.ClusterWebIdentityKind, identityObjectKey.Name)
+	}
+	setPrincipalUsageAllowedCondition(clusterScoper)
+
+	// A token file is read by the provider whenever credentials are renewed.
+	if webIdentity.Spec.TokenSecretRef == "" {
+		return identity.NewAWSWebIdentityPrincipalTypeProvider(webIdentity, nil, region, log), nil
+	}
+
+	secret := &corev1.Secret{}
+	err = k8sClient.Get(ctx, client.ObjectKey{Name: webIdentity.Spec.TokenSecretRef, Namespace: system.GetManagerNamespace()}, secret)
+	if err != nil {
+		return nil, err
+	}
+	token := secret.Data["token"]
+	if len(token) == 0 {
+		return nil, errors.Errorf("secret name:%s namespace:%s does not contain a web identity token", secret.Name, secret.Namespace)
+	}
+
+	// Set ClusterWebIdentity as Secret's owner reference for 'clusterctl move'.
+	patchHelper, err := v1beta1patch.NewHelper(secret, k8sClient)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to init patch helper for secret name:%s namespace:%s", secret.Name, secret.Namespace)
+	}
+
+	secret.OwnerReferences = util.EnsureOwnerRef(secret.OwnerReferences, metav1.OwnerReference{
+		APIVersion: infrav1.GroupVersion.String(),
+		Kind:       string(infrav1.ClusterWebIdentityKind),
+		Name:       webIdentity.Name,
+		UID:        webIdentity.UID,
+	})
+
+	if err := patchHelper.Patch(ctx, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to patch secret name:%s namespace:%s", secret.Name, secret.Namespace)
+	}
+
+	return identity.NewAWSWebIdentityPrincipalTypeProvider(webIdentity, token, region, log), nil
+}
+
+func buildAWSClusterRolesAnywhereIdentity(ctx context.Context, identityObjectKey client.ObjectKey, k8sClient client.Client, clusterScoper cloud.SessionMetadata, region string, log logger.Wrapper) (*identity.AWSRolesAnywherePrincipalTypeProvider, error) {
+	rolesAnywhereIdentity := &infrav1.AWSClusterRolesAnywhereIdentity{}
+	err := k8sClient.Get(ctx, identityObjectKey, rolesAnywhereIdentity)
+	if err != nil {
+		return nil, err
+	}
+	secret := &corev1.Secret{}
+	err = k8sClient.Get(ctx, client.ObjectKey{Name: rolesAnywhereIdentity.Spec.SecretRef, Namespace: system.GetManagerNamespace()}, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set ClusterRolesAnywhereIdentity as Secret's owner reference for 'clusterctl move'.
+	patchHelper, err := v1beta1patch.NewHelper(secret, k8sClient)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to init patch helper for secret name:%s namespace:%s", secret.Name, secret.Namespace)
+	}
+
+	secret.OwnerReferences = util.EnsureOwnerRef(secret.OwnerReferences, metav1.OwnerReference{
+		APIVersion: infrav1.GroupVersion.String(),
+		Kind:       string(infrav1.ClusterRolesAnywhereIdentityKind),
+		Name:       rolesAnywhereIdentity.Name,
+		UID:        rolesAnywhereIdentity.UID,
+	})
+
+	if err := patchHelper.Patch(ctx, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to patch secret name:%s namespace:%s", secret.Name, secret.Namespace)
+	}
+
+	canUse, err := isClusterPermittedToUsePrincipal(k8sClient, rolesAnywhereIdentity.Spec.AllowedNamespaces, clusterScoper.Namespace())
+	if err != nil {
+		return nil, err
+	}
+	if !canUse {
+		setPrincipalUsageNotAllowedCondition(infrav1.ClusterRolesAnywhereIdentityKind, identityObjectKey, clusterScoper)
+		return nil, errors.Errorf(notPermittedError, infrav1.ClusterRolesAnywhereIdentityKind, identityObjectKey.Name)
+	}
+	setPrincipalUsageAllowedCondition(clusterScoper)
+
+	return identity.NewAWSRolesAnywherePrincipalTypeProvider(rolesAnywhereIdentity, secret, region, log), nil
+}
+
 func buildAWSClusterControllerIdentity(ctx context.Context, identityObjectKey client.ObjectKey, k8sClient client.Client, clusterScoper cloud.SessionMetadata) error {
 	controllerIdentity := &infrav1.AWSClusterControllerIdentity{}
 	controllerIdentity.Kind = string(infrav1.ControllerIdentityKind)
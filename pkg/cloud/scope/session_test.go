@@ -477,6 +477,308 @@ func TestPrincipalParsing(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Can get a session for a web identity Principal with a token file",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster4",
+					Namespace: "default",
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "AWSCluster",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					IdentityRef: &infrav1.AWSIdentityReference{
+						Name: "web-identity",
+						Kind: infrav1.ClusterWebIdentityKind,
+					},
+				},
+			},
+			setup: func(t *testing.T, c client.Client) {
+				t.Helper()
+
+				identity := &infrav1.AWSClusterWebIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "web-identity",
+					},
+					Spec: infrav1.AWSClusterWebIdentitySpec{
+						AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+							AllowedNamespaces: &infrav1.AllowedNamespaces{},
+						},
+						AWSRoleSpec: infrav1.AWSRoleSpec{
+							RoleArn: "role-arn",
+						},
+						TokenFile: "/var/run/secrets/sts.amazonaws.com/serviceaccount/token",
+					},
+				}
+				identity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterWebIdentity"))
+				err := c.Create(context.Background(), identity)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(providers []identity.AWSPrincipalTypeProvider) {
+				if len(providers) != 1 {
+					t.Fatalf("Expected 1 providers, got %v", len(providers))
+				}
+				p, ok := providers[0].(*identity.AWSWebIdentityPrincipalTypeProvider)
+				if !ok {
+					t.Fatal("Expected providers to be of type AWSWebIdentityPrincipalTypeProvider")
+				}
+				if p.Principal.Spec.RoleArn != "role-arn" {
+					t.Fatal(errors.Errorf("Expected Web Identity Provider ARN to be 'role-arn', got '%s'", p.Principal.Spec.RoleArn))
+				}
+				if len(p.Token) != 0 {
+					t.Fatal("Expected the token to be read from the token file")
+				}
+			},
+		},
+		{
+			name: "Can get a session for a web identity Principal with a token secret",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster5",
+					Namespace: "default",
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "AWSCluster",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					IdentityRef: &infrav1.AWSIdentityReference{
+						Name: "web-identity",
+						Kind: infrav1.ClusterWebIdentityKind,
+					},
+				},
+			},
+			setup: func(t *testing.T, c client.Client) {
+				t.Helper()
+
+				identity := &infrav1.AWSClusterWebIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "web-identity",
+					},
+					Spec: infrav1.AWSClusterWebIdentitySpec{
+						AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+							AllowedNamespaces: &infrav1.AllowedNamespaces{},
+						},
+						AWSRoleSpec: infrav1.AWSRoleSpec{
+							RoleArn: "role-arn",
+						},
+						TokenSecretRef: "web-identity-token",
+					},
+				}
+				identity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterWebIdentity"))
+				err := c.Create(context.Background(), identity)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				tokenSecret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "web-identity-token",
+						Namespace: system.GetManagerNamespace(),
+					},
+					Data: map[string][]byte{
+						"token": []byte("web-identity-token-value"),
+					},
+				}
+				tokenSecret.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Kind: "Secret", Version: "v1"})
+				err = c.Create(context.Background(), tokenSecret)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(providers []identity.AWSPrincipalTypeProvider) {
+				if len(providers) != 1 {
+					t.Fatalf("Expected 1 providers, got %v", len(providers))
+				}
+				p, ok := providers[0].(*identity.AWSWebIdentityPrincipalTypeProvider)
+				if !ok {
+					t.Fatal("Expected providers to be of type AWSWebIdentityPrincipalTypeProvider")
+				}
+				if string(p.Token) != "web-identity-token-value" {
+					t.Fatalf("Expected Token to be '%s', got '%s'", "web-identity-token-value", string(p.Token))
+				}
+			},
+		},
+		{
+			name: "Cannot get a session for a web identity Principal from a namespace that is not allowed",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster6",
+					Namespace: "default",
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "AWSCluster",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					IdentityRef: &infrav1.AWSIdentityReference{
+						Name: "web-identity",
+						Kind: infrav1.ClusterWebIdentityKind,
+					},
+				},
+			},
+			setup: func(t *testing.T, c client.Client) {
+				t.Helper()
+
+				identity := &infrav1.AWSClusterWebIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "web-identity",
+					},
+					Spec: infrav1.AWSClusterWebIdentitySpec{
+						AWSRoleSpec: infrav1.AWSRoleSpec{
+							RoleArn: "role-arn",
+						},
+						TokenFile: "/var/run/secrets/sts.amazonaws.com/serviceaccount/token",
+					},
+				}
+				identity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterWebIdentity"))
+				err := c.Create(context.Background(), identity)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expectError: true,
+		},
+		{
+			name: "Can get a session for a role Principal with a Roles Anywhere source Principal",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster7",
+					Namespace: "default",
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "AWSCluster",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					IdentityRef: &infrav1.AWSIdentityReference{
+						Name: "role-identity",
+						Kind: infrav1.ClusterRoleIdentityKind,
+					},
+				},
+			},
+			setup: func(t *testing.T, c client.Client) {
+				t.Helper()
+
+				rolesAnywhereIdentity := &infrav1.AWSClusterRolesAnywhereIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "roles-anywhere-identity",
+					},
+					Spec: infrav1.AWSClusterRolesAnywhereIdentitySpec{
+						AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+							AllowedNamespaces: &infrav1.AllowedNamespaces{},
+						},
+						SecretRef:      "roles-anywhere-certificate",
+						TrustAnchorARN: "trust-anchor-arn",
+						ProfileARN:     "profile-arn",
+						RoleARN:        "source-role-arn",
+					},
+				}
+				rolesAnywhereIdentity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterRolesAnywhereIdentity"))
+				err := c.Create(context.Background(), rolesAnywhereIdentity)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				certificateSecret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "roles-anywhere-certificate",
+						Namespace: system.GetManagerNamespace(),
+					},
+					Data: map[string][]byte{
+						corev1.TLSCertKey:       []byte("certificate"),
+						corev1.TLSPrivateKeyKey: []byte("private-key"),
+					},
+				}
+				certificateSecret.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Kind: "Secret", Version: "v1"})
+				err = c.Create(context.Background(), certificateSecret)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				roleIdentity := &infrav1.AWSClusterRoleIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "role-identity",
+					},
+					Spec: infrav1.AWSClusterRoleIdentitySpec{
+						AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+							AllowedNamespaces: &infrav1.AllowedNamespaces{},
+						},
+						SourceIdentityRef: &infrav1.AWSIdentityReference{
+							Name: "roles-anywhere-identity",
+							Kind: infrav1.ClusterRolesAnywhereIdentityKind,
+						},
+						AWSRoleSpec: infrav1.AWSRoleSpec{
+							RoleArn: "role-arn",
+						},
+					},
+				}
+				roleIdentity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterRoleIdentity"))
+				err = c.Create(context.Background(), roleIdentity)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(providers []identity.AWSPrincipalTypeProvider) {
+				if len(providers) != 1 {
+					t.Fatalf("Expected 1 providers, got %v", len(providers))
+				}
+				p, ok := providers[0].(*identity.AWSRolePrincipalTypeProvider)
+				if !ok {
+					t.Fatal("Expected providers to be of type AWSRolePrincipalTypeProvider")
+				}
+				if p.Principal.Spec.RoleArn != "role-arn" {
+					t.Fatal(errors.Errorf("Expected Role Provider ARN to be 'role-arn', got '%s'", p.Principal.Spec.RoleArn))
+				}
+			},
+		},
+		{
+			name: "Cannot get a session for a Roles Anywhere Principal without its certificate secret",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster8",
+					Namespace: "default",
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "AWSCluster",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					IdentityRef: &infrav1.AWSIdentityReference{
+						Name: "roles-anywhere-identity",
+						Kind: infrav1.ClusterRolesAnywhereIdentityKind,
+					},
+				},
+			},
+			setup: func(t *testing.T, c client.Client) {
+				t.Helper()
+
+				identity := &infrav1.AWSClusterRolesAnywhereIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "roles-anywhere-identity",
+					},
+					Spec: infrav1.AWSClusterRolesAnywhereIdentitySpec{
+						AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+							AllowedNamespaces: &infrav1.AllowedNamespaces{},
+						},
+						SecretRef:      "roles-anywhere-certificate",
+						TrustAnchorARN: "trust-anchor-arn",
+						ProfileARN:     "profile-arn",
+						RoleARN:        "role-arn",
+					},
+				}
+				identity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterRolesAnywhereIdentity"))
+				err := c.Create(context.Background(), identity)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -60,6 +60,15 @@ type SGScope interface {
 	// AdditionalNodeIngressRules returns the additional ingress rules for the node security group.
 	AdditionalNodeIngressRules() []infrav1.IngressRule
 
+	// AdditionalControlPlaneEgressRules returns the additional egress rules for the control plane security group.
+	AdditionalControlPlaneEgressRules() []infrav1.EgressRule
+
+	// AdditionalNodeEgressRules returns the additional egress rules for the node security group.
+	AdditionalNodeEgressRules() []infrav1.EgressRule
+
+	// SecurityGroupEgressRules returns the egress rules declared for each security group role.
+	SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules
+
 	// ControlPlaneLoadBalancers returns both the ControlPlaneLoadBalancer and SecondaryControlPlaneLoadBalancer AWSLoadBalancerSpecs.
 	// The control plane load balancers should always be returned in the above order.
 	ControlPlaneLoadBalancers() []*infrav1.AWSLoadBalancerSpec
@@ -39,7 +39,6 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/utils"
-	"sigs.k8s.io/cluster-api/util/annotations"
 )
 
 // SDKToAutoScalingGroup converts an AWS EC2 SDK AutoScalingGroup to the CAPA AutoScalingGroup type.
@@ -68,7 +67,10 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 		}
 
 		for _, override := range v.MixedInstancesPolicy.LaunchTemplate.Overrides {
-			i.MixedInstancesPolicy.Overrides = append(i.MixedInstancesPolicy.Overrides, expinfrav1.Overrides{InstanceType: aws.ToString(override.InstanceType)})
+			i.MixedInstancesPolicy.Overrides = append(i.MixedInstancesPolicy.Overrides, expinfrav1.Overrides{
+				InstanceType:         aws.ToString(override.InstanceType),
+				InstanceRequirements: sdkToInstanceRequirements(override.InstanceRequirements),
+			})
 		}
 
 		onDemandAllocationStrategy := aws.ToString(v.MixedInstancesPolicy.InstancesDistribution.OnDemandAllocationStrategy)
@@ -115,6 +117,8 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 		}
 	}
 
+	i.WarmPool = sdkToWarmPool(v.WarmPoolConfiguration)
+
 	if len(v.SuspendedProcesses) > 0 {
 		currentlySuspendedProcesses := make([]string, len(v.SuspendedProcesses))
 		for i, service := range v.SuspendedProcesses {
@@ -150,12 +154,25 @@ func (s *Service) ASGIfExists(name *string) (*expinfrav1.AutoScalingGroup, error
 		record.Eventf(s.scope.InfraCluster(), expinfrav1.ASGNotFoundReason, "Unable to find ASG matching %q", *name)
 		return nil, nil
 	}
-	return s.SDKToAutoScalingGroup(&out.AutoScalingGroups[0])
+
+	asg, err := s.SDKToAutoScalingGroup(&out.AutoScalingGroups[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if out.AutoScalingGroups[0].WarmPoolConfiguration != nil {
+		asg.WarmPoolInstances, err = s.describeWarmPoolInstances(context.TODO(), *name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return asg, nil
 }
 
 // GetASGByName returns the existing ASG or nothing if it doesn't exist.
 func (s *Service) GetASGByName(scope *scope.MachinePoolScope) (*expinfrav1.AutoScalingGroup, error) {
-	name := scope.Name()
+	name := scope.ASGName()
 	return s.ASGIfExists(&name)
 }
 
@@ -166,9 +183,6 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 		return nil, fmt.Errorf("getting subnets for ASG: %w", err)
 	}
 
-	name := machinePoolScope.Name()
-	s.scope.Info("Creating ASG", "name", name)
-
 	// Default value of MachinePool replicas set by CAPI is 1.
 	mpReplicas := *machinePoolScope.MachinePool.Spec.Replicas
 	var desiredCapacity *int32
@@ -177,12 +191,39 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 	// Ignore the problem for externally managed clusters because MachinePool replicas will be updated to the right value automatically.
 	if mpReplicas >= machinePoolScope.AWSMachinePool.Spec.MinSize && mpReplicas <= machinePoolScope.AWSMachinePool.Spec.MaxSize {
 		desiredCapacity = &mpReplicas
-	} else if !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	} else if !machinePoolScope.ReplicasExternallyManaged() {
 		return nil, fmt.Errorf("incorrect number of replicas %d in MachinePool %v", mpReplicas, machinePoolScope.MachinePool.Name)
 	}
 
+	if err := s.createASG(machinePoolScope, machinePoolScope.ASGName(), subnets, desiredCapacity, machinePoolScope.ASGLaunchTemplateVersion()); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// CreateReplacementASG creates an ASG with the given name and desired capacity, to replace the current ASG
+// of the pool with the BlueGreen strategy. The ASG is pinned to the launch template version the replacement
+// was started for, so a version created in the meantime doesn't end up in the ASG without being rolled out.
+func (s *Service) CreateReplacementASG(machinePoolScope *scope.MachinePoolScope, name string, desiredCapacity int32) error {
+	blueGreen := machinePoolScope.AWSMachinePool.Status.BlueGreen
+	if blueGreen == nil || blueGreen.LaunchTemplateVersion == "" {
+		return errors.New("AWSMachinePool has no launch template version for the replacement ASG")
+	}
+
+	subnets, err := s.SubnetIDs(machinePoolScope)
+	if err != nil {
+		return fmt.Errorf("getting subnets for ASG: %w", err)
+	}
+
+	return s.createASG(machinePoolScope, name, subnets, &desiredCapacity, blueGreen.LaunchTemplateVersion)
+}
+
+func (s *Service) createASG(machinePoolScope *scope.MachinePoolScope, name string, subnets []string, desiredCapacity *int32, launchTemplateVersion string) error {
+	s.scope.Info("Creating ASG", "name", name)
+
 	if machinePoolScope.AWSMachinePool.Status.LaunchTemplateID == "" {
-		return nil, errors.New("AWSMachinePool has no LaunchTemplateID for some reason")
+		return errors.New("AWSMachinePool has no LaunchTemplateID for some reason")
 	}
 
 	// Make sure to use the MachinePoolScope here to get the merger of AWSCluster and AWSMachinePool tags
@@ -206,11 +247,11 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 	}
 
 	if machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy != nil {
-		input.MixedInstancesPolicy = createSDKMixedInstancesPolicy(name, machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy)
+		input.MixedInstancesPolicy = createSDKMixedInstancesPolicy(machinePoolScope.LaunchTemplateName(), launchTemplateVersion, machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy)
 	} else {
 		input.LaunchTemplate = &autoscalingtypes.LaunchTemplateSpecification{
 			LaunchTemplateId: aws.String(machinePoolScope.AWSMachinePool.Status.LaunchTemplateID),
-			Version:          aws.String(expinfrav1.LaunchTemplateLatestVersion),
+			Version:          aws.String(launchTemplateVersion),
 		}
 	}
 
@@ -224,12 +265,12 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 
 	if _, err := s.ASGClient.CreateAutoScalingGroup(context.TODO(), input); err != nil {
 		s.scope.Error(err, "unable to create AutoScalingGroup")
-		return nil, errors.Wrap(err, "failed to create autoscaling group")
+		return errors.Wrap(err, "failed to create autoscaling group")
 	}
 
-	record.Eventf(machinePoolScope.AWSMachinePool, "SuccessfulCreate", "Created new ASG: %s", machinePoolScope.Name())
+	record.Eventf(machinePoolScope.AWSMachinePool, "SuccessfulCreate", "Created new ASG: %s", name)
 
-	return nil, nil
+	return nil
 }
 
 // DeleteASGAndWait will delete an ASG and wait until it is deleted.
@@ -270,6 +311,36 @@ func (s *Service) DeleteASG(name string) error {
 	return nil
 }
 
+// DetachASGInstances detaches instances from an ASG and decrements its desired capacity accordingly, so the
+// instances are not replaced. The minimum size of the ASG is set to zero first, so it can be scaled down
+// to no instances at all.
+func (s *Service) DetachASGInstances(name string, instanceIDs []string) error {
+	// DetachInstances accepts up to 20 instances per call.
+	const maxInstancesPerCall = 20
+
+	if _, err := s.ASGClient.UpdateAutoScalingGroup(context.TODO(), &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(name),
+		MinSize:              aws.Int32(0),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to set the minimum size of ASG %q to 0", name)
+	}
+
+	for start := 0; start < len(instanceIDs); start += maxInstancesPerCall {
+		end := min(start+maxInstancesPerCall, len(instanceIDs))
+		input := &autoscaling.DetachInstancesInput{
+			AutoScalingGroupName:           aws.String(name),
+			InstanceIds:                    instanceIDs[start:end],
+			ShouldDecrementDesiredCapacity: aws.Bool(true),
+		}
+		if _, err := s.ASGClient.DetachInstances(context.TODO(), input); err != nil {
+			return errors.Wrapf(err, "failed to detach instances from ASG %q", name)
+		}
+	}
+
+	s.scope.Debug("Detached instances from ASG", "name", name, "instances", instanceIDs)
+	return nil
+}
+
 // UpdateASG will update the ASG of a service.
 func (s *Service) UpdateASG(machinePoolScope *scope.MachinePoolScope) error {
 	subnetIDs, err := s.SubnetIDs(machinePoolScope)
@@ -278,28 +349,28 @@ func (s *Service) UpdateASG(machinePoolScope *scope.MachinePoolScope) error {
 	}
 
 	input := &autoscaling.UpdateAutoScalingGroupInput{
-		AutoScalingGroupName: aws.String(machinePoolScope.Name()), // TODO: define dynamically - borrow logic from ec2
+		AutoScalingGroupName: aws.String(machinePoolScope.ASGName()),
 		MaxSize:              aws.Int32(machinePoolScope.AWSMachinePool.Spec.MaxSize),
 		MinSize:              aws.Int32(machinePoolScope.AWSMachinePool.Spec.MinSize),
 		VPCZoneIdentifier:    aws.String(strings.Join(subnetIDs, ",")),
 		CapacityRebalance:    aws.Bool(machinePoolScope.AWSMachinePool.Spec.CapacityRebalance),
 	}
 
-	if machinePoolScope.MachinePool.Spec.Replicas != nil && !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	if machinePoolScope.MachinePool.Spec.Replicas != nil && !machinePoolScope.ReplicasExternallyManaged() {
 		input.DesiredCapacity = aws.Int32(*machinePoolScope.MachinePool.Spec.Replicas)
 	}
 
 	if machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy != nil {
-		input.MixedInstancesPolicy = createSDKMixedInstancesPolicy(machinePoolScope.Name(), machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy)
+		input.MixedInstancesPolicy = createSDKMixedInstancesPolicy(machinePoolScope.LaunchTemplateName(), machinePoolScope.ASGLaunchTemplateVersion(), machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy)
 	} else {
 		input.LaunchTemplate = &autoscalingtypes.LaunchTemplateSpecification{
 			LaunchTemplateId: aws.String(machinePoolScope.AWSMachinePool.Status.LaunchTemplateID),
-			Version:          aws.String(expinfrav1.LaunchTemplateLatestVersion),
+			Version:          aws.String(machinePoolScope.ASGLaunchTemplateVersion()),
 		}
 	}
 
 	if _, err := s.ASGClient.UpdateAutoScalingGroup(context.TODO(), input); err != nil {
-		return errors.Wrapf(err, "failed to update ASG %q", machinePoolScope.Name())
+		return errors.Wrapf(err, "failed to update ASG %q", machinePoolScope.ASGName())
 	}
 
 	return nil
@@ -307,7 +378,7 @@ func (s *Service) UpdateASG(machinePoolScope *scope.MachinePoolScope) error {
 
 // CanStartASGInstanceRefresh checks if a new ASG instance refresh can currently be started, and returns the status if there is an existing, unfinished refresh.
 func (s *Service) CanStartASGInstanceRefresh(scope *scope.MachinePoolScope) (bool, *autoscalingtypes.InstanceRefreshStatus, error) {
-	describeInput := &autoscaling.DescribeInstanceRefreshesInput{AutoScalingGroupName: aws.String(scope.Name())}
+	describeInput := &autoscaling.DescribeInstanceRefreshesInput{AutoScalingGroupName: aws.String(scope.ASGName())}
 	refreshes, err := s.ASGClient.DescribeInstanceRefreshes(context.TODO(), describeInput)
 	if err != nil {
 		return false, nil, err
@@ -330,7 +401,7 @@ func (s *Service) CanStartASGInstanceRefresh(scope *scope.MachinePoolScope) (boo
 // CancelASGInstanceRefresh cancels an ASG instance refresh.
 func (s *Service) CancelASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 	input := &autoscaling.CancelInstanceRefreshInput{
-		AutoScalingGroupName: aws.String(scope.Name()),
+		AutoScalingGroupName: aws.String(scope.ASGName()),
 	}
 
 	if _, err := s.ASGClient.CancelInstanceRefresh(context.TODO(), input); err != nil {
@@ -342,7 +413,7 @@ func (s *Service) CancelASGInstanceRefresh(scope *scope.MachinePoolScope) error
 			return nil
 		}
 
-		return errors.Wrapf(err, "failed to cancel ASG instance refresh %q", scope.Name())
+		return errors.Wrapf(err, "failed to cancel ASG instance refresh %q", scope.ASGName())
 	}
 
 	return nil
@@ -368,7 +439,7 @@ func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 	}
 
 	input := &autoscaling.StartInstanceRefreshInput{
-		AutoScalingGroupName: aws.String(scope.Name()),
+		AutoScalingGroupName: aws.String(scope.ASGName()),
 		Strategy:             *strategy,
 		Preferences: &autoscalingtypes.RefreshPreferences{
 			InstanceWarmup:       instanceWarmup,
@@ -378,18 +449,18 @@ func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 	}
 
 	if _, err := s.ASGClient.StartInstanceRefresh(context.TODO(), input); err != nil {
-		return errors.Wrapf(err, "failed to start ASG instance refresh %q", scope.Name())
+		return errors.Wrapf(err, "failed to start ASG instance refresh %q", scope.ASGName())
 	}
 
 	return nil
 }
 
-func createSDKMixedInstancesPolicy(name string, i *expinfrav1.MixedInstancesPolicy) *autoscalingtypes.MixedInstancesPolicy {
+func createSDKMixedInstancesPolicy(name, version string, i *expinfrav1.MixedInstancesPolicy) *autoscalingtypes.MixedInstancesPolicy {
 	mixedInstancesPolicy := &autoscalingtypes.MixedInstancesPolicy{
 		LaunchTemplate: &autoscalingtypes.LaunchTemplate{
 			LaunchTemplateSpecification: &autoscalingtypes.LaunchTemplateSpecification{
 				LaunchTemplateName: aws.String(name),
-				Version:            aws.String(expinfrav1.LaunchTemplateLatestVersion),
+				Version:            aws.String(version),
 			},
 		},
 	}
@@ -404,14 +475,106 @@ func createSDKMixedInstancesPolicy(name string, i *expinfrav1.MixedInstancesPoli
 	}
 
 	for _, override := range i.Overrides {
-		mixedInstancesPolicy.LaunchTemplate.Overrides = append(mixedInstancesPolicy.LaunchTemplate.Overrides, autoscalingtypes.LaunchTemplateOverrides{
-			InstanceType: aws.String(override.InstanceType),
-		})
+		sdkOverride := autoscalingtypes.LaunchTemplateOverrides{
+			InstanceRequirements: createSDKInstanceRequirements(override.InstanceRequirements),
+		}
+		if override.InstanceType != "" {
+			sdkOverride.InstanceType = aws.String(override.InstanceType)
+		}
+		mixedInstancesPolicy.LaunchTemplate.Overrides = append(mixedInstancesPolicy.LaunchTemplate.Overrides, sdkOverride)
 	}
 
 	return mixedInstancesPolicy
 }
 
+func createSDKInstanceRequirements(r *expinfrav1.InstanceRequirements) *autoscalingtypes.InstanceRequirements {
+	if r == nil {
+		return nil
+	}
+
+	req := &autoscalingtypes.InstanceRequirements{
+		VCpuCount: &autoscalingtypes.VCpuCountRequest{
+			Min: aws.Int32(r.VCPUCount.Min),
+			Max: r.VCPUCount.Max,
+		},
+		MemoryMiB: &autoscalingtypes.MemoryMiBRequest{
+			Min: aws.Int32(r.MemoryMiB.Min),
+			Max: r.MemoryMiB.Max,
+		},
+		BurstablePerformance:                      autoscalingtypes.BurstablePerformance(r.BurstablePerformance),
+		ExcludedInstanceTypes:                     r.ExcludedInstanceTypes,
+		SpotMaxPricePercentageOverLowestPrice:     r.SpotMaxPricePercentageOverLowestPrice,
+		OnDemandMaxPricePercentageOverLowestPrice: r.OnDemandMaxPricePercentageOverLowestPrice,
+	}
+
+	for _, m := range r.CPUManufacturers {
+		req.CpuManufacturers = append(req.CpuManufacturers, autoscalingtypes.CpuManufacturer(m))
+	}
+	for _, t := range r.AcceleratorTypes {
+		req.AcceleratorTypes = append(req.AcceleratorTypes, autoscalingtypes.AcceleratorType(t))
+	}
+	for _, m := range r.AcceleratorManufacturers {
+		req.AcceleratorManufacturers = append(req.AcceleratorManufacturers, autoscalingtypes.AcceleratorManufacturer(m))
+	}
+	if r.AcceleratorCount != nil {
+		req.AcceleratorCount = &autoscalingtypes.AcceleratorCountRequest{
+			Min: aws.Int32(r.AcceleratorCount.Min),
+			Max: r.AcceleratorCount.Max,
+		}
+	}
+	for _, g := range r.InstanceGenerations {
+		req.InstanceGenerations = append(req.InstanceGenerations, autoscalingtypes.InstanceGeneration(g))
+	}
+
+	return req
+}
+
+func sdkToInstanceRequirements(r *autoscalingtypes.InstanceRequirements) *expinfrav1.InstanceRequirements {
+	if r == nil {
+		return nil
+	}
+
+	i := &expinfrav1.InstanceRequirements{
+		BurstablePerformance:                      expinfrav1.BurstablePerformance(r.BurstablePerformance),
+		ExcludedInstanceTypes:                     r.ExcludedInstanceTypes,
+		SpotMaxPricePercentageOverLowestPrice:     r.SpotMaxPricePercentageOverLowestPrice,
+		OnDemandMaxPricePercentageOverLowestPrice: r.OnDemandMaxPricePercentageOverLowestPrice,
+	}
+
+	if r.VCpuCount != nil {
+		i.VCPUCount = expinfrav1.InstanceRequirementsRange{
+			Min: aws.ToInt32(r.VCpuCount.Min),
+			Max: r.VCpuCount.Max,
+		}
+	}
+	if r.MemoryMiB != nil {
+		i.MemoryMiB = expinfrav1.InstanceRequirementsRange{
+			Min: aws.ToInt32(r.MemoryMiB.Min),
+			Max: r.MemoryMiB.Max,
+		}
+	}
+	for _, m := range r.CpuManufacturers {
+		i.CPUManufacturers = append(i.CPUManufacturers, expinfrav1.CPUManufacturer(m))
+	}
+	for _, t := range r.AcceleratorTypes {
+		i.AcceleratorTypes = append(i.AcceleratorTypes, expinfrav1.AcceleratorType(t))
+	}
+	for _, m := range r.AcceleratorManufacturers {
+		i.AcceleratorManufacturers = append(i.AcceleratorManufacturers, expinfrav1.AcceleratorManufacturer(m))
+	}
+	if r.AcceleratorCount != nil {
+		i.AcceleratorCount = &expinfrav1.InstanceRequirementsRange{
+			Min: aws.ToInt32(r.AcceleratorCount.Min),
+			Max: r.AcceleratorCount.Max,
+		}
+	}
+	for _, g := range r.InstanceGenerations {
+		i.InstanceGenerations = append(i.InstanceGenerations, expinfrav1.InstanceGeneration(g))
+	}
+
+	return i
+}
+
 // BuildTagsFromMap takes a map of keys and values and returns them as autoscaling group tags.
 func BuildTagsFromMap(asgName string, inTags map[string]string) []autoscalingtypes.Tag {
 	if inTags == nil {
@@ -334,6 +334,52 @@ func TestServiceSDKToAutoScalingGroup(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid input - instance requirements overrides",
+			input: &autoscalingtypes.AutoScalingGroup{
+				DesiredCapacity: aws.Int32(1234),
+				MaxSize:         aws.Int32(1234),
+				MinSize:         aws.Int32(1234),
+				MixedInstancesPolicy: &autoscalingtypes.MixedInstancesPolicy{
+					InstancesDistribution: &autoscalingtypes.InstancesDistribution{
+						OnDemandAllocationStrategy: aws.String("prioritized"),
+						SpotAllocationStrategy:     aws.String("lowest-price"),
+					},
+					LaunchTemplate: &autoscalingtypes.LaunchTemplate{
+						Overrides: []autoscalingtypes.LaunchTemplateOverrides{
+							{
+								InstanceRequirements: &autoscalingtypes.InstanceRequirements{
+									VCpuCount:        &autoscalingtypes.VCpuCountRequest{Min: aws.Int32(2), Max: aws.Int32(8)},
+									MemoryMiB:        &autoscalingtypes.MemoryMiBRequest{Min: aws.Int32(4096)},
+									CpuManufacturers: []autoscalingtypes.CpuManufacturer{autoscalingtypes.CpuManufacturerIntel},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &expinfrav1.AutoScalingGroup{
+				DesiredCapacity: aws.Int32(1234),
+				MaxSize:         int32(1234),
+				MinSize:         int32(1234),
+				MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
+					InstancesDistribution: &expinfrav1.InstancesDistribution{
+						OnDemandAllocationStrategy: expinfrav1.OnDemandAllocationStrategyPrioritized,
+						SpotAllocationStrategy:     expinfrav1.SpotAllocationStrategyLowestPrice,
+					},
+					Overrides: []expinfrav1.Overrides{
+						{
+							InstanceRequirements: &expinfrav1.InstanceRequirements{
+								VCPUCount:        expinfrav1.InstanceRequirementsRange{Min: 2, Max: aws.Int32(8)},
+								MemoryMiB:        expinfrav1.InstanceRequirementsRange{Min: 4096},
+								CPUManufacturers: []expinfrav1.CPUManufacturer{expinfrav1.CPUManufacturerIntel},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid input - incorrect spot allocation strategy",
 			input: &autoscalingtypes.AutoScalingGroup{
@@ -450,6 +496,41 @@ func TestServiceASGIfExists(t *testing.T) {
 						}}, nil)
 			},
 		},
+		{
+			name:    "should return ASG with its warm pool instances, if it has a warm pool",
+			asgName: aws.String("asgName"),
+			wantErr: false,
+			wantASG: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeAutoScalingGroups(context.TODO(), gomock.Eq(&autoscaling.DescribeAutoScalingGroupsInput{
+					AutoScalingGroupNames: []string{
+						"asgName",
+					},
+				})).
+					Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+						AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{
+							{
+								AutoScalingGroupName: aws.String("asgName"),
+								WarmPoolConfiguration: &autoscalingtypes.WarmPoolConfiguration{
+									MinSize:   aws.Int32(1),
+									PoolState: autoscalingtypes.WarmPoolStateStopped,
+								},
+							},
+						}}, nil)
+				m.DescribeWarmPool(context.TODO(), gomock.Eq(&autoscaling.DescribeWarmPoolInput{
+					AutoScalingGroupName: aws.String("asgName"),
+				}), gomock.Any()).
+					Return(&autoscaling.DescribeWarmPoolOutput{
+						Instances: []autoscalingtypes.Instance{
+							{
+								InstanceId:       aws.String("i-warm"),
+								AvailabilityZone: aws.String("us-east-1a"),
+								LifecycleState:   autoscalingtypes.LifecycleStateWarmedStopped,
+							},
+						},
+					}, nil)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -701,6 +782,73 @@ func TestServiceCreateASG(t *testing.T) {
 	}
 }
 
+func TestServiceCreateReplacementASG(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	tests := []struct {
+		name                  string
+		setupMachinePoolScope func(*scope.MachinePoolScope)
+		wantErr               bool
+		expect                func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name: "should pin the replacement ASG to the launch template version of the replacement",
+			setupMachinePoolScope: func(mps *scope.MachinePoolScope) {
+				mps.AWSMachinePool.Spec.MixedInstancesPolicy = nil
+				mps.AWSMachinePool.Status.BlueGreen = &expinfrav1.BlueGreenStatus{
+					Phase:                         expinfrav1.BlueGreenPhaseScalingUp,
+					ASGName:                       "replacement-asg-3",
+					LaunchTemplateVersion:         "3",
+					PreviousLaunchTemplateVersion: "2",
+				}
+			},
+			wantErr: false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.CreateAutoScalingGroup(context.TODO(), gomock.AssignableToTypeOf(&autoscaling.CreateAutoScalingGroupInput{})).Do(
+					func(ctx context.Context, actual *autoscaling.CreateAutoScalingGroupInput, requestOptions ...autoscaling.Options) (*autoscaling.CreateAutoScalingGroupOutput, error) {
+						if aws.ToString(actual.AutoScalingGroupName) != "replacement-asg-3" {
+							t.Fatalf("Actual AutoScalingGroupName did not match expected, Actual: %s, Expected: replacement-asg-3", aws.ToString(actual.AutoScalingGroupName))
+						}
+						if aws.ToInt32(actual.DesiredCapacity) != 3 {
+							t.Fatalf("Actual DesiredCapacity did not match expected, Actual: %d, Expected: 3", aws.ToInt32(actual.DesiredCapacity))
+						}
+						if actual.LaunchTemplate == nil || aws.ToString(actual.LaunchTemplate.Version) != "3" {
+							t.Fatalf("Actual LaunchTemplate did not match expected, Actual: %v, Expected version: 3", actual.LaunchTemplate)
+						}
+						return &autoscaling.CreateAutoScalingGroupOutput{}, nil
+					})
+			},
+		},
+		{
+			name:                  "should return error if no replacement is in progress",
+			setupMachinePoolScope: func(mps *scope.MachinePoolScope) {},
+			wantErr:               true,
+			expect:                func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			mps, err := getMachinePoolScope(fakeClient, clusterScope)
+			g.Expect(err).ToNot(HaveOccurred())
+			mps.AWSMachinePool.Name = "replacement-asg"
+
+			tt.setupMachinePoolScope(mps)
+			err = s.CreateReplacementASG(mps, "replacement-asg-3", 3)
+			checkErr(tt.wantErr, err, g)
+		})
+	}
+}
+
 func TestServiceUpdateASG(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -764,6 +912,29 @@ func TestServiceUpdateASG(t *testing.T) {
 				})
 			},
 		},
+		{
+			name:            "ASG being replaced with the BlueGreen strategy",
+			machinePoolName: "update-asg-blue-green",
+			wantErr:         false,
+			setupMachinePoolScope: func(mps *scope.MachinePoolScope) {
+				mps.AWSMachinePool.Spec.MixedInstancesPolicy = nil
+				mps.AWSMachinePool.Status.ASGName = "update-asg-blue-green-3"
+				mps.AWSMachinePool.Status.BlueGreen = &expinfrav1.BlueGreenStatus{
+					Phase:                         expinfrav1.BlueGreenPhaseScalingUp,
+					ASGName:                       "update-asg-blue-green-5",
+					LaunchTemplateVersion:         "5",
+					PreviousLaunchTemplateVersion: "3",
+				}
+			},
+			expect: func(e *mocks.MockEC2APIMockRecorder, m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder, g *WithT) {
+				m.UpdateAutoScalingGroup(context.TODO(), gomock.AssignableToTypeOf(&autoscaling.UpdateAutoScalingGroupInput{})).DoAndReturn(func(ctx context.Context, input *autoscaling.UpdateAutoScalingGroupInput, options ...autoscaling.Options) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+					// The current ASG is updated, pinned to the launch template version it runs
+					g.Expect(input.AutoScalingGroupName).To(BeComparableTo(ptr.To("update-asg-blue-green-3")))
+					g.Expect(input.LaunchTemplate.Version).To(BeComparableTo(ptr.To("3")))
+					return &autoscaling.UpdateAutoScalingGroupOutput{}, nil
+				})
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1045,6 +1216,60 @@ func TestServiceDeleteASG(t *testing.T) {
 	}
 }
 
+func TestServiceDetachASGInstances(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name    string
+		wantErr bool
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name:    "Detach instances successful",
+			wantErr: false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.UpdateAutoScalingGroup(context.TODO(), gomock.Eq(&autoscaling.UpdateAutoScalingGroupInput{
+					AutoScalingGroupName: aws.String("asgName"),
+					MinSize:              aws.Int32(0),
+				})).
+					Return(&autoscaling.UpdateAutoScalingGroupOutput{}, nil)
+				m.DetachInstances(context.TODO(), gomock.Eq(&autoscaling.DetachInstancesInput{
+					AutoScalingGroupName:           aws.String("asgName"),
+					InstanceIds:                    []string{"i-1", "i-2"},
+					ShouldDecrementDesiredCapacity: aws.Bool(true),
+				})).
+					Return(&autoscaling.DetachInstancesOutput{}, nil)
+			},
+		},
+		{
+			name:    "Detach instances should fail when the minimum size cannot be updated",
+			wantErr: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.UpdateAutoScalingGroup(context.TODO(), gomock.Any()).
+					Return(nil, awserrors.NewNotFound("not found"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			err = s.DetachASGInstances("asgName", []string{"i-1", "i-2"})
+			checkErr(tt.wantErr, err, g)
+		})
+	}
+}
+
 func TestServiceDeleteASGAndWait(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
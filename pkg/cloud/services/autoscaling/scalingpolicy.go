@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"math"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	deprecatedv1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
+)
+
+// defaultSchedulingBufferTime is the scheduling buffer time AWS uses for a predictive scaling policy without one.
+const defaultSchedulingBufferTime = 300
+
+// DescribeScalingPolicies returns the scaling policies for the given AutoScalingGroup after retrieving them from the AWS API.
+func (s *Service) DescribeScalingPolicies(ctx context.Context, asgName string) ([]*expinfrav1.ScalingPolicy, error) {
+	input := &autoscaling.DescribePoliciesInput{
+		AutoScalingGroupName: ptr.To(asgName),
+	}
+
+	var policies []*expinfrav1.ScalingPolicy
+	paginator := autoscaling.NewDescribePoliciesPaginator(s.ASGClient, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe scaling policies for AutoScalingGroup: %q", asgName)
+		}
+		for _, policy := range out.ScalingPolicies {
+			policies = append(policies, SDKToScalingPolicy(policy))
+		}
+	}
+
+	return policies, nil
+}
+
+// PutScalingPolicy creates or updates a scaling policy for the given AutoScalingGroup.
+func (s *Service) PutScalingPolicy(ctx context.Context, asgName string, policy *expinfrav1.ScalingPolicy) error {
+	input := &autoscaling.PutScalingPolicyInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		PolicyName:           ptr.To(policy.Name),
+		PolicyType:           ptr.To(string(policy.PolicyType)),
+	}
+
+	if tt := policy.TargetTracking; tt != nil {
+		input.TargetTrackingConfiguration = &autoscalingtypes.TargetTrackingConfiguration{
+			PredefinedMetricSpecification: &autoscalingtypes.PredefinedMetricSpecification{
+				PredefinedMetricType: autoscalingtypes.MetricType(tt.PredefinedMetricType),
+				ResourceLabel:        optionalString(tt.ResourceLabel),
+			},
+			TargetValue:    aws.Float64(float64(tt.TargetValue)),
+			DisableScaleIn: aws.Bool(tt.DisableScaleIn),
+		}
+	}
+
+	if p := policy.Predictive; p != nil {
+		predictive := normalizeScalingPolicy(policy).Predictive
+		input.PredictiveScalingConfiguration = &autoscalingtypes.PredictiveScalingConfiguration{
+			MetricSpecifications: []autoscalingtypes.PredictiveScalingMetricSpecification{
+				{
+					PredefinedMetricPairSpecification: &autoscalingtypes.PredictiveScalingPredefinedMetricPair{
+						PredefinedMetricType: autoscalingtypes.PredefinedMetricPairType(p.PredefinedMetricType),
+						ResourceLabel:        optionalString(p.ResourceLabel),
+					},
+					TargetValue: aws.Float64(float64(p.TargetValue)),
+				},
+			},
+			Mode:                      autoscalingtypes.PredictiveScalingMode(predictive.Mode),
+			SchedulingBufferTime:      predictive.SchedulingBufferTime,
+			MaxCapacityBreachBehavior: autoscalingtypes.PredictiveScalingMaxCapacityBreachBehaviorHonorMaxCapacity,
+		}
+		if p.MaxCapacityBuffer != nil {
+			input.PredictiveScalingConfiguration.MaxCapacityBreachBehavior = autoscalingtypes.PredictiveScalingMaxCapacityBreachBehaviorIncreaseMaxCapacity
+			input.PredictiveScalingConfiguration.MaxCapacityBuffer = p.MaxCapacityBuffer
+		}
+	}
+
+	if _, err := s.ASGClient.PutScalingPolicy(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to put scaling policy %q for AutoScalingGroup: %q", policy.Name, asgName)
+	}
+
+	return nil
+}
+
+// DeleteScalingPolicy deletes a scaling policy for the given AutoScalingGroup.
+func (s *Service) DeleteScalingPolicy(ctx context.Context, asgName string, policyName string) error {
+	input := &autoscaling.DeletePolicyInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		PolicyName:           ptr.To(policyName),
+	}
+
+	if _, err := s.ASGClient.DeletePolicy(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to delete scaling policy %q for AutoScalingGroup: %q", policyName, asgName)
+	}
+
+	return nil
+}
+
+// SDKToScalingPolicy converts an AWS SDK ScalingPolicy to the CAPA scaling policy type. Only the settings
+// CAPA manages are converted, so policies using other settings show up as drifted.
+func SDKToScalingPolicy(policy autoscalingtypes.ScalingPolicy) *expinfrav1.ScalingPolicy {
+	ret := &expinfrav1.ScalingPolicy{
+		Name:       aws.ToString(policy.PolicyName),
+		PolicyType: expinfrav1.ScalingPolicyType(aws.ToString(policy.PolicyType)),
+	}
+
+	if tt := policy.TargetTrackingConfiguration; tt != nil && tt.PredefinedMetricSpecification != nil {
+		ret.TargetTracking = &expinfrav1.TargetTrackingConfiguration{
+			PredefinedMetricType: expinfrav1.TargetTrackingMetricType(tt.PredefinedMetricSpecification.PredefinedMetricType),
+			ResourceLabel:        aws.ToString(tt.PredefinedMetricSpecification.ResourceLabel),
+			TargetValue:          int32(math.Round(aws.ToFloat64(tt.TargetValue))),
+			DisableScaleIn:       aws.ToBool(tt.DisableScaleIn),
+		}
+	}
+
+	if p := policy.PredictiveScalingConfiguration; p != nil && len(p.MetricSpecifications) == 1 && p.MetricSpecifications[0].PredefinedMetricPairSpecification != nil {
+		spec := p.MetricSpecifications[0]
+		ret.Predictive = &expinfrav1.PredictiveScalingConfiguration{
+			PredefinedMetricType: expinfrav1.PredictiveScalingMetricType(spec.PredefinedMetricPairSpecification.PredefinedMetricType),
+			ResourceLabel:        aws.ToString(spec.PredefinedMetricPairSpecification.ResourceLabel),
+			TargetValue:          int32(math.Round(aws.ToFloat64(spec.TargetValue))),
+			Mode:                 expinfrav1.PredictiveScalingMode(p.Mode),
+			SchedulingBufferTime: p.SchedulingBufferTime,
+		}
+		if p.MaxCapacityBreachBehavior == autoscalingtypes.PredictiveScalingMaxCapacityBreachBehaviorIncreaseMaxCapacity {
+			ret.Predictive.MaxCapacityBuffer = ptr.To(ptr.Deref(p.MaxCapacityBuffer, 0))
+		}
+	}
+
+	return ret
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return ptr.To(s)
+}
+
+// normalizeScalingPolicy returns a copy of the policy with the AWS defaults applied.
+func normalizeScalingPolicy(policy *expinfrav1.ScalingPolicy) *expinfrav1.ScalingPolicy {
+	ret := policy.DeepCopy()
+	if p := ret.Predictive; p != nil {
+		if p.Mode == "" {
+			p.Mode = expinfrav1.PredictiveScalingModeForecastOnly
+		}
+		if p.SchedulingBufferTime == nil {
+			p.SchedulingBufferTime = ptr.To[int32](defaultSchedulingBufferTime)
+		}
+	}
+	return ret
+}
+
+func scalingPolicyNeedsUpdate(existing *expinfrav1.ScalingPolicy, expected *expinfrav1.ScalingPolicy) bool {
+	return !cmp.Equal(normalizeScalingPolicy(existing), normalizeScalingPolicy(expected))
+}
+
+// ReconcileScalingPolicies reconciles the scaling policies of an ASG
+// by creating missing policies, updating drifted policies and deleting
+// extraneous policies. When wantedScalingPolicies is empty, only the policies
+// CAPA created, as listed in managedScalingPolicies, are deleted, which leaves
+// policies managed outside of CAPA untouched. It returns the names of the
+// policies CAPA manages afterwards, to be listed in managedScalingPolicies next time.
+func ReconcileScalingPolicies(ctx context.Context, asgService services.ASGInterface, asgName string, wantedScalingPolicies []expinfrav1.ScalingPolicy, managedScalingPolicies []string, storeConditionsOnObject deprecatedv1beta1conditions.Setter, log logger.Wrapper) ([]string, error) {
+	if len(wantedScalingPolicies) == 0 && len(managedScalingPolicies) == 0 {
+		return nil, nil
+	}
+
+	existingPolicies, err := asgService.DescribeScalingPolicies(ctx, asgName)
+	if err != nil {
+		return managedScalingPolicies, err
+	}
+
+	existingByName := make(map[string]*expinfrav1.ScalingPolicy, len(existingPolicies))
+	for _, policy := range existingPolicies {
+		existingByName[policy.Name] = policy
+	}
+
+	wantedNames := make([]string, 0, len(wantedScalingPolicies))
+	for i := range wantedScalingPolicies {
+		wantedNames = append(wantedNames, wantedScalingPolicies[i].Name)
+	}
+	managed := sets.New(managedScalingPolicies...)
+
+	markFailed := func(err error) ([]string, error) {
+		deprecatedv1beta1conditions.MarkFalse(storeConditionsOnObject, clusterv1.ConditionType(expinfrav1.ScalingPoliciesReadyCondition), expinfrav1.ScalingPolicyReconcileFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		// keep track of the policies that may have been created before the failure
+		return sets.List(managed.Insert(wantedNames...)), err
+	}
+
+	for i := range wantedScalingPolicies {
+		wanted := &wantedScalingPolicies[i]
+		existing, found := existingByName[wanted.Name]
+		delete(existingByName, wanted.Name)
+		if found && !scalingPolicyNeedsUpdate(existing, wanted) {
+			continue
+		}
+
+		log.Info("Putting scaling policy", "policy", wanted.Name)
+		if err := asgService.PutScalingPolicy(ctx, asgName, wanted); err != nil {
+			return markFailed(err)
+		}
+	}
+
+	for name := range existingByName {
+		if len(wantedScalingPolicies) == 0 && !managed.Has(name) {
+			continue
+		}
+		log.Info("Deleting extraneous scaling policy", "policy", name)
+		if err := asgService.DeleteScalingPolicy(ctx, asgName, name); err != nil {
+			return markFailed(err)
+		}
+	}
+
+	if len(wantedScalingPolicies) == 0 {
+		deprecatedv1beta1conditions.Delete(storeConditionsOnObject, clusterv1.ConditionType(expinfrav1.ScalingPoliciesReadyCondition))
+		return nil, nil
+	}
+
+	deprecatedv1beta1conditions.MarkTrue(storeConditionsOnObject, clusterv1.ConditionType(expinfrav1.ScalingPoliciesReadyCondition))
+	return wantedNames, nil
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestScalingPolicyNeedsUpdate(t *testing.T) {
+	cpuTargetTracking := func(target int32) expinfrav1.ScalingPolicy {
+		return expinfrav1.ScalingPolicy{
+			Name:       "cpu",
+			PolicyType: expinfrav1.ScalingPolicyTypeTargetTracking,
+			TargetTracking: &expinfrav1.TargetTrackingConfiguration{
+				PredefinedMetricType: expinfrav1.TargetTrackingMetricTypeCPUUtilization,
+				TargetValue:          target,
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		existing   expinfrav1.ScalingPolicy
+		expected   expinfrav1.ScalingPolicy
+		wantUpdate bool
+	}{
+		{
+			name:       "exactly equal",
+			existing:   cpuTargetTracking(60),
+			expected:   cpuTargetTracking(60),
+			wantUpdate: false,
+		},
+		{
+			name:       "target value differs",
+			existing:   cpuTargetTracking(60),
+			expected:   cpuTargetTracking(70),
+			wantUpdate: true,
+		},
+		{
+			name: "policy type differs",
+			existing: expinfrav1.ScalingPolicy{
+				Name:       "cpu",
+				PolicyType: "StepScaling",
+			},
+			expected:   cpuTargetTracking(60),
+			wantUpdate: true,
+		},
+		{
+			name: "predictive defaults not set in manifest, but set by AWS",
+			existing: expinfrav1.ScalingPolicy{
+				Name:       "forecast",
+				PolicyType: expinfrav1.ScalingPolicyTypePredictive,
+				Predictive: &expinfrav1.PredictiveScalingConfiguration{
+					PredefinedMetricType: expinfrav1.PredictiveScalingMetricTypeCPUUtilization,
+					TargetValue:          50,
+					Mode:                 expinfrav1.PredictiveScalingModeForecastOnly,
+					SchedulingBufferTime: aws.Int32(300),
+				},
+			},
+			expected: expinfrav1.ScalingPolicy{
+				Name:       "forecast",
+				PolicyType: expinfrav1.ScalingPolicyTypePredictive,
+				Predictive: &expinfrav1.PredictiveScalingConfiguration{
+					PredefinedMetricType: expinfrav1.PredictiveScalingMetricTypeCPUUtilization,
+					TargetValue:          50,
+				},
+			},
+			wantUpdate: false,
+		},
+		{
+			name: "predictive mode differs",
+			existing: expinfrav1.ScalingPolicy{
+				Name:       "forecast",
+				PolicyType: expinfrav1.ScalingPolicyTypePredictive,
+				Predictive: &expinfrav1.PredictiveScalingConfiguration{
+					PredefinedMetricType: expinfrav1.PredictiveScalingMetricTypeCPUUtilization,
+					TargetValue:          50,
+					Mode:                 expinfrav1.PredictiveScalingModeForecastOnly,
+				},
+			},
+			expected: expinfrav1.ScalingPolicy{
+				Name:       "forecast",
+				PolicyType: expinfrav1.ScalingPolicyTypePredictive,
+				Predictive: &expinfrav1.PredictiveScalingConfiguration{
+					PredefinedMetricType: expinfrav1.PredictiveScalingMetricTypeCPUUtilization,
+					TargetValue:          50,
+					Mode:                 expinfrav1.PredictiveScalingModeForecastAndScale,
+				},
+			},
+			wantUpdate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(scalingPolicyNeedsUpdate(&tt.existing, &tt.expected)).To(Equal(tt.wantUpdate))
+		})
+	}
+}
+
+func TestSDKToScalingPolicy(t *testing.T) {
+	tests := []struct {
+		name  string
+		input autoscalingtypes.ScalingPolicy
+		want  *expinfrav1.ScalingPolicy
+	}{
+		{
+			name: "target tracking policy",
+			input: autoscalingtypes.ScalingPolicy{
+				PolicyName: aws.String("requests"),
+				PolicyType: aws.String("TargetTrackingScaling"),
+				TargetTrackingConfiguration: &autoscalingtypes.TargetTrackingConfiguration{
+					PredefinedMetricSpecification: &autoscalingtypes.PredefinedMetricSpecification{
+						PredefinedMetricType: autoscalingtypes.MetricTypeALBRequestCountPerTarget,
+						ResourceLabel:        aws.String("app/my-alb/1234567890abcdef/targetgroup/my-tg/1234567890abcdef"),
+					},
+					TargetValue:    aws.Float64(1000),
+					DisableScaleIn: aws.Bool(true),
+				},
+			},
+			want: &expinfrav1.ScalingPolicy{
+				Name:       "requests",
+				PolicyType: expinfrav1.ScalingPolicyTypeTargetTracking,
+				TargetTracking: &expinfrav1.TargetTrackingConfiguration{
+					PredefinedMetricType: expinfrav1.TargetTrackingMetricTypeALBRequestCountPerTarget,
+					ResourceLabel:        "app/my-alb/1234567890abcdef/targetgroup/my-tg/1234567890abcdef",
+					TargetValue:          1000,
+					DisableScaleIn:       true,
+				},
+			},
+		},
+		{
+			name: "predictive policy allowed to exceed the max size",
+			input: autoscalingtypes.ScalingPolicy{
+				PolicyName: aws.String("forecast"),
+				PolicyType: aws.String("PredictiveScaling"),
+				PredictiveScalingConfiguration: &autoscalingtypes.PredictiveScalingConfiguration{
+					MetricSpecifications: []autoscalingtypes.PredictiveScalingMetricSpecification{
+						{
+							PredefinedMetricPairSpecification: &autoscalingtypes.PredictiveScalingPredefinedMetricPair{
+								PredefinedMetricType: autoscalingtypes.PredefinedMetricPairTypeASGCPUUtilization,
+							},
+							TargetValue: aws.Float64(50),
+						},
+					},
+					Mode:                      autoscalingtypes.PredictiveScalingModeForecastAndScale,
+					SchedulingBufferTime:      aws.Int32(600),
+					MaxCapacityBreachBehavior: autoscalingtypes.PredictiveScalingMaxCapacityBreachBehaviorIncreaseMaxCapacity,
+					MaxCapacityBuffer:         aws.Int32(10),
+				},
+			},
+			want: &expinfrav1.ScalingPolicy{
+				Name:       "forecast",
+				PolicyType: expinfrav1.ScalingPolicyTypePredictive,
+				Predictive: &expinfrav1.PredictiveScalingConfiguration{
+					PredefinedMetricType: expinfrav1.PredictiveScalingMetricTypeCPUUtilization,
+					TargetValue:          50,
+					Mode:                 expinfrav1.PredictiveScalingModeForecastAndScale,
+					SchedulingBufferTime: aws.Int32(600),
+					MaxCapacityBuffer:    aws.Int32(10),
+				},
+			},
+		},
+		{
+			name: "step scaling policy",
+			input: autoscalingtypes.ScalingPolicy{
+				PolicyName: aws.String("steps"),
+				PolicyType: aws.String("StepScaling"),
+			},
+			want: &expinfrav1.ScalingPolicy{
+				Name:       "steps",
+				PolicyType: "StepScaling",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(SDKToScalingPolicy(tt.input)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestReconcileScalingPoliciesDeletesManagedPoliciesWhenRemoved(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	asgMock := mock_services.NewMockASGInterface(mockCtrl)
+
+	asgMock.EXPECT().DescribeScalingPolicies(gomock.Any(), "asg").Return([]*expinfrav1.ScalingPolicy{
+		{Name: "cpu", PolicyType: expinfrav1.ScalingPolicyTypeTargetTracking},
+		{Name: "external", PolicyType: expinfrav1.ScalingPolicyTypeTargetTracking},
+	}, nil)
+	asgMock.EXPECT().DeleteScalingPolicy(gomock.Any(), "asg", "cpu").Return(nil)
+
+	managed, err := ReconcileScalingPolicies(context.TODO(), asgMock, "asg", nil, []string{"cpu"}, &clusterv1.MachinePool{}, logger.NewLogger(logr.Discard()))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(managed).To(BeEmpty())
+}
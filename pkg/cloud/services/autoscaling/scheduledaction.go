@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	deprecatedv1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
+)
+
+// defaultScheduledActionTimeZone is the time zone AWS uses for a scheduled action without one.
+const defaultScheduledActionTimeZone = "UTC"
+
+// DescribeScheduledActions returns the scheduled actions for the given AutoScalingGroup after retrieving them from the AWS API.
+func (s *Service) DescribeScheduledActions(ctx context.Context, asgName string) ([]*expinfrav1.ScheduledAction, error) {
+	input := &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: ptr.To(asgName),
+	}
+
+	var actions []*expinfrav1.ScheduledAction
+	paginator := autoscaling.NewDescribeScheduledActionsPaginator(s.ASGClient, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe scheduled actions for AutoScalingGroup: %q", asgName)
+		}
+		for _, action := range out.ScheduledUpdateGroupActions {
+			actions = append(actions, SDKToScheduledAction(action))
+		}
+	}
+
+	return actions, nil
+}
+
+// PutScheduledAction creates or updates a scheduled action for the given AutoScalingGroup.
+func (s *Service) PutScheduledAction(ctx context.Context, asgName string, action *expinfrav1.ScheduledAction) error {
+	input := &autoscaling.PutScheduledUpdateGroupActionInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		ScheduledActionName:  ptr.To(action.Name),
+		Recurrence:           ptr.To(action.Recurrence),
+		TimeZone:             ptr.To(scheduledActionTimeZone(action)),
+		MinSize:              action.MinSize,
+		MaxSize:              action.MaxSize,
+		DesiredCapacity:      action.DesiredCapacity,
+	}
+
+	if _, err := s.ASGClient.PutScheduledUpdateGroupAction(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to put scheduled action %q for AutoScalingGroup: %q", action.Name, asgName)
+	}
+
+	return nil
+}
+
+// DeleteScheduledAction deletes a scheduled action for the given AutoScalingGroup.
+func (s *Service) DeleteScheduledAction(ctx context.Context, asgName string, actionName string) error {
+	input := &autoscaling.DeleteScheduledActionInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		ScheduledActionName:  ptr.To(actionName),
+	}
+
+	if _, err := s.ASGClient.DeleteScheduledAction(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to delete scheduled action %q for AutoScalingGroup: %q", actionName, asgName)
+	}
+
+	return nil
+}
+
+// SDKToScheduledAction converts an AWS SDK ScheduledUpdateGroupAction to the CAPA scheduled action type.
+func SDKToScheduledAction(action autoscalingtypes.ScheduledUpdateGroupAction) *expinfrav1.ScheduledAction {
+	return &expinfrav1.ScheduledAction{
+		Name:            aws.ToString(action.ScheduledActionName),
+		Recurrence:      aws.ToString(action.Recurrence),
+		TimeZone:        aws.ToString(action.TimeZone),
+		MinSize:         action.MinSize,
+		MaxSize:         action.MaxSize,
+		DesiredCapacity: action.DesiredCapacity,
+	}
+}
+
+func scheduledActionTimeZone(action *expinfrav1.ScheduledAction) string {
+	if action.TimeZone == "" {
+		return defaultScheduledActionTimeZone
+	}
+	return action.TimeZone
+}
+
+func scheduledActionNeedsUpdate(existing *expinfrav1.ScheduledAction, expected *expinfrav1.ScheduledAction) bool {
+	return existing.Recurrence != expected.Recurrence ||
+		scheduledActionTimeZone(existing) != scheduledActionTimeZone(expected) ||
+		!ptr.Equal(existing.MinSize, expected.MinSize) ||
+		!ptr.Equal(existing.MaxSize, expected.MaxSize) ||
+		!ptr.Equal(existing.DesiredCapacity, expected.DesiredCapacity)
+}
+
+// ReconcileScheduledActions reconciles the scheduled actions of an ASG
+// by creating missing actions, updating drifted actions and deleting
+// extraneous actions. When wantedScheduledActions is empty, only the actions
+// CAPA created, as listed in managedScheduledActions, are deleted, which leaves
+// actions managed outside of CAPA untouched. It returns the names of the
+// actions CAPA manages afterwards, to be listed in managedScheduledActions next time.
+func ReconcileScheduledActions(ctx context.Context, asgService services.ASGInterface, asgName string, wantedScheduledActions []expinfrav1.ScheduledAction, managedScheduledActions []string, storeConditionsOnObject deprecatedv1beta1conditions.Setter, log logger.Wrapper) ([]string, error) {
+	if len(wantedScheduledActions) == 0 && len(managedScheduledActions) == 0 {
+		return nil, nil
+	}
+
+	existingActions, err := asgService.DescribeScheduledActions(ctx, asgName)
+	if err != nil {
+		return managedScheduledActions, err
+	}
+
+	existingByName := make(map[string]*expinfrav1.ScheduledAction, len(existingActions))
+	for _, action := range existingActions {
+		existingByName[action.Name] = action
+	}
+
+	wantedNames := make([]string, 0, len(wantedScheduledActions))
+	for i := range wantedScheduledActions {
+		wantedNames = append(wantedNames, wantedScheduledActions[i].Name)
+	}
+	managed := sets.New(managedScheduledActions...)
+
+	markFailed := func(err error) ([]string, error) {
+		deprecatedv1beta1conditions.MarkFalse(storeConditionsOnObject, clusterv1.ConditionType(expinfrav1.ScheduledActionsReadyCondition), expinfrav1.ScheduledActionReconcileFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		// keep track of the actions that may have been created before the failure
+		return sets.List(managed.Insert(wantedNames...)), err
+	}
+
+	for i := range wantedScheduledActions {
+		wanted := &wantedScheduledActions[i]
+		existing, found := existingByName[wanted.Name]
+		delete(existingByName, wanted.Name)
+		if found && !scheduledActionNeedsUpdate(existing, wanted) {
+			continue
+		}
+
+		log.Info("Putting scheduled action", "action", wanted.Name)
+		if err := asgService.PutScheduledAction(ctx, asgName, wanted); err != nil {
+			return markFailed(err)
+		}
+	}
+
+	for name := range existingByName {
+		if len(wantedScheduledActions) == 0 && !managed.Has(name) {
+			continue
+		}
+		log.Info("Deleting extraneous scheduled action", "action", name)
+		if err := asgService.DeleteScheduledAction(ctx, asgName, name); err != nil {
+			return markFailed(err)
+		}
+	}
+
+	if len(wantedScheduledActions) == 0 {
+		deprecatedv1beta1conditions.Delete(storeConditionsOnObject, clusterv1.ConditionType(expinfrav1.ScheduledActionsReadyCondition))
+		return nil, nil
+	}
+
+	deprecatedv1beta1conditions.MarkTrue(storeConditionsOnObject, clusterv1.ConditionType(expinfrav1.ScheduledActionsReadyCondition))
+	return wantedNames, nil
+}
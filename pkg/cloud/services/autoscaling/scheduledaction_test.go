@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	deprecatedv1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
+)
+
+func TestScheduledActionNeedsUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   expinfrav1.ScheduledAction
+		expected   expinfrav1.ScheduledAction
+		wantUpdate bool
+	}{
+		{
+			name: "time zone not set in manifest, but set by AWS",
+			existing: expinfrav1.ScheduledAction{
+				Recurrence: "0 8 * * *",
+				TimeZone:   "UTC",
+				MinSize:    aws.Int32(3),
+			},
+			expected: expinfrav1.ScheduledAction{
+				Recurrence: "0 8 * * *",
+				MinSize:    aws.Int32(3),
+			},
+			wantUpdate: false,
+		},
+		{
+			name: "exactly equal",
+			existing: expinfrav1.ScheduledAction{
+				Recurrence:      "0 8 * * 1-5",
+				TimeZone:        "Europe/Berlin",
+				MinSize:         aws.Int32(3),
+				MaxSize:         aws.Int32(10),
+				DesiredCapacity: aws.Int32(5),
+			},
+			expected: expinfrav1.ScheduledAction{
+				Recurrence:      "0 8 * * 1-5",
+				TimeZone:        "Europe/Berlin",
+				MinSize:         aws.Int32(3),
+				MaxSize:         aws.Int32(10),
+				DesiredCapacity: aws.Int32(5),
+			},
+			wantUpdate: false,
+		},
+		{
+			name: "recurrence differs",
+			existing: expinfrav1.ScheduledAction{
+				Recurrence: "0 8 * * *",
+			},
+			expected: expinfrav1.ScheduledAction{
+				Recurrence: "0 9 * * *",
+			},
+			wantUpdate: true,
+		},
+		{
+			name: "time zone differs",
+			existing: expinfrav1.ScheduledAction{
+				Recurrence: "0 8 * * *",
+				TimeZone:   "UTC",
+			},
+			expected: expinfrav1.ScheduledAction{
+				Recurrence: "0 8 * * *",
+				TimeZone:   "Europe/Berlin",
+			},
+			wantUpdate: true,
+		},
+		{
+			name: "desired capacity removed",
+			existing: expinfrav1.ScheduledAction{
+				Recurrence:      "0 8 * * *",
+				DesiredCapacity: aws.Int32(5),
+			},
+			expected: expinfrav1.ScheduledAction{
+				Recurrence: "0 8 * * *",
+			},
+			wantUpdate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(scheduledActionNeedsUpdate(&tt.existing, &tt.expected)).To(Equal(tt.wantUpdate))
+		})
+	}
+}
+
+func TestSDKToScheduledAction(t *testing.T) {
+	g := NewWithT(t)
+	action := autoscalingtypes.ScheduledUpdateGroupAction{
+		ScheduledActionName: aws.String("scale-up"),
+		Recurrence:          aws.String("0 8 * * 1-5"),
+		TimeZone:            aws.String("Europe/Berlin"),
+		MinSize:             aws.Int32(3),
+		DesiredCapacity:     aws.Int32(5),
+	}
+	g.Expect(SDKToScheduledAction(action)).To(Equal(&expinfrav1.ScheduledAction{
+		Name:            "scale-up",
+		Recurrence:      "0 8 * * 1-5",
+		TimeZone:        "Europe/Berlin",
+		MinSize:         aws.Int32(3),
+		DesiredCapacity: aws.Int32(5),
+	}))
+}
+
+func TestReconcileScheduledActions(t *testing.T) {
+	businessHours := expinfrav1.ScheduledAction{Name: "business-hours", Recurrence: "0 8 * * 1-5", TimeZone: "UTC", MinSize: aws.Int32(3)}
+	external := expinfrav1.ScheduledAction{Name: "external", Recurrence: "0 0 * * *", TimeZone: "UTC", MinSize: aws.Int32(1)}
+
+	tests := []struct {
+		name          string
+		wanted        []expinfrav1.ScheduledAction
+		managed       []string
+		expect        func(m *mock_services.MockASGInterfaceMockRecorder)
+		wantManaged   []string
+		wantCondition bool
+	}{
+		{
+			name:   "leaves the actions untouched when none are wanted or managed",
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {},
+		},
+		{
+			name:    "deletes only the managed actions when none are wanted",
+			managed: []string{"business-hours"},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions(gomock.Any(), "asg").Return([]*expinfrav1.ScheduledAction{&businessHours, &external}, nil)
+				m.DeleteScheduledAction(gomock.Any(), "asg", "business-hours").Return(nil)
+			},
+		},
+		{
+			name:   "deletes the actions not wanted",
+			wanted: []expinfrav1.ScheduledAction{businessHours},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions(gomock.Any(), "asg").Return([]*expinfrav1.ScheduledAction{&businessHours, &external}, nil)
+				m.DeleteScheduledAction(gomock.Any(), "asg", "external").Return(nil)
+			},
+			wantManaged:   []string{"business-hours"},
+			wantCondition: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			asgMock := mock_services.NewMockASGInterface(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+
+			machinePool := &clusterv1.MachinePool{}
+			managed, err := ReconcileScheduledActions(context.TODO(), asgMock, "asg", tt.wanted, tt.managed, machinePool, logger.NewLogger(logr.Discard()))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(managed).To(Equal(tt.wantManaged))
+			g.Expect(deprecatedv1beta1conditions.IsTrue(machinePool, clusterv1.ConditionType(expinfrav1.ScheduledActionsReadyCondition))).To(Equal(tt.wantCondition))
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	deprecatedv1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
+)
+
+// PutWarmPool creates or updates the warm pool of the given AutoScalingGroup.
+func (s *Service) PutWarmPool(ctx context.Context, asgName string, warmPool *expinfrav1.WarmPool) error {
+	input := &autoscaling.PutWarmPoolInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		MinSize:              ptr.To(ptr.Deref(warmPool.MinSize, 0)),
+		// -1 sizes the warm pool from the maximum size of the group.
+		MaxGroupPreparedCapacity: ptr.To(ptr.Deref(warmPool.MaxGroupPreparedCapacity, -1)),
+		PoolState:                autoscalingtypes.WarmPoolState(warmPoolState(warmPool)),
+		InstanceReusePolicy: &autoscalingtypes.InstanceReusePolicy{
+			ReuseOnScaleIn: aws.Bool(warmPool.ReuseOnScaleIn),
+		},
+	}
+
+	if _, err := s.ASGClient.PutWarmPool(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to put warm pool for AutoScalingGroup: %q", asgName)
+	}
+
+	return nil
+}
+
+// DeleteWarmPool deletes the warm pool of the given AutoScalingGroup, terminating its instances.
+func (s *Service) DeleteWarmPool(ctx context.Context, asgName string) error {
+	input := &autoscaling.DeleteWarmPoolInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		ForceDelete:          aws.Bool(true),
+	}
+
+	if _, err := s.ASGClient.DeleteWarmPool(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to delete warm pool for AutoScalingGroup: %q", asgName)
+	}
+
+	return nil
+}
+
+// describeWarmPoolInstances returns the instances in the warm pool of the given AutoScalingGroup.
+func (s *Service) describeWarmPoolInstances(ctx context.Context, asgName string) ([]infrav1.Instance, error) {
+	input := &autoscaling.DescribeWarmPoolInput{
+		AutoScalingGroupName: ptr.To(asgName),
+	}
+
+	var instances []infrav1.Instance
+	paginator := autoscaling.NewDescribeWarmPoolPaginator(s.ASGClient, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe warm pool for AutoScalingGroup: %q", asgName)
+		}
+		for _, instance := range out.Instances {
+			instances = append(instances, infrav1.Instance{
+				ID:               aws.ToString(instance.InstanceId),
+				State:            infrav1.InstanceState(instance.LifecycleState),
+				AvailabilityZone: aws.ToString(instance.AvailabilityZone),
+			})
+		}
+	}
+
+	return instances, nil
+}
+
+// sdkToWarmPool converts an AWS SDK warm pool configuration to the CAPA warm pool type. A warm pool
+// being deleted is reported as no warm pool.
+func sdkToWarmPool(v *autoscalingtypes.WarmPoolConfiguration) *expinfrav1.WarmPool {
+	if v == nil || v.Status == autoscalingtypes.WarmPoolStatusPendingDelete {
+		return nil
+	}
+
+	warmPool := &expinfrav1.WarmPool{
+		MinSize:   v.MinSize,
+		PoolState: expinfrav1.WarmPoolState(v.PoolState),
+	}
+	if v.MaxGroupPreparedCapacity != nil && *v.MaxGroupPreparedCapacity != -1 {
+		warmPool.MaxGroupPreparedCapacity = v.MaxGroupPreparedCapacity
+	}
+	if v.InstanceReusePolicy != nil {
+		warmPool.ReuseOnScaleIn = aws.ToBool(v.InstanceReusePolicy.ReuseOnScaleIn)
+	}
+
+	return warmPool
+}
+
+func warmPoolState(warmPool *expinfrav1.WarmPool) expinfrav1.WarmPoolState {
+	if warmPool.PoolState == "" {
+		return expinfrav1.WarmPoolStateStopped
+	}
+	return warmPool.PoolState
+}
+
+func warmPoolNeedsUpdate(existing *expinfrav1.WarmPool, expected *expinfrav1.WarmPool) bool {
+	return ptr.Deref(existing.MinSize, 0) != ptr.Deref(expected.MinSize, 0) ||
+		ptr.Deref(existing.MaxGroupPreparedCapacity, -1) != ptr.Deref(expected.MaxGroupPreparedCapacity, -1) ||
+		warmPoolState(existing) != warmPoolState(expected) ||
+		existing.ReuseOnScaleIn != expected.ReuseOnScaleIn
+}
+
+// ReconcileWarmPool reconciles the warm pool of an ASG by creating or updating
+// it to match the wanted warm pool, or deleting it when none is wanted.
+func ReconcileWarmPool(ctx context.Context, asgService services.ASGInterface, asgName string, wantedWarmPool *expinfrav1.WarmPool, existingWarmPool *expinfrav1.WarmPool, storeConditionsOnObject deprecatedv1beta1conditions.Setter, log logger.Wrapper) error {
+	if wantedWarmPool == nil {
+		if existingWarmPool == nil {
+			return nil
+		}
+
+		log.Info("Deleting warm pool")
+		if err := asgService.DeleteWarmPool(ctx, asgName); err != nil {
+			deprecatedv1beta1conditions.MarkFalse(storeConditionsOnObject, clusterv1.ConditionType(expinfrav1.WarmPoolReadyCondition), expinfrav1.WarmPoolDeletionFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return err
+		}
+		deprecatedv1beta1conditions.Delete(storeConditionsOnObject, clusterv1.ConditionType(expinfrav1.WarmPoolReadyCondition))
+		return nil
+	}
+
+	if existingWarmPool == nil || warmPoolNeedsUpdate(existingWarmPool, wantedWarmPool) {
+		log.Info("Putting warm pool")
+		if err := asgService.PutWarmPool(ctx, asgName, wantedWarmPool); err != nil {
+			deprecatedv1beta1conditions.MarkFalse(storeConditionsOnObject, clusterv1.ConditionType(expinfrav1.WarmPoolReadyCondition), expinfrav1.WarmPoolReconcileFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return err
+		}
+	}
+
+	deprecatedv1beta1conditions.MarkTrue(storeConditionsOnObject, clusterv1.ConditionType(expinfrav1.WarmPoolReadyCondition))
+	return nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	. "github.com/onsi/gomega"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+)
+
+func TestWarmPoolNeedsUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   expinfrav1.WarmPool
+		expected   expinfrav1.WarmPool
+		wantUpdate bool
+	}{
+		{
+			name: "defaults not set in manifest, but set by AWS",
+			existing: expinfrav1.WarmPool{
+				MinSize:   aws.Int32(0),
+				PoolState: expinfrav1.WarmPoolStateStopped,
+			},
+			expected:   expinfrav1.WarmPool{},
+			wantUpdate: false,
+		},
+		{
+			name: "exactly equal",
+			existing: expinfrav1.WarmPool{
+				MinSize:                  aws.Int32(1),
+				MaxGroupPreparedCapacity: aws.Int32(5),
+				PoolState:                expinfrav1.WarmPoolStateHibernated,
+				ReuseOnScaleIn:           true,
+			},
+			expected: expinfrav1.WarmPool{
+				MinSize:                  aws.Int32(1),
+				MaxGroupPreparedCapacity: aws.Int32(5),
+				PoolState:                expinfrav1.WarmPoolStateHibernated,
+				ReuseOnScaleIn:           true,
+			},
+			wantUpdate: false,
+		},
+		{
+			name: "min size differs",
+			existing: expinfrav1.WarmPool{
+				MinSize: aws.Int32(1),
+			},
+			expected: expinfrav1.WarmPool{
+				MinSize: aws.Int32(2),
+			},
+			wantUpdate: true,
+		},
+		{
+			name:     "max group prepared capacity added",
+			existing: expinfrav1.WarmPool{},
+			expected: expinfrav1.WarmPool{
+				MaxGroupPreparedCapacity: aws.Int32(5),
+			},
+			wantUpdate: true,
+		},
+		{
+			name: "pool state differs",
+			existing: expinfrav1.WarmPool{
+				PoolState: expinfrav1.WarmPoolStateStopped,
+			},
+			expected: expinfrav1.WarmPool{
+				PoolState: expinfrav1.WarmPoolStateRunning,
+			},
+			wantUpdate: true,
+		},
+		{
+			name:     "reuse on scale in differs",
+			existing: expinfrav1.WarmPool{},
+			expected: expinfrav1.WarmPool{
+				ReuseOnScaleIn: true,
+			},
+			wantUpdate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(warmPoolNeedsUpdate(&tt.existing, &tt.expected)).To(Equal(tt.wantUpdate))
+		})
+	}
+}
+
+func TestSDKToWarmPool(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *autoscalingtypes.WarmPoolConfiguration
+		want  *expinfrav1.WarmPool
+	}{
+		{
+			name:  "no warm pool",
+			input: nil,
+			want:  nil,
+		},
+		{
+			name: "warm pool being deleted",
+			input: &autoscalingtypes.WarmPoolConfiguration{
+				MinSize:   aws.Int32(1),
+				PoolState: autoscalingtypes.WarmPoolStateStopped,
+				Status:    autoscalingtypes.WarmPoolStatusPendingDelete,
+			},
+			want: nil,
+		},
+		{
+			name: "max group prepared capacity defaulted to the group max size",
+			input: &autoscalingtypes.WarmPoolConfiguration{
+				MinSize:                  aws.Int32(1),
+				MaxGroupPreparedCapacity: aws.Int32(-1),
+				PoolState:                autoscalingtypes.WarmPoolStateHibernated,
+				InstanceReusePolicy:      &autoscalingtypes.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(true)},
+			},
+			want: &expinfrav1.WarmPool{
+				MinSize:        aws.Int32(1),
+				PoolState:      expinfrav1.WarmPoolStateHibernated,
+				ReuseOnScaleIn: true,
+			},
+		},
+		{
+			name: "max group prepared capacity set",
+			input: &autoscalingtypes.WarmPoolConfiguration{
+				MinSize:                  aws.Int32(0),
+				MaxGroupPreparedCapacity: aws.Int32(5),
+				PoolState:                autoscalingtypes.WarmPoolStateRunning,
+			},
+			want: &expinfrav1.WarmPool{
+				MinSize:                  aws.Int32(0),
+				MaxGroupPreparedCapacity: aws.Int32(5),
+				PoolState:                expinfrav1.WarmPoolStateRunning,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(sdkToWarmPool(tt.input)).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+)
+
+// InstanceTypeCapacity returns the CPU and memory capacity of an instance type, as used for
+// autoscaling from zero.
+func InstanceTypeCapacity(info types.InstanceTypeInfo) corev1.ResourceList {
+	resourceList := corev1.ResourceList{}
+
+	if info.VCpuInfo != nil && info.VCpuInfo.DefaultVCpus != nil {
+		resourceList[corev1.ResourceCPU] = cpuQuantity(int64(*info.VCpuInfo.DefaultVCpus))
+	}
+
+	if info.MemoryInfo != nil && info.MemoryInfo.SizeInMiB != nil {
+		resourceList[corev1.ResourceMemory] = memoryQuantity(*info.MemoryInfo.SizeInMiB)
+	}
+
+	return resourceList
+}
+
+// InstanceRequirementsCapacity returns the CPU and memory capacity of the minimum shape matching all
+// the given instance requirements, as used for autoscaling from zero. Any instance type the requirements
+// match has at least this capacity.
+func InstanceRequirementsCapacity(requirements ...*expinfrav1.InstanceRequirements) corev1.ResourceList {
+	var vCPUs, memoryMiB int64 = -1, -1
+	for _, r := range requirements {
+		if r == nil {
+			continue
+		}
+		if vCPUs < 0 || int64(r.VCPUCount.Min) < vCPUs {
+			vCPUs = int64(r.VCPUCount.Min)
+		}
+		if memoryMiB < 0 || int64(r.MemoryMiB.Min) < memoryMiB {
+			memoryMiB = int64(r.MemoryMiB.Min)
+		}
+	}
+
+	resourceList := corev1.ResourceList{}
+	if vCPUs > 0 {
+		resourceList[corev1.ResourceCPU] = cpuQuantity(vCPUs)
+	}
+	if memoryMiB > 0 {
+		resourceList[corev1.ResourceMemory] = memoryQuantity(memoryMiB)
+	}
+
+	return resourceList
+}
+
+func cpuQuantity(vCPUs int64) resource.Quantity {
+	return *resource.NewQuantity(vCPUs, resource.DecimalSI)
+}
+
+func memoryQuantity(memoryMiB int64) resource.Quantity {
+	return resource.MustParse(fmt.Sprintf("%dMi", memoryMiB))
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+)
+
+func TestInstanceTypeCapacity(t *testing.T) {
+	g := NewWithT(t)
+
+	got := InstanceTypeCapacity(ec2types.InstanceTypeInfo{
+		VCpuInfo:   &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(2)},
+		MemoryInfo: &ec2types.MemoryInfo{SizeInMiB: aws.Int64(8192)},
+	})
+	g.Expect(got).To(Equal(corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewQuantity(2, resource.DecimalSI),
+		corev1.ResourceMemory: resource.MustParse("8192Mi"),
+	}))
+}
+
+func TestInstanceRequirementsCapacity(t *testing.T) {
+	tests := []struct {
+		name         string
+		requirements []*expinfrav1.InstanceRequirements
+		want         corev1.ResourceList
+	}{
+		{
+			name: "no requirements",
+			want: corev1.ResourceList{},
+		},
+		{
+			name: "single requirements use the minimums",
+			requirements: []*expinfrav1.InstanceRequirements{
+				{
+					VCPUCount: expinfrav1.InstanceRequirementsRange{Min: 4, Max: aws.Int32(8)},
+					MemoryMiB: expinfrav1.InstanceRequirementsRange{Min: 16384},
+				},
+			},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:    *resource.NewQuantity(4, resource.DecimalSI),
+				corev1.ResourceMemory: resource.MustParse("16384Mi"),
+			},
+		},
+		{
+			name: "multiple requirements use the lowest minimum of each resource",
+			requirements: []*expinfrav1.InstanceRequirements{
+				{
+					VCPUCount: expinfrav1.InstanceRequirementsRange{Min: 4},
+					MemoryMiB: expinfrav1.InstanceRequirementsRange{Min: 8192},
+				},
+				nil,
+				{
+					VCPUCount: expinfrav1.InstanceRequirementsRange{Min: 8},
+					MemoryMiB: expinfrav1.InstanceRequirementsRange{Min: 4096},
+				},
+			},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:    *resource.NewQuantity(4, resource.DecimalSI),
+				corev1.ResourceMemory: resource.MustParse("4096Mi"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(InstanceRequirementsCapacity(tt.requirements...)).To(Equal(tt.want))
+		})
+	}
+}
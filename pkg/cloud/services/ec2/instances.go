@@ -284,6 +284,8 @@ func (s *Service) CreateInstance(ctx context.Context, scope *scope.MachineScope,
 
 	input.CPUOptions = scope.AWSMachine.Spec.CPUOptions
 
+	input.HibernationEnabled = scope.AWSMachine.Spec.HibernationEnabled
+
 	s.scope.Debug("Running instance", "machine-role", scope.Role())
 	s.scope.Debug("Running instance with instance metadata options", "metadata options", input.InstanceMetadataOptions)
 	out, err := s.runInstance(scope.Role(), input)
@@ -557,6 +559,41 @@ func (s *Service) TerminateInstance(instanceID string) error {
 	return nil
 }
 
+// StopInstance stops an EC2 instance, hibernating it if hibernate is true.
+// Returns nil on success, error in all other cases.
+func (s *Service) StopInstance(instanceID string, hibernate bool) error {
+	s.scope.Debug("Attempting to stop instance", "instance-id", instanceID, "hibernate", hibernate)
+
+	input := &ec2.StopInstancesInput{
+		InstanceIds: []string{instanceID},
+		Hibernate:   aws.Bool(hibernate),
+	}
+
+	if _, err := s.EC2Client.StopInstances(context.TODO(), input); err != nil {
+		return errors.Wrapf(err, "failed to stop instance with id %q", instanceID)
+	}
+
+	s.scope.Debug("Stopped instance", "instance-id", instanceID, "hibernate", hibernate)
+	return nil
+}
+
+// StartInstance starts a stopped or hibernated EC2 instance.
+// Returns nil on success, error in all other cases.
+func (s *Service) StartInstance(instanceID string) error {
+	s.scope.Debug("Attempting to start instance", "instance-id", instanceID)
+
+	input := &ec2.StartInstancesInput{
+		InstanceIds: []string{instanceID},
+	}
+
+	if _, err := s.EC2Client.StartInstances(context.TODO(), input); err != nil {
+		return errors.Wrapf(err, "failed to start instance with id %q", instanceID)
+	}
+
+	s.scope.Debug("Started instance", "instance-id", instanceID)
+	return nil
+}
+
 // TerminateInstanceAndWait terminates and waits
 // for an EC2 instance to terminate.
 func (s *Service) TerminateInstanceAndWait(instanceID string) error {
@@ -654,6 +691,10 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 			return nil, errors.Errorf("non root volume should have device name specified")
 		}
 
+		if err := s.checkNonRootVolume(&nonRootVolume); err != nil {
+			return nil, err
+		}
+
 		blockDeviceMapping := volumeToBlockDeviceMapping(&nonRootVolume)
 		blockdeviceMappings = append(blockdeviceMappings, blockDeviceMapping)
 	}
@@ -699,6 +740,11 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	input.PrivateDnsNameOptions = getPrivateDNSNameOptionsRequest(i.PrivateDNSName)
 	input.CapacityReservationSpecification = getCapacityReservationSpecification(i.CapacityReservationID, i.CapacityReservationPreference)
 	input.CpuOptions = getInstanceCPUOptionsRequest(i.CPUOptions)
+	if i.HibernationEnabled {
+		input.HibernationOptions = &types.HibernationOptionsRequest{
+			Configured: aws.Bool(true),
+		}
+	}
 
 	if i.Tenancy != "" {
 		input.Placement = &types.Placement{
@@ -783,6 +829,14 @@ func volumeToBlockDeviceMapping(v *infrav1.Volume) types.BlockDeviceMapping {
 		ebsDevice.VolumeType = types.VolumeType(string(v.Type))
 	}
 
+	if v.SnapshotID != "" {
+		ebsDevice.SnapshotId = aws.String(v.SnapshotID)
+	}
+
+	if v.VolumeInitializationRate != nil {
+		ebsDevice.VolumeInitializationRate = utils.ToInt32Pointer(v.VolumeInitializationRate)
+	}
+
 	return types.BlockDeviceMapping{
 		DeviceName: &v.DeviceName,
 		Ebs:        ebsDevice,
@@ -941,6 +995,27 @@ func (s *Service) getImageSnapshotSize(imageID string) (*int32, error) {
 	return output.Images[0].BlockDeviceMappings[0].Ebs.VolumeSize, nil
 }
 
+func (s *Service) getSnapshotSize(snapshotID string) (*int32, error) {
+	input := &ec2.DescribeSnapshotsInput{
+		SnapshotIds: []string{snapshotID},
+	}
+
+	output, err := s.EC2Client.DescribeSnapshots(context.TODO(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.Snapshots) == 0 {
+		return nil, errors.Errorf("no snapshots returned when looking up ID %q", snapshotID)
+	}
+
+	if output.Snapshots[0].VolumeSize == nil {
+		return nil, errors.Errorf("no volume size returned when looking up snapshot ID %q", snapshotID)
+	}
+
+	return output.Snapshots[0].VolumeSize, nil
+}
+
 // SDKToInstance converts an AWS EC2 SDK instance to the CAPA instance type.
 // SDKToInstance populates all instance fields except for rootVolumeSize,
 // because EC2.DescribeInstances does not return the size of storage devices. An
@@ -1010,6 +1085,10 @@ func (s *Service) SDKToInstance(v types.Instance) (*infrav1.Instance, error) {
 		i.InstanceMetadataOptions = metadataOptions
 	}
 
+	if v.HibernationOptions != nil {
+		i.HibernationEnabled = aws.ToBool(v.HibernationOptions.Configured)
+	}
+
 	if v.PrivateDnsNameOptions != nil {
 		i.PrivateDNSName = &infrav1.PrivateDNSName{
 			EnableResourceNameDNSAAAARecord: v.PrivateDnsNameOptions.EnableResourceNameDnsAAAARecord,
@@ -1165,6 +1244,25 @@ func (s *Service) checkRootVolume(rootVolume *infrav1.Volume, imageID string) (*
 	return rootDeviceName, nil
 }
 
+// checkNonRootVolume checks the input non root volume options against the size of the
+// snapshot the volume is created from, if any.
+func (s *Service) checkNonRootVolume(volume *infrav1.Volume) error {
+	if volume.SnapshotID == "" {
+		return nil
+	}
+
+	snapshotSize, err := s.getSnapshotSize(volume.SnapshotID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get size of snapshot %q for volume %q", volume.SnapshotID, volume.DeviceName)
+	}
+
+	if volume.Size < int64(*snapshotSize) {
+		return errors.Errorf("volume %q size (%d) must be greater than or equal to snapshot size (%d)", volume.DeviceName, volume.Size, *snapshotSize)
+	}
+
+	return nil
+}
+
 // ModifyInstanceMetadataOptions modifies the metadata options of the given EC2 instance.
 func (s *Service) ModifyInstanceMetadataOptions(instanceID string, options *infrav1.InstanceMetadataOptions) error {
 	input := &ec2.ModifyInstanceMetadataOptionsInput{
@@ -644,6 +644,8 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 		}
 	}
 
+	data.InstanceRequirements = InstanceRequirementsToSDK(lt.InstanceRequirements)
+
 	if lt.EnclaveOptions != nil {
 		data.EnclaveOptions = &types.LaunchTemplateEnclaveOptionsRequest{
 			Enabled: lt.EnclaveOptions.Enabled,
@@ -699,6 +701,10 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 	for vi := range lt.NonRootVolumes {
 		nonRootVolume := lt.NonRootVolumes[vi]
 
+		if err := s.checkNonRootVolume(&nonRootVolume); err != nil {
+			return nil, err
+		}
+
 		blockDeviceMapping := volumeToLaunchTemplateBlockDeviceMappingRequest(&nonRootVolume)
 		blockDeviceMappings = append(blockDeviceMappings, *blockDeviceMapping)
 	}
@@ -754,6 +760,14 @@ func volumeToLaunchTemplateBlockDeviceMappingRequest(v *infrav1.Volume) *types.L
 		ltEbsDevice.VolumeType = types.VolumeType(string(v.Type))
 	}
 
+	if v.SnapshotID != "" {
+		ltEbsDevice.SnapshotId = aws.String(v.SnapshotID)
+	}
+
+	if v.VolumeInitializationRate != nil {
+		ltEbsDevice.VolumeInitializationRate = utils.ToInt32Pointer(v.VolumeInitializationRate)
+	}
+
 	return &types.LaunchTemplateBlockDeviceMappingRequest{
 		DeviceName: &v.DeviceName,
 		Ebs:        ltEbsDevice,
@@ -907,6 +921,112 @@ func CapacityReservationPreferenceToSDK(preference infrav1.CapacityReservationPr
 	}
 }
 
+// InstanceRequirementsToSDK converts CAPA instance requirements to the AWS EC2 SDK launch template equivalent.
+// inverse to `SDKToInstanceRequirements`.
+func InstanceRequirementsToSDK(r *expinfrav1.InstanceRequirements) *types.InstanceRequirementsRequest {
+	if r == nil {
+		return nil
+	}
+
+	req := &types.InstanceRequirementsRequest{
+		VCpuCount: &types.VCpuCountRangeRequest{
+			Min: aws.Int32(r.VCPUCount.Min),
+			Max: r.VCPUCount.Max,
+		},
+		MemoryMiB: &types.MemoryMiBRequest{
+			Min: aws.Int32(r.MemoryMiB.Min),
+			Max: r.MemoryMiB.Max,
+		},
+		BurstablePerformance:                      types.BurstablePerformance(r.BurstablePerformance),
+		ExcludedInstanceTypes:                     r.ExcludedInstanceTypes,
+		SpotMaxPricePercentageOverLowestPrice:     r.SpotMaxPricePercentageOverLowestPrice,
+		OnDemandMaxPricePercentageOverLowestPrice: r.OnDemandMaxPricePercentageOverLowestPrice,
+	}
+
+	for _, m := range r.CPUManufacturers {
+		req.CpuManufacturers = append(req.CpuManufacturers, types.CpuManufacturer(m))
+	}
+	for _, t := range r.AcceleratorTypes {
+		req.AcceleratorTypes = append(req.AcceleratorTypes, types.AcceleratorType(t))
+	}
+	for _, m := range r.AcceleratorManufacturers {
+		req.AcceleratorManufacturers = append(req.AcceleratorManufacturers, types.AcceleratorManufacturer(m))
+	}
+	if r.AcceleratorCount != nil {
+		req.AcceleratorCount = &types.AcceleratorCountRequest{
+			Min: aws.Int32(r.AcceleratorCount.Min),
+			Max: r.AcceleratorCount.Max,
+		}
+	}
+	for _, g := range r.InstanceGenerations {
+		req.InstanceGenerations = append(req.InstanceGenerations, types.InstanceGeneration(g))
+	}
+
+	return req
+}
+
+// SDKToInstanceRequirements converts AWS EC2 SDK launch template instance requirements to the CAPA equivalent.
+// inverse to `InstanceRequirementsToSDK`.
+func SDKToInstanceRequirements(r *types.InstanceRequirements) *expinfrav1.InstanceRequirements {
+	if r == nil {
+		return nil
+	}
+
+	i := &expinfrav1.InstanceRequirements{
+		BurstablePerformance:                      expinfrav1.BurstablePerformance(r.BurstablePerformance),
+		ExcludedInstanceTypes:                     r.ExcludedInstanceTypes,
+		SpotMaxPricePercentageOverLowestPrice:     r.SpotMaxPricePercentageOverLowestPrice,
+		OnDemandMaxPricePercentageOverLowestPrice: r.OnDemandMaxPricePercentageOverLowestPrice,
+	}
+
+	if r.VCpuCount != nil {
+		i.VCPUCount = expinfrav1.InstanceRequirementsRange{
+			Min: aws.ToInt32(r.VCpuCount.Min),
+			Max: r.VCpuCount.Max,
+		}
+	}
+	if r.MemoryMiB != nil {
+		i.MemoryMiB = expinfrav1.InstanceRequirementsRange{
+			Min: aws.ToInt32(r.MemoryMiB.Min),
+			Max: r.MemoryMiB.Max,
+		}
+	}
+	for _, m := range r.CpuManufacturers {
+		i.CPUManufacturers = append(i.CPUManufacturers, expinfrav1.CPUManufacturer(m))
+	}
+	for _, t := range r.AcceleratorTypes {
+		i.AcceleratorTypes = append(i.AcceleratorTypes, expinfrav1.AcceleratorType(t))
+	}
+	for _, m := range r.AcceleratorManufacturers {
+		i.AcceleratorManufacturers = append(i.AcceleratorManufacturers, expinfrav1.AcceleratorManufacturer(m))
+	}
+	if r.AcceleratorCount != nil {
+		i.AcceleratorCount = &expinfrav1.InstanceRequirementsRange{
+			Min: aws.ToInt32(r.AcceleratorCount.Min),
+			Max: r.AcceleratorCount.Max,
+		}
+	}
+	for _, g := range r.InstanceGenerations {
+		i.InstanceGenerations = append(i.InstanceGenerations, expinfrav1.InstanceGeneration(g))
+	}
+
+	return i
+}
+
+// pickArchitectureForInstanceRequirements returns the architecture of the instance types matching the
+// instance requirements: arm64 when only AWS Graviton CPUs are allowed, x86_64 otherwise.
+func pickArchitectureForInstanceRequirements(r *expinfrav1.InstanceRequirements) string {
+	if len(r.CPUManufacturers) == 0 {
+		return Amd64ArchitectureTag
+	}
+	for _, m := range r.CPUManufacturers {
+		if m != expinfrav1.CPUManufacturerAmazonWebServices {
+			return Amd64ArchitectureTag
+		}
+	}
+	return Arm64ArchitectureTag
+}
+
 // SDKToLaunchTemplate converts an AWS EC2 SDK instance to the CAPA instance type.
 func (s *Service) SDKToLaunchTemplate(d types.LaunchTemplateVersion) (*expinfrav1.AWSLaunchTemplate, string, *apimachinerytypes.NamespacedName, *string, error) {
 	v := d.LaunchTemplateData
@@ -915,10 +1035,11 @@ func (s *Service) SDKToLaunchTemplate(d types.LaunchTemplateVersion) (*expinfrav
 		AMI: infrav1.AMIReference{
 			ID: v.ImageId,
 		},
-		InstanceType:      string(v.InstanceType),
-		SSHKeyName:        v.KeyName,
-		SpotMarketOptions: SDKToSpotMarketOptions(v.InstanceMarketOptions),
-		VersionNumber:     d.VersionNumber,
+		InstanceType:         string(v.InstanceType),
+		InstanceRequirements: SDKToInstanceRequirements(v.InstanceRequirements),
+		SSHKeyName:           v.KeyName,
+		SpotMarketOptions:    SDKToSpotMarketOptions(v.InstanceMarketOptions),
+		VersionNumber:        d.VersionNumber,
 	}
 
 	if v.CapacityReservationSpecification != nil &&
@@ -1022,6 +1143,10 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, inc
 		return true, services.LaunchTemplateNeedsUpdateReasonInstanceType, nil
 	}
 
+	if !cmp.Equal(incoming.InstanceRequirements, existing.InstanceRequirements) {
+		return true, services.LaunchTemplateNeedsUpdateReasonInstanceRequirements, nil
+	}
+
 	if !cmp.Equal(incoming.InstanceMetadataOptions, existing.InstanceMetadataOptions) {
 		return true, services.LaunchTemplateNeedsUpdateReasonInstanceMetadataOptions, nil
 	}
@@ -1117,6 +1242,8 @@ func (s *Service) DiscoverLaunchTemplateAMI(ctx context.Context, scope scope.Lau
 		if err != nil {
 			return nil, err
 		}
+	} else if lt.InstanceRequirements != nil {
+		imageArchitecture = pickArchitectureForInstanceRequirements(lt.InstanceRequirements)
 	}
 
 	if scope.IsEKSManaged() && imageLookupFormat == "" && imageLookupOrg == "" && imageLookupBaseOS == "" {
@@ -723,6 +723,27 @@ func TestServiceLaunchTemplateNeedsUpdate(t *testing.T) {
 			want:                  true,
 			wantNeedsUpdateReason: services.LaunchTemplateNeedsUpdateReasonInstanceType,
 		},
+		{
+			name: "Should return true if incoming InstanceRequirements are not same as existing InstanceRequirements",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				InstanceRequirements: &expinfrav1.InstanceRequirements{
+					VCPUCount: expinfrav1.InstanceRequirementsRange{Min: 4},
+					MemoryMiB: expinfrav1.InstanceRequirementsRange{Min: 8192},
+				},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+				InstanceRequirements: &expinfrav1.InstanceRequirements{
+					VCPUCount: expinfrav1.InstanceRequirementsRange{Min: 2},
+					MemoryMiB: expinfrav1.InstanceRequirementsRange{Min: 4096},
+				},
+			},
+			want:                  true,
+			wantNeedsUpdateReason: services.LaunchTemplateNeedsUpdateReasonInstanceRequirements,
+		},
 		{
 			name: "new additional security group with filters",
 			incoming: &expinfrav1.AWSLaunchTemplate{
@@ -1523,6 +1544,57 @@ func TestLaunchTemplateDataCreation(t *testing.T) {
 	})
 }
 
+func TestVolumeToLaunchTemplateBlockDeviceMappingRequest(t *testing.T) {
+	testCases := []struct {
+		name     string
+		volume   *infrav1.Volume
+		expected *ec2types.LaunchTemplateBlockDeviceMappingRequest
+	}{
+		{
+			name: "Should map a volume",
+			volume: &infrav1.Volume{
+				DeviceName: "/dev/sdb",
+				Size:       100,
+				Type:       infrav1.VolumeTypeGP3,
+			},
+			expected: &ec2types.LaunchTemplateBlockDeviceMappingRequest{
+				DeviceName: aws.String("/dev/sdb"),
+				Ebs: &ec2types.LaunchTemplateEbsBlockDeviceRequest{
+					DeleteOnTermination: aws.Bool(true),
+					VolumeSize:          aws.Int32(100),
+					VolumeType:          ec2types.VolumeTypeGp3,
+				},
+			},
+		},
+		{
+			name: "Should map a volume created from a snapshot",
+			volume: &infrav1.Volume{
+				DeviceName:               "/dev/sdb",
+				Size:                     100,
+				Type:                     infrav1.VolumeTypeGP3,
+				SnapshotID:               "snap-1",
+				VolumeInitializationRate: aws.Int64(300),
+			},
+			expected: &ec2types.LaunchTemplateBlockDeviceMappingRequest{
+				DeviceName: aws.String("/dev/sdb"),
+				Ebs: &ec2types.LaunchTemplateEbsBlockDeviceRequest{
+					DeleteOnTermination:      aws.Bool(true),
+					VolumeSize:               aws.Int32(100),
+					VolumeType:               ec2types.VolumeTypeGp3,
+					SnapshotId:               aws.String("snap-1"),
+					VolumeInitializationRate: aws.Int32(300),
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(volumeToLaunchTemplateBlockDeviceMappingRequest(tc.volume)).To(Equal(tc.expected))
+		})
+	}
+}
+
 var LaunchTemplateVersionIgnoreUnexported = cmpopts.IgnoreUnexported(
 	ec2types.CapacityReservationTarget{},
 	ec2types.LaunchTemplateCapacityReservationSpecificationRequest{},
@@ -1535,6 +1607,44 @@ var LaunchTemplateVersionIgnoreUnexported = cmpopts.IgnoreUnexported(
 	ec2.CreateLaunchTemplateVersionInput{},
 )
 
+func TestInstanceRequirementsToSDK(t *testing.T) {
+	g := NewWithT(t)
+
+	requirements := &expinfrav1.InstanceRequirements{
+		VCPUCount:                             expinfrav1.InstanceRequirementsRange{Min: 2, Max: aws.Int32(8)},
+		MemoryMiB:                             expinfrav1.InstanceRequirementsRange{Min: 4096},
+		CPUManufacturers:                      []expinfrav1.CPUManufacturer{expinfrav1.CPUManufacturerAmazonWebServices},
+		BurstablePerformance:                  expinfrav1.BurstablePerformanceExcluded,
+		AcceleratorTypes:                      []expinfrav1.AcceleratorType{expinfrav1.AcceleratorTypeGPU},
+		AcceleratorManufacturers:              []expinfrav1.AcceleratorManufacturer{expinfrav1.AcceleratorManufacturerNVIDIA},
+		AcceleratorCount:                      &expinfrav1.InstanceRequirementsRange{Min: 1, Max: aws.Int32(1)},
+		InstanceGenerations:                   []expinfrav1.InstanceGeneration{expinfrav1.InstanceGenerationCurrent},
+		ExcludedInstanceTypes:                 []string{"t4g.*"},
+		SpotMaxPricePercentageOverLowestPrice: aws.Int32(50),
+	}
+
+	sdk := InstanceRequirementsToSDK(requirements)
+	g.Expect(sdk.VCpuCount.Min).To(Equal(aws.Int32(2)))
+	g.Expect(sdk.VCpuCount.Max).To(Equal(aws.Int32(8)))
+	g.Expect(sdk.MemoryMiB.Min).To(Equal(aws.Int32(4096)))
+	g.Expect(sdk.MemoryMiB.Max).To(BeNil())
+	g.Expect(sdk.CpuManufacturers).To(Equal([]ec2types.CpuManufacturer{ec2types.CpuManufacturerAmazonWebServices}))
+
+	got := SDKToInstanceRequirements(&ec2types.InstanceRequirements{
+		VCpuCount:                             &ec2types.VCpuCountRange{Min: sdk.VCpuCount.Min, Max: sdk.VCpuCount.Max},
+		MemoryMiB:                             &ec2types.MemoryMiB{Min: sdk.MemoryMiB.Min, Max: sdk.MemoryMiB.Max},
+		CpuManufacturers:                      sdk.CpuManufacturers,
+		BurstablePerformance:                  sdk.BurstablePerformance,
+		AcceleratorTypes:                      sdk.AcceleratorTypes,
+		AcceleratorManufacturers:              sdk.AcceleratorManufacturers,
+		AcceleratorCount:                      &ec2types.AcceleratorCount{Min: sdk.AcceleratorCount.Min, Max: sdk.AcceleratorCount.Max},
+		InstanceGenerations:                   sdk.InstanceGenerations,
+		ExcludedInstanceTypes:                 sdk.ExcludedInstanceTypes,
+		SpotMaxPricePercentageOverLowestPrice: sdk.SpotMaxPricePercentageOverLowestPrice,
+	})
+	g.Expect(got).To(Equal(requirements))
+}
+
 func TestCreateLaunchTemplateVersion(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
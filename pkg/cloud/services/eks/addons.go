@@ -19,6 +19,7 @@ package eks
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -135,6 +136,12 @@ func (s *Service) getClusterAddonsInstalled(ctx context.Context, eksClusterName
 		for k, v := range describeOutput.Addon.Tags {
 			installedAddon.Tags[k] = v
 		}
+		if len(describeOutput.Addon.PodIdentityAssociations) > 0 {
+			installedAddon.PodIdentityAssociations, err = s.getAddonPodIdentityAssociations(ctx, eksClusterName, describeOutput.Addon.PodIdentityAssociations)
+			if err != nil {
+				return addonsInstalled, fmt.Errorf("getting pod identity associations of eks addon %s: %w", addon, err)
+			}
+		}
 
 		addonsInstalled = append(addonsInstalled, installedAddon)
 	}
@@ -142,6 +149,31 @@ func (s *Service) getClusterAddonsInstalled(ctx context.Context, eksClusterName
 	return addonsInstalled, nil
 }
 
+// getAddonPodIdentityAssociations returns the service accounts and roles of the given pod identity associations.
+func (s *Service) getAddonPodIdentityAssociations(ctx context.Context, eksClusterName string, associationARNs []string) ([]ekstypes.AddonPodIdentityAssociations, error) {
+	associations := []ekstypes.AddonPodIdentityAssociations{}
+	for _, associationARN := range associationARNs {
+		// The association ID is the last part of the ARN
+		associationID := associationARN[strings.LastIndex(associationARN, "/")+1:]
+		describeOutput, err := s.EKSClient.DescribePodIdentityAssociation(ctx, &eks.DescribePodIdentityAssociationInput{
+			AssociationId: aws.String(associationID),
+			ClusterName:   aws.String(eksClusterName),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describing pod identity association %s: %w", associationARN, err)
+		}
+		if describeOutput.Association == nil {
+			continue
+		}
+		associations = append(associations, ekstypes.AddonPodIdentityAssociations{
+			RoleArn:        describeOutput.Association.RoleArn,
+			ServiceAccount: describeOutput.Association.ServiceAccount,
+		})
+	}
+
+	return associations, nil
+}
+
 func (s *Service) getInstalledState(ctx context.Context, eksClusterName string, addonNames []string) ([]ekscontrolplanev1.AddonState, error) {
 	s.Debug("getting eks addons installed to create state")
 
@@ -209,6 +241,12 @@ func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) []*eksad
 			ServiceAccountRoleARN: addon.ServiceAccountRoleArn,
 			Preserve:              addon.PreserveOnDelete,
 		}
+		for _, ref := range addon.PodIdentityAssociations {
+			convertedAddon.PodIdentityAssociations = append(convertedAddon.PodIdentityAssociations, ekstypes.AddonPodIdentityAssociations{
+				RoleArn:        aws.String(s.podIdentityAssociationRoleARN(ref.Namespace, ref.ServiceAccount)),
+				ServiceAccount: aws.String(ref.ServiceAccount),
+			})
+		}
 
 		converted = append(converted, convertedAddon)
 	}
@@ -128,6 +128,10 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 		return errors.Wrap(err, "failed reconciling access config")
 	}
 
+	if err := s.reconcileAutoMode(ctx, cluster); err != nil {
+		return errors.Wrap(err, "failed reconciling auto mode")
+	}
+
 	if err := s.reconcileAccessEntries(ctx); err != nil {
 		return errors.Wrap(err, "failed reconciling access entries")
 	}
@@ -474,6 +478,19 @@ func (s *Service) createCluster(ctx context.Context, eksClusterName string) (*ek
 		}
 	}
 
+	var (
+		computeConfig *ekstypes.ComputeConfigRequest
+		storageConfig *ekstypes.StorageConfigRequest
+	)
+	if autoMode := s.scope.ControlPlane.Spec.AutoMode; autoMode != nil {
+		computeConfig = makeComputeConfig(autoMode)
+		storageConfig = makeStorageConfig(autoMode)
+		if netConfig == nil {
+			netConfig = &ekstypes.KubernetesNetworkConfigRequest{}
+		}
+		netConfig.ElasticLoadBalancing = makeElasticLoadBalancing(autoMode)
+	}
+
 	// Make sure to use the MachineScope here to get the merger of AWSCluster and AWSMachine tags
 	additionalTags := s.scope.AdditionalTags()
 
@@ -521,6 +538,8 @@ func (s *Service) createCluster(ctx context.Context, eksClusterName string) (*ek
 		KubernetesNetworkConfig:    netConfig,
 		BootstrapSelfManagedAddons: bootstrapAddon,
 		UpgradePolicy:              upgradePolicy,
+		ComputeConfig:              computeConfig,
+		StorageConfig:              storageConfig,
 	}
 
 	var out *eks.CreateClusterOutput
@@ -641,6 +660,117 @@ func (s *Service) reconcileAccessConfig(ctx context.Context, accessConfig *eksty
 	return nil
 }
 
+// reconcileAutoMode makes sure the compute, block storage and load balancing capabilities of the
+// cluster match the EKS Auto Mode configuration of the spec. EKS requires all three capabilities to be
+// enabled or disabled together, so they are always updated in a single request.
+func (s *Service) reconcileAutoMode(ctx context.Context, cluster *ekstypes.Cluster) error {
+	autoMode := s.scope.ControlPlane.Spec.AutoMode
+	if autoMode == nil {
+		return nil
+	}
+
+	s.scope.Debug("Reconciling EKS Auto Mode for cluster", "cluster-name", s.scope.KubernetesClusterName(), "enabled", autoMode.Enabled)
+	if autoModeInSync(autoMode, cluster) {
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSAutoModeConfiguredCondition)
+		return nil
+	}
+
+	input := &eks.UpdateClusterConfigInput{
+		Name:          aws.String(s.scope.KubernetesClusterName()),
+		ComputeConfig: makeComputeConfig(autoMode),
+		StorageConfig: makeStorageConfig(autoMode),
+		KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigRequest{
+			ElasticLoadBalancing: makeElasticLoadBalancing(autoMode),
+		},
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.EKSClient.UpdateClusterConfig(ctx, input); err != nil {
+			return false, err
+		}
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAutoModeConfiguredCondition, ekscontrolplanev1.EKSAutoModeUpdatingReason, clusterv1beta1.ConditionSeverityInfo, "")
+		record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEKSControlPlane", "Initiated auto mode update for EKS control plane %s", s.scope.KubernetesClusterName())
+		return true, nil
+	}); err != nil {
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAutoModeConfiguredCondition, ekscontrolplanev1.EKSAutoModeConfigurationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Failed to update EKS control plane auto mode: %v", err)
+		return errors.Wrapf(err, "failed to update EKS cluster")
+	}
+
+	return nil
+}
+
+// autoModeInSync returns true if the Auto Mode capabilities of the cluster match the spec.
+func autoModeInSync(autoMode *ekscontrolplanev1.AutoMode, cluster *ekstypes.Cluster) bool {
+	var (
+		computeEnabled bool
+		storageEnabled bool
+		elbEnabled     bool
+		nodePools      []string
+		nodeRoleArn    string
+	)
+	if cluster.ComputeConfig != nil {
+		computeEnabled = aws.ToBool(cluster.ComputeConfig.Enabled)
+		nodePools = cluster.ComputeConfig.NodePools
+		nodeRoleArn = aws.ToString(cluster.ComputeConfig.NodeRoleArn)
+	}
+	if cluster.StorageConfig != nil && cluster.StorageConfig.BlockStorage != nil {
+		storageEnabled = aws.ToBool(cluster.StorageConfig.BlockStorage.Enabled)
+	}
+	if cluster.KubernetesNetworkConfig != nil && cluster.KubernetesNetworkConfig.ElasticLoadBalancing != nil {
+		elbEnabled = aws.ToBool(cluster.KubernetesNetworkConfig.ElasticLoadBalancing.Enabled)
+	}
+
+	if computeEnabled != autoMode.Enabled || storageEnabled != autoMode.Enabled || elbEnabled != autoMode.Enabled {
+		return false
+	}
+	if !autoMode.Enabled {
+		return true
+	}
+
+	compute := makeComputeConfig(autoMode)
+	if aws.ToString(compute.NodeRoleArn) != nodeRoleArn {
+		return false
+	}
+
+	return sets.New(compute.NodePools...).Equal(sets.New(nodePools...))
+}
+
+func makeComputeConfig(autoMode *ekscontrolplanev1.AutoMode) *ekstypes.ComputeConfigRequest {
+	computeConfig := &ekstypes.ComputeConfigRequest{
+		Enabled: aws.Bool(autoMode.Enabled),
+	}
+	if !autoMode.Enabled {
+		return computeConfig
+	}
+
+	// An empty list of node pools disables the built-in node pools, so it is always sent.
+	computeConfig.NodePools = []string{}
+	if autoMode.Compute != nil {
+		for _, pool := range autoMode.Compute.NodePools {
+			computeConfig.NodePools = append(computeConfig.NodePools, string(pool))
+		}
+		computeConfig.NodeRoleArn = autoMode.Compute.NodeRoleArn
+	}
+
+	return computeConfig
+}
+
+func makeStorageConfig(autoMode *ekscontrolplanev1.AutoMode) *ekstypes.StorageConfigRequest {
+	return &ekstypes.StorageConfigRequest{
+		BlockStorage: &ekstypes.BlockStorage{
+			Enabled: aws.Bool(autoMode.Enabled),
+		},
+	}
+}
+
+func makeElasticLoadBalancing(autoMode *ekscontrolplanev1.AutoMode) *ekstypes.ElasticLoadBalancing {
+	return &ekstypes.ElasticLoadBalancing{
+		Enabled: aws.Bool(autoMode.Enabled),
+	}
+}
+
 func (s *Service) reconcileLogging(ctx context.Context, logging *ekstypes.Logging) error {
 	input := &eks.UpdateClusterConfigInput{Name: aws.String(s.scope.KubernetesClusterName())}
 
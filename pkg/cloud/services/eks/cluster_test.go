@@ -28,6 +28,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/version"
@@ -40,6 +41,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
 func TestMakeEKSEncryptionConfigs(t *testing.T) {
@@ -591,6 +593,182 @@ func TestReconcileAccessConfig(t *testing.T) {
 	}
 }
 
+func TestReconcileAutoMode(t *testing.T) {
+	clusterName := "default.cluster"
+	nodeRoleArn := "arn:aws:iam::123456789012:role/auto-mode-nodes"
+	enabledCluster := &ekstypes.Cluster{
+		Name: aws.String(clusterName),
+		ComputeConfig: &ekstypes.ComputeConfigResponse{
+			Enabled:     aws.Bool(true),
+			NodePools:   []string{"system", "general-purpose"},
+			NodeRoleArn: aws.String(nodeRoleArn),
+		},
+		StorageConfig: &ekstypes.StorageConfigResponse{
+			BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(true)},
+		},
+		KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigResponse{
+			ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(true)},
+		},
+	}
+	enabledAutoMode := &ekscontrolplanev1.AutoMode{
+		Enabled: true,
+		Compute: &ekscontrolplanev1.AutoModeCompute{
+			NodePools:   []ekscontrolplanev1.AutoModeNodePool{ekscontrolplanev1.AutoModeNodePoolGeneralPurpose, ekscontrolplanev1.AutoModeNodePoolSystem},
+			NodeRoleArn: aws.String(nodeRoleArn),
+		},
+	}
+
+	tests := []struct {
+		name            string
+		autoMode        *ekscontrolplanev1.AutoMode
+		cluster         *ekstypes.Cluster
+		expect          func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectError     bool
+		expectCondition *bool
+	}{
+		{
+			name:     "auto mode not configured",
+			autoMode: nil,
+			cluster:  &ekstypes.Cluster{Name: aws.String(clusterName)},
+			expect:   func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name:            "auto mode disabled and not enabled on cluster",
+			autoMode:        &ekscontrolplanev1.AutoMode{Enabled: false},
+			cluster:         &ekstypes.Cluster{Name: aws.String(clusterName)},
+			expect:          func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectCondition: ptr.To(true),
+		},
+		{
+			name:            "auto mode enabled and up to date",
+			autoMode:        enabledAutoMode,
+			cluster:         enabledCluster,
+			expect:          func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectCondition: ptr.To(true),
+		},
+		{
+			name:     "auto mode needs to be enabled",
+			autoMode: enabledAutoMode,
+			cluster:  &ekstypes.Cluster{Name: aws.String(clusterName)},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateClusterConfig(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdateClusterConfigInput{
+					Name: aws.String(clusterName),
+					ComputeConfig: &ekstypes.ComputeConfigRequest{
+						Enabled:     aws.Bool(true),
+						NodePools:   []string{"general-purpose", "system"},
+						NodeRoleArn: aws.String(nodeRoleArn),
+					},
+					StorageConfig: &ekstypes.StorageConfigRequest{
+						BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(true)},
+					},
+					KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigRequest{
+						ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(true)},
+					},
+				})).Return(&eks.UpdateClusterConfigOutput{}, nil)
+			},
+			expectCondition: ptr.To(false),
+		},
+		{
+			name:     "auto mode needs to be disabled",
+			autoMode: &ekscontrolplanev1.AutoMode{Enabled: false},
+			cluster:  enabledCluster,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateClusterConfig(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdateClusterConfigInput{
+					Name: aws.String(clusterName),
+					ComputeConfig: &ekstypes.ComputeConfigRequest{
+						Enabled: aws.Bool(false),
+					},
+					StorageConfig: &ekstypes.StorageConfigRequest{
+						BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(false)},
+					},
+					KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigRequest{
+						ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(false)},
+					},
+				})).Return(&eks.UpdateClusterConfigOutput{}, nil)
+			},
+			expectCondition: ptr.To(false),
+		},
+		{
+			name: "node pools changed",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+				Compute: &ekscontrolplanev1.AutoModeCompute{
+					NodePools:   []ekscontrolplanev1.AutoModeNodePool{ekscontrolplanev1.AutoModeNodePoolSystem},
+					NodeRoleArn: aws.String(nodeRoleArn),
+				},
+			},
+			cluster: enabledCluster,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateClusterConfig(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.UpdateClusterConfigInput{})).
+					Return(&eks.UpdateClusterConfigOutput{}, nil)
+			},
+			expectCondition: ptr.To(false),
+		},
+		{
+			name:     "api error",
+			autoMode: enabledAutoMode,
+			cluster:  &ekstypes.Cluster{Name: aws.String(clusterName)},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateClusterConfig(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.UpdateClusterConfigInput{})).
+					Return(nil, errors.New("unsupported compute configuration"))
+			},
+			expectError:     true,
+			expectCondition: ptr.To(false),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName: clusterName,
+						AutoMode:       tc.autoMode,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(eksMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+
+			err = s.reconcileAutoMode(context.TODO(), tc.cluster)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+
+			condition := v1beta1conditions.Get(scope.ControlPlane, ekscontrolplanev1.EKSAutoModeConfiguredCondition)
+			if tc.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status == corev1.ConditionTrue).To(Equal(*tc.expectCondition))
+		})
+	}
+}
+
 func TestCreateCluster(t *testing.T) {
 	clusterName := "cluster.default"
 	version := aws.String("1.24")
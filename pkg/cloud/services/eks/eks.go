@@ -19,6 +19,7 @@ package eks
 
 import (
 	"context"
+	"strings"
 
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
@@ -36,11 +37,18 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 	s.scope.Debug("Reconciling EKS control plane", "cluster", klog.KRef(s.scope.Cluster.Namespace, s.scope.Cluster.Name))
 
 	// Control Plane IAM Role
-	if err := s.reconcileControlPlaneIAMRole(ctx); err != nil {
+	missingPermissions, err := s.reconcileControlPlaneIAMRole(ctx)
+	if err != nil {
 		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition, ekscontrolplanev1.IAMControlPlaneRolesReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
 		return err
 	}
-	v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition)
+	if len(missingPermissions) > 0 {
+		record.Warnf(s.scope.ControlPlane, "MissingAutoModePermissions", "Control plane IAM role %q lacks permissions required by EKS Auto Mode: %s", *s.scope.ControlPlane.Spec.RoleName, strings.Join(missingPermissions, ", "))
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition, ekscontrolplanev1.IAMControlPlaneRoleMissingAutoModePermissionsReason, clusterv1beta1.ConditionSeverityWarning,
+			"Control plane role lacks permissions required by EKS Auto Mode: %s", strings.Join(missingPermissions, ", "))
+	} else {
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition)
+	}
 
 	// EKS Cluster
 	if err := s.reconcileCluster(ctx); err != nil {
@@ -49,6 +57,13 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 	}
 	v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition)
 
+	// EKS Pod Identity Associations
+	if err := s.reconcilePodIdentityAssociations(ctx); err != nil {
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return errors.Wrap(err, "failed reconciling eks pod identity associations")
+	}
+	v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition)
+
 	// EKS Addons
 	if err := s.reconcileAddons(ctx); err != nil {
 		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition, ekscontrolplanev1.EKSAddonsConfiguredFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
@@ -81,6 +96,11 @@ func (s *Service) DeleteControlPlane(ctx context.Context) (err error) {
 		return err
 	}
 
+	// Pod Identity association IAM roles, the associations are deleted with the cluster
+	if err := s.deletePodIdentityRoles(ctx); err != nil {
+		return err
+	}
+
 	// OIDC Provider
 	if err := s.deleteOIDCProvider(ctx); err != nil {
 		return err
@@ -35,6 +35,8 @@ var (
 	// ErrCannotUseAdditionalRoles is an error if the spec contains additional role and the
 	// EKSAllowAddRoles feature flag isn't enabled.
 	ErrCannotUseAdditionalRoles = errors.New("additional rules cannot be added as this has been disabled")
+	// ErrPodIdentityRoleNotFound is an error if the role of a pod identity association couldn't be found in AWS.
+	ErrPodIdentityRoleNotFound = errors.New("the specified pod identity association role couldn't be found")
 	// ErrNoSecurityGroup is an error when no security group is found for an EKS cluster.
 	ErrNoSecurityGroup = errors.New("no security group for EKS cluster")
 )
@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/url"
+	"slices"
 	"sort"
 	"strings"
 
@@ -44,6 +45,8 @@ import (
 const (
 	// EKSFargateService is the service to trust for fargate pod execution roles.
 	EKSFargateService = "eks-fargate-pods.amazonaws.com"
+	// EKSPodIdentityService is the service to trust for pod identity association roles.
+	EKSPodIdentityService = "pods.eks.amazonaws.com"
 )
 
 // IAMService defines the specs for an IAM service.
@@ -342,6 +345,54 @@ func (s *IAMService) DeleteRole(ctx context.Context, name string) error {
 	return nil
 }
 
+// MissingPolicies returns the given policies that are not attached to the role.
+func (s *IAMService) MissingPolicies(ctx context.Context, role *iamtypes.Role, policies []string) ([]string, error) {
+	attached, err := s.getIAMRolePolicies(ctx, *role.RoleName)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, policy := range policies {
+		if !slices.Contains(attached, policy) {
+			missing = append(missing, policy)
+		}
+	}
+
+	return missing, nil
+}
+
+// TrustsAction returns true if the trust relationship of the role allows the action.
+func TrustsAction(role *iamtypes.Role, action string) (bool, error) {
+	if role.AssumeRolePolicyDocument == nil {
+		return false, nil
+	}
+
+	rolePolicyDocumentRaw, err := url.PathUnescape(*role.AssumeRolePolicyDocument)
+	if err != nil {
+		return false, errors.Wrap(err, "couldn't decode AssumeRolePolicyDocument")
+	}
+
+	var rolePolicyDocument iamv1.PolicyDocument
+	if err := json.Unmarshal([]byte(rolePolicyDocumentRaw), &rolePolicyDocument); err != nil {
+		return false, errors.Wrap(err, "couldn't unmarshal AssumeRolePolicyDocument")
+	}
+
+	service, _, _ := strings.Cut(action, ":")
+	for _, statement := range rolePolicyDocument.Statement {
+		if statement.Effect != iamv1.EffectAllow {
+			continue
+		}
+		for _, allowed := range statement.Action {
+			if allowed == action || allowed == "*" || allowed == service+":*" {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
 // IsUnmanaged will check if a given role and tag are unmanaged against the IAMService.
 func (s *IAMService) IsUnmanaged(role *iamtypes.Role, key string) bool {
 	keyToFind := infrav1.ClusterAWSCloudProviderTagKey(key)
@@ -378,6 +429,15 @@ func ControlPlaneTrustRelationship(enableFargate bool) *iamv1.PolicyDocument {
 	return policy
 }
 
+// ControlPlaneAutoModeTrustRelationship will generate a ControlPlane PolicyDocument for clusters using EKS Auto Mode.
+// Auto Mode requires the EKS service to be able to tag the sessions of the cluster role.
+func ControlPlaneAutoModeTrustRelationship() *iamv1.PolicyDocument {
+	policy := ControlPlaneTrustRelationship(false)
+	policy.Statement[0].Action = append(policy.Statement[0].Action, "sts:TagSession")
+
+	return policy
+}
+
 // FargateTrustRelationship will generate a Fargate PolicyDocument.
 func FargateTrustRelationship() *iamv1.PolicyDocument {
 	identity := make(iamv1.Principals)
@@ -399,6 +459,28 @@ func FargateTrustRelationship() *iamv1.PolicyDocument {
 	return policy
 }
 
+// PodIdentityTrustRelationship will generate a Pod Identity association PolicyDocument.
+func PodIdentityTrustRelationship() *iamv1.PolicyDocument {
+	identity := make(iamv1.Principals)
+	identity["Service"] = []string{EKSPodIdentityService}
+
+	policy := &iamv1.PolicyDocument{
+		Version: "2012-10-17",
+		Statement: []iamv1.StatementEntry{
+			{
+				Effect: "Allow",
+				Action: []string{
+					"sts:AssumeRole",
+					"sts:TagSession",
+				},
+				Principal: identity,
+			},
+		},
+	}
+
+	return policy
+}
+
 // NodegroupTrustRelationship will generate a Nodegroup PolicyDocument.
 func NodegroupTrustRelationship() *iamv1.PolicyDocument {
 	identity := make(iamv1.Principals)
@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	ekspodidentity "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/podidentity"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+func (s *Service) reconcilePodIdentityAssociations(ctx context.Context) error {
+	associations := s.scope.ControlPlane.Spec.PodIdentityAssociations
+	if len(associations) == 0 && len(s.scope.ControlPlane.Status.PodIdentityAssociations) == 0 {
+		s.scope.Debug("no pod identity associations defined, skipping reconcile")
+		return nil
+	}
+
+	s.scope.Info("Reconciling EKS pod identity associations")
+
+	eksClusterName := s.scope.KubernetesClusterName()
+
+	// Resolve the role of each association, creating the roles defined inline
+	roleARNs := map[string]string{}
+	roleNames := map[string]string{}
+	for i := range associations {
+		association := associations[i]
+		key := podIdentityAssociationKey(association.Namespace, association.ServiceAccount)
+		if association.Role == nil {
+			roleARNs[key] = association.RoleARN
+			continue
+		}
+
+		roleName, roleARN, err := s.reconcilePodIdentityRole(ctx, &association)
+		if err != nil {
+			return fmt.Errorf("reconciling role of pod identity association %s: %w", key, err)
+		}
+		roleARNs[key] = roleARN
+		roleNames[key] = roleName
+	}
+
+	// Associations referenced by addons are created by EKS along with the addon
+	addonOwned := map[string]bool{}
+	for _, addon := range s.scope.Addons() {
+		for _, ref := range addon.PodIdentityAssociations {
+			addonOwned[podIdentityAssociationKey(ref.Namespace, ref.ServiceAccount)] = true
+		}
+	}
+
+	desired := []*ekspodidentity.EKSPodIdentityAssociation{}
+	for _, association := range associations {
+		key := podIdentityAssociationKey(association.Namespace, association.ServiceAccount)
+		if addonOwned[key] {
+			continue
+		}
+		desired = append(desired, &ekspodidentity.EKSPodIdentityAssociation{
+			Namespace:      association.Namespace,
+			ServiceAccount: association.ServiceAccount,
+			RoleARN:        roleARNs[key],
+			Tags:           ngTags(s.scope.Cluster.Name, s.scope.AdditionalTags()),
+		})
+	}
+
+	existing, err := s.describePodIdentityAssociations(ctx, eksClusterName)
+	if err != nil {
+		return err
+	}
+
+	// Only the associations created by the controller are managed, the ones owned
+	// by addons are managed by EKS.
+	managedTag := infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())
+	installed := []*ekspodidentity.EKSPodIdentityAssociation{}
+	for _, association := range existing {
+		if association.OwnerArn != nil {
+			continue
+		}
+		if _, managed := association.Tags[managedTag]; !managed {
+			continue
+		}
+		installed = append(installed, podIdentityAssociationFromSDK(association))
+	}
+
+	//  Compute operations to move installed to desired
+	s.scope.Debug("creating pod identity associations plan", "cluster", eksClusterName, "numdesired", len(desired), "numinstalled", len(installed))
+	associationsPlan := ekspodidentity.NewPlan(eksClusterName, desired, installed, s.EKSClient)
+	procedures, err := associationsPlan.Create(ctx)
+	if err != nil {
+		return fmt.Errorf("creating pod identity associations plan: %w", err)
+	}
+
+	for _, procedure := range procedures {
+		s.scope.Debug("Executing pod identity association procedure", "name", procedure.Name())
+		if err := procedure.Do(ctx); err != nil {
+			s.scope.Error(err, "failed executing pod identity association procedure", "name", procedure.Name())
+			return fmt.Errorf("%s: %w", procedure.Name(), err)
+		}
+	}
+
+	// Roles created for associations that were removed are no longer used
+	for _, status := range s.scope.ControlPlane.Status.PodIdentityAssociations {
+		if status.RoleName == "" {
+			continue
+		}
+		if roleNames[podIdentityAssociationKey(status.Namespace, status.ServiceAccount)] == status.RoleName {
+			continue
+		}
+		if err := s.deletePodIdentityRole(ctx, status.RoleName); err != nil {
+			return err
+		}
+	}
+
+	if len(procedures) > 0 {
+		existing, err = s.describePodIdentityAssociations(ctx, eksClusterName)
+		if err != nil {
+			return err
+		}
+	}
+
+	statuses := []ekscontrolplanev1.PodIdentityAssociationStatus{}
+	for _, association := range associations {
+		key := podIdentityAssociationKey(association.Namespace, association.ServiceAccount)
+		status := ekscontrolplanev1.PodIdentityAssociationStatus{
+			Namespace:      association.Namespace,
+			ServiceAccount: association.ServiceAccount,
+			RoleARN:        roleARNs[key],
+			RoleName:       roleNames[key],
+		}
+		for _, installed := range existing {
+			if podIdentityAssociationKey(aws.ToString(installed.Namespace), aws.ToString(installed.ServiceAccount)) != key {
+				continue
+			}
+			status.AssociationID = aws.ToString(installed.AssociationId)
+			status.AssociationARN = aws.ToString(installed.AssociationArn)
+			status.OwnerARN = aws.ToString(installed.OwnerArn)
+		}
+		statuses = append(statuses, status)
+	}
+	s.scope.ControlPlane.Status.PodIdentityAssociations = statuses
+
+	record.Eventf(s.scope.ControlPlane, "SuccessfulReconcilePodIdentityAssociations", "Reconciled pod identity associations for EKS Cluster %s", eksClusterName)
+	s.scope.Debug("Reconcile EKS pod identity associations completed successfully")
+
+	return nil
+}
+
+// describePodIdentityAssociations returns all the pod identity associations of the cluster.
+func (s *Service) describePodIdentityAssociations(ctx context.Context, eksClusterName string) ([]*ekstypes.PodIdentityAssociation, error) {
+	associations := []*ekstypes.PodIdentityAssociation{}
+	var nextToken *string
+
+	for {
+		output, err := s.EKSClient.ListPodIdentityAssociations(ctx, &eks.ListPodIdentityAssociationsInput{
+			ClusterName: aws.String(eksClusterName),
+			NextToken:   nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing pod identity associations: %w", err)
+		}
+
+		for _, summary := range output.Associations {
+			describeOutput, err := s.EKSClient.DescribePodIdentityAssociation(ctx, &eks.DescribePodIdentityAssociationInput{
+				AssociationId: summary.AssociationId,
+				ClusterName:   aws.String(eksClusterName),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("describing pod identity association %s: %w", aws.ToString(summary.AssociationId), err)
+			}
+			if describeOutput.Association == nil {
+				continue
+			}
+			associations = append(associations, describeOutput.Association)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return associations, nil
+}
+
+func podIdentityAssociationFromSDK(association *ekstypes.PodIdentityAssociation) *ekspodidentity.EKSPodIdentityAssociation {
+	converted := &ekspodidentity.EKSPodIdentityAssociation{
+		Namespace:      aws.ToString(association.Namespace),
+		ServiceAccount: aws.ToString(association.ServiceAccount),
+		RoleARN:        aws.ToString(association.RoleArn),
+		AssociationID:  aws.ToString(association.AssociationId),
+		AssociationARN: aws.ToString(association.AssociationArn),
+		Tags:           infrav1.Tags{},
+	}
+	for k, v := range association.Tags {
+		converted.Tags[k] = v
+	}
+
+	return converted
+}
+
+// podIdentityAssociationRoleARN returns the role resolved for the pod identity association of the given service account.
+func (s *Service) podIdentityAssociationRoleARN(namespace, serviceAccount string) string {
+	for _, status := range s.scope.ControlPlane.Status.PodIdentityAssociations {
+		if status.Namespace == namespace && status.ServiceAccount == serviceAccount {
+			return status.RoleARN
+		}
+	}
+
+	return ""
+}
+
+func podIdentityAssociationKey(namespace, serviceAccount string) string {
+	return fmt.Sprintf("%s/%s", namespace, serviceAccount)
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/converters"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcilePodIdentityAssociations(t *testing.T) {
+	const (
+		namespace      = "kube-system"
+		serviceAccount = "ebs-csi-controller-sa"
+		roleARN        = "arn:aws:iam::123456789012:role/ebs-csi"
+		associationID  = "a-abcdefghijklmnop1"
+		associationARN = "arn:aws:eks:us-east-1:123456789012:podidentityassociation/test-cluster/a-abcdefghijklmnop1"
+	)
+	managedTags := map[string]string{infrav1.ClusterAWSCloudProviderTagKey(clusterName): string(infrav1.ResourceLifecycleOwned)}
+	trustPolicy, err := converters.IAMPolicyDocumentToJSON(*eksiam.PodIdentityTrustRelationship())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ownedRoleTags := []iamtypes.Tag{{Key: aws.String(infrav1.ClusterAWSCloudProviderTagKey(clusterName)), Value: aws.String(string(infrav1.ResourceLifecycleOwned))}}
+
+	tests := []struct {
+		name         string
+		associations []ekscontrolplanev1.PodIdentityAssociation
+		addons       *[]ekscontrolplanev1.Addon
+		status       []ekscontrolplanev1.PodIdentityAssociationStatus
+		expect       func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectIAM    func(m *mock_iamauth.MockIAMAPIMockRecorder)
+		expectStatus []ekscontrolplanev1.PodIdentityAssociationStatus
+		expectError  bool
+	}{
+		{
+			name:         "no pod identity associations",
+			expect:       func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectStatus: nil,
+		},
+		{
+			name: "create association with role ARN",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: namespace, ServiceAccount: serviceAccount, RoleARN: roleARN},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				gomock.InOrder(
+					m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).
+						Return(&eks.ListPodIdentityAssociationsOutput{}, nil),
+					m.CreatePodIdentityAssociation(gomock.Any(), &eks.CreatePodIdentityAssociationInput{
+						ClusterName:    aws.String(clusterName),
+						Namespace:      aws.String(namespace),
+						ServiceAccount: aws.String(serviceAccount),
+						RoleArn:        aws.String(roleARN),
+						Tags:           managedTags,
+					}).Return(&eks.CreatePodIdentityAssociationOutput{
+						Association: &ekstypes.PodIdentityAssociation{AssociationId: aws.String(associationID)},
+					}, nil),
+					m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).
+						Return(&eks.ListPodIdentityAssociationsOutput{
+							Associations: []ekstypes.PodIdentityAssociationSummary{{AssociationId: aws.String(associationID)}},
+						}, nil),
+				)
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					AssociationId: aws.String(associationID),
+					ClusterName:   aws.String(clusterName),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String(associationID),
+						AssociationArn: aws.String(associationARN),
+						Namespace:      aws.String(namespace),
+						ServiceAccount: aws.String(serviceAccount),
+						RoleArn:        aws.String(roleARN),
+						Tags:           managedTags,
+					},
+				}, nil)
+			},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{
+					Namespace:      namespace,
+					ServiceAccount: serviceAccount,
+					AssociationID:  associationID,
+					AssociationARN: associationARN,
+					RoleARN:        roleARN,
+				},
+			},
+		},
+		{
+			name: "delete removed managed association and ignore unmanaged ones",
+			status: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{Namespace: namespace, ServiceAccount: serviceAccount, AssociationID: associationID, RoleARN: roleARN},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).
+					Return(&eks.ListPodIdentityAssociationsOutput{
+						Associations: []ekstypes.PodIdentityAssociationSummary{
+							{AssociationId: aws.String(associationID)},
+							{AssociationId: aws.String("a-unmanaged")},
+						},
+					}, nil).Times(2)
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					AssociationId: aws.String(associationID),
+					ClusterName:   aws.String(clusterName),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String(associationID),
+						Namespace:      aws.String(namespace),
+						ServiceAccount: aws.String(serviceAccount),
+						RoleArn:        aws.String(roleARN),
+						Tags:           managedTags,
+					},
+				}, nil).Times(2)
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					AssociationId: aws.String("a-unmanaged"),
+					ClusterName:   aws.String(clusterName),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-unmanaged"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("app"),
+						RoleArn:        aws.String(roleARN),
+					},
+				}, nil).Times(2)
+				m.DeletePodIdentityAssociation(gomock.Any(), &eks.DeletePodIdentityAssociationInput{
+					AssociationId: aws.String(associationID),
+					ClusterName:   aws.String(clusterName),
+				}).Return(&eks.DeletePodIdentityAssociationOutput{}, nil)
+			},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{},
+		},
+		{
+			name: "association referenced by an addon is left to the addon",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: namespace, ServiceAccount: serviceAccount, RoleARN: roleARN},
+			},
+			addons: &[]ekscontrolplanev1.Addon{
+				{
+					Name:    "aws-ebs-csi-driver",
+					Version: "v1.30.0-eksbuild.1",
+					PodIdentityAssociations: []ekscontrolplanev1.AddonPodIdentityAssociationReference{
+						{Namespace: namespace, ServiceAccount: serviceAccount},
+					},
+				},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).
+					Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+			},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{Namespace: namespace, ServiceAccount: serviceAccount, RoleARN: roleARN},
+			},
+		},
+		{
+			name: "create association with an existing managed inline role",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: namespace, ServiceAccount: serviceAccount, Role: &ekscontrolplanev1.PodIdentityRole{Name: "ebs-csi"}},
+			},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("ebs-csi")}).
+					Return(&iam.GetRoleOutput{
+						Role: &iamtypes.Role{
+							RoleName:                 aws.String("ebs-csi"),
+							Arn:                      aws.String(roleARN),
+							AssumeRolePolicyDocument: aws.String(trustPolicy),
+							Tags:                     ownedRoleTags,
+						},
+					}, nil)
+				m.ListAttachedRolePolicies(gomock.Any(), gomock.Any()).
+					Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				gomock.InOrder(
+					m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).
+						Return(&eks.ListPodIdentityAssociationsOutput{}, nil),
+					m.CreatePodIdentityAssociation(gomock.Any(), &eks.CreatePodIdentityAssociationInput{
+						ClusterName:    aws.String(clusterName),
+						Namespace:      aws.String(namespace),
+						ServiceAccount: aws.String(serviceAccount),
+						RoleArn:        aws.String(roleARN),
+						Tags:           managedTags,
+					}).Return(&eks.CreatePodIdentityAssociationOutput{
+						Association: &ekstypes.PodIdentityAssociation{AssociationId: aws.String(associationID)},
+					}, nil),
+					m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).
+						Return(&eks.ListPodIdentityAssociationsOutput{}, nil),
+				)
+			},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{Namespace: namespace, ServiceAccount: serviceAccount, RoleARN: roleARN, RoleName: "ebs-csi"},
+			},
+		},
+		{
+			name: "delete inline role of a removed association",
+			status: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{Namespace: namespace, ServiceAccount: serviceAccount, RoleARN: roleARN, RoleName: "ebs-csi"},
+			},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("ebs-csi")}).
+					Return(&iam.GetRoleOutput{
+						Role: &iamtypes.Role{RoleName: aws.String("ebs-csi"), Arn: aws.String(roleARN), Tags: ownedRoleTags},
+					}, nil)
+				m.ListAttachedRolePolicies(gomock.Any(), gomock.Any()).
+					Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+				m.DeleteRole(gomock.Any(), &iam.DeleteRoleInput{RoleName: aws.String("ebs-csi")}).
+					Return(&iam.DeleteRoleOutput{}, nil)
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any()).
+					Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+			},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{},
+		},
+		{
+			name: "inline role not found and IAM disabled",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: namespace, ServiceAccount: serviceAccount, Role: &ekscontrolplanev1.PodIdentityRole{}},
+			},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("test-cluster_kube-system-ebs-csi-controller-sa")}).
+					Return(nil, &iamtypes.NoSuchEntityException{})
+			},
+			expect:      func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      clusterName,
+				},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:          clusterName,
+					PodIdentityAssociations: tc.associations,
+					Addons:                  tc.addons,
+				},
+				Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+					PodIdentityAssociations: tc.status,
+				},
+			}
+
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: controlPlane,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(eksMock.EXPECT())
+			if tc.expectIAM != nil {
+				tc.expectIAM(iamMock.EXPECT())
+			}
+			s := NewService(scope)
+			s.EKSClient = eksMock
+			s.IAMClient = iamMock
+
+			err = s.reconcilePodIdentityAssociations(context.TODO())
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(controlPlane.Status.PodIdentityAssociations).To(Equal(tc.expectStatus))
+		})
+	}
+}
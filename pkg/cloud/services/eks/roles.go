@@ -22,12 +22,14 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/smithy-go"
 	"github.com/pkg/errors"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/api/bootstrap/v1beta1"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
 	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
@@ -70,7 +72,9 @@ func FargateRolePoliciesUSGov() []string {
 	}
 }
 
-func (s *Service) reconcileControlPlaneIAMRole(ctx context.Context) error {
+// reconcileControlPlaneIAMRole reconciles the control plane role. It returns the permissions required by
+// EKS Auto Mode that an unmanaged role lacks, as CAPA doesn't update roles it doesn't manage.
+func (s *Service) reconcileControlPlaneIAMRole(ctx context.Context) ([]string, error) {
 	s.scope.Debug("Reconciling EKS Control Plane IAM Role")
 
 	if s.scope.ControlPlane.Spec.RoleName == nil {
@@ -87,26 +91,29 @@ func (s *Service) reconcileControlPlaneIAMRole(ctx context.Context) error {
 	role, err := s.GetIAMRole(ctx, *s.scope.ControlPlane.Spec.RoleName)
 	if err != nil {
 		if !isNotFound(err) {
-			return err
+			return nil, err
 		}
 
 		// If the disable IAM flag is used then the role must exist
 		if !s.scope.EnableIAM() {
-			return fmt.Errorf("getting role %s: %w", *s.scope.ControlPlane.Spec.RoleName, ErrClusterRoleNotFound)
+			return nil, fmt.Errorf("getting role %s: %w", *s.scope.ControlPlane.Spec.RoleName, ErrClusterRoleNotFound)
 		}
 
-		role, err = s.CreateRole(ctx, *s.scope.ControlPlane.Spec.RoleName, s.scope.Name(), eksiam.ControlPlaneTrustRelationship(false), s.scope.AdditionalTags(), s.scope.ControlPlane.Spec.RolePath, s.scope.ControlPlane.Spec.RolePermissionsBoundary)
+		role, err = s.CreateRole(ctx, *s.scope.ControlPlane.Spec.RoleName, s.scope.Name(), s.controlPlaneTrustRelationship(), s.scope.AdditionalTags(), s.scope.ControlPlane.Spec.RolePath, s.scope.ControlPlane.Spec.RolePermissionsBoundary)
 		if err != nil {
 			record.Warnf(s.scope.ControlPlane, "FailedIAMRoleCreation", "Failed to create control plane IAM role %q: %v", *s.scope.ControlPlane.Spec.RoleName, err)
 
-			return fmt.Errorf("creating role %s: %w", *s.scope.ControlPlane.Spec.RoleName, err)
+			return nil, fmt.Errorf("creating role %s: %w", *s.scope.ControlPlane.Spec.RoleName, err)
 		}
 		record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleCreation", "Created control plane IAM role %q", *s.scope.ControlPlane.Spec.RoleName)
 	}
 
 	if s.IsUnmanaged(role, s.scope.Name()) {
 		s.scope.Debug("Skipping, EKS control plane role policy assignment as role is unmanaged")
-		return nil
+		if !s.autoModeEnabled() {
+			return nil, nil
+		}
+		return s.missingAutoModePermissions(ctx, role)
 	}
 
 	//TODO: check tags and trust relationship to see if they need updating
@@ -115,9 +122,18 @@ func (s *Service) reconcileControlPlaneIAMRole(ctx context.Context) error {
 		fmt.Sprintf("arn:%s:iam::aws:policy/AmazonEKSClusterPolicy", s.scope.Partition()),
 	}
 
+	if s.autoModeEnabled() {
+		// Roles created before Auto Mode was enabled lack sts:TagSession in their trust relationship.
+		if _, err := s.EnsureTagsAndPolicy(ctx, role, s.scope.Name(), s.controlPlaneTrustRelationship(), s.scope.AdditionalTags()); err != nil {
+			return nil, errors.Wrapf(err, "error ensuring tags and policy document are set on control plane role")
+		}
+
+		policies = append(policies, s.autoModeControlPlanePolicyARNs()...)
+	}
+
 	if s.scope.ControlPlane.Spec.RoleAdditionalPolicies != nil {
 		if !s.scope.AllowAdditionalRoles() && len(*s.scope.ControlPlane.Spec.RoleAdditionalPolicies) > 0 {
-			return ErrCannotUseAdditionalRoles
+			return nil, ErrCannotUseAdditionalRoles
 		}
 
 		for _, policy := range *s.scope.ControlPlane.Spec.RoleAdditionalPolicies {
@@ -127,10 +143,62 @@ func (s *Service) reconcileControlPlaneIAMRole(ctx context.Context) error {
 	}
 	_, err = s.EnsurePoliciesAttached(ctx, role, policies)
 	if err != nil {
-		return errors.Wrapf(err, "error ensuring policies are attached: %v", policies)
+		return nil, errors.Wrapf(err, "error ensuring policies are attached: %v", policies)
 	}
 
-	return nil
+	return nil, nil
+}
+
+// AutoModeControlPlanePolicies gives the managed policies required by the cluster role when EKS Auto Mode is enabled.
+func AutoModeControlPlanePolicies() []string {
+	return []string{
+		"AmazonEKSComputePolicy",
+		"AmazonEKSBlockStoragePolicy",
+		"AmazonEKSLoadBalancingPolicy",
+		"AmazonEKSNetworkingPolicy",
+	}
+}
+
+func (s *Service) autoModeControlPlanePolicyARNs() []string {
+	policies := []string{}
+	for _, policy := range AutoModeControlPlanePolicies() {
+		policies = append(policies, fmt.Sprintf("arn:%s:iam::aws:policy/%s", s.scope.Partition(), policy))
+	}
+
+	return policies
+}
+
+// missingAutoModePermissions returns the sts:TagSession trust and the managed policies required by EKS Auto Mode
+// that the control plane role lacks.
+func (s *Service) missingAutoModePermissions(ctx context.Context, role *iamtypes.Role) ([]string, error) {
+	var missing []string
+
+	trusted, err := eksiam.TrustsAction(role, "sts:TagSession")
+	if err != nil {
+		return nil, errors.Wrapf(err, "error checking the trust relationship of control plane role")
+	}
+	if !trusted {
+		missing = append(missing, "sts:TagSession trust")
+	}
+
+	missingPolicies, err := s.MissingPolicies(ctx, role, s.autoModeControlPlanePolicyARNs())
+	if err != nil {
+		return nil, errors.Wrapf(err, "error checking the policies attached to control plane role")
+	}
+
+	return append(missing, missingPolicies...), nil
+}
+
+func (s *Service) autoModeEnabled() bool {
+	return s.scope.ControlPlane.Spec.AutoMode != nil && s.scope.ControlPlane.Spec.AutoMode.Enabled
+}
+
+func (s *Service) controlPlaneTrustRelationship() *iamv1.PolicyDocument {
+	if s.autoModeEnabled() {
+		return eksiam.ControlPlaneAutoModeTrustRelationship()
+	}
+
+	return eksiam.ControlPlaneTrustRelationship(false)
 }
 
 func (s *Service) deleteControlPlaneIAMRole(ctx context.Context) error {
@@ -399,6 +467,98 @@ func (s *FargateService) deleteFargateIAMRole(ctx context.Context) (reterr error
 	return nil
 }
 
+// reconcilePodIdentityRole ensures the role defined inline for an association exists and returns its name and ARN.
+func (s *Service) reconcilePodIdentityRole(ctx context.Context, association *ekscontrolplanev1.PodIdentityAssociation) (string, string, error) {
+	roleName := association.Role.Name
+	if roleName == "" {
+		var err error
+		roleName, err = eks.GenerateEKSName(
+			fmt.Sprintf("%s-%s", association.Namespace, association.ServiceAccount),
+			s.scope.KubernetesClusterName(),
+			maxIAMRoleNameLength,
+		)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to generate IAM role name")
+		}
+	}
+
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if !isNotFound(err) {
+			return "", "", err
+		}
+
+		// If the disable IAM flag is used then the role must exist
+		if !s.scope.EnableIAM() {
+			return "", "", fmt.Errorf("getting role %s: %w", roleName, ErrPodIdentityRoleNotFound)
+		}
+
+		role, err = s.CreateRole(ctx, roleName, s.scope.Name(), eksiam.PodIdentityTrustRelationship(), s.scope.AdditionalTags(), s.scope.ControlPlane.Spec.RolePath, s.scope.ControlPlane.Spec.RolePermissionsBoundary)
+		if err != nil {
+			record.Warnf(s.scope.ControlPlane, "FailedIAMRoleCreation", "Failed to create pod identity IAM role %q: %v", roleName, err)
+			return "", "", fmt.Errorf("creating role %s: %w", roleName, err)
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleCreation", "Created pod identity IAM role %q", roleName)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.scope.Debug("Skipping, pod identity role policy assignment as role is unmanaged", "role", roleName)
+		return roleName, aws.ToString(role.Arn), nil
+	}
+
+	if _, err := s.EnsureTagsAndPolicy(ctx, role, s.scope.Name(), eksiam.PodIdentityTrustRelationship(), s.scope.AdditionalTags()); err != nil {
+		return "", "", errors.Wrapf(err, "error ensuring tags and policy document are set on pod identity role %s", roleName)
+	}
+
+	if len(association.Role.PolicyARNs) > 0 && !s.scope.AllowAdditionalRoles() {
+		return "", "", ErrCannotUseAdditionalRoles
+	}
+	if _, err := s.EnsurePoliciesAttached(ctx, role, association.Role.PolicyARNs); err != nil {
+		return "", "", errors.Wrapf(err, "error ensuring policies are attached: %v", association.Role.PolicyARNs)
+	}
+
+	return roleName, aws.ToString(role.Arn), nil
+}
+
+// deletePodIdentityRoles deletes the roles created for the pod identity associations of the cluster.
+func (s *Service) deletePodIdentityRoles(ctx context.Context) error {
+	for _, status := range s.scope.ControlPlane.Status.PodIdentityAssociations {
+		if status.RoleName == "" {
+			continue
+		}
+		if err := s.deletePodIdentityRole(ctx, status.RoleName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) deletePodIdentityRole(ctx context.Context, roleName string) error {
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if isNotFound(err) {
+			s.Debug("Pod identity IAM role already deleted", "role", roleName)
+			return nil
+		}
+
+		return errors.Wrapf(err, "getting pod identity iam role %s", roleName)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.Debug("Skipping, pod identity iam role deletion as role is unmanaged", "role", roleName)
+		return nil
+	}
+
+	if err := s.DeleteRole(ctx, roleName); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedIAMRoleDeletion", "Failed to delete pod identity IAM role %q: %v", roleName, err)
+		return err
+	}
+
+	record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleDeletion", "Deleted pod identity IAM role %q", roleName)
+	return nil
+}
+
 func isNotFound(err error) bool {
 	var apiErr smithy.APIError
 	if errors.As(err, &apiErr) {
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/converters"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileControlPlaneIAMRoleUnmanaged(t *testing.T) {
+	const roleName = "eks-controlplane"
+
+	trustPolicy, err := converters.IAMPolicyDocumentToJSON(*eksiam.ControlPlaneTrustRelationship(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	autoModeTrustPolicy, err := converters.IAMPolicyDocumentToJSON(*eksiam.ControlPlaneAutoModeTrustRelationship())
+	if err != nil {
+		t.Fatal(err)
+	}
+	autoModePolicies := []iamtypes.AttachedPolicy{
+		{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonEKSClusterPolicy")},
+		{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonEKSComputePolicy")},
+		{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonEKSBlockStoragePolicy")},
+		{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonEKSLoadBalancingPolicy")},
+		{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonEKSNetworkingPolicy")},
+	}
+
+	tests := []struct {
+		name          string
+		autoMode      *ekscontrolplanev1.AutoMode
+		trustPolicy   string
+		expectIAM     func(m *mock_iamauth.MockIAMAPIMockRecorder)
+		expectMissing []string
+	}{
+		{
+			name:        "doesn't check the role without Auto Mode",
+			trustPolicy: trustPolicy,
+		},
+		{
+			name:        "reports the trust and the policies the role lacks for Auto Mode",
+			autoMode:    &ekscontrolplanev1.AutoMode{Enabled: true},
+			trustPolicy: trustPolicy,
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListAttachedRolePolicies(gomock.Any(), &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)}).
+					Return(&iam.ListAttachedRolePoliciesOutput{AttachedPolicies: autoModePolicies[:2]}, nil)
+			},
+			expectMissing: []string{
+				"sts:TagSession trust",
+				"arn:aws:iam::aws:policy/AmazonEKSBlockStoragePolicy",
+				"arn:aws:iam::aws:policy/AmazonEKSLoadBalancingPolicy",
+				"arn:aws:iam::aws:policy/AmazonEKSNetworkingPolicy",
+			},
+		},
+		{
+			name:        "reports nothing when the role has the Auto Mode permissions",
+			autoMode:    &ekscontrolplanev1.AutoMode{Enabled: true},
+			trustPolicy: autoModeTrustPolicy,
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListAttachedRolePolicies(gomock.Any(), &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)}).
+					Return(&iam.ListAttachedRolePoliciesOutput{AttachedPolicies: autoModePolicies}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName: clusterName,
+						RoleName:       aws.String(roleName),
+						AutoMode:       tc.autoMode,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			iamMock.EXPECT().GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String(roleName)}).
+				Return(&iam.GetRoleOutput{Role: &iamtypes.Role{
+					RoleName:                 aws.String(roleName),
+					AssumeRolePolicyDocument: aws.String(tc.trustPolicy),
+				}}, nil)
+			if tc.expectIAM != nil {
+				tc.expectIAM(iamMock.EXPECT())
+			}
+			s := NewService(scope)
+			s.IAMClient = iamMock
+
+			missing, err := s.reconcileControlPlaneIAMRole(context.TODO())
+			g.Expect(err).To(BeNil())
+			g.Expect(missing).To(Equal(tc.expectMissing))
+		})
+	}
+}
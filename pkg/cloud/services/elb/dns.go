@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// reconcileAPIServerDNSRecord creates or updates the Route53 record pointing at the primary API server load balancer,
+// when one is configured.
+func (s *Service) reconcileAPIServerDNSRecord(ctx context.Context) error {
+	lbSpec := s.scope.ControlPlaneLoadBalancer()
+	if lbSpec == nil || lbSpec.DNS == nil {
+		return nil
+	}
+
+	lb := s.scope.Network().APIServerELB
+	if lb.DNSName == "" {
+		s.scope.Debug("Load balancer DNS name not yet available, skipping DNS record reconciliation")
+		return nil
+	}
+	if lbSpec.DNS.IsAlias() && lb.CanonicalHostedZoneID == "" {
+		s.scope.Debug("Load balancer hosted zone not yet available, skipping DNS record reconciliation")
+		return nil
+	}
+
+	desired := apiServerResourceRecordSet(lbSpec.DNS, &lb)
+	zoneID := hostedZoneID(lbSpec.DNS.HostedZoneID)
+
+	existing, err := s.describeResourceRecordSet(ctx, zoneID, lbSpec.DNS.FQDN(), desired.Type)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case existing != nil && resourceRecordSetMatches(existing, desired):
+		s.scope.Debug("DNS record is up to date", "name", lbSpec.DNS.FQDN(), "hosted-zone-id", zoneID)
+	case existing != nil && s.scope.Network().APIServerDNSRecord == nil:
+		// Never take over a record that was not created by the controller.
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateDNSRecord", "DNS record %q already exists in hosted zone %q and points to another target", lbSpec.DNS.FQDN(), zoneID)
+		return errors.Errorf("DNS record %q of type %s already exists in hosted zone %q and points to another target", lbSpec.DNS.FQDN(), desired.Type, zoneID)
+	default:
+		if err := s.changeResourceRecordSet(ctx, zoneID, route53types.ChangeActionUpsert, desired); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateDNSRecord", "Failed to create DNS record %q: %v", lbSpec.DNS.FQDN(), err)
+			return errors.Wrapf(err, "failed to create DNS record %q in hosted zone %q", lbSpec.DNS.FQDN(), zoneID)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateDNSRecord", "Created DNS record %q pointing at load balancer %q", lbSpec.DNS.FQDN(), lb.DNSName)
+		s.scope.Info("Created DNS record for the API server load balancer", "name", lbSpec.DNS.FQDN(), "hosted-zone-id", zoneID)
+	}
+
+	s.scope.Network().APIServerDNSRecord = &infrav1.DNSRecord{
+		HostedZoneID: zoneID,
+		Name:         lbSpec.DNS.FQDN(),
+		Type:         string(desired.Type),
+	}
+
+	return nil
+}
+
+// deleteAPIServerDNSRecord deletes the Route53 record created for the API server load balancer, if any.
+func (s *Service) deleteAPIServerDNSRecord(ctx context.Context) error {
+	dnsRecord := s.scope.Network().APIServerDNSRecord
+	if dnsRecord == nil {
+		return nil
+	}
+
+	existing, err := s.describeResourceRecordSet(ctx, dnsRecord.HostedZoneID, dnsRecord.Name, route53types.RRType(dnsRecord.Type))
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		// Deletion requires the exact values of the record set, so the record is deleted as currently found.
+		if err := s.changeResourceRecordSet(ctx, dnsRecord.HostedZoneID, route53types.ChangeActionDelete, existing); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteDNSRecord", "Failed to delete DNS record %q: %v", dnsRecord.Name, err)
+			return errors.Wrapf(err, "failed to delete DNS record %q in hosted zone %q", dnsRecord.Name, dnsRecord.HostedZoneID)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteDNSRecord", "Deleted DNS record %q", dnsRecord.Name)
+		s.scope.Info("Deleted DNS record for the API server load balancer", "name", dnsRecord.Name, "hosted-zone-id", dnsRecord.HostedZoneID)
+	}
+
+	s.scope.Network().APIServerDNSRecord = nil
+	return nil
+}
+
+// describeResourceRecordSet returns the record set with the given name and type, or nil if it does not exist.
+func (s *Service) describeResourceRecordSet(ctx context.Context, zoneID, name string, recordType route53types.RRType) (*route53types.ResourceRecordSet, error) {
+	out, err := s.Route53Client.ListResourceRecordSets(ctx, &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zoneID),
+		StartRecordName: aws.String(name),
+		StartRecordType: recordType,
+		MaxItems:        aws.Int32(1),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list DNS records in hosted zone %q", zoneID)
+	}
+
+	for i := range out.ResourceRecordSets {
+		rrs := out.ResourceRecordSets[i]
+		if rrs.Type == recordType && dnsNamesEqual(aws.ToString(rrs.Name), name) {
+			return &rrs, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Service) changeResourceRecordSet(ctx context.Context, zoneID string, action route53types.ChangeAction, rrs *route53types.ResourceRecordSet) error {
+	_, err := s.Route53Client.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+		ChangeBatch: &route53types.ChangeBatch{
+			Comment: aws.String(fmt.Sprintf("Kubernetes API server endpoint of cluster %s", s.scope.Name())),
+			Changes: []route53types.Change{
+				{
+					Action:            action,
+					ResourceRecordSet: rrs,
+				},
+			},
+		},
+	})
+	return err
+}
+
+// apiServerResourceRecordSet returns the record set pointing at the given load balancer.
+func apiServerResourceRecordSet(spec *infrav1.LoadBalancerDNSSpec, lb *infrav1.LoadBalancer) *route53types.ResourceRecordSet {
+	if spec.IsAlias() {
+		return &route53types.ResourceRecordSet{
+			Name: aws.String(spec.FQDN()),
+			Type: route53types.RRTypeA,
+			AliasTarget: &route53types.AliasTarget{
+				DNSName:              aws.String(lb.DNSName),
+				HostedZoneId:         aws.String(lb.CanonicalHostedZoneID),
+				EvaluateTargetHealth: false,
+			},
+		}
+	}
+
+	return &route53types.ResourceRecordSet{
+		Name: aws.String(spec.FQDN()),
+		Type: route53types.RRTypeCname,
+		TTL:  spec.TTL,
+		ResourceRecords: []route53types.ResourceRecord{
+			{Value: aws.String(lb.DNSName)},
+		},
+	}
+}
+
+// resourceRecordSetMatches returns whether an existing record set points at the same target as the desired one.
+func resourceRecordSetMatches(existing, desired *route53types.ResourceRecordSet) bool {
+	if desired.AliasTarget != nil {
+		return existing.AliasTarget != nil &&
+			dnsNamesEqual(aws.ToString(existing.AliasTarget.DNSName), aws.ToString(desired.AliasTarget.DNSName)) &&
+			hostedZoneID(aws.ToString(existing.AliasTarget.HostedZoneId)) == aws.ToString(desired.AliasTarget.HostedZoneId)
+	}
+
+	return existing.AliasTarget == nil &&
+		aws.ToInt64(existing.TTL) == aws.ToInt64(desired.TTL) &&
+		len(existing.ResourceRecords) == 1 &&
+		dnsNamesEqual(aws.ToString(existing.ResourceRecords[0].Value), aws.ToString(desired.ResourceRecords[0].Value))
+}
+
+// hostedZoneID strips the "/hostedzone/" prefix that Route53 returns in some hosted zone IDs.
+func hostedZoneID(id string) string {
+	return strings.TrimPrefix(id, "/hostedzone/")
+}
+
+// dnsNamesEqual compares two DNS names, ignoring case and trailing dots.
+func dnsNamesEqual(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	testHostedZoneID   = "Z0123456789ABCDEFGHIJ"
+	testLBHostedZoneID = "Z26RNL4JYFTOTI"
+	testLBDNSName      = "apiserver-123456.elb.us-east-1.amazonaws.com"
+)
+
+func TestReconcileAPIServerDNSRecord(t *testing.T) {
+	aliasRecord := func(target string) route53types.ResourceRecordSet {
+		return route53types.ResourceRecordSet{
+			Name: aws.String("api.example.com."),
+			Type: route53types.RRTypeA,
+			AliasTarget: &route53types.AliasTarget{
+				DNSName:      aws.String(target + "."),
+				HostedZoneId: aws.String(testLBHostedZoneID),
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		dns     *infrav1.LoadBalancerDNSSpec
+		lb      infrav1.LoadBalancer
+		status  *infrav1.DNSRecord
+		expect  func(m *mocks.MockRoute53APIMockRecorder)
+		check   func(g *WithT, status *infrav1.DNSRecord)
+		wantErr bool
+	}{
+		{
+			name:   "does nothing without a dns spec",
+			lb:     infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBHostedZoneID},
+			expect: func(m *mocks.MockRoute53APIMockRecorder) {},
+			check: func(g *WithT, status *infrav1.DNSRecord) {
+				g.Expect(status).To(BeNil())
+			},
+		},
+		{
+			name:   "waits for the load balancer hosted zone before creating an alias record",
+			dns:    &infrav1.LoadBalancerDNSSpec{HostedZoneID: testHostedZoneID, RecordName: "api.example.com"},
+			lb:     infrav1.LoadBalancer{DNSName: testLBDNSName},
+			expect: func(m *mocks.MockRoute53APIMockRecorder) {},
+			check: func(g *WithT, status *infrav1.DNSRecord) {
+				g.Expect(status).To(BeNil())
+			},
+		},
+		{
+			name: "creates an alias record pointing at the load balancer",
+			dns:  &infrav1.LoadBalancerDNSSpec{HostedZoneID: "/hostedzone/" + testHostedZoneID, RecordName: "api.example.com."},
+			lb:   infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBHostedZoneID},
+			expect: func(m *mocks.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(gomock.Any(), gomock.Eq(&route53.ListResourceRecordSetsInput{
+					HostedZoneId:    aws.String(testHostedZoneID),
+					StartRecordName: aws.String("api.example.com"),
+					StartRecordType: route53types.RRTypeA,
+					MaxItems:        aws.Int32(1),
+				})).Return(&route53.ListResourceRecordSetsOutput{}, nil)
+				m.ChangeResourceRecordSets(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *route53.ChangeResourceRecordSetsInput, _ ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+					g := NewWithT(t)
+					g.Expect(aws.ToString(input.HostedZoneId)).To(Equal(testHostedZoneID))
+					g.Expect(input.ChangeBatch.Changes).To(HaveLen(1))
+					change := input.ChangeBatch.Changes[0]
+					g.Expect(change.Action).To(Equal(route53types.ChangeActionUpsert))
+					g.Expect(change.ResourceRecordSet.Type).To(Equal(route53types.RRTypeA))
+					g.Expect(aws.ToString(change.ResourceRecordSet.AliasTarget.DNSName)).To(Equal(testLBDNSName))
+					g.Expect(aws.ToString(change.ResourceRecordSet.AliasTarget.HostedZoneId)).To(Equal(testLBHostedZoneID))
+					return &route53.ChangeResourceRecordSetsOutput{}, nil
+				})
+			},
+			check: func(g *WithT, status *infrav1.DNSRecord) {
+				g.Expect(status).To(Equal(&infrav1.DNSRecord{HostedZoneID: testHostedZoneID, Name: "api.example.com", Type: "A"}))
+			},
+		},
+		{
+			name: "creates a CNAME record when a TTL is set",
+			dns:  &infrav1.LoadBalancerDNSSpec{HostedZoneID: testHostedZoneID, RecordName: "api.example.com", TTL: aws.Int64(60)},
+			lb:   infrav1.LoadBalancer{DNSName: testLBDNSName},
+			expect: func(m *mocks.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53.ListResourceRecordSetsOutput{}, nil)
+				m.ChangeResourceRecordSets(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *route53.ChangeResourceRecordSetsInput, _ ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+					g := NewWithT(t)
+					rrs := input.ChangeBatch.Changes[0].ResourceRecordSet
+					g.Expect(rrs.Type).To(Equal(route53types.RRTypeCname))
+					g.Expect(aws.ToInt64(rrs.TTL)).To(BeEquivalentTo(60))
+					g.Expect(rrs.ResourceRecords).To(HaveLen(1))
+					g.Expect(aws.ToString(rrs.ResourceRecords[0].Value)).To(Equal(testLBDNSName))
+					return &route53.ChangeResourceRecordSetsOutput{}, nil
+				})
+			},
+			check: func(g *WithT, status *infrav1.DNSRecord) {
+				g.Expect(status).To(Equal(&infrav1.DNSRecord{HostedZoneID: testHostedZoneID, Name: "api.example.com", Type: "CNAME"}))
+			},
+		},
+		{
+			name:   "does not change a record that is up to date",
+			dns:    &infrav1.LoadBalancerDNSSpec{HostedZoneID: testHostedZoneID, RecordName: "api.example.com"},
+			lb:     infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBHostedZoneID},
+			status: &infrav1.DNSRecord{HostedZoneID: testHostedZoneID, Name: "api.example.com", Type: "A"},
+			expect: func(m *mocks.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []route53types.ResourceRecordSet{aliasRecord(testLBDNSName)},
+				}, nil)
+			},
+			check: func(g *WithT, status *infrav1.DNSRecord) {
+				g.Expect(status).ToNot(BeNil())
+			},
+		},
+		{
+			name:   "updates a record created by the controller that points to another target",
+			dns:    &infrav1.LoadBalancerDNSSpec{HostedZoneID: testHostedZoneID, RecordName: "api.example.com"},
+			lb:     infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBHostedZoneID},
+			status: &infrav1.DNSRecord{HostedZoneID: testHostedZoneID, Name: "api.example.com", Type: "A"},
+			expect: func(m *mocks.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []route53types.ResourceRecordSet{aliasRecord("old-apiserver.elb.us-east-1.amazonaws.com")},
+				}, nil)
+				m.ChangeResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53.ChangeResourceRecordSetsOutput{}, nil)
+			},
+			check: func(g *WithT, status *infrav1.DNSRecord) {
+				g.Expect(status).ToNot(BeNil())
+			},
+		},
+		{
+			name: "refuses to take over a record that was not created by the controller",
+			dns:  &infrav1.LoadBalancerDNSSpec{HostedZoneID: testHostedZoneID, RecordName: "api.example.com"},
+			lb:   infrav1.LoadBalancer{DNSName: testLBDNSName, CanonicalHostedZoneID: testLBHostedZoneID},
+			expect: func(m *mocks.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []route53types.ResourceRecordSet{aliasRecord("other.elb.us-east-1.amazonaws.com")},
+				}, nil)
+			},
+			check: func(g *WithT, status *infrav1.DNSRecord) {
+				g.Expect(status).To(BeNil())
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			route53Mock := mocks.NewMockRoute53API(mockCtrl)
+
+			clusterScope := newDNSTestClusterScope(t, tc.dns, tc.lb, tc.status)
+			tc.expect(route53Mock.EXPECT())
+
+			s := &Service{
+				scope:         clusterScope,
+				Route53Client: route53Mock,
+			}
+
+			err := s.reconcileAPIServerDNSRecord(context.TODO())
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			tc.check(g, clusterScope.Network().APIServerDNSRecord)
+		})
+	}
+}
+
+func TestDeleteAPIServerDNSRecord(t *testing.T) {
+	existing := route53types.ResourceRecordSet{
+		Name: aws.String("api.example.com."),
+		Type: route53types.RRTypeCname,
+		TTL:  aws.Int64(60),
+		ResourceRecords: []route53types.ResourceRecord{
+			{Value: aws.String(testLBDNSName)},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		status *infrav1.DNSRecord
+		expect func(m *mocks.MockRoute53APIMockRecorder)
+	}{
+		{
+			name:   "does nothing when no record was created",
+			expect: func(m *mocks.MockRoute53APIMockRecorder) {},
+		},
+		{
+			name:   "deletes the record as currently found",
+			status: &infrav1.DNSRecord{HostedZoneID: testHostedZoneID, Name: "api.example.com", Type: "CNAME"},
+			expect: func(m *mocks.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []route53types.ResourceRecordSet{existing},
+				}, nil)
+				m.ChangeResourceRecordSets(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *route53.ChangeResourceRecordSetsInput, _ ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+					g := NewWithT(t)
+					g.Expect(input.ChangeBatch.Changes[0].Action).To(Equal(route53types.ChangeActionDelete))
+					g.Expect(*input.ChangeBatch.Changes[0].ResourceRecordSet).To(Equal(existing))
+					return &route53.ChangeResourceRecordSetsOutput{}, nil
+				})
+			},
+		},
+		{
+			name:   "clears the status when the record is already gone",
+			status: &infrav1.DNSRecord{HostedZoneID: testHostedZoneID, Name: "api.example.com", Type: "A"},
+			expect: func(m *mocks.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(gomock.Any(), gomock.Any()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []route53types.ResourceRecordSet{
+						{Name: aws.String("b.example.com."), Type: route53types.RRTypeA},
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			route53Mock := mocks.NewMockRoute53API(mockCtrl)
+
+			clusterScope := newDNSTestClusterScope(t, nil, infrav1.LoadBalancer{}, tc.status)
+			tc.expect(route53Mock.EXPECT())
+
+			s := &Service{
+				scope:         clusterScope,
+				Route53Client: route53Mock,
+			}
+
+			g.Expect(s.deleteAPIServerDNSRecord(context.TODO())).To(Succeed())
+			g.Expect(clusterScope.Network().APIServerDNSRecord).To(BeNil())
+		})
+	}
+}
+
+func newDNSTestClusterScope(t *testing.T, dns *infrav1.LoadBalancerDNSSpec, lb infrav1.LoadBalancer, status *infrav1.DNSRecord) *scope.ClusterScope {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "bar",
+			},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrav1.AWSClusterSpec{
+				ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+					LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+					DNS:              dns,
+				},
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					APIServerELB:       lb,
+					APIServerDNSRecord: status,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return clusterScope
+}
@@ -19,6 +19,7 @@ package elb
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -69,6 +70,14 @@ const additionalTargetGroupPrefix = "additional-listener-"
 // cantAttachSGToNLBRegions is a set of regions that do not support Security Groups in NLBs.
 var cantAttachSGToNLBRegions = sets.New("us-iso-east-1", "us-iso-west-1", "us-isob-east-1")
 
+// optionalLBFeatureAttributes are the load balancer attributes only set when the matching feature is enabled
+// in the load balancer spec.
+var optionalLBFeatureAttributes = []string{
+	infrav1.LoadBalancerAttributeAccessLogsEnabled,
+	infrav1.LoadBalancerAttributeConnectionLogsEnabled,
+	infrav1.LoadBalancerAttributeDeletionProtectionEnabled,
+}
+
 type lbReconciler func() error
 
 // ReconcileLoadbalancers reconciles the load balancers for the given cluster.
@@ -116,6 +125,13 @@ func (s *Service) ReconcileLoadbalancers(ctx context.Context) error {
 		}
 	}
 
+	// The DNS record can only point at the load balancer once it is reconciled.
+	if len(errs) == 0 {
+		if err := s.reconcileAPIServerDNSRecord(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return kerrors.NewAggregate(errs)
 }
 
@@ -178,6 +194,13 @@ func (s *Service) reconcileV2LB(ctx context.Context, lb *infrav1.LoadBalancer, d
 			return errors.Wrapf(err, "failed to create target groups/listeners for load balancer %q", lb.Name)
 		}
 
+		// Features removed from the spec have to be explicitly turned off on the load balancer.
+		for _, key := range optionalLBFeatureAttributes {
+			if _, ok := desiredLB.ELBAttributes[key]; !ok && aws.ToString(lb.ELBAttributes[key]) == "true" {
+				desiredLB.ELBAttributes[key] = aws.String("false")
+			}
+		}
+
 		if !cmp.Equal(desiredLB.ELBAttributes, lb.ELBAttributes) {
 			if err := s.configureLBAttributes(ctx, lb.ARN, desiredLB.ELBAttributes); err != nil {
 				return err
@@ -271,7 +294,7 @@ func (s *Service) getAPITargetGroupHealthCheck(lbSpec *infrav1.AWSLoadBalancerSp
 func (s *Service) getAdditionalTargetGroupHealthCheck(ln infrav1.AdditionalListenerSpec) *infrav1.TargetGroupHealthCheck {
 	healthCheck := &infrav1.TargetGroupHealthCheck{
 		Port:                    aws.String(fmt.Sprintf("%d", ln.Port)),
-		Protocol:                aws.String(ln.Protocol.String()),
+		Protocol:                aws.String(additionalTargetGroupProtocol(ln).String()),
 		Path:                    nil,
 		IntervalSeconds:         aws.Int64(infrav1.DefaultAPIServerHealthCheckIntervalSec),
 		TimeoutSeconds:          aws.Int64(infrav1.DefaultAPIServerHealthCheckTimeoutSec),
@@ -306,6 +329,15 @@ func (s *Service) getAdditionalTargetGroupHealthCheck(ln infrav1.AdditionalListe
 	return healthCheck
 }
 
+// additionalTargetGroupProtocol returns the protocol of the target group of an additional listener.
+// TLS is terminated on the load balancer, so the traffic of TLS listeners is forwarded to the targets over TCP.
+func additionalTargetGroupProtocol(ln infrav1.AdditionalListenerSpec) infrav1.ELBProtocol {
+	if ln.Protocol == infrav1.ELBProtocolTLS {
+		return infrav1.ELBProtocolTCP
+	}
+	return ln.Protocol
+}
+
 // getAPITargetGroupIPType determines the IP address type for the API server target group.
 // It examines the control plane subnets to determine if they have IPv4 and/or IPv6 addresses,
 // and can be overridden by the load balancer spec.
@@ -405,8 +437,9 @@ func (s *Service) getAPIServerLBSpec(ctx context.Context, elbName string, lbSpec
 
 	if lbSpec != nil {
 		for _, listener := range lbSpec.AdditionalListeners {
+			tgProtocol := additionalTargetGroupProtocol(listener)
 			lnHealthCheck := &infrav1.TargetGroupHealthCheck{
-				Protocol: aws.String(string(listener.Protocol)),
+				Protocol: aws.String(string(tgProtocol)),
 				Port:     aws.String(strconv.FormatInt(listener.Port, 10)),
 			}
 			if listener.HealthCheck != nil {
@@ -414,12 +447,15 @@ func (s *Service) getAPIServerLBSpec(ctx context.Context, elbName string, lbSpec
 				lnHealthCheck = s.getAdditionalTargetGroupHealthCheck(listener)
 			}
 			res.ELBListeners = append(res.ELBListeners, infrav1.Listener{
-				Protocol: listener.Protocol,
-				Port:     listener.Port,
+				Protocol:        listener.Protocol,
+				Port:            listener.Port,
+				CertificateARNs: listener.CertificateARNs,
+				SSLPolicy:       aws.ToString(listener.SSLPolicy),
+				ALPNPolicy:      ptr.Deref(listener.ALPNPolicy, ""),
 				TargetGroup: infrav1.TargetGroupSpec{
 					Name:        names.SimpleNameGenerator.GenerateName(additionalTargetGroupPrefix),
 					Port:        listener.Port,
-					Protocol:    listener.Protocol,
+					Protocol:    tgProtocol,
 					VpcID:       s.scope.VPC().ID,
 					HealthCheck: lnHealthCheck,
 					IPType:      s.getAdditionalTargetGroupIPType(listener),
@@ -435,6 +471,23 @@ func (s *Service) getAPIServerLBSpec(ctx context.Context, elbName string, lbSpec
 	if lbSpec != nil {
 		isCrossZoneLB := lbSpec.CrossZoneLoadBalancing
 		res.ELBAttributes[infrav1.LoadBalancerAttributeEnableLoadBalancingCrossZone] = aws.String(strconv.FormatBool(isCrossZoneLB))
+
+		if lbSpec.DeletionProtection {
+			res.ELBAttributes[infrav1.LoadBalancerAttributeDeletionProtectionEnabled] = aws.String("true")
+		}
+	}
+
+	if lbSpec != nil && lbSpec.AccessLogs != nil &&
+		(lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeALB || lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeNLB) {
+		res.ELBAttributes[infrav1.LoadBalancerAttributeAccessLogsEnabled] = aws.String("true")
+		res.ELBAttributes[infrav1.LoadBalancerAttributeAccessLogsBucket] = aws.String(lbSpec.AccessLogs.Bucket)
+		res.ELBAttributes[infrav1.LoadBalancerAttributeAccessLogsPrefix] = aws.String(lbSpec.AccessLogs.Prefix)
+	}
+
+	if lbSpec != nil && lbSpec.ConnectionLogs != nil && lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeALB {
+		res.ELBAttributes[infrav1.LoadBalancerAttributeConnectionLogsEnabled] = aws.String("true")
+		res.ELBAttributes[infrav1.LoadBalancerAttributeConnectionLogsBucket] = aws.String(lbSpec.ConnectionLogs.Bucket)
+		res.ELBAttributes[infrav1.LoadBalancerAttributeConnectionLogsPrefix] = aws.String(lbSpec.ConnectionLogs.Prefix)
 	}
 
 	res.Tags = infrav1.Build(infrav1.BuildParams{
@@ -556,6 +609,7 @@ func (s *Service) createLB(ctx context.Context, spec *infrav1.LoadBalancer, lbSp
 	res := spec.DeepCopy()
 	s.scope.Debug("applying load balancer DNS to result", "dns", dnsName)
 	res.DNSName = dnsName
+	res.CanonicalHostedZoneID = aws.ToString(out.LoadBalancers[0].CanonicalHostedZoneId)
 	res.ARN = arn
 	return res, nil
 }
@@ -822,6 +876,10 @@ func (s *Service) deleteAWSCloudProviderELBs(ctx context.Context) error {
 func (s *Service) DeleteLoadbalancers(ctx context.Context) error {
 	s.scope.Debug("Deleting load balancers")
 
+	if err := s.deleteAPIServerDNSRecord(ctx); err != nil {
+		return errors.Wrap(err, "failed to delete control plane DNS record")
+	}
+
 	if err := s.deleteAPIServerELB(ctx); err != nil {
 		return errors.Wrap(err, "failed to delete control plane load balancer")
 	}
@@ -872,6 +930,17 @@ func (s *Service) deleteExistingNLB(ctx context.Context, lbSpec *infrav1.AWSLoad
 		s.scope.Debug("Found unmanaged load balancer for apiserver, skipping deletion", "api-server-elb-name", lb.Name)
 		return nil
 	}
+
+	if aws.ToString(lb.ELBAttributes[infrav1.LoadBalancerAttributeDeletionProtectionEnabled]) == "true" {
+		s.scope.Debug("disabling deletion protection on load balancer", "name", name)
+		if err := s.configureLBAttributes(ctx, lb.ARN, map[string]*string{
+			infrav1.LoadBalancerAttributeDeletionProtectionEnabled: aws.String("false"),
+		}); err != nil {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.LoadBalancerReadyCondition, "DeletingFailed", clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+			return err
+		}
+	}
+
 	s.scope.Debug("deleting load balancer", "name", name)
 	if err := s.deleteLB(ctx, lb.ARN); err != nil {
 		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.LoadBalancerReadyCondition, "DeletingFailed", clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
@@ -1358,10 +1427,10 @@ func (s *Service) configureAttributes(ctx context.Context, name string, attribut
 
 func (s *Service) configureLBAttributes(ctx context.Context, arn string, attributes map[string]*string) error {
 	attrs := make([]elbv2types.LoadBalancerAttribute, 0)
-	for k, v := range attributes {
+	for _, k := range sets.List(sets.KeySet(attributes)) {
 		attrs = append(attrs, elbv2types.LoadBalancerAttribute{
 			Key:   aws.String(k),
-			Value: v,
+			Value: attributes[k],
 		})
 	}
 	s.scope.Debug("adding attributes to load balancer", "attrs", attrs)
@@ -1730,8 +1799,6 @@ func (s *Service) reconcileTargetGroupsAndListeners(ctx context.Context, lbARN s
 	createdTargetGroups := make([]*elbv2types.TargetGroup, 0, len(spec.ELBListeners))
 	createdListeners := make([]*elbv2types.Listener, 0, len(spec.ELBListeners))
 
-	// TODO(Skarlso): Add options to set up SSL.
-	// https://github.com/kubernetes-sigs/cluster-api-provider-aws/issues/3899
 	for _, ln := range spec.ELBListeners {
 		var group *elbv2types.TargetGroup
 		tgSpec := ln.TargetGroup
@@ -1795,6 +1862,10 @@ func (s *Service) reconcileTargetGroupsAndListeners(ctx context.Context, lbARN s
 				return nil, nil, err
 			}
 			createdListeners = append(createdListeners, listener)
+		} else if ln.Protocol == infrav1.ELBProtocolTLS || listener.Protocol == elbv2types.ProtocolEnumTls {
+			if err := s.reconcileTLSListener(ctx, listener, ln); err != nil {
+				return nil, nil, err
+			}
 		}
 	}
 
@@ -1815,6 +1886,17 @@ func (s *Service) createListener(ctx context.Context, ln infrav1.Listener, group
 		Protocol:        elbProtocolToSDKProtocol(ln.Protocol),
 		Tags:            converters.MapToV2Tags(tags),
 	}
+	if ln.Protocol == infrav1.ELBProtocolTLS {
+		if len(ln.CertificateARNs) > 0 {
+			listenerInput.Certificates = []elbv2types.Certificate{{CertificateArn: aws.String(ln.CertificateARNs[0])}}
+		}
+		if ln.SSLPolicy != "" {
+			listenerInput.SslPolicy = aws.String(ln.SSLPolicy)
+		}
+		if ln.ALPNPolicy != "" {
+			listenerInput.AlpnPolicy = []string{string(ln.ALPNPolicy)}
+		}
+	}
 	// Create ClassicELBListeners
 	listener, err := s.ELBV2Client.CreateListener(ctx, listenerInput)
 	if err != nil {
@@ -1826,9 +1908,115 @@ func (s *Service) createListener(ctx context.Context, ln infrav1.Listener, group
 	if len(listener.Listeners) > 1 {
 		return nil, errors.New("more than one listener created; expected only one")
 	}
+	if len(ln.CertificateARNs) > 1 {
+		if err := s.reconcileListenerCertificates(ctx, aws.ToString(listener.Listeners[0].ListenerArn), ln.CertificateARNs); err != nil {
+			return nil, err
+		}
+	}
 	return &listener.Listeners[0], nil
 }
 
+// reconcileTLSListener updates the protocol, default certificate and policies of an existing listener,
+// and its additional certificates. This allows rotating the certificates without recreating the listener.
+func (s *Service) reconcileTLSListener(ctx context.Context, listener *elbv2types.Listener, ln infrav1.Listener) error {
+	input := &elbv2.ModifyListenerInput{ListenerArn: listener.ListenerArn}
+	needsUpdate := false
+
+	if !strings.EqualFold(string(listener.Protocol), ln.Protocol.String()) {
+		input.Protocol = elbProtocolToSDKProtocol(ln.Protocol)
+		needsUpdate = true
+	}
+
+	if ln.Protocol == infrav1.ELBProtocolTLS {
+		var defaultCertificateARN string
+		if len(listener.Certificates) > 0 {
+			defaultCertificateARN = aws.ToString(listener.Certificates[0].CertificateArn)
+		}
+		if len(ln.CertificateARNs) > 0 && defaultCertificateARN != ln.CertificateARNs[0] {
+			input.Certificates = []elbv2types.Certificate{{CertificateArn: aws.String(ln.CertificateARNs[0])}}
+			needsUpdate = true
+		}
+		if ln.SSLPolicy != "" && aws.ToString(listener.SslPolicy) != ln.SSLPolicy {
+			input.SslPolicy = aws.String(ln.SSLPolicy)
+			needsUpdate = true
+		}
+		if ln.ALPNPolicy != "" && !slices.Equal(listener.AlpnPolicy, []string{string(ln.ALPNPolicy)}) {
+			input.AlpnPolicy = []string{string(ln.ALPNPolicy)}
+			needsUpdate = true
+		}
+	}
+
+	if needsUpdate {
+		s.scope.Debug("updating listener", "listener-arn", aws.ToString(listener.ListenerArn), "protocol", ln.Protocol)
+		if _, err := s.ELBV2Client.ModifyListener(ctx, input); err != nil {
+			return errors.Wrapf(err, "failed to modify listener %q", aws.ToString(listener.ListenerArn))
+		}
+	}
+
+	if ln.Protocol != infrav1.ELBProtocolTLS {
+		return nil
+	}
+	return s.reconcileListenerCertificates(ctx, aws.ToString(listener.ListenerArn), ln.CertificateARNs)
+}
+
+// reconcileListenerCertificates reconciles the certificates of a TLS listener besides its default certificate,
+// which is the first of the given certificates.
+func (s *Service) reconcileListenerCertificates(ctx context.Context, listenerARN string, certificateARNs []string) error {
+	desired := sets.New[string]()
+	if len(certificateARNs) > 1 {
+		desired.Insert(certificateARNs[1:]...)
+	}
+
+	current := sets.New[string]()
+	input := &elbv2.DescribeListenerCertificatesInput{ListenerArn: aws.String(listenerARN)}
+	for {
+		out, err := s.ELBV2Client.DescribeListenerCertificates(ctx, input)
+		if err != nil {
+			return errors.Wrapf(err, "failed to describe certificates of listener %q", listenerARN)
+		}
+		for _, c := range out.Certificates {
+			if aws.ToBool(c.IsDefault) {
+				continue
+			}
+			current.Insert(aws.ToString(c.CertificateArn))
+		}
+		if aws.ToString(out.NextMarker) == "" {
+			break
+		}
+		input.Marker = out.NextMarker
+	}
+
+	if toAdd := sets.List(desired.Difference(current)); len(toAdd) > 0 {
+		s.scope.Debug("adding certificates to listener", "listener-arn", listenerARN, "certificates", toAdd)
+		if _, err := s.ELBV2Client.AddListenerCertificates(ctx, &elbv2.AddListenerCertificatesInput{
+			ListenerArn:  aws.String(listenerARN),
+			Certificates: listenerCertificates(toAdd),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to add certificates to listener %q", listenerARN)
+		}
+	}
+
+	if toRemove := sets.List(current.Difference(desired)); len(toRemove) > 0 {
+		s.scope.Debug("removing certificates from listener", "listener-arn", listenerARN, "certificates", toRemove)
+		if _, err := s.ELBV2Client.RemoveListenerCertificates(ctx, &elbv2.RemoveListenerCertificatesInput{
+			ListenerArn:  aws.String(listenerARN),
+			Certificates: listenerCertificates(toRemove),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to remove certificates from listener %q", listenerARN)
+		}
+	}
+
+	return nil
+}
+
+func listenerCertificates(arns []string) []elbv2types.Certificate {
+	certificates := make([]elbv2types.Certificate, 0, len(arns))
+	for _, certificateARN := range arns {
+		certificates = append(certificates, elbv2types.Certificate{CertificateArn: aws.String(certificateARN)})
+	}
+	return certificates
+}
+
 // createTargetGroup creates a single Target Group.
 func (s *Service) createTargetGroup(ctx context.Context, ln infrav1.Listener, tags map[string]string) (*elbv2types.TargetGroup, error) {
 	targetGroupInput := &elbv2.CreateTargetGroupInput{
@@ -1898,13 +2086,14 @@ func (s *Service) getHealthCheckTarget() string {
 
 func fromSDKTypeToClassicELB(v *elbtypes.LoadBalancerDescription, attrs *elbtypes.LoadBalancerAttributes, tags []elbtypes.Tag) *infrav1.LoadBalancer {
 	res := &infrav1.LoadBalancer{
-		Name:             aws.ToString(v.LoadBalancerName),
-		Scheme:           infrav1.ELBScheme(*v.Scheme),
-		SubnetIDs:        v.Subnets,
-		SecurityGroupIDs: v.SecurityGroups,
-		DNSName:          aws.ToString(v.DNSName),
-		Tags:             converters.ELBTagsToMap(tags),
-		LoadBalancerType: infrav1.LoadBalancerTypeClassic,
+		Name:                  aws.ToString(v.LoadBalancerName),
+		Scheme:                infrav1.ELBScheme(*v.Scheme),
+		SubnetIDs:             v.Subnets,
+		SecurityGroupIDs:      v.SecurityGroups,
+		DNSName:               aws.ToString(v.DNSName),
+		CanonicalHostedZoneID: aws.ToString(v.CanonicalHostedZoneNameID),
+		Tags:                  converters.ELBTagsToMap(tags),
+		LoadBalancerType:      infrav1.LoadBalancerTypeClassic,
 		// Classic Load Balancers only support IPv4.
 		LoadBalancerIPAddressType: infrav1.LoadBalancerIPAddressTypeIPv4,
 	}
@@ -1933,6 +2122,7 @@ func fromSDKTypeToLB(v elbv2types.LoadBalancer, attrs []elbv2types.LoadBalancerA
 		SecurityGroupIDs:          v.SecurityGroups,
 		AvailabilityZones:         availabilityZones,
 		DNSName:                   aws.ToString(v.DNSName),
+		CanonicalHostedZoneID:     aws.ToString(v.CanonicalHostedZoneId),
 		Tags:                      converters.V2TagsToMap(tags),
 		LoadBalancerIPAddressType: infrav1.LoadBalancerIPAddressType(v.IpAddressType),
 	}
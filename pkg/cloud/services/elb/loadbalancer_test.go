@@ -435,6 +435,76 @@ func TestGetAPIServerV2ELBSpecControlPlaneLoadBalancer(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "A TLS additional listener forwards TCP traffic to its target group",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+				AdditionalListeners: []infrav1.AdditionalListenerSpec{
+					{
+						Port:            8443,
+						Protocol:        infrav1.ELBProtocolTLS,
+						CertificateARNs: []string{"arn:aws:acm:us-east-1:123456789012:certificate/default"},
+						SSLPolicy:       aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+					},
+				},
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBListeners).To(HaveLen(2))
+				listener := res.ELBListeners[1]
+				g.Expect(listener.Protocol).To(Equal(infrav1.ELBProtocolTLS))
+				g.Expect(listener.CertificateARNs).To(Equal([]string{"arn:aws:acm:us-east-1:123456789012:certificate/default"}))
+				g.Expect(listener.SSLPolicy).To(Equal("ELBSecurityPolicy-TLS13-1-2-2021-06"))
+				g.Expect(listener.TargetGroup.Protocol).To(Equal(infrav1.ELBProtocolTCP))
+				g.Expect(listener.TargetGroup.HealthCheck.Protocol).To(Equal(aws.String("TCP")))
+			},
+		},
+		{
+			name: "load balancer config with access logs, connection logs and deletion protection for ALB",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType:   infrav1.LoadBalancerTypeALB,
+				DeletionProtection: true,
+				AccessLogs: &infrav1.LoadBalancerLogsSpec{
+					Bucket: "lb-logs",
+					Prefix: "access",
+				},
+				ConnectionLogs: &infrav1.LoadBalancerLogsSpec{
+					Bucket: "lb-logs",
+					Prefix: "connection",
+				},
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeDeletionProtectionEnabled, aws.String("true")))
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeAccessLogsEnabled, aws.String("true")))
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeAccessLogsBucket, aws.String("lb-logs")))
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeAccessLogsPrefix, aws.String("access")))
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeConnectionLogsEnabled, aws.String("true")))
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeConnectionLogsBucket, aws.String("lb-logs")))
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeConnectionLogsPrefix, aws.String("connection")))
+			},
+		},
+		{
+			name: "connection logs are not configured for NLB",
+			lb: &infrav1.AWSLoadBalancerSpec{
+				LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+				AccessLogs: &infrav1.LoadBalancerLogsSpec{
+					Bucket: "lb-logs",
+				},
+				ConnectionLogs: &infrav1.LoadBalancerLogsSpec{
+					Bucket: "lb-logs",
+				},
+			},
+			mocks: func(m *mocks.MockEC2APIMockRecorder) {},
+			expect: func(t *testing.T, g *WithT, res *infrav1.LoadBalancer) {
+				t.Helper()
+				g.Expect(res.ELBAttributes).To(HaveKeyWithValue(infrav1.LoadBalancerAttributeAccessLogsEnabled, aws.String("true")))
+				g.Expect(res.ELBAttributes).NotTo(HaveKey(infrav1.LoadBalancerAttributeConnectionLogsEnabled))
+				g.Expect(res.ELBAttributes).NotTo(HaveKey(infrav1.LoadBalancerAttributeDeletionProtectionEnabled))
+			},
+		},
 	}
 
 	ctx := context.TODO()
@@ -2206,6 +2276,193 @@ func TestReconcileTargetGroupsAndListeners(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "TLS listener is created with its certificates and policies",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				spec.ELBListeners = []infrav1.Listener{
+					{
+						Protocol:        infrav1.ELBProtocolTLS,
+						Port:            8443,
+						CertificateARNs: []string{"arn:aws:acm:us-east-1:123456789012:certificate/default", "arn:aws:acm:us-east-1:123456789012:certificate/sni"},
+						SSLPolicy:       "ELBSecurityPolicy-TLS13-1-2-2021-06",
+						ALPNPolicy:      infrav1.ALPNPolicyHTTP2Preferred,
+						TargetGroup: infrav1.TargetGroupSpec{
+							Name:     "additional-listener-tls",
+							Port:     8443,
+							Protocol: infrav1.ELBProtocolTCP,
+							VpcID:    vpcID,
+						},
+					},
+				}
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetGroups(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []elbv2types.TargetGroup{},
+				}, nil)
+				m.CreateTargetGroup(gomock.Any(), gomock.Any()).Return(&elbv2.CreateTargetGroupOutput{
+					TargetGroups: []elbv2types.TargetGroup{
+						{
+							TargetGroupArn:  aws.String(tgArn),
+							TargetGroupName: aws.String("additional-listener-tls"),
+						},
+					},
+				}, nil)
+				m.ModifyTargetGroupAttributes(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.DescribeListeners(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []elbv2types.Listener{},
+				}, nil)
+				m.CreateListener(gomock.Any(), gomock.Eq(&elbv2.CreateListenerInput{
+					DefaultActions: []elbv2types.Action{
+						{
+							TargetGroupArn: aws.String(tgArn),
+							Type:           elbv2types.ActionTypeEnumForward,
+						},
+					},
+					LoadBalancerArn: aws.String(elbArn),
+					Port:            aws.Int32(8443),
+					Protocol:        elbv2types.ProtocolEnumTls,
+					Certificates: []elbv2types.Certificate{
+						{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/default")},
+					},
+					SslPolicy:  aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+					AlpnPolicy: []string{"HTTP2Preferred"},
+					Tags: []elbv2types.Tag{
+						{
+							Key:   aws.String("test"),
+							Value: aws.String("tag"),
+						},
+					},
+				})).Return(&elbv2.CreateListenerOutput{
+					Listeners: []elbv2types.Listener{
+						{
+							DefaultActions: []elbv2types.Action{
+								{
+									TargetGroupArn: aws.String(tgArn),
+									Type:           elbv2types.ActionTypeEnumForward,
+								},
+							},
+							ListenerArn: aws.String("listener::arn"),
+							Port:        aws.Int32(8443),
+							Protocol:    elbv2types.ProtocolEnumTls,
+						},
+					},
+				}, nil)
+				m.DescribeListenerCertificates(gomock.Any(), gomock.Eq(&elbv2.DescribeListenerCertificatesInput{
+					ListenerArn: aws.String("listener::arn"),
+				})).Return(&elbv2.DescribeListenerCertificatesOutput{
+					Certificates: []elbv2types.Certificate{
+						{
+							CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/default"),
+							IsDefault:      aws.Bool(true),
+						},
+					},
+				}, nil)
+				m.AddListenerCertificates(gomock.Any(), gomock.Eq(&elbv2.AddListenerCertificatesInput{
+					ListenerArn: aws.String("listener::arn"),
+					Certificates: []elbv2types.Certificate{
+						{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/sni")},
+					},
+				})).Return(&elbv2.AddListenerCertificatesOutput{}, nil)
+			},
+			check: func(t *testing.T, tgs []*elbv2types.TargetGroup, listeners []*elbv2types.Listener, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if len(listeners) != 1 {
+					t.Fatalf("expected 1 listener to be created, got %d", len(listeners))
+				}
+			},
+		},
+		{
+			name: "existing TLS listener certificates are rotated",
+			spec: func(spec infrav1.LoadBalancer) infrav1.LoadBalancer {
+				spec.ELBListeners = []infrav1.Listener{
+					{
+						Protocol:        infrav1.ELBProtocolTLS,
+						Port:            8443,
+						CertificateARNs: []string{"arn:aws:acm:us-east-1:123456789012:certificate/new"},
+						TargetGroup: infrav1.TargetGroupSpec{
+							Name:     "additional-listener-tls",
+							Port:     8443,
+							Protocol: infrav1.ELBProtocolTCP,
+							VpcID:    vpcID,
+						},
+					},
+				}
+				return spec
+			},
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				return acl
+			},
+			elbV2APIMocks: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeTargetGroups(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []elbv2types.TargetGroup{
+						{
+							TargetGroupArn:  aws.String(tgArn),
+							TargetGroupName: aws.String("additional-listener-existing"),
+							Port:            aws.Int32(8443),
+							Protocol:        elbv2types.ProtocolEnumTcp,
+						},
+					},
+				}, nil)
+				m.DescribeListeners(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []elbv2types.Listener{
+						{
+							DefaultActions: []elbv2types.Action{
+								{
+									TargetGroupArn: aws.String(tgArn),
+									Type:           elbv2types.ActionTypeEnumForward,
+								},
+							},
+							ListenerArn: aws.String("listener::arn"),
+							Port:        aws.Int32(8443),
+							Protocol:    elbv2types.ProtocolEnumTls,
+							Certificates: []elbv2types.Certificate{
+								{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/old")},
+							},
+						},
+					},
+				}, nil)
+				m.ModifyListener(gomock.Any(), gomock.Eq(&elbv2.ModifyListenerInput{
+					ListenerArn: aws.String("listener::arn"),
+					Certificates: []elbv2types.Certificate{
+						{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/new")},
+					},
+				})).Return(&elbv2.ModifyListenerOutput{}, nil)
+				m.DescribeListenerCertificates(gomock.Any(), gomock.Any()).Return(&elbv2.DescribeListenerCertificatesOutput{
+					Certificates: []elbv2types.Certificate{
+						{
+							CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/new"),
+							IsDefault:      aws.Bool(true),
+						},
+						{
+							CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/stale"),
+							IsDefault:      aws.Bool(false),
+						},
+					},
+				}, nil)
+				m.RemoveListenerCertificates(gomock.Any(), gomock.Eq(&elbv2.RemoveListenerCertificatesInput{
+					ListenerArn: aws.String("listener::arn"),
+					Certificates: []elbv2types.Certificate{
+						{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/stale")},
+					},
+				})).Return(&elbv2.RemoveListenerCertificatesOutput{}, nil)
+			},
+			check: func(t *testing.T, tgs []*elbv2types.TargetGroup, listeners []*elbv2types.Listener, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("did not expect error: %v", err)
+				}
+				if len(tgs) != 0 || len(listeners) != 0 {
+					t.Fatalf("expected no target group or listener to be created, got %d target group(s) and %d listener(s)", len(tgs), len(listeners))
+				}
+			},
+		},
 	}
 
 	ctx := context.TODO()
@@ -3283,6 +3540,93 @@ func TestDeleteNLB(t *testing.T) {
 				m.DeleteTargetGroup(gomock.Any(), &elbv2.DeleteTargetGroupInput{TargetGroupArn: aws.String(tgArn)}).Return(&elbv2.DeleteTargetGroupOutput{}, nil)
 				// delete the load balancer
 
+				m.DeleteLoadBalancer(gomock.Any(), &elbv2.DeleteLoadBalancerInput{LoadBalancerArn: aws.String(elbArn)}).Return(
+					&elbv2.DeleteLoadBalancerOutput{}, nil)
+
+				m.DescribeLoadBalancers(gomock.Any(), &elbv2.DescribeLoadBalancersInput{Names: []string{elbName}}).Return(
+					&elbv2.DescribeLoadBalancersOutput{
+						LoadBalancers: []elbv2types.LoadBalancer{},
+					},
+					nil,
+				)
+			},
+		},
+		{
+			name: "if control plane ELB is found, and it is managed with deletion protection, disable the protection and delete the ELB",
+			elbv2ApiMock: func(m *mocks.MockELBV2APIMockRecorder) {
+				m.DescribeLoadBalancers(gomock.Any(), &elbv2.DescribeLoadBalancersInput{Names: []string{elbName}}).Return(
+					&elbv2.DescribeLoadBalancersOutput{
+						LoadBalancers: []elbv2types.LoadBalancer{
+							{
+								LoadBalancerArn:  aws.String(elbArn),
+								LoadBalancerName: aws.String(elbName),
+								Scheme:           SchemeToSDKScheme(infrav1.ELBSchemeInternetFacing),
+							},
+						},
+					},
+					nil,
+				)
+
+				m.DescribeLoadBalancerAttributes(gomock.Any(), &elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(elbArn)}).Return(
+					&elbv2.DescribeLoadBalancerAttributesOutput{
+						Attributes: []elbv2types.LoadBalancerAttribute{
+							{
+								Key:   aws.String("load_balancing.cross_zone.enabled"),
+								Value: aws.String("false"),
+							},
+							{
+								Key:   aws.String(infrav1.LoadBalancerAttributeDeletionProtectionEnabled),
+								Value: aws.String("true"),
+							},
+						},
+					},
+					nil,
+				)
+
+				m.DescribeTags(gomock.Any(), &elbv2.DescribeTagsInput{ResourceArns: []string{elbArn}}).Return(
+					&elbv2.DescribeTagsOutput{
+						TagDescriptions: []elbv2types.TagDescription{
+							{
+								ResourceArn: aws.String(elbArn),
+								Tags: []elbv2types.Tag{{
+									Key:   aws.String(infrav1.ClusterTagKey(clusterName)),
+									Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+								}},
+							},
+						},
+					},
+					nil,
+				)
+
+				// disable deletion protection
+				m.ModifyLoadBalancerAttributes(gomock.Any(), &elbv2.ModifyLoadBalancerAttributesInput{
+					LoadBalancerArn: aws.String(elbArn),
+					Attributes: []elbv2types.LoadBalancerAttribute{{
+						Key:   aws.String(infrav1.LoadBalancerAttributeDeletionProtectionEnabled),
+						Value: aws.String("false"),
+					}},
+				}).Return(&elbv2.ModifyLoadBalancerAttributesOutput{}, nil)
+
+				// delete listeners
+				m.DescribeListeners(gomock.Any(), &elbv2.DescribeListenersInput{LoadBalancerArn: aws.String(elbArn)}).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []elbv2types.Listener{
+						{
+							ListenerArn: aws.String("listener::arn"),
+						},
+					},
+				}, nil)
+				m.DeleteListener(gomock.Any(), &elbv2.DeleteListenerInput{ListenerArn: aws.String("listener::arn")}).Return(&elbv2.DeleteListenerOutput{}, nil)
+				// delete target groups
+				m.DescribeTargetGroups(gomock.Any(), &elbv2.DescribeTargetGroupsInput{LoadBalancerArn: aws.String(elbArn)}).Return(&elbv2.DescribeTargetGroupsOutput{
+					TargetGroups: []elbv2types.TargetGroup{
+						{
+							TargetGroupArn: aws.String(tgArn),
+						},
+					},
+				}, nil)
+				m.DeleteTargetGroup(gomock.Any(), &elbv2.DeleteTargetGroupInput{TargetGroupArn: aws.String(tgArn)}).Return(&elbv2.DeleteTargetGroupOutput{}, nil)
+				// delete the load balancer
+
 				m.DeleteLoadBalancer(gomock.Any(), &elbv2.DeleteLoadBalancerInput{LoadBalancerArn: aws.String(elbArn)}).Return(
 					&elbv2.DeleteLoadBalancerOutput{}, nil)
 
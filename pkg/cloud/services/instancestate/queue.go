@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -66,28 +67,13 @@ func (s *Service) deleteSQSQueue(ctx context.Context) error {
 }
 
 func (s *Service) createPolicyForRule(ctx context.Context, input *createPolicyForRuleInput) error {
-	attrs := make(map[string]string)
-	policy := iamv1.PolicyDocument{
-		Version: iamv1.CurrentVersion,
-		ID:      input.QueueArn,
-		Statement: iamv1.Statements{
-			iamv1.StatementEntry{
-				Sid:       fmt.Sprintf("CAPAEvents_%s_%s", s.getEC2RuleName(), GenerateQueueName(s.scope.Name())),
-				Effect:    iamv1.EffectAllow,
-				Principal: iamv1.Principals{iamv1.PrincipalService: iamv1.PrincipalID{"events.amazonaws.com"}},
-				Action:    iamv1.Actions{"sqs:SendMessage"},
-				Resource:  iamv1.Resources{input.QueueArn},
-				Condition: iamv1.Conditions{
-					"ArnEquals": map[string]string{"aws:SourceArn": input.RuleArn},
-				},
-			},
-		},
-	}
-	policyData, err := json.Marshal(policy)
+	policy, err := s.getPolicyForRules(input)
 	if err != nil {
-		return errors.Wrap(err, "unable to JSON marshal policy")
+		return err
 	}
-	attrs[string(sqstypes.QueueAttributeNamePolicy)] = string(policyData)
+
+	attrs := make(map[string]string)
+	attrs[string(sqstypes.QueueAttributeNamePolicy)] = policy
 
 	_, err = s.SQSClient.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
 		QueueUrl:   aws.String(input.QueueURL),
@@ -97,6 +83,41 @@ func (s *Service) createPolicyForRule(ctx context.Context, input *createPolicyFo
 	return errors.Wrap(err, "unable to update queue attributes")
 }
 
+// getPolicyForRules returns the queue policy authorizing the rules to emit messages to the queue.
+func (s *Service) getPolicyForRules(input *createPolicyForRuleInput) (string, error) {
+	ruleNames := make([]string, 0, len(input.RuleArns))
+	for ruleName := range input.RuleArns {
+		ruleNames = append(ruleNames, ruleName)
+	}
+	sort.Strings(ruleNames)
+
+	statements := iamv1.Statements{}
+	for _, ruleName := range ruleNames {
+		statements = append(statements, iamv1.StatementEntry{
+			Sid:       fmt.Sprintf("CAPAEvents_%s_%s", ruleName, GenerateQueueName(s.scope.Name())),
+			Effect:    iamv1.EffectAllow,
+			Principal: iamv1.Principals{iamv1.PrincipalService: iamv1.PrincipalID{"events.amazonaws.com"}},
+			Action:    iamv1.Actions{"sqs:SendMessage"},
+			Resource:  iamv1.Resources{input.QueueArn},
+			Condition: iamv1.Conditions{
+				"ArnEquals": map[string]string{"aws:SourceArn": input.RuleArns[ruleName]},
+			},
+		})
+	}
+
+	policy := iamv1.PolicyDocument{
+		Version:   iamv1.CurrentVersion,
+		ID:        input.QueueArn,
+		Statement: statements,
+	}
+	policyData, err := json.Marshal(policy)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to JSON marshal policy")
+	}
+
+	return string(policyData), nil
+}
+
 // GenerateQueueName will generate a queue name.
 func GenerateQueueName(clusterName string) string {
 	adjusted := strings.ReplaceAll(clusterName, ".", "-")
@@ -114,5 +135,6 @@ func queueNotFoundError(err error) bool {
 type createPolicyForRuleInput struct {
 	QueueArn string
 	QueueURL string
-	RuleArn  string
+	// RuleArns are the ARNs of the rules emitting messages to the queue, keyed by rule name.
+	RuleArns map[string]string
 }
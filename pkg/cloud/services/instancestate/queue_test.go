@@ -209,7 +209,7 @@ func TestCreatePolicyForRule(t *testing.T) {
 			input: &createPolicyForRuleInput{
 				QueueArn: "test-cluster-queue-arn",
 				QueueURL: "test-cluster-queue-url",
-				RuleArn:  "test-cluster-rule-arn",
+				RuleArns: map[string]string{"test-cluster-ec2-rule": "test-cluster-rule-arn"},
 			},
 			expect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				buffer := new(bytes.Buffer)
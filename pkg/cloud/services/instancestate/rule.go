@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
@@ -32,42 +33,80 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 )
 
-// Ec2StateChangeNotification defines the EC2 instance's state change notification.
-const Ec2StateChangeNotification = "EC2 Instance State-change Notification"
+const (
+	// Ec2StateChangeNotification defines the EC2 instance's state change notification.
+	Ec2StateChangeNotification = "EC2 Instance State-change Notification"
+	// Ec2SpotInterruptionWarning defines the EC2 Spot instance's interruption warning, sent two minutes
+	// before the instance is reclaimed.
+	Ec2SpotInterruptionWarning = "EC2 Spot Instance Interruption Warning"
+	// Ec2RebalanceRecommendation defines the EC2 instance's rebalance recommendation, sent when a Spot
+	// instance is at an elevated risk of interruption.
+	Ec2RebalanceRecommendation = "EC2 Instance Rebalance Recommendation"
+	// HealthEvent defines the AWS Health event, used to notify of EC2 scheduled events such as
+	// instance retirements or maintenances.
+	HealthEvent = "AWS Health Event"
+
+	ec2EventSource    = "aws.ec2"
+	healthEventSource = "aws.health"
+)
 
-// reconcileRules creates rules and attaches the queue as a target.
-func (s Service) reconcileRules(ctx context.Context) error {
-	var ruleNotFound bool
-	ruleResp, err := s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
-	})
-	if err != nil {
-		if resourceNotFoundError(err) {
-			ruleNotFound = true
-		} else {
-			return errors.Wrapf(err, "unable to describe rule %s", s.getEC2RuleName())
-		}
-	}
+// rule is an EventBridge rule sending EC2 events of the tracked instances to the cluster queue.
+type rule struct {
+	name    string
+	pattern eventPattern
+}
 
-	if ruleNotFound {
-		err = s.createRule(ctx)
-		if err != nil {
-			return errors.Wrap(err, "unable to create rule")
-		}
-		// fetch newly created rule
-		ruleResp, err = s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-			Name: aws.String(s.getEC2RuleName()),
-		})
+// getRules returns the rules of the cluster.
+func (s Service) getRules() []rule {
+	return []rule{
+		{
+			name: s.getEC2RuleName(),
+			pattern: eventPattern{
+				Source:     []string{ec2EventSource},
+				DetailType: []string{Ec2StateChangeNotification},
+				EventDetail: &eventDetail{
+					States: []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
+				},
+			},
+		},
+		{
+			name: s.getEC2InterruptionRuleName(),
+			pattern: eventPattern{
+				Source:     []string{ec2EventSource},
+				DetailType: []string{Ec2SpotInterruptionWarning, Ec2RebalanceRecommendation},
+			},
+		},
+		{
+			name: s.getEC2ScheduledEventRuleName(),
+			pattern: eventPattern{
+				Source:     []string{healthEventSource},
+				DetailType: []string{HealthEvent},
+				EventDetail: &eventDetail{
+					Services:            []string{"EC2"},
+					EventTypeCategories: []string{"scheduledChange"},
+					// closed events notify the end of a scheduled change, not an upcoming interruption
+					StatusCodes: []string{"open", "upcoming"},
+				},
+			},
+		},
+	}
+}
 
+// reconcileRules creates rules and attaches the queue as a target.
+func (s Service) reconcileRules(ctx context.Context) error {
+	rules := s.getRules()
+	ruleArns := make(map[string]string, len(rules))
+	for _, r := range rules {
+		ruleArn, err := s.reconcileRule(ctx, r)
 		if err != nil {
-			return errors.Wrapf(err, "unable to describe new rule %s", s.getEC2RuleName())
+			return err
 		}
+		ruleArns[r.name] = ruleArn
 	}
 
 	queueURLResp, err := s.SQSClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
 		QueueName: aws.String(GenerateQueueName(s.scope.Name())),
 	})
-
 	if err != nil {
 		return errors.Wrap(err, "unable to get queue URL")
 	}
@@ -75,76 +114,105 @@ func (s Service) reconcileRules(ctx context.Context) error {
 		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameQueueArn, sqstypes.QueueAttributeNamePolicy},
 		QueueUrl:       queueURLResp.QueueUrl,
 	})
-
 	if err != nil {
 		return errors.Wrap(err, "unable to get queue attributes")
 	}
-
 	queueArn, ok := queueAttrs.Attributes[string(sqstypes.QueueAttributeNameQueueArn)]
 	if !ok {
 		return errors.New("queue ARN not exist in queue attributes response")
 	}
 
+	for _, r := range rules {
+		if err := s.reconcileRuleTarget(ctx, r.name, queueArn); err != nil {
+			return err
+		}
+	}
+
+	// the policy authorizes the rules to emit messages to the queue, it is updated when rules are added
+	policyInput := &createPolicyForRuleInput{
+		QueueArn: queueArn,
+		QueueURL: *queueURLResp.QueueUrl,
+		RuleArns: ruleArns,
+	}
+	policy, err := s.getPolicyForRules(policyInput)
+	if err != nil {
+		return err
+	}
+	if queueAttrs.Attributes[string(sqstypes.QueueAttributeNamePolicy)] != policy {
+		if err := s.createPolicyForRule(ctx, policyInput); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileRule creates the rule if it doesn't exist and returns its ARN.
+func (s Service) reconcileRule(ctx context.Context, r rule) (string, error) {
+	ruleResp, err := s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+		Name: aws.String(r.name),
+	})
+	if err == nil {
+		return aws.ToString(ruleResp.Arn), nil
+	}
+	if !resourceNotFoundError(err) {
+		return "", errors.Wrapf(err, "unable to describe rule %s", r.name)
+	}
+
+	if err := s.createRule(ctx, r); err != nil {
+		return "", errors.Wrapf(err, "unable to create rule %s", r.name)
+	}
+	// fetch newly created rule
+	ruleResp, err = s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+		Name: aws.String(r.name),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to describe new rule %s", r.name)
+	}
+
+	return aws.ToString(ruleResp.Arn), nil
+}
+
+// reconcileRuleTarget adds the queue as a target of the rule if it isn't already.
+func (s Service) reconcileRuleTarget(ctx context.Context, ruleName, queueArn string) error {
 	targetsResp, err := s.EventBridgeClient.ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{
-		Rule: aws.String(s.getEC2RuleName()),
+		Rule: aws.String(ruleName),
 	})
 	if err != nil {
-		return errors.Wrapf(err, "unable to list targets for rule %s", s.getEC2RuleName())
+		return errors.Wrapf(err, "unable to list targets for rule %s", ruleName)
 	}
 
-	targetFound := false
 	for _, target := range targetsResp.Targets {
 		// check if queue is already added as a target
 		if *target.Id == GenerateQueueName(s.scope.Name()) && *target.Arn == queueArn {
-			targetFound = true
-		}
-	}
-
-	if !targetFound {
-		_, err = s.EventBridgeClient.PutTargets(ctx, &eventbridge.PutTargetsInput{
-			Rule: ruleResp.Name,
-			Targets: []eventbridgetypes.Target{{
-				Arn: aws.String(queueArn),
-				Id:  aws.String(GenerateQueueName(s.scope.Name())),
-			}},
-		})
-
-		if err != nil {
-			return errors.Wrapf(err, "unable to add SQS target %s to rule %s", GenerateQueueName(s.scope.Name()), s.getEC2RuleName())
+			return nil
 		}
 	}
 
-	if _, ok := queueAttrs.Attributes[string(sqstypes.QueueAttributeNamePolicy)]; !ok {
-		// add a policy for the rule so the rule is authorized to emit messages to the queue
-		err = s.createPolicyForRule(ctx, &createPolicyForRuleInput{
-			QueueArn: queueArn,
-			QueueURL: *queueURLResp.QueueUrl,
-			RuleArn:  *ruleResp.Arn,
-		})
-		if err != nil {
-			return err
-		}
+	_, err = s.EventBridgeClient.PutTargets(ctx, &eventbridge.PutTargetsInput{
+		Rule: aws.String(ruleName),
+		Targets: []eventbridgetypes.Target{{
+			Arn: aws.String(queueArn),
+			Id:  aws.String(GenerateQueueName(s.scope.Name())),
+		}},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to add SQS target %s to rule %s", GenerateQueueName(s.scope.Name()), ruleName)
 	}
 
 	return nil
 }
 
-func (s Service) createRule(ctx context.Context) error {
-	eventPattern := eventPattern{
-		Source:     []string{"aws.ec2"},
-		DetailType: []string{Ec2StateChangeNotification},
-		EventDetail: &eventDetail{
-			States: []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
-		},
-	}
-	data, err := json.Marshal(eventPattern)
+func (s Service) createRule(ctx context.Context, r rule) error {
+	data, err := json.Marshal(r.pattern)
 	if err != nil {
 		return err
 	}
+
 	// create in disabled state so the rule doesn't pick up all EC2 instances. As machines get created,
 	// the rule will get updated to track those machines
 	_, err = s.EventBridgeClient.PutRule(ctx, &eventbridge.PutRuleInput{
-		Name:         aws.String(s.getEC2RuleName()),
+		Name:         aws.String(r.name),
 		EventPattern: aws.String(string(data)),
 		State:        eventbridgetypes.RuleStateDisabled,
 	})
@@ -153,98 +221,136 @@ func (s Service) createRule(ctx context.Context) error {
 }
 
 func (s Service) deleteRules(ctx context.Context) error {
-	_, err := s.EventBridgeClient.RemoveTargets(ctx, &eventbridge.RemoveTargetsInput{
-		Rule: aws.String(s.getEC2RuleName()),
-		Ids:  []string{GenerateQueueName(s.scope.Name())},
-	})
-	if err != nil && !resourceNotFoundError(err) {
-		return errors.Wrapf(err, "unable to remove target %s for rule %s", GenerateQueueName(s.scope.Name()), s.getEC2RuleName())
-	}
-	_, err = s.EventBridgeClient.DeleteRule(ctx, &eventbridge.DeleteRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
-	})
+	for _, r := range s.getRules() {
+		_, err := s.EventBridgeClient.RemoveTargets(ctx, &eventbridge.RemoveTargetsInput{
+			Rule: aws.String(r.name),
+			Ids:  []string{GenerateQueueName(s.scope.Name())},
+		})
+		if err != nil && !resourceNotFoundError(err) {
+			return errors.Wrapf(err, "unable to remove target %s for rule %s", GenerateQueueName(s.scope.Name()), r.name)
+		}
 
-	if err != nil && resourceNotFoundError(err) {
-		return nil
+		_, err = s.EventBridgeClient.DeleteRule(ctx, &eventbridge.DeleteRuleInput{
+			Name: aws.String(r.name),
+		})
+		if err != nil && !resourceNotFoundError(err) {
+			return err
+		}
 	}
 
-	return err
+	return nil
 }
 
-// AddInstanceToEventPattern will add an instance to an event pattern.
+// AddInstanceToEventPattern will add an instance to the event pattern of the rules.
 func (s Service) AddInstanceToEventPattern(ctx context.Context, instanceID string) error {
+	for _, r := range s.getRules() {
+		if err := s.addInstanceToRule(ctx, r, instanceID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s Service) addInstanceToRule(ctx context.Context, r rule, instanceID string) error {
 	ruleResp, err := s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+		Name: aws.String(r.name),
 	})
 	if err != nil {
-		return errors.Wrapf(err, "unable to describe rule %s", s.getEC2RuleName())
+		return errors.Wrapf(err, "unable to describe rule %s", r.name)
 	}
+
 	e := eventPattern{}
 	err = json.Unmarshal([]byte(*ruleResp.EventPattern), &e)
 	if err != nil {
 		return err
 	}
-	e.DetailType = []string{Ec2StateChangeNotification}
 
-	for _, r := range e.EventDetail.InstanceIDs {
+	e.DetailType = r.pattern.DetailType
+	if e.EventDetail == nil {
+		e.EventDetail = &eventDetail{}
+	}
+	instanceIDs := e.instanceIDs()
+	tracked := false
+	for _, r := range *instanceIDs {
 		if r == instanceID {
-			// instance is already tracked by rule
-			return nil
+			tracked = true
+			break
 		}
 	}
+	// rules created by earlier versions may lack filters of the detail
+	if tracked && e.EventDetail.equalFilters(r.pattern.EventDetail) {
+		return nil
+	}
+	e.EventDetail.setFilters(r.pattern.EventDetail)
+	if !tracked {
+		*instanceIDs = append(*instanceIDs, instanceID)
+	}
+	e.dropEmptyDetail()
 
-	e.EventDetail.InstanceIDs = append(e.EventDetail.InstanceIDs, instanceID)
 	eventData, err := json.Marshal(e)
 	if err != nil {
 		return err
 	}
+
 	_, err = s.EventBridgeClient.PutRule(ctx, &eventbridge.PutRuleInput{
-		Name:         aws.String(s.getEC2RuleName()),
+		Name:         aws.String(r.name),
 		EventPattern: aws.String(string(eventData)),
 		State:        eventbridgetypes.RuleStateEnabled,
 	})
+
 	return err
 }
 
-// RemoveInstanceFromEventPattern attempts a best effort update to the event rule to remove the instance.
+// RemoveInstanceFromEventPattern attempts a best effort update to the event rules to remove the instance.
 // Any errors encountered won't be blocking.
 func (s Service) RemoveInstanceFromEventPattern(ctx context.Context, instanceID string) {
+	for _, r := range s.getRules() {
+		s.removeInstanceFromRule(ctx, r, instanceID)
+	}
+}
+
+func (s Service) removeInstanceFromRule(ctx context.Context, r rule, instanceID string) {
 	ruleResp, err := s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+		Name: aws.String(r.name),
 	})
 	if err != nil {
 		return
 	}
+
 	e := eventPattern{}
 	err = json.Unmarshal([]byte(*ruleResp.EventPattern), &e)
 	if err != nil {
 		return
 	}
-	e.DetailType = []string{Ec2StateChangeNotification}
-
+	e.DetailType = r.pattern.DetailType
+	instanceIDs := e.instanceIDs()
 	found := false
-	for i, r := range e.EventDetail.InstanceIDs {
+	for i, r := range *instanceIDs {
 		if r == instanceID {
 			found = true
-			e.EventDetail.InstanceIDs = append(e.EventDetail.InstanceIDs[:i], e.EventDetail.InstanceIDs[i+1:]...)
+			*instanceIDs = append((*instanceIDs)[:i], (*instanceIDs)[i+1:]...)
 			break
 		}
 	}
-
 	if found {
+		remaining := len(*instanceIDs)
+		e.dropEmptyDetail()
 		eventData, err := json.Marshal(e)
 		if err != nil {
 			return
 		}
+
 		input := &eventbridge.PutRuleInput{
-			Name:         aws.String(s.getEC2RuleName()),
+			Name:         aws.String(r.name),
 			EventPattern: aws.String(string(eventData)),
 			State:        eventbridgetypes.RuleStateEnabled,
 		}
 
-		if len(e.EventDetail.InstanceIDs) == 0 {
+		if remaining == 0 {
 			input.State = eventbridgetypes.RuleStateDisabled
 		}
+
 		_, _ = s.EventBridgeClient.PutRule(ctx, input)
 	}
 }
@@ -253,6 +359,14 @@ func (s Service) getEC2RuleName() string {
 	return fmt.Sprintf("%s-ec2-rule", s.scope.Name())
 }
 
+func (s Service) getEC2InterruptionRuleName() string {
+	return fmt.Sprintf("%s-ec2-interruption-rule", s.scope.Name())
+}
+
+func (s Service) getEC2ScheduledEventRuleName() string {
+	return fmt.Sprintf("%s-ec2-scheduled-event-rule", s.scope.Name())
+}
+
 func resourceNotFoundError(err error) bool {
 	smithyErr := awserrors.ParseSmithyError(err)
 	if smithyErr == nil {
@@ -264,10 +378,58 @@ func resourceNotFoundError(err error) bool {
 type eventPattern struct {
 	Source      []string     `json:"source"`
 	DetailType  []string     `json:"detail-type,omitempty"`
+	Resources   []string     `json:"resources,omitempty"`
 	EventDetail *eventDetail `json:"detail,omitempty"`
 }
 
+// dropEmptyDetail removes a detail without any filter, as EventBridge rejects empty objects in event patterns.
+func (e *eventPattern) dropEmptyDetail() {
+	if e.EventDetail != nil && len(e.EventDetail.InstanceIDs) == 0 && e.EventDetail.equalFilters(nil) {
+		e.EventDetail = nil
+	}
+}
+
+// instanceIDs returns the instance IDs tracked by the pattern. AWS Health events list
+// the affected instances in their resources instead of their detail.
+func (e *eventPattern) instanceIDs() *[]string {
+	for _, source := range e.Source {
+		if source == healthEventSource {
+			return &e.Resources
+		}
+	}
+
+	if e.EventDetail == nil {
+		e.EventDetail = &eventDetail{}
+	}
+	return &e.EventDetail.InstanceIDs
+}
+
 type eventDetail struct {
-	InstanceIDs []string                `json:"instance-id,omitempty"`
-	States      []infrav1.InstanceState `json:"state,omitempty"`
+	InstanceIDs         []string                `json:"instance-id,omitempty"`
+	States              []infrav1.InstanceState `json:"state,omitempty"`
+	Services            []string                `json:"service,omitempty"`
+	EventTypeCategories []string                `json:"eventTypeCategory,omitempty"`
+	StatusCodes         []string                `json:"statusCode,omitempty"`
+}
+
+// equalFilters returns true if the detail filters on the same states, services, categories and status codes.
+func (d *eventDetail) equalFilters(other *eventDetail) bool {
+	if other == nil {
+		other = &eventDetail{}
+	}
+	return slices.Equal(d.States, other.States) &&
+		slices.Equal(d.Services, other.Services) &&
+		slices.Equal(d.EventTypeCategories, other.EventTypeCategories) &&
+		slices.Equal(d.StatusCodes, other.StatusCodes)
+}
+
+// setFilters sets the filters of the detail, keeping its instance IDs.
+func (d *eventDetail) setFilters(other *eventDetail) {
+	if other == nil {
+		other = &eventDetail{}
+	}
+	d.States = other.States
+	d.Services = other.Services
+	d.EventTypeCategories = other.EventTypeCategories
+	d.StatusCodes = other.StatusCodes
 }
@@ -38,9 +38,25 @@ import (
 func TestReconcileRules(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
-	ruleName := "test-cluster-ec2-rule"
+	ruleNames := []string{"test-cluster-ec2-rule", "test-cluster-ec2-interruption-rule", "test-cluster-ec2-scheduled-event-rule"}
 	ctx := context.TODO()
 
+	clusterScope, err := setupCluster("test-cluster")
+	if err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+	ruleArns := map[string]string{}
+	for _, ruleName := range ruleNames {
+		ruleArns[ruleName] = ruleName + "-arn"
+	}
+	policy, err := NewService(clusterScope).getPolicyForRules(&createPolicyForRuleInput{
+		QueueArn: "test-cluster-queue-arn",
+		RuleArns: ruleArns,
+	})
+	if err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+
 	testCases := []struct {
 		name                        string
 		eventBridgeExpect           func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder)
@@ -49,47 +65,66 @@ func TestReconcileRules(t *testing.T) {
 		expectErr                   bool
 	}{
 		{
-			name: "successfully creates missing rule and target",
+			name: "successfully creates missing rules and targets",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
-					Name: aws.String(ruleName),
-				})).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
-				e := &eventPattern{
-					Source:     []string{"aws.ec2"},
-					DetailType: []string{Ec2StateChangeNotification},
-					EventDetail: &eventDetail{
-						States: []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
+				patterns := map[string]*eventPattern{
+					"test-cluster-ec2-rule": {
+						Source:     []string{"aws.ec2"},
+						DetailType: []string{Ec2StateChangeNotification},
+						EventDetail: &eventDetail{
+							States: []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
+						},
+					},
+					"test-cluster-ec2-interruption-rule": {
+						Source:     []string{"aws.ec2"},
+						DetailType: []string{Ec2SpotInterruptionWarning, Ec2RebalanceRecommendation},
+					},
+					"test-cluster-ec2-scheduled-event-rule": {
+						Source:     []string{"aws.health"},
+						DetailType: []string{HealthEvent},
+						EventDetail: &eventDetail{
+							Services:            []string{"EC2"},
+							EventTypeCategories: []string{"scheduledChange"},
+							StatusCodes:         []string{"open", "upcoming"},
+						},
 					},
 				}
-				data, err := json.Marshal(e)
-				if err != nil {
-					t.Fatalf("got an unexpected error: %v", err)
+				for _, ruleName := range ruleNames {
+					m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+						Name: aws.String(ruleName),
+					})).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
+					data, err := json.Marshal(patterns[ruleName])
+					if err != nil {
+						t.Fatalf("got an unexpected error: %v", err)
+					}
+					m.PutRule(ctx, gomock.Eq(&eventbridge.PutRuleInput{
+						Name:         aws.String(ruleName),
+						State:        eventbridgetypes.RuleStateDisabled,
+						EventPattern: aws.String(string(data)),
+					}))
 				}
-				m.PutRule(ctx, gomock.Eq(&eventbridge.PutRuleInput{
-					Name:         aws.String(ruleName),
-					State:        eventbridgetypes.RuleStateDisabled,
-					EventPattern: aws.String(string(data)),
-				}))
 			},
 			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
-					Name: aws.String(ruleName),
-				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String("rule-arn")}, nil)
-				m.ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{
-					Rule: aws.String(ruleName),
-				}).Return(&eventbridge.ListTargetsByRuleOutput{
-					Targets: []eventbridgetypes.Target{{
-						Id:  aws.String("another-queue"),
-						Arn: aws.String("another-queue-arn"),
-					}},
-				}, nil)
-				m.PutTargets(ctx, gomock.Eq(&eventbridge.PutTargetsInput{
-					Rule: aws.String(ruleName),
-					Targets: []eventbridgetypes.Target{{
-						Arn: aws.String("test-cluster-queue-arn"),
-						Id:  aws.String("test-cluster-queue"),
-					}},
-				}))
+				for _, ruleName := range ruleNames {
+					m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+						Name: aws.String(ruleName),
+					})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String(ruleName + "-arn")}, nil)
+					m.ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{
+						Rule: aws.String(ruleName),
+					}).Return(&eventbridge.ListTargetsByRuleOutput{
+						Targets: []eventbridgetypes.Target{{
+							Id:  aws.String("another-queue"),
+							Arn: aws.String("another-queue-arn"),
+						}},
+					}, nil)
+					m.PutTargets(ctx, gomock.Eq(&eventbridge.PutTargetsInput{
+						Rule: aws.String(ruleName),
+						Targets: []eventbridgetypes.Target{{
+							Arn: aws.String("test-cluster-queue-arn"),
+							Id:  aws.String("test-cluster-queue"),
+						}},
+					}))
+				}
 			},
 			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
 				m.GetQueueUrl(ctx, gomock.Eq(&sqs.GetQueueUrlInput{
@@ -101,22 +136,55 @@ func TestReconcileRules(t *testing.T) {
 					AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameQueueArn, sqstypes.QueueAttributeNamePolicy},
 					QueueUrl:       aws.String("test-cluster-queue-url"),
 				})).Return(&sqs.GetQueueAttributesOutput{Attributes: attrs}, nil)
-				m.SetQueueAttributes(ctx, gomock.AssignableToTypeOf(&sqs.SetQueueAttributesInput{})).Return(nil, nil)
+				m.SetQueueAttributes(ctx, gomock.Eq(&sqs.SetQueueAttributesInput{
+					QueueUrl:   aws.String("test-cluster-queue-url"),
+					Attributes: map[string]string{string(sqstypes.QueueAttributeNamePolicy): policy},
+				})).Return(nil, nil)
 			},
 			expectErr: false,
 		},
 		{
-			name: "skips creating target and queue policy if they already exist",
+			name: "skips creating targets and queue policy if they already exist",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
-					Name: aws.String(ruleName),
-				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String("rule-arn")}, nil)
-				m.ListTargetsByRule(ctx, gomock.AssignableToTypeOf(&eventbridge.ListTargetsByRuleInput{})).Return(&eventbridge.ListTargetsByRuleOutput{
-					Targets: []eventbridgetypes.Target{{
-						Id:  aws.String("test-cluster-queue"),
-						Arn: aws.String("test-cluster-queue-arn"),
-					}},
-				}, nil)
+				for _, ruleName := range ruleNames {
+					m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+						Name: aws.String(ruleName),
+					})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String(ruleName + "-arn")}, nil)
+					m.ListTargetsByRule(ctx, gomock.Eq(&eventbridge.ListTargetsByRuleInput{
+						Rule: aws.String(ruleName),
+					})).Return(&eventbridge.ListTargetsByRuleOutput{
+						Targets: []eventbridgetypes.Target{{
+							Id:  aws.String("test-cluster-queue"),
+							Arn: aws.String("test-cluster-queue-arn"),
+						}},
+					}, nil)
+				}
+			},
+			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				m.GetQueueUrl(ctx, gomock.AssignableToTypeOf(&sqs.GetQueueUrlInput{})).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
+				attrs := make(map[string]string)
+				attrs[string(sqstypes.QueueAttributeNameQueueArn)] = "test-cluster-queue-arn"
+				attrs[string(sqstypes.QueueAttributeNamePolicy)] = policy
+				m.GetQueueAttributes(ctx, gomock.AssignableToTypeOf(&sqs.GetQueueAttributesInput{})).Return(&sqs.GetQueueAttributesOutput{Attributes: attrs}, nil)
+			},
+		},
+		{
+			name: "updates queue policy when it doesn't authorize all the rules",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				for _, ruleName := range ruleNames {
+					m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+						Name: aws.String(ruleName),
+					})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String(ruleName + "-arn")}, nil)
+					m.ListTargetsByRule(ctx, gomock.Eq(&eventbridge.ListTargetsByRuleInput{
+						Rule: aws.String(ruleName),
+					})).Return(&eventbridge.ListTargetsByRuleOutput{
+						Targets: []eventbridgetypes.Target{{
+							Id:  aws.String("test-cluster-queue"),
+							Arn: aws.String("test-cluster-queue-arn"),
+						}},
+					}, nil)
+				}
 			},
 			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
 			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
@@ -125,14 +193,20 @@ func TestReconcileRules(t *testing.T) {
 				attrs[string(sqstypes.QueueAttributeNameQueueArn)] = "test-cluster-queue-arn"
 				attrs[string(sqstypes.QueueAttributeNamePolicy)] = "some policy"
 				m.GetQueueAttributes(ctx, gomock.AssignableToTypeOf(&sqs.GetQueueAttributesInput{})).Return(&sqs.GetQueueAttributesOutput{Attributes: attrs}, nil)
+				m.SetQueueAttributes(ctx, gomock.Eq(&sqs.SetQueueAttributesInput{
+					QueueUrl:   aws.String("test-cluster-queue-url"),
+					Attributes: map[string]string{string(sqstypes.QueueAttributeNamePolicy): policy},
+				})).Return(nil, nil)
 			},
 		},
 		{
 			name: "returns error if GetQueueAttributes doesn't have queue ARN",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
-					Name: aws.String(ruleName),
-				})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String("rule-arn")}, nil)
+				for _, ruleName := range ruleNames {
+					m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+						Name: aws.String(ruleName),
+					})).Return(&eventbridge.DescribeRuleOutput{Name: aws.String(ruleName), Arn: aws.String(ruleName + "-arn")}, nil)
+				}
 			},
 			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
 			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
@@ -146,7 +220,7 @@ func TestReconcileRules(t *testing.T) {
 			name: "returns error if DescribeRule runs into unexpected error",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
-					Name: aws.String(ruleName),
+					Name: aws.String("test-cluster-ec2-rule"),
 				})).Return(nil, errors.New("some error"))
 			},
 			postCreateEventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {},
@@ -183,6 +257,7 @@ func TestReconcileRules(t *testing.T) {
 func TestDeleteRules(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ruleNames := []string{"test-cluster-ec2-rule", "test-cluster-ec2-interruption-rule", "test-cluster-ec2-scheduled-event-rule"}
 
 	ctx := context.TODO()
 
@@ -192,26 +267,32 @@ func TestDeleteRules(t *testing.T) {
 		expectErr         bool
 	}{
 		{
-			name: "removes target and ec2 rule successfully when they both exist",
+			name: "removes targets and rules successfully when they exist",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.RemoveTargets(ctx, gomock.Eq(&eventbridge.RemoveTargetsInput{
-					Rule: aws.String("test-cluster-ec2-rule"),
-					Ids:  []string{"test-cluster-queue"},
-				})).Return(nil, nil)
-				m.DeleteRule(ctx, gomock.Eq(&eventbridge.DeleteRuleInput{
-					Name: aws.String("test-cluster-ec2-rule"),
-				})).Return(nil, nil)
+				for _, ruleName := range ruleNames {
+					m.RemoveTargets(ctx, gomock.Eq(&eventbridge.RemoveTargetsInput{
+						Rule: aws.String(ruleName),
+						Ids:  []string{"test-cluster-queue"},
+					})).Return(nil, nil)
+					m.DeleteRule(ctx, gomock.Eq(&eventbridge.DeleteRuleInput{
+						Name: aws.String(ruleName),
+					})).Return(nil, nil)
+				}
 			},
 			expectErr: false,
 		},
 		{
-			name: "continues to remove rule when target doesn't exist",
+			name: "continues to remove rules when targets and rules don't exist",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.RemoveTargets(ctx, gomock.AssignableToTypeOf(&eventbridge.RemoveTargetsInput{})).
-					Return(nil, &eventbridgetypes.ResourceNotFoundException{})
-				m.DeleteRule(ctx, gomock.Eq(&eventbridge.DeleteRuleInput{
-					Name: aws.String("test-cluster-ec2-rule"),
-				})).Return(nil, nil)
+				for _, ruleName := range ruleNames {
+					m.RemoveTargets(ctx, gomock.Eq(&eventbridge.RemoveTargetsInput{
+						Rule: aws.String(ruleName),
+						Ids:  []string{"test-cluster-queue"},
+					})).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
+					m.DeleteRule(ctx, gomock.Eq(&eventbridge.DeleteRuleInput{
+						Name: aws.String(ruleName),
+					})).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
+				}
 			},
 			expectErr: false,
 		},
@@ -256,17 +337,6 @@ func TestDeleteRules(t *testing.T) {
 func TestAddInstanceToRule(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
-	pattern := eventPattern{
-		DetailType: []string{Ec2StateChangeNotification},
-		Source:     []string{"aws.ec2"},
-		EventDetail: &eventDetail{
-			InstanceIDs: []string{"instance-a"},
-		},
-	}
-	patternData, err := json.Marshal(pattern)
-	if err != nil {
-		t.Fatalf("got an unexpected error: %v", err)
-	}
 
 	ctx := context.TODO()
 
@@ -277,39 +347,84 @@ func TestAddInstanceToRule(t *testing.T) {
 		expectErr         bool
 	}{
 		{
-			name: "adds instance to event pattern when it doesn't exist",
+			name: "adds instance to event patterns when it doesn't exist",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				expectedPatterns := testEventPatterns(t, "instance-a", "instance-b")
+				for ruleName, patternData := range testEventPatterns(t, "instance-a") {
+					m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+						Name: aws.String(ruleName),
+					}).Return(&eventbridge.DescribeRuleOutput{
+						EventPattern: aws.String(patternData),
+					}, nil)
+					m.PutRule(ctx, &eventbridge.PutRuleInput{
+						Name:         aws.String(ruleName),
+						EventPattern: aws.String(expectedPatterns[ruleName]),
+						State:        eventbridgetypes.RuleStateEnabled,
+					}).Return(nil, nil)
+				}
+			},
+			newInstanceID: "instance-b",
+			expectErr:     false,
+		},
+		{
+			name: "does nothing if instance is already tracked in event patterns",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				for ruleName, patternData := range testEventPatterns(t, "instance-a") {
+					m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+						Name: aws.String(ruleName),
+					}).Return(&eventbridge.DescribeRuleOutput{
+						EventPattern: aws.String(patternData),
+					}, nil)
+				}
+			},
+			newInstanceID: "instance-a",
+			expectErr:     false,
+		},
+		{
+			name: "updates the filters of rules created by earlier versions",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				expectedPatterns := testEventPatterns(t, "instance-a")
+				for _, ruleName := range []string{"test-cluster-ec2-rule", "test-cluster-ec2-interruption-rule"} {
+					m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+						Name: aws.String(ruleName),
+					}).Return(&eventbridge.DescribeRuleOutput{
+						EventPattern: aws.String(expectedPatterns[ruleName]),
+					}, nil)
+				}
+				stalePatterns := marshalEventPatterns(t, map[string]eventPattern{
+					"test-cluster-ec2-scheduled-event-rule": {
+						Source:     []string{"aws.health"},
+						DetailType: []string{HealthEvent},
+						Resources:  []string{"instance-a"},
+						EventDetail: &eventDetail{
+							Services:            []string{"EC2"},
+							EventTypeCategories: []string{"scheduledChange"},
+						},
+					},
+				})
 				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-					Name: aws.String("test-cluster-ec2-rule"),
+					Name: aws.String("test-cluster-ec2-scheduled-event-rule"),
 				}).Return(&eventbridge.DescribeRuleOutput{
-					EventPattern: aws.String(string(patternData)),
+					EventPattern: aws.String(stalePatterns["test-cluster-ec2-scheduled-event-rule"]),
 				}, nil)
-				expectedPattern := pattern
-				expectedPattern.EventDetail.InstanceIDs = append(expectedPattern.EventDetail.InstanceIDs, "instance-b")
-				expectedData, err := json.Marshal(expectedPattern)
-				if err != nil {
-					t.Fatalf("got an unexpected error: %v", err)
-				}
 				m.PutRule(ctx, &eventbridge.PutRuleInput{
-					Name:         aws.String("test-cluster-ec2-rule"),
-					EventPattern: aws.String(string(expectedData)),
+					Name:         aws.String("test-cluster-ec2-scheduled-event-rule"),
+					EventPattern: aws.String(expectedPatterns["test-cluster-ec2-scheduled-event-rule"]),
 					State:        eventbridgetypes.RuleStateEnabled,
 				}).Return(nil, nil)
 			},
-			newInstanceID: "instance-b",
+			newInstanceID: "instance-a",
 			expectErr:     false,
 		},
 		{
-			name: "does nothing if instance is already tracked in event pattern",
+			name: "returns error if a rule can't be described",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
 				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
 					Name: aws.String("test-cluster-ec2-rule"),
-				}).Return(&eventbridge.DescribeRuleOutput{
-					EventPattern: aws.String(string(patternData)),
-				}, nil)
+				}).Return(nil, errors.New("some error"))
 			},
-			newInstanceID: "instance-a",
-			expectErr:     false,
+			newInstanceID: "instance-b",
+			expectErr:     true,
 		},
 	}
 
@@ -337,17 +452,6 @@ func TestAddInstanceToRule(t *testing.T) {
 func TestRemoveInstanceStateFromEventPattern(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
-	pattern := eventPattern{
-		DetailType: []string{Ec2StateChangeNotification},
-		Source:     []string{"aws.ec2"},
-		EventDetail: &eventDetail{
-			InstanceIDs: []string{"instance-a", "instance-b", "instance-c"},
-		},
-	}
-	patternData, err := json.Marshal(pattern)
-	if err != nil {
-		t.Fatalf("got an unexpected error: %v", err)
-	}
 
 	ctx := context.TODO()
 
@@ -357,64 +461,53 @@ func TestRemoveInstanceStateFromEventPattern(t *testing.T) {
 		instanceID        string
 	}{
 		{
-			name: "remove instance from instance IDs and disables rule when no instances are tracked",
+			name: "remove instance from instance IDs and disables rules when no instances are tracked",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				singleInstanceEventPattern := pattern
-				singleInstanceEventPattern.EventDetail.InstanceIDs = []string{"instance-a"}
-				patternData, err := json.Marshal(pattern)
-				if err != nil {
-					t.Fatalf("got an unexpected error: %v", err)
-				}
-				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-					Name: aws.String("test-cluster-ec2-rule"),
-				}).Return(&eventbridge.DescribeRuleOutput{
-					EventPattern: aws.String(string(patternData)),
-				}, nil)
-				expectedPattern := pattern
-				expectedPattern.EventDetail.InstanceIDs = []string{}
-				expectedData, err := json.Marshal(expectedPattern)
-				if err != nil {
-					t.Fatalf("got an unexpected error: %v", err)
+				expectedPatterns := testEventPatterns(t)
+				for ruleName, patternData := range testEventPatterns(t, "instance-a") {
+					m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+						Name: aws.String(ruleName),
+					}).Return(&eventbridge.DescribeRuleOutput{
+						EventPattern: aws.String(patternData),
+					}, nil)
+					m.PutRule(ctx, &eventbridge.PutRuleInput{
+						Name:         aws.String(ruleName),
+						EventPattern: aws.String(expectedPatterns[ruleName]),
+						State:        eventbridgetypes.RuleStateDisabled,
+					}).Return(nil, nil)
 				}
-
-				m.PutRule(ctx, &eventbridge.PutRuleInput{
-					Name:         aws.String("test-cluster-ec2-rule"),
-					EventPattern: aws.String(string(expectedData)),
-					State:        eventbridgetypes.RuleStateDisabled,
-				}).Return(nil, nil)
 			},
 			instanceID: "instance-a",
 		},
 		{
-			name: "remove instance from instance IDs and rule remains enabled when other instances are tracked",
+			name: "remove instance from instance IDs and rules remain enabled when other instances are tracked",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-					Name: aws.String("test-cluster-ec2-rule"),
-				}).Return(&eventbridge.DescribeRuleOutput{
-					EventPattern: aws.String(string(patternData)),
-				}, nil)
-				expectedPattern := pattern
-				expectedPattern.EventDetail.InstanceIDs = []string{"instance-a", "instance-c"}
-				expectedData, err := json.Marshal(expectedPattern)
-				if err != nil {
-					t.Fatalf("got an unexpected error: %v", err)
+				expectedPatterns := testEventPatterns(t, "instance-a", "instance-c")
+				for ruleName, patternData := range testEventPatterns(t, "instance-a", "instance-b", "instance-c") {
+					m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+						Name: aws.String(ruleName),
+					}).Return(&eventbridge.DescribeRuleOutput{
+						EventPattern: aws.String(patternData),
+					}, nil)
+					m.PutRule(ctx, &eventbridge.PutRuleInput{
+						Name:         aws.String(ruleName),
+						EventPattern: aws.String(expectedPatterns[ruleName]),
+						State:        eventbridgetypes.RuleStateEnabled,
+					}).Return(nil, nil)
 				}
-				m.PutRule(ctx, &eventbridge.PutRuleInput{
-					Name:         aws.String("test-cluster-ec2-rule"),
-					EventPattern: aws.String(string(expectedData)),
-					State:        eventbridgetypes.RuleStateEnabled,
-				}).Return(nil, nil)
 			},
 			instanceID: "instance-b",
 		},
 		{
 			name: "does nothing when instanceID is not tracked",
 			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
-				m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
-					Name: aws.String("test-cluster-ec2-rule"),
-				}).Return(&eventbridge.DescribeRuleOutput{
-					EventPattern: aws.String(string(patternData)),
-				}, nil)
+				for ruleName, patternData := range testEventPatterns(t, "instance-a", "instance-b", "instance-c") {
+					m.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+						Name: aws.String(ruleName),
+					}).Return(&eventbridge.DescribeRuleOutput{
+						EventPattern: aws.String(patternData),
+					}, nil)
+				}
 			},
 			instanceID: "instance-d",
 		},
@@ -435,3 +528,55 @@ func TestRemoveInstanceStateFromEventPattern(t *testing.T) {
 		})
 	}
 }
+
+// testEventPatterns returns the event patterns of the cluster rules tracking the given instances, keyed by rule name.
+func testEventPatterns(t *testing.T, instanceIDs ...string) map[string]string {
+	t.Helper()
+
+	// EventBridge rejects empty objects, the interruption rule has no detail until it tracks instances.
+	var interruptionDetail *eventDetail
+	if len(instanceIDs) > 0 {
+		interruptionDetail = &eventDetail{InstanceIDs: instanceIDs}
+	}
+
+	return marshalEventPatterns(t, map[string]eventPattern{
+		"test-cluster-ec2-rule": {
+			Source:     []string{"aws.ec2"},
+			DetailType: []string{Ec2StateChangeNotification},
+			EventDetail: &eventDetail{
+				InstanceIDs: instanceIDs,
+				States:      []infrav1.InstanceState{infrav1.InstanceStateShuttingDown, infrav1.InstanceStateTerminated},
+			},
+		},
+		"test-cluster-ec2-interruption-rule": {
+			Source:      []string{"aws.ec2"},
+			DetailType:  []string{Ec2SpotInterruptionWarning, Ec2RebalanceRecommendation},
+			EventDetail: interruptionDetail,
+		},
+		"test-cluster-ec2-scheduled-event-rule": {
+			Source:     []string{"aws.health"},
+			DetailType: []string{HealthEvent},
+			Resources:  instanceIDs,
+			EventDetail: &eventDetail{
+				Services:            []string{"EC2"},
+				EventTypeCategories: []string{"scheduledChange"},
+				StatusCodes:         []string{"open", "upcoming"},
+			},
+		},
+	})
+}
+
+func marshalEventPatterns(t *testing.T, patterns map[string]eventPattern) map[string]string {
+	t.Helper()
+
+	patternsData := map[string]string{}
+	for ruleName, pattern := range patterns {
+		data, err := json.Marshal(pattern)
+		if err != nil {
+			t.Fatalf("got an unexpected error: %v", err)
+		}
+		patternsData[ruleName] = string(data)
+	}
+
+	return patternsData
+}
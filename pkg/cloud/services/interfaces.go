@@ -52,6 +52,8 @@ const (
 	LaunchTemplateNeedsUpdateReasonIamInstanceProfile LaunchTemplateNeedsUpdateReason = "IamInstanceProfile"
 	// LaunchTemplateNeedsUpdateReasonInstanceType means a difference in the instance type was found.
 	LaunchTemplateNeedsUpdateReasonInstanceType LaunchTemplateNeedsUpdateReason = "InstanceType"
+	// LaunchTemplateNeedsUpdateReasonInstanceRequirements means a difference in the instance requirements was found.
+	LaunchTemplateNeedsUpdateReasonInstanceRequirements LaunchTemplateNeedsUpdateReason = "InstanceRequirements"
 	// LaunchTemplateNeedsUpdateReasonInstanceMetadataOptions means a difference in the instance metadata options was found.
 	LaunchTemplateNeedsUpdateReasonInstanceMetadataOptions LaunchTemplateNeedsUpdateReason = "InstanceMetadataOptions"
 	// LaunchTemplateNeedsUpdateReasonSpotMarketOptions means a difference in the spot market options was found.
@@ -74,12 +76,15 @@ type ASGInterface interface {
 	ASGIfExists(id *string) (*expinfrav1.AutoScalingGroup, error)
 	GetASGByName(scope *scope.MachinePoolScope) (*expinfrav1.AutoScalingGroup, error)
 	CreateASG(scope *scope.MachinePoolScope) (*expinfrav1.AutoScalingGroup, error)
+	CreateReplacementASG(scope *scope.MachinePoolScope, name string, desiredCapacity int32) error
 	UpdateASG(scope *scope.MachinePoolScope) error
 	CancelASGInstanceRefresh(scope *scope.MachinePoolScope) error
 	StartASGInstanceRefresh(scope *scope.MachinePoolScope) error
 	CanStartASGInstanceRefresh(scope *scope.MachinePoolScope) (bool, *autoscalingtypes.InstanceRefreshStatus, error)
 	UpdateResourceTags(resourceID *string, create, remove map[string]string) error
 	DeleteASGAndWait(id string) error
+	DeleteASG(name string) error
+	DetachASGInstances(name string, instanceIDs []string) error
 	SuspendProcesses(name string, processes []string) error
 	ResumeProcesses(name string, processes []string) error
 	SubnetIDs(scope *scope.MachinePoolScope) ([]string, error)
@@ -87,6 +92,14 @@ type ASGInterface interface {
 	CreateLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
 	UpdateLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
 	DeleteLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
+	PutWarmPool(ctx context.Context, asgName string, warmPool *expinfrav1.WarmPool) error
+	DeleteWarmPool(ctx context.Context, asgName string) error
+	DescribeScheduledActions(ctx context.Context, asgName string) ([]*expinfrav1.ScheduledAction, error)
+	PutScheduledAction(ctx context.Context, asgName string, action *expinfrav1.ScheduledAction) error
+	DeleteScheduledAction(ctx context.Context, asgName string, actionName string) error
+	DescribeScalingPolicies(ctx context.Context, asgName string) ([]*expinfrav1.ScalingPolicy, error)
+	PutScalingPolicy(ctx context.Context, asgName string, policy *expinfrav1.ScalingPolicy) error
+	DeleteScalingPolicy(ctx context.Context, asgName string, policyName string) error
 }
 
 // EC2Interface encapsulates the methods exposed to the machine
@@ -94,6 +107,8 @@ type ASGInterface interface {
 type EC2Interface interface {
 	InstanceIfExists(id *string) (*infrav1.Instance, error)
 	TerminateInstance(id string) error
+	StopInstance(id string, hibernate bool) error
+	StartInstance(id string) error
 	CreateInstance(ctx context.Context, scope *scope.MachineScope, userData []byte, userDataFormat string) (*infrav1.Instance, error)
 	GetRunningInstanceByTags(scope *scope.MachineScope) (*infrav1.Instance, error)
 
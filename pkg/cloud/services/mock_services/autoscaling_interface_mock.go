@@ -127,6 +127,34 @@ func (mr *MockASGInterfaceMockRecorder) CreateLifecycleHook(arg0, arg1, arg2 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLifecycleHook", reflect.TypeOf((*MockASGInterface)(nil).CreateLifecycleHook), arg0, arg1, arg2)
 }
 
+// CreateReplacementASG mocks base method.
+func (m *MockASGInterface) CreateReplacementASG(arg0 *scope.MachinePoolScope, arg1 string, arg2 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReplacementASG", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReplacementASG indicates an expected call of CreateReplacementASG.
+func (mr *MockASGInterfaceMockRecorder) CreateReplacementASG(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReplacementASG", reflect.TypeOf((*MockASGInterface)(nil).CreateReplacementASG), arg0, arg1, arg2)
+}
+
+// DeleteASG mocks base method.
+func (m *MockASGInterface) DeleteASG(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteASG", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteASG indicates an expected call of DeleteASG.
+func (mr *MockASGInterfaceMockRecorder) DeleteASG(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteASG", reflect.TypeOf((*MockASGInterface)(nil).DeleteASG), arg0)
+}
+
 // DeleteASGAndWait mocks base method.
 func (m *MockASGInterface) DeleteASGAndWait(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -155,6 +183,48 @@ func (mr *MockASGInterfaceMockRecorder) DeleteLifecycleHook(arg0, arg1, arg2 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLifecycleHook", reflect.TypeOf((*MockASGInterface)(nil).DeleteLifecycleHook), arg0, arg1, arg2)
 }
 
+// DeleteScalingPolicy mocks base method.
+func (m *MockASGInterface) DeleteScalingPolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScalingPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScalingPolicy indicates an expected call of DeleteScalingPolicy.
+func (mr *MockASGInterfaceMockRecorder) DeleteScalingPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScalingPolicy", reflect.TypeOf((*MockASGInterface)(nil).DeleteScalingPolicy), arg0, arg1, arg2)
+}
+
+// DeleteScheduledAction mocks base method.
+func (m *MockASGInterface) DeleteScheduledAction(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduledAction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScheduledAction indicates an expected call of DeleteScheduledAction.
+func (mr *MockASGInterfaceMockRecorder) DeleteScheduledAction(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledAction", reflect.TypeOf((*MockASGInterface)(nil).DeleteScheduledAction), arg0, arg1, arg2)
+}
+
+// DeleteWarmPool mocks base method.
+func (m *MockASGInterface) DeleteWarmPool(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWarmPool", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWarmPool indicates an expected call of DeleteWarmPool.
+func (mr *MockASGInterfaceMockRecorder) DeleteWarmPool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWarmPool", reflect.TypeOf((*MockASGInterface)(nil).DeleteWarmPool), arg0, arg1)
+}
+
 // DescribeLifecycleHooks mocks base method.
 func (m *MockASGInterface) DescribeLifecycleHooks(arg0 string) ([]*v1beta2.AWSLifecycleHook, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +240,50 @@ func (mr *MockASGInterfaceMockRecorder) DescribeLifecycleHooks(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLifecycleHooks", reflect.TypeOf((*MockASGInterface)(nil).DescribeLifecycleHooks), arg0)
 }
 
+// DescribeScalingPolicies mocks base method.
+func (m *MockASGInterface) DescribeScalingPolicies(arg0 context.Context, arg1 string) ([]*v1beta2.ScalingPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScalingPolicies", arg0, arg1)
+	ret0, _ := ret[0].([]*v1beta2.ScalingPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScalingPolicies indicates an expected call of DescribeScalingPolicies.
+func (mr *MockASGInterfaceMockRecorder) DescribeScalingPolicies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScalingPolicies", reflect.TypeOf((*MockASGInterface)(nil).DescribeScalingPolicies), arg0, arg1)
+}
+
+// DescribeScheduledActions mocks base method.
+func (m *MockASGInterface) DescribeScheduledActions(arg0 context.Context, arg1 string) ([]*v1beta2.ScheduledAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScheduledActions", arg0, arg1)
+	ret0, _ := ret[0].([]*v1beta2.ScheduledAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScheduledActions indicates an expected call of DescribeScheduledActions.
+func (mr *MockASGInterfaceMockRecorder) DescribeScheduledActions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScheduledActions", reflect.TypeOf((*MockASGInterface)(nil).DescribeScheduledActions), arg0, arg1)
+}
+
+// DetachASGInstances mocks base method.
+func (m *MockASGInterface) DetachASGInstances(arg0 string, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachASGInstances", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachASGInstances indicates an expected call of DetachASGInstances.
+func (mr *MockASGInterfaceMockRecorder) DetachASGInstances(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachASGInstances", reflect.TypeOf((*MockASGInterface)(nil).DetachASGInstances), arg0, arg1)
+}
+
 // GetASGByName mocks base method.
 func (m *MockASGInterface) GetASGByName(arg0 *scope.MachinePoolScope) (*v1beta2.AutoScalingGroup, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +299,48 @@ func (mr *MockASGInterfaceMockRecorder) GetASGByName(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetASGByName", reflect.TypeOf((*MockASGInterface)(nil).GetASGByName), arg0)
 }
 
+// PutScalingPolicy mocks base method.
+func (m *MockASGInterface) PutScalingPolicy(arg0 context.Context, arg1 string, arg2 *v1beta2.ScalingPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutScalingPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutScalingPolicy indicates an expected call of PutScalingPolicy.
+func (mr *MockASGInterfaceMockRecorder) PutScalingPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutScalingPolicy", reflect.TypeOf((*MockASGInterface)(nil).PutScalingPolicy), arg0, arg1, arg2)
+}
+
+// PutScheduledAction mocks base method.
+func (m *MockASGInterface) PutScheduledAction(arg0 context.Context, arg1 string, arg2 *v1beta2.ScheduledAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutScheduledAction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutScheduledAction indicates an expected call of PutScheduledAction.
+func (mr *MockASGInterfaceMockRecorder) PutScheduledAction(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutScheduledAction", reflect.TypeOf((*MockASGInterface)(nil).PutScheduledAction), arg0, arg1, arg2)
+}
+
+// PutWarmPool mocks base method.
+func (m *MockASGInterface) PutWarmPool(arg0 context.Context, arg1 string, arg2 *v1beta2.WarmPool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWarmPool", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutWarmPool indicates an expected call of PutWarmPool.
+func (mr *MockASGInterfaceMockRecorder) PutWarmPool(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWarmPool", reflect.TypeOf((*MockASGInterface)(nil).PutWarmPool), arg0, arg1, arg2)
+}
+
 // ResumeProcesses mocks base method.
 func (m *MockASGInterface) ResumeProcesses(arg0 string, arg1 []string) error {
 	m.ctrl.T.Helper()
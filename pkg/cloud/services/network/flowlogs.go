@@ -0,0 +1,425 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/converters"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	// flowLogsServicePrincipal is the service principal publishing the flow logs.
+	flowLogsServicePrincipal = "vpc-flow-logs.amazonaws.com"
+
+	// flowLogsDeliveryPolicyName is the name of the inline policy of the delivery role created by the controller.
+	flowLogsDeliveryPolicyName = "vpc-flow-logs-delivery"
+)
+
+// reconcileFlowLogs creates the flow log of the VPC declared in the VPC spec and, if requested,
+// the IAM role used to publish it to CloudWatch Logs.
+// Flow logs cannot be modified, so a flow log that drifted from the spec is replaced.
+// For more information, see: https://docs.aws.amazon.com/vpc/latest/userguide/flow-logs.html
+func (s *Service) reconcileFlowLogs() error {
+	vpc := s.scope.VPC()
+	if vpc.ID == "" {
+		return nil
+	}
+	if vpc.IsUnmanaged(s.scope.Name()) && !s.scope.UnmanagedVPCFlowLogs() {
+		s.scope.Trace("Skipping flow logs reconcile for unmanaged VPC")
+		return nil
+	}
+
+	spec := vpc.FlowLogs
+	if spec == nil {
+		// The flow logs were disabled, remove the ones we created.
+		return s.deleteFlowLogs()
+	}
+
+	status := s.scope.Network().FlowLog
+	if status == nil {
+		status = &infrav1.VPCFlowLog{}
+	}
+
+	permissionARN := spec.DeliverLogsPermissionARN
+	if spec.CreateDeliveryRole {
+		roleARN, err := s.reconcileFlowLogsDeliveryRole(spec)
+		if err != nil {
+			return err
+		}
+		permissionARN = roleARN
+		status.DeliveryRoleName = s.getFlowLogsDeliveryRoleName()
+	}
+
+	existing, err := s.describeFlowLogs()
+	if err != nil {
+		return err
+	}
+
+	var current *types.FlowLog
+	for i := range existing {
+		fl := &existing[i]
+		if current == nil && s.flowLogMatchesSpec(fl, spec, permissionARN) {
+			current = fl
+			continue
+		}
+		if err := s.deleteFlowLogByID(aws.ToString(fl.FlowLogId)); err != nil {
+			return err
+		}
+	}
+
+	if current == nil {
+		id, err := s.createFlowLog(spec, permissionARN)
+		if err != nil {
+			return err
+		}
+		status.ID = id
+	} else {
+		status.ID = aws.ToString(current.FlowLogId)
+	}
+
+	// The delivery role is no longer used by the flow log, remove it.
+	if !spec.CreateDeliveryRole && status.DeliveryRoleName != "" {
+		if err := s.deleteFlowLogsDeliveryRole(status.DeliveryRoleName); err != nil {
+			return err
+		}
+		status.DeliveryRoleName = ""
+	}
+
+	s.scope.Network().FlowLog = status
+	return nil
+}
+
+// deleteFlowLogs deletes the flow log and the delivery role recorded in the network status.
+func (s *Service) deleteFlowLogs() error {
+	flowLog := s.scope.Network().FlowLog
+	if flowLog == nil {
+		return nil
+	}
+
+	if flowLog.ID != "" {
+		if err := s.deleteFlowLogByID(flowLog.ID); err != nil {
+			return err
+		}
+	}
+	if flowLog.DeliveryRoleName != "" {
+		if err := s.deleteFlowLogsDeliveryRole(flowLog.DeliveryRoleName); err != nil {
+			return err
+		}
+	}
+
+	s.scope.Network().FlowLog = nil
+	return nil
+}
+
+func (s *Service) describeFlowLogs() ([]types.FlowLog, error) {
+	out, err := s.EC2Client.DescribeFlowLogs(context.TODO(), &ec2.DescribeFlowLogsInput{
+		Filter: []types.Filter{
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			{
+				Name:   aws.String("resource-id"),
+				Values: []string{s.scope.VPC().ID},
+			},
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeFlowLogs", "Failed to describe flow logs of VPC %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe flow logs of vpc %q", s.scope.VPC().ID)
+	}
+
+	return out.FlowLogs, nil
+}
+
+func (s *Service) createFlowLog(spec *infrav1.VPCFlowLogsSpec, permissionARN string) (string, error) {
+	input := &ec2.CreateFlowLogsInput{
+		ResourceIds:        []string{s.scope.VPC().ID},
+		ResourceType:       types.FlowLogsResourceTypeVpc,
+		LogDestinationType: types.LogDestinationType(flowLogDestinationType(spec)),
+		TrafficType:        types.TrafficType(flowLogTrafficType(spec)),
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeVpcFlowLog, s.getFlowLogTagParams()),
+		},
+	}
+	if spec.DestinationARN != "" {
+		input.LogDestination = aws.String(spec.DestinationARN)
+	} else {
+		input.LogGroupName = aws.String(s.getFlowLogsLogGroupName())
+	}
+	if permissionARN != "" {
+		input.DeliverLogsPermissionArn = aws.String(permissionARN)
+	}
+	if spec.LogFormat != "" {
+		input.LogFormat = aws.String(spec.LogFormat)
+	}
+	if spec.MaxAggregationInterval != 0 {
+		input.MaxAggregationInterval = aws.Int32(spec.MaxAggregationInterval)
+	}
+
+	out, err := s.EC2Client.CreateFlowLogs(context.TODO(), input)
+	if err == nil && len(out.Unsuccessful) > 0 && out.Unsuccessful[0].Error != nil {
+		err = errors.New(aws.ToString(out.Unsuccessful[0].Error.Message))
+	}
+	if err == nil && len(out.FlowLogIds) == 0 {
+		err = errors.New("empty response")
+	}
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateFlowLog", "Failed to create flow log for VPC %q: %v", s.scope.VPC().ID, err)
+		return "", errors.Wrapf(err, "failed to create flow log for vpc %q", s.scope.VPC().ID)
+	}
+
+	id := out.FlowLogIds[0]
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateFlowLog", "Created flow log %q for VPC %q", id, s.scope.VPC().ID)
+	s.scope.Info("Created flow log", "flow-log-id", id, "vpc-id", s.scope.VPC().ID)
+	return id, nil
+}
+
+func (s *Service) deleteFlowLogByID(id string) error {
+	out, err := s.EC2Client.DeleteFlowLogs(context.TODO(), &ec2.DeleteFlowLogsInput{
+		FlowLogIds: []string{id},
+	})
+	if err == nil && len(out.Unsuccessful) > 0 && out.Unsuccessful[0].Error != nil {
+		if aws.ToString(out.Unsuccessful[0].Error.Code) == awserrors.FlowLogNotFound {
+			return nil
+		}
+		err = errors.New(aws.ToString(out.Unsuccessful[0].Error.Message))
+	}
+	if err != nil {
+		if code, ok := awserrors.Code(err); ok && code == awserrors.FlowLogNotFound {
+			return nil
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteFlowLog", "Failed to delete flow log %q: %v", id, err)
+		return errors.Wrapf(err, "failed to delete flow log %q", id)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteFlowLog", "Deleted flow log %q", id)
+	s.scope.Info("Deleted flow log", "flow-log-id", id)
+	return nil
+}
+
+// reconcileFlowLogsDeliveryRole ensures the IAM role publishing the flow logs to CloudWatch Logs exists
+// and returns its ARN.
+func (s *Service) reconcileFlowLogsDeliveryRole(spec *infrav1.VPCFlowLogsSpec) (string, error) {
+	ctx := context.TODO()
+	roleName := s.getFlowLogsDeliveryRoleName()
+	iamService := &eksiam.IAMService{
+		Wrapper:   s.scope,
+		IAMClient: s.IAMClient,
+	}
+
+	role, err := iamService.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if code, ok := awserrors.Code(err); !ok || code != awserrors.NoSuchEntity {
+			return "", errors.Wrapf(err, "failed to get flow logs delivery role %q", roleName)
+		}
+
+		role, err = iamService.CreateRole(ctx, roleName, s.scope.Name(), flowLogsTrustRelationship(), s.scope.AdditionalTags(), "", "")
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateFlowLogsDeliveryRole", "Failed to create flow logs delivery role %q: %v", roleName, err)
+			return "", errors.Wrapf(err, "failed to create flow logs delivery role %q", roleName)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateFlowLogsDeliveryRole", "Created flow logs delivery role %q", roleName)
+		s.scope.Info("Created flow logs delivery role", "role", roleName)
+	} else if iamService.IsUnmanaged(role, s.scope.Name()) {
+		return "", errors.Errorf("flow logs delivery role %q already exists and is not owned by the cluster", roleName)
+	}
+
+	logGroupARN, err := s.getFlowLogsLogGroupARN(spec, aws.ToString(role.Arn))
+	if err != nil {
+		return "", err
+	}
+	policy, err := converters.IAMPolicyDocumentToJSON(flowLogsDeliveryPolicy(logGroupARN))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert flow logs delivery policy to json")
+	}
+	if _, err := s.IAMClient.PutRolePolicy(ctx, &iam.PutRolePolicyInput{
+		RoleName:       aws.String(roleName),
+		PolicyName:     aws.String(flowLogsDeliveryPolicyName),
+		PolicyDocument: aws.String(policy),
+	}); err != nil {
+		return "", errors.Wrapf(err, "failed to put policy on flow logs delivery role %q", roleName)
+	}
+
+	return aws.ToString(role.Arn), nil
+}
+
+func (s *Service) deleteFlowLogsDeliveryRole(roleName string) error {
+	ctx := context.TODO()
+	if _, err := s.IAMClient.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{
+		RoleName:   aws.String(roleName),
+		PolicyName: aws.String(flowLogsDeliveryPolicyName),
+	}); err != nil {
+		if code, ok := awserrors.Code(err); !ok || code != awserrors.NoSuchEntity {
+			return errors.Wrapf(err, "failed to delete policy of flow logs delivery role %q", roleName)
+		}
+	}
+
+	if _, err := s.IAMClient.DeleteRole(ctx, &iam.DeleteRoleInput{
+		RoleName: aws.String(roleName),
+	}); err != nil {
+		if code, ok := awserrors.Code(err); ok && code == awserrors.NoSuchEntity {
+			return nil
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteFlowLogsDeliveryRole", "Failed to delete flow logs delivery role %q: %v", roleName, err)
+		return errors.Wrapf(err, "failed to delete flow logs delivery role %q", roleName)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteFlowLogsDeliveryRole", "Deleted flow logs delivery role %q", roleName)
+	s.scope.Info("Deleted flow logs delivery role", "role", roleName)
+	return nil
+}
+
+// flowLogMatchesSpec returns whether the flow log publishes the traffic requested by the spec to the requested destination.
+func (s *Service) flowLogMatchesSpec(fl *types.FlowLog, spec *infrav1.VPCFlowLogsSpec, permissionARN string) bool {
+	if string(fl.LogDestinationType) != string(flowLogDestinationType(spec)) ||
+		string(fl.TrafficType) != string(flowLogTrafficType(spec)) {
+		return false
+	}
+	if spec.DestinationARN != "" {
+		if aws.ToString(fl.LogDestination) != spec.DestinationARN {
+			return false
+		}
+	} else if aws.ToString(fl.LogGroupName) != s.getFlowLogsLogGroupName() {
+		return false
+	}
+	if permissionARN != "" && aws.ToString(fl.DeliverLogsPermissionArn) != permissionARN {
+		return false
+	}
+	// AWS reports the default format when none was requested.
+	if spec.LogFormat != "" && aws.ToString(fl.LogFormat) != spec.LogFormat {
+		return false
+	}
+	if spec.MaxAggregationInterval != 0 && aws.ToInt32(fl.MaxAggregationInterval) != spec.MaxAggregationInterval {
+		return false
+	}
+	return true
+}
+
+func (s *Service) getFlowLogsLogGroupName() string {
+	return fmt.Sprintf("/aws/vpc-flow-logs/%s", s.scope.Name())
+}
+
+// getFlowLogsLogGroupARN returns the ARN of the log group the flow logs are published to. The default log group
+// is in the account of the delivery role.
+func (s *Service) getFlowLogsLogGroupARN(spec *infrav1.VPCFlowLogsSpec, roleARN string) (string, error) {
+	if spec.DestinationARN != "" {
+		return strings.TrimSuffix(spec.DestinationARN, ":*"), nil
+	}
+
+	parsed, err := arn.Parse(roleARN)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse flow logs delivery role arn %q", roleARN)
+	}
+	return arn.ARN{
+		Partition: parsed.Partition,
+		Service:   "logs",
+		Region:    s.scope.Region(),
+		AccountID: parsed.AccountID,
+		Resource:  "log-group:" + s.getFlowLogsLogGroupName(),
+	}.String(), nil
+}
+
+// getFlowLogsDeliveryRoleName returns the name of the delivery role, derived from the VPC ID
+// as IAM role names are global to the account and limited to 64 characters.
+func (s *Service) getFlowLogsDeliveryRoleName() string {
+	return fmt.Sprintf("%s-flow-logs", s.scope.VPC().ID)
+}
+
+func (s *Service) getFlowLogTagParams() infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  services.TemporaryResourceID,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-flow-log", s.scope.Name())),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+func flowLogDestinationType(spec *infrav1.VPCFlowLogsSpec) infrav1.FlowLogDestinationType {
+	if spec.DestinationType == "" {
+		return infrav1.FlowLogDestinationTypeCloudWatchLogs
+	}
+	return spec.DestinationType
+}
+
+func flowLogTrafficType(spec *infrav1.VPCFlowLogsSpec) infrav1.FlowLogTrafficType {
+	if spec.TrafficType == "" {
+		return infrav1.FlowLogTrafficTypeAll
+	}
+	return spec.TrafficType
+}
+
+// flowLogsTrustRelationship returns the trust policy allowing the flow logs service to assume the delivery role.
+func flowLogsTrustRelationship() *iamv1.PolicyDocument {
+	return &iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: []iamv1.StatementEntry{
+			{
+				Effect:    iamv1.EffectAllow,
+				Action:    iamv1.Actions{"sts:AssumeRole"},
+				Principal: iamv1.Principals{iamv1.PrincipalService: iamv1.PrincipalID{flowLogsServicePrincipal}},
+			},
+		},
+	}
+}
+
+// flowLogsDeliveryPolicy returns the permissions needed to publish the flow logs to the given CloudWatch Logs log group.
+func flowLogsDeliveryPolicy(logGroupARN string) iamv1.PolicyDocument {
+	// DescribeLogGroups can't be scoped to a single log group.
+	allLogGroups := logGroupARN
+	if i := strings.Index(logGroupARN, ":log-group:"); i >= 0 {
+		allLogGroups = logGroupARN[:i] + ":log-group:*"
+	}
+
+	return iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		Statement: []iamv1.StatementEntry{
+			{
+				Effect: iamv1.EffectAllow,
+				Action: iamv1.Actions{
+					"logs:CreateLogGroup",
+					"logs:CreateLogStream",
+					"logs:DescribeLogStreams",
+					"logs:PutLogEvents",
+				},
+				Resource: iamv1.Resources{logGroupARN, logGroupARN + ":log-stream:*"},
+			},
+			{
+				Effect:   iamv1.EffectAllow,
+				Action:   iamv1.Actions{"logs:DescribeLogGroups"},
+				Resource: iamv1.Resources{allLogGroups},
+			},
+		},
+	}
+}
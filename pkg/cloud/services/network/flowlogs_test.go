@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileFlowLogs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	vpcWithFlowLogs := func(flowLogs *infrav1.VPCFlowLogsSpec, managed bool) infrav1.NetworkSpec {
+		vpc := infrav1.VPCSpec{
+			ID:        "vpc-flow",
+			CidrBlock: "10.0.0.0/16",
+			FlowLogs:  flowLogs,
+		}
+		if managed {
+			vpc.Tags = infrav1.Tags{
+				infrav1.ClusterTagKey("test-cluster"): "owned",
+			}
+		}
+		return infrav1.NetworkSpec{VPC: vpc}
+	}
+
+	describeInput := &ec2.DescribeFlowLogsInput{
+		Filter: []types.Filter{
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: []string{"owned"},
+			},
+			{
+				Name:   aws.String("resource-id"),
+				Values: []string{"vpc-flow"},
+			},
+		},
+	}
+
+	s3Spec := &infrav1.VPCFlowLogsSpec{
+		DestinationType:        infrav1.FlowLogDestinationTypeS3,
+		DestinationARN:         "arn:aws:s3:::flow-logs-bucket",
+		TrafficType:            infrav1.FlowLogTrafficTypeReject,
+		MaxAggregationInterval: 60,
+	}
+
+	testCases := []struct {
+		name                 string
+		input                infrav1.NetworkSpec
+		status               *infrav1.VPCFlowLog
+		unmanagedVPCFlowLogs bool
+		expect               func(m *mocks.MockEC2APIMockRecorder)
+		expectIAM            func(m *mock_iamauth.MockIAMAPIMockRecorder)
+		expectedStatus       *infrav1.VPCFlowLog
+		wantErr              bool
+	}{
+		{
+			name:   "no flow logs declared or recorded, does nothing",
+			input:  vpcWithFlowLogs(nil, true),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:   "unmanaged vpc without the feature gate, does nothing",
+			input:  vpcWithFlowLogs(s3Spec, false),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:                 "unmanaged vpc with the feature gate, creates the flow log",
+			input:                vpcWithFlowLogs(s3Spec, false),
+			unmanagedVPCFlowLogs: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateFlowLogsInput, _ ...func(*ec2.Options)) (*ec2.CreateFlowLogsOutput, error) {
+						if input.ResourceIds[0] != "vpc-flow" || input.ResourceType != types.FlowLogsResourceTypeVpc ||
+							input.LogDestinationType != types.LogDestinationTypeS3 || aws.ToString(input.LogDestination) != "arn:aws:s3:::flow-logs-bucket" ||
+							input.TrafficType != types.TrafficTypeReject || aws.ToInt32(input.MaxAggregationInterval) != 60 {
+							t.Fatalf("unexpected create flow logs input %+v", input)
+						}
+						if input.LogGroupName != nil || input.DeliverLogsPermissionArn != nil {
+							t.Fatalf("unexpected cloudwatch logs settings on s3 flow log %+v", input)
+						}
+						if input.TagSpecifications[0].ResourceType != types.ResourceTypeVpcFlowLog {
+							t.Fatalf("unexpected tag specification %+v", input.TagSpecifications)
+						}
+						return &ec2.CreateFlowLogsOutput{FlowLogIds: []string{"fl-1"}}, nil
+					})
+			},
+			expectedStatus: &infrav1.VPCFlowLog{ID: "fl-1"},
+		},
+		{
+			name: "creates the delivery role and the cloudwatch logs flow log",
+			input: vpcWithFlowLogs(&infrav1.VPCFlowLogsSpec{
+				DestinationType:    infrav1.FlowLogDestinationTypeCloudWatchLogs,
+				CreateDeliveryRole: true,
+			}, true),
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), gomock.Eq(&iam.GetRoleInput{RoleName: aws.String("vpc-flow-flow-logs")})).
+					Return(nil, &iamtypes.NoSuchEntityException{Message: aws.String("not found")})
+				m.CreateRole(gomock.Any(), gomock.AssignableToTypeOf(&iam.CreateRoleInput{})).
+					Return(&iam.CreateRoleOutput{Role: &iamtypes.Role{
+						RoleName: aws.String("vpc-flow-flow-logs"),
+						Arn:      aws.String("arn:aws:iam::123456789012:role/vpc-flow-flow-logs"),
+					}}, nil)
+				m.PutRolePolicy(gomock.Any(), gomock.AssignableToTypeOf(&iam.PutRolePolicyInput{})).
+					DoAndReturn(func(_ context.Context, input *iam.PutRolePolicyInput, _ ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error) {
+						logGroupARN := "arn:aws:logs:us-east-1:123456789012:log-group:/aws/vpc-flow-logs/test-cluster"
+						if !strings.Contains(aws.ToString(input.PolicyDocument), `"`+logGroupARN+`"`) ||
+							strings.Contains(aws.ToString(input.PolicyDocument), `"*"`) {
+							t.Fatalf("unexpected delivery policy %s", aws.ToString(input.PolicyDocument))
+						}
+						return &iam.PutRolePolicyOutput{}, nil
+					})
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateFlowLogsInput, _ ...func(*ec2.Options)) (*ec2.CreateFlowLogsOutput, error) {
+						if input.LogDestinationType != types.LogDestinationTypeCloudWatchLogs || input.TrafficType != types.TrafficTypeAll ||
+							aws.ToString(input.LogGroupName) != "/aws/vpc-flow-logs/test-cluster" ||
+							aws.ToString(input.DeliverLogsPermissionArn) != "arn:aws:iam::123456789012:role/vpc-flow-flow-logs" {
+							t.Fatalf("unexpected create flow logs input %+v", input)
+						}
+						return &ec2.CreateFlowLogsOutput{FlowLogIds: []string{"fl-1"}}, nil
+					})
+			},
+			expectedStatus: &infrav1.VPCFlowLog{ID: "fl-1", DeliveryRoleName: "vpc-flow-flow-logs"},
+		},
+		{
+			name:   "replaces a flow log that drifted from the spec",
+			input:  vpcWithFlowLogs(s3Spec, true),
+			status: &infrav1.VPCFlowLog{ID: "fl-old"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeFlowLogsOutput{
+						FlowLogs: []types.FlowLog{
+							{
+								FlowLogId:              aws.String("fl-old"),
+								LogDestinationType:     types.LogDestinationTypeS3,
+								LogDestination:         aws.String("arn:aws:s3:::flow-logs-bucket"),
+								TrafficType:            types.TrafficTypeAll,
+								MaxAggregationInterval: aws.Int32(60),
+							},
+						},
+					}, nil)
+				m.DeleteFlowLogs(context.TODO(), gomock.Eq(&ec2.DeleteFlowLogsInput{
+					FlowLogIds: []string{"fl-old"},
+				})).Return(&ec2.DeleteFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					Return(&ec2.CreateFlowLogsOutput{FlowLogIds: []string{"fl-new"}}, nil)
+			},
+			expectedStatus: &infrav1.VPCFlowLog{ID: "fl-new"},
+		},
+		{
+			name:   "keeps a flow log matching the spec",
+			input:  vpcWithFlowLogs(s3Spec, true),
+			status: &infrav1.VPCFlowLog{ID: "fl-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeFlowLogsOutput{
+						FlowLogs: []types.FlowLog{
+							{
+								FlowLogId:              aws.String("fl-1"),
+								LogDestinationType:     types.LogDestinationTypeS3,
+								LogDestination:         aws.String("arn:aws:s3:::flow-logs-bucket"),
+								TrafficType:            types.TrafficTypeReject,
+								MaxAggregationInterval: aws.Int32(60),
+								LogFormat:              aws.String("${version} ${account-id}"),
+							},
+						},
+					}, nil)
+			},
+			expectedStatus: &infrav1.VPCFlowLog{ID: "fl-1"},
+		},
+		{
+			name:   "failure to create the flow log is reported",
+			input:  vpcWithFlowLogs(s3Spec, true),
+			status: nil,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					Return(&ec2.CreateFlowLogsOutput{
+						Unsuccessful: []types.UnsuccessfulItem{
+							{
+								Error: &types.UnsuccessfulItemError{
+									Code:    aws.String("InvalidParameter"),
+									Message: aws.String("Access Denied for LogDestination"),
+								},
+							},
+						},
+					}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "flow logs removed from the spec are deleted with their delivery role",
+			input:  vpcWithFlowLogs(nil, true),
+			status: &infrav1.VPCFlowLog{ID: "fl-1", DeliveryRoleName: "vpc-flow-flow-logs"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DeleteFlowLogs(context.TODO(), gomock.Eq(&ec2.DeleteFlowLogsInput{
+					FlowLogIds: []string{"fl-1"},
+				})).Return(&ec2.DeleteFlowLogsOutput{}, nil)
+			},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.DeleteRolePolicy(gomock.Any(), gomock.Eq(&iam.DeleteRolePolicyInput{
+					RoleName:   aws.String("vpc-flow-flow-logs"),
+					PolicyName: aws.String(flowLogsDeliveryPolicyName),
+				})).Return(&iam.DeleteRolePolicyOutput{}, nil)
+				m.DeleteRole(gomock.Any(), gomock.Eq(&iam.DeleteRoleInput{
+					RoleName: aws.String("vpc-flow-flow-logs"),
+				})).Return(nil, &iamtypes.NoSuchEntityException{Message: aws.String("not found")})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						Region:      "us-east-1",
+						NetworkSpec: tc.input,
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							FlowLog: tc.status,
+						},
+					},
+				},
+				UnmanagedVPCFlowLogs: tc.unmanagedVPCFlowLogs,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+			if tc.expectIAM != nil {
+				tc.expectIAM(iamMock.EXPECT())
+			}
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+			s.IAMClient = iamMock
+
+			err = s.reconcileFlowLogs()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scope.Network().FlowLog).To(Equal(tc.expectedStatus))
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package network
 
 import (
+	"strings"
+
 	"k8s.io/klog/v2"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -79,6 +81,44 @@ func (s *Service) ReconcileNetwork() (err error) {
 	}
 	v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition)
 
+	// Transit Gateway attachment.
+	if err := s.reconcileTransitGatewayAttachment(); err != nil {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.TransitGatewayAttachmentReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
+		return err
+	}
+	if s.scope.VPC().TransitGateway != nil {
+		if s.isTransitGatewayAttachmentAvailable() {
+			v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)
+		} else {
+			// Routes through the transit gateway are added by a later reconciliation, once the attachment is available.
+			attachment := s.scope.Network().TransitGatewayAttachment
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.TransitGatewayAttachmentNotAvailableReason, clusterv1beta1.ConditionSeverityInfo, "Transit gateway attachment %s is in state %s", attachment.ID, attachment.State)
+		}
+	} else {
+		v1beta1conditions.Delete(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)
+	}
+
+	// VPC Peering connections.
+	peerRouteConflicts, err := s.reconcileVPCPeerings()
+	if err != nil {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcPeeringReadyCondition, infrav1.VpcPeeringReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
+		return err
+	}
+	if len(s.scope.VPCPeerings()) > 0 {
+		inactive := s.getInactiveVPCPeerings()
+		switch {
+		case len(inactive) > 0:
+			// Routes through the peering connections are added by a later reconciliation, once they are active.
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcPeeringReadyCondition, infrav1.VpcPeeringNotActiveReason, clusterv1beta1.ConditionSeverityInfo, "VPC peering connection %s is in state %s", inactive[0].ID, inactive[0].State)
+		case len(peerRouteConflicts) > 0:
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcPeeringReadyCondition, infrav1.VpcPeeringRouteConflictReason, clusterv1beta1.ConditionSeverityWarning, "Peer routes to %s go through another target", strings.Join(peerRouteConflicts, ", "))
+		default:
+			v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.VpcPeeringReadyCondition)
+		}
+	} else {
+		v1beta1conditions.Delete(s.scope.InfraCluster(), infrav1.VpcPeeringReadyCondition)
+	}
+
 	// Routing tables.
 	if err := s.reconcileRouteTables(); err != nil {
 		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition, infrav1.RouteTableReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
@@ -93,6 +133,15 @@ func (s *Service) ReconcileNetwork() (err error) {
 	}
 	v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition)
 
+	// VPC Flow Logs.
+	if err := s.reconcileFlowLogs(); err != nil {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, infrav1.VpcFlowLogsReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
+		return err
+	}
+	if s.scope.VPC().FlowLogs != nil && s.scope.Network().FlowLog != nil {
+		v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition)
+	}
+
 	s.scope.Debug("Reconcile network completed successfully")
 	return nil
 }
@@ -119,6 +168,20 @@ func (s *Service) DeleteNetwork() (err error) {
 
 	vpc.DeepCopyInto(s.scope.VPC())
 
+	// VPC Flow Logs.
+	if s.scope.Network().FlowLog != nil {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
+		if err := s.scope.PatchObject(); err != nil {
+			return err
+		}
+
+		if err := s.deleteFlowLogs(); err != nil {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, "DeletingFailed", clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+			return err
+		}
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcFlowLogsReadyCondition, clusterv1beta1.DeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
+	}
+
 	// VPC Endpoints.
 	v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
@@ -143,6 +206,34 @@ func (s *Service) DeleteNetwork() (err error) {
 	}
 	v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition, clusterv1beta1.DeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
 
+	// Transit Gateway attachment.
+	if s.scope.Network().TransitGatewayAttachment != nil {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
+		if err := s.scope.PatchObject(); err != nil {
+			return err
+		}
+
+		if err := s.deleteTransitGatewayAttachment(); err != nil {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, "DeletingFailed", clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+			return err
+		}
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, clusterv1beta1.DeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
+	}
+
+	// VPC Peering connections.
+	if len(s.scope.Network().VPCPeerings) > 0 {
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcPeeringReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
+		if err := s.scope.PatchObject(); err != nil {
+			return err
+		}
+
+		if err := s.deleteVPCPeerings(); err != nil {
+			v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcPeeringReadyCondition, "DeletingFailed", clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+			return err
+		}
+		v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcPeeringReadyCondition, clusterv1beta1.DeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
+	}
+
 	// NAT Gateways.
 	v1beta1conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
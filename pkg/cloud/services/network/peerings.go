@@ -0,0 +1,464 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	// vpcPeeringRoleSessionName is the session name used when assuming the role of the peer account.
+	vpcPeeringRoleSessionName = "cluster-api-provider-aws-vpc-peering"
+)
+
+// reconcileVPCPeerings requests the peering connections declared in the network spec, accepts them when
+// the controller has access to the peer account and adds the routes of the peer VPC route tables.
+// Peering connections that are no longer declared are removed. It returns the peer routes that already
+// go through another target, which are left untouched.
+// For more information, see: https://docs.aws.amazon.com/vpc/latest/peering/what-is-vpc-peering.html
+func (s *Service) reconcileVPCPeerings() ([]string, error) {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping VPC peering reconcile in unmanaged mode")
+		return nil, nil
+	}
+
+	desired := s.scope.VPCPeerings()
+
+	// Nothing was ever requested, avoid describing the peering connections on every reconciliation.
+	if len(desired) == 0 && len(s.scope.Network().VPCPeerings) == 0 {
+		return nil, nil
+	}
+
+	s.scope.Debug("Reconciling VPC peering connections")
+
+	existing, err := s.describeVPCPeeringConnections()
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []string
+	status := make([]infrav1.VPCPeering, 0, len(desired))
+	wanted := sets.New[string]()
+	for i := range desired {
+		spec := &desired[i]
+		wanted.Insert(spec.PeerVPCID)
+
+		pcx, ok := existing[spec.PeerVPCID]
+		if !ok {
+			pcx, err = s.createVPCPeeringConnection(spec)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if vpcPeeringState(pcx) == types.VpcPeeringConnectionStateReasonCodePendingAcceptance && canAcceptVPCPeering(spec) {
+			pcx, err = s.acceptVPCPeeringConnection(spec, pcx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if vpcPeeringState(pcx) == types.VpcPeeringConnectionStateReasonCodeActive && len(spec.PeerRouteTableIDs) > 0 {
+			peerConflicts, err := s.reconcilePeerRoutes(spec, aws.ToString(pcx.VpcPeeringConnectionId))
+			if err != nil {
+				return nil, err
+			}
+			conflicts = append(conflicts, peerConflicts...)
+		}
+
+		status = append(status, infrav1.VPCPeering{
+			ID:                aws.ToString(pcx.VpcPeeringConnectionId),
+			PeerVPCID:         spec.PeerVPCID,
+			PeerOwnerID:       spec.PeerOwnerID,
+			PeerRegion:        spec.PeerRegion,
+			PeerRoleARN:       spec.PeerRoleARN,
+			PeerRouteTableIDs: spec.PeerRouteTableIDs,
+			State:             string(vpcPeeringState(pcx)),
+		})
+	}
+
+	// Remove the peering connections that are no longer part of the spec. The spec entry is gone, so the
+	// peer routes are found through what was recorded in the status.
+	recorded := make(map[string]infrav1.VPCPeering, len(s.scope.Network().VPCPeerings))
+	for _, peering := range s.scope.Network().VPCPeerings {
+		recorded[peering.PeerVPCID] = peering
+	}
+	removals := []string{}
+	for peerVPCID := range existing {
+		if !wanted.Has(peerVPCID) {
+			removals = append(removals, peerVPCID)
+		}
+	}
+	sort.Strings(removals)
+	for _, peerVPCID := range removals {
+		pcx := existing[peerVPCID]
+		id := aws.ToString(pcx.VpcPeeringConnectionId)
+		if peering, ok := recorded[peerVPCID]; ok && len(peering.PeerRouteTableIDs) > 0 &&
+			vpcPeeringState(pcx) == types.VpcPeeringConnectionStateReasonCodeActive {
+			if err := s.deletePeerRoutes(vpcPeeringSpecFromStatus(&peering), id); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.deleteRoutesThrough("", id); err != nil {
+			return nil, err
+		}
+		if err := s.deleteVPCPeeringConnection(id); err != nil {
+			return nil, err
+		}
+	}
+
+	s.scope.Network().VPCPeerings = status
+	return conflicts, nil
+}
+
+// getInactiveVPCPeerings returns the peering connections recorded in the status that can't route traffic yet.
+func (s *Service) getInactiveVPCPeerings() []infrav1.VPCPeering {
+	var inactive []infrav1.VPCPeering
+	for _, peering := range s.scope.Network().VPCPeerings {
+		if peering.State != string(types.VpcPeeringConnectionStateReasonCodeActive) {
+			inactive = append(inactive, peering)
+		}
+	}
+	return inactive
+}
+
+// getVPCPeeringRoutes returns the routes of the cluster route tables that go through the active peering connections.
+func (s *Service) getVPCPeeringRoutes() []*ec2.CreateRouteInput {
+	active := map[string]string{}
+	for _, peering := range s.scope.Network().VPCPeerings {
+		if peering.State == string(types.VpcPeeringConnectionStateReasonCodeActive) {
+			active[peering.PeerVPCID] = peering.ID
+		}
+	}
+
+	var routes []*ec2.CreateRouteInput
+	for _, spec := range s.scope.VPCPeerings() {
+		id, ok := active[spec.PeerVPCID]
+		if !ok {
+			continue
+		}
+		for _, cidrBlock := range spec.RouteCidrBlocks {
+			routes = append(routes, &ec2.CreateRouteInput{
+				DestinationCidrBlock:   aws.String(cidrBlock),
+				VpcPeeringConnectionId: aws.String(id),
+			})
+		}
+	}
+	return routes
+}
+
+func (s *Service) describeVPCPeeringConnections() (map[string]*types.VpcPeeringConnection, error) {
+	out, err := s.EC2Client.DescribeVpcPeeringConnections(context.TODO(), &ec2.DescribeVpcPeeringConnectionsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("requester-vpc-info.vpc-id"),
+				Values: []string{s.scope.VPC().ID},
+			},
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeVPCPeeringConnection", "Failed to describe VPC peering connections of vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe vpc peering connections of vpc %q", s.scope.VPC().ID)
+	}
+
+	res := make(map[string]*types.VpcPeeringConnection, len(out.VpcPeeringConnections))
+	for i := range out.VpcPeeringConnections {
+		pcx := &out.VpcPeeringConnections[i]
+		switch vpcPeeringState(pcx) {
+		case types.VpcPeeringConnectionStateReasonCodeDeleting, types.VpcPeeringConnectionStateReasonCodeDeleted,
+			types.VpcPeeringConnectionStateReasonCodeRejected, types.VpcPeeringConnectionStateReasonCodeFailed,
+			types.VpcPeeringConnectionStateReasonCodeExpired:
+			continue
+		}
+		if pcx.AccepterVpcInfo == nil {
+			continue
+		}
+		res[aws.ToString(pcx.AccepterVpcInfo.VpcId)] = pcx
+	}
+	return res, nil
+}
+
+func (s *Service) createVPCPeeringConnection(spec *infrav1.VPCPeeringSpec) (*types.VpcPeeringConnection, error) {
+	input := &ec2.CreateVpcPeeringConnectionInput{
+		VpcId:     aws.String(s.scope.VPC().ID),
+		PeerVpcId: aws.String(spec.PeerVPCID),
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeVpcPeeringConnection, s.getVPCPeeringTagParams(spec.PeerVPCID)),
+		},
+	}
+	if spec.PeerOwnerID != "" {
+		input.PeerOwnerId = aws.String(spec.PeerOwnerID)
+	}
+	if spec.PeerRegion != "" {
+		input.PeerRegion = aws.String(spec.PeerRegion)
+	}
+
+	out, err := s.EC2Client.CreateVpcPeeringConnection(context.TODO(), input)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateVPCPeeringConnection", "Failed to request peering between VPC %q and VPC %q: %v", s.scope.VPC().ID, spec.PeerVPCID, err)
+		return nil, errors.Wrapf(err, "failed to request peering between vpc %q and vpc %q", s.scope.VPC().ID, spec.PeerVPCID)
+	}
+	if out.VpcPeeringConnection == nil {
+		return nil, errors.Errorf("failed to request peering between vpc %q and vpc %q: empty response", s.scope.VPC().ID, spec.PeerVPCID)
+	}
+
+	id := aws.ToString(out.VpcPeeringConnection.VpcPeeringConnectionId)
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateVPCPeeringConnection", "Requested VPC peering connection %q with VPC %q", id, spec.PeerVPCID)
+	s.scope.Info("Requested VPC peering connection", "vpc-peering-connection-id", id, "peer-vpc-id", spec.PeerVPCID)
+	return out.VpcPeeringConnection, nil
+}
+
+func (s *Service) acceptVPCPeeringConnection(spec *infrav1.VPCPeeringSpec, pcx *types.VpcPeeringConnection) (*types.VpcPeeringConnection, error) {
+	id := aws.ToString(pcx.VpcPeeringConnectionId)
+	out, err := s.PeerEC2Client(spec).AcceptVpcPeeringConnection(context.TODO(), &ec2.AcceptVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: pcx.VpcPeeringConnectionId,
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAcceptVPCPeeringConnection", "Failed to accept VPC peering connection %q: %v", id, err)
+		return nil, errors.Wrapf(err, "failed to accept vpc peering connection %q", id)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAcceptVPCPeeringConnection", "Accepted VPC peering connection %q", id)
+	s.scope.Info("Accepted VPC peering connection", "vpc-peering-connection-id", id)
+	if out.VpcPeeringConnection == nil {
+		return pcx, nil
+	}
+	return out.VpcPeeringConnection, nil
+}
+
+// reconcilePeerRoutes makes sure the route tables of the peer VPC route the cluster VPC CIDR blocks through the peering connection.
+// Routes to these CIDR blocks that go through another target belong to the peer VPC owner, they are returned as conflicts.
+func (s *Service) reconcilePeerRoutes(spec *infrav1.VPCPeeringSpec, pcxID string) ([]string, error) {
+	client := s.PeerEC2Client(spec)
+	rts, err := s.describePeerRouteTables(client, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []string
+
+	destinations := []string{s.scope.VPC().CidrBlock}
+	for _, block := range s.scope.AllSecondaryCidrBlocks() {
+		destinations = append(destinations, block.IPv4CidrBlock)
+	}
+
+	for _, rt := range rts {
+		current := map[string]types.Route{}
+		for _, route := range rt.Routes {
+			current[aws.ToString(route.DestinationCidrBlock)] = route
+		}
+
+		for _, destination := range destinations {
+			if destination == "" {
+				continue
+			}
+			route, ok := current[destination]
+			switch {
+			case !ok:
+				if _, err := client.CreateRoute(context.TODO(), &ec2.CreateRouteInput{
+					RouteTableId:           rt.RouteTableId,
+					DestinationCidrBlock:   aws.String(destination),
+					VpcPeeringConnectionId: aws.String(pcxID),
+				}); err != nil {
+					record.Warnf(s.scope.InfraCluster(), "FailedCreatePeerRoute", "Failed to create route to %q in peer RouteTable %q: %v", destination, aws.ToString(rt.RouteTableId), err)
+					return nil, errors.Wrapf(err, "failed to create route to %q in peer route table %q", destination, aws.ToString(rt.RouteTableId))
+				}
+				record.Eventf(s.scope.InfraCluster(), "SuccessfulCreatePeerRoute", "Created route to %q in peer RouteTable %q", destination, aws.ToString(rt.RouteTableId))
+			case aws.ToString(route.VpcPeeringConnectionId) != pcxID:
+				record.Warnf(s.scope.InfraCluster(), "ConflictingPeerRoute", "Route to %q in peer RouteTable %q doesn't go through VPC peering connection %q, leaving it untouched", destination, aws.ToString(rt.RouteTableId), pcxID)
+				conflicts = append(conflicts, fmt.Sprintf("%s in %s", destination, aws.ToString(rt.RouteTableId)))
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+// deletePeerRoutes removes the routes through the peering connection from the route tables of the peer VPC.
+func (s *Service) deletePeerRoutes(spec *infrav1.VPCPeeringSpec, pcxID string) error {
+	client := s.PeerEC2Client(spec)
+	rts, err := s.describePeerRouteTables(client, spec)
+	if err != nil {
+		return err
+	}
+
+	for _, rt := range rts {
+		for _, route := range rt.Routes {
+			if aws.ToString(route.VpcPeeringConnectionId) != pcxID || route.DestinationCidrBlock == nil {
+				continue
+			}
+			if _, err := client.DeleteRoute(context.TODO(), &ec2.DeleteRouteInput{
+				RouteTableId:         rt.RouteTableId,
+				DestinationCidrBlock: route.DestinationCidrBlock,
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedDeletePeerRoute", "Failed to delete route to %q from peer RouteTable %q: %v", aws.ToString(route.DestinationCidrBlock), aws.ToString(rt.RouteTableId), err)
+				return errors.Wrapf(err, "failed to delete route to %q from peer route table %q", aws.ToString(route.DestinationCidrBlock), aws.ToString(rt.RouteTableId))
+			}
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulDeletePeerRoute", "Deleted route to %q from peer RouteTable %q", aws.ToString(route.DestinationCidrBlock), aws.ToString(rt.RouteTableId))
+		}
+	}
+	return nil
+}
+
+func (s *Service) describePeerRouteTables(client common.EC2API, spec *infrav1.VPCPeeringSpec) ([]types.RouteTable, error) {
+	out, err := client.DescribeRouteTables(context.TODO(), &ec2.DescribeRouteTablesInput{
+		RouteTableIds: spec.PeerRouteTableIDs,
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribePeerRouteTable", "Failed to describe route tables of peer vpc %q: %v", spec.PeerVPCID, err)
+		return nil, errors.Wrapf(err, "failed to describe route tables of peer vpc %q", spec.PeerVPCID)
+	}
+	return out.RouteTables, nil
+}
+
+// deleteVPCPeerings removes the peering connections recorded in the status, together with the routes
+// they own in the peer VPC route tables.
+func (s *Service) deleteVPCPeerings() error {
+	peerings := s.scope.VPCPeerings()
+	specs := make(map[string]*infrav1.VPCPeeringSpec, len(peerings))
+	for i := range peerings {
+		specs[peerings[i].PeerVPCID] = &peerings[i]
+	}
+
+	for i := range s.scope.Network().VPCPeerings {
+		peering := &s.scope.Network().VPCPeerings[i]
+		spec, ok := specs[peering.PeerVPCID]
+		if !ok {
+			spec = vpcPeeringSpecFromStatus(peering)
+		}
+		if len(spec.PeerRouteTableIDs) > 0 && peering.State == string(types.VpcPeeringConnectionStateReasonCodeActive) {
+			if err := s.deletePeerRoutes(spec, peering.ID); err != nil {
+				return err
+			}
+		}
+		if err := s.deleteVPCPeeringConnection(peering.ID); err != nil {
+			return err
+		}
+	}
+
+	s.scope.Network().VPCPeerings = nil
+	return nil
+}
+
+func (s *Service) deleteVPCPeeringConnection(id string) error {
+	if _, err := s.EC2Client.DeleteVpcPeeringConnection(context.TODO(), &ec2.DeleteVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(id),
+	}); err != nil {
+		if code, ok := awserrors.Code(err); ok && code == awserrors.VPCPeeringConnectionNotFound {
+			return nil
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteVPCPeeringConnection", "Failed to delete VPC peering connection %q: %v", id, err)
+		return errors.Wrapf(err, "failed to delete vpc peering connection %q", id)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteVPCPeeringConnection", "Deleted VPC peering connection %q", id)
+	s.scope.Info("Deleted VPC peering connection", "vpc-peering-connection-id", id)
+	return nil
+}
+
+func (s *Service) getVPCPeeringTagParams(peerVPCID string) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  services.TemporaryResourceID,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-peering-%s", s.scope.Name(), peerVPCID)),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+// vpcPeeringSpecFromStatus returns the parts of the spec of a peering connection needed to remove its
+// peer routes, as recorded in the status.
+func vpcPeeringSpecFromStatus(peering *infrav1.VPCPeering) *infrav1.VPCPeeringSpec {
+	return &infrav1.VPCPeeringSpec{
+		PeerVPCID:         peering.PeerVPCID,
+		PeerOwnerID:       peering.PeerOwnerID,
+		PeerRegion:        peering.PeerRegion,
+		PeerRoleARN:       peering.PeerRoleARN,
+		PeerRouteTableIDs: peering.PeerRouteTableIDs,
+	}
+}
+
+func vpcPeeringState(pcx *types.VpcPeeringConnection) types.VpcPeeringConnectionStateReasonCode {
+	if pcx.Status == nil {
+		return ""
+	}
+	return pcx.Status.Code
+}
+
+// canAcceptVPCPeering returns true when the controller has access to the account of the peer VPC.
+func canAcceptVPCPeering(spec *infrav1.VPCPeeringSpec) bool {
+	return spec.PeerRoleARN != "" || spec.PeerOwnerID == ""
+}
+
+// peerSession is the session used to manage the peer side of a VPC peering connection.
+type peerSession struct {
+	config          aws.Config
+	serviceLimiters cloud.Session
+}
+
+// Session returns the AWS configuration targeting the peer VPC.
+func (p *peerSession) Session() aws.Config {
+	return p.config
+}
+
+// ServiceLimiter returns the rate limiter of the cluster session for the given service.
+func (p *peerSession) ServiceLimiter(service string) *throttle.ServiceLimiter {
+	return p.serviceLimiters.ServiceLimiter(service)
+}
+
+// peerSessionKey identifies the sessions that can be shared between VPC peering connections.
+type peerSessionKey struct {
+	region  string
+	roleARN string
+}
+
+// newPeerSession returns a session for the region of the peer VPC, using the credentials of the
+// peer role when one is set.
+func newPeerSession(session cloud.Session, peering *infrav1.VPCPeeringSpec) cloud.Session {
+	cfg := session.Session().Copy()
+	if peering.PeerRegion != "" {
+		cfg.Region = peering.PeerRegion
+	}
+	if peering.PeerRoleARN != "" {
+		stsClient := sts.NewFromConfig(cfg)
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, peering.PeerRoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = vpcPeeringRoleSessionName
+		}))
+	}
+	return &peerSession{config: cfg, serviceLimiters: session}
+}
@@ -0,0 +1,400 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileVPCPeerings(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	describeInput := &ec2.DescribeVpcPeeringConnectionsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("requester-vpc-info.vpc-id"),
+				Values: []string{"vpc-cluster"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: []string{"owned"},
+			},
+		},
+	}
+
+	pcx := func(id, peerVPCID string, state types.VpcPeeringConnectionStateReasonCode) *types.VpcPeeringConnection {
+		return &types.VpcPeeringConnection{
+			VpcPeeringConnectionId: aws.String(id),
+			AccepterVpcInfo:        &types.VpcPeeringConnectionVpcInfo{VpcId: aws.String(peerVPCID)},
+			Status:                 &types.VpcPeeringConnectionStateReason{Code: state},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		peerings          []infrav1.VPCPeeringSpec
+		status            []infrav1.VPCPeering
+		expect            func(m *mocks.MockEC2APIMockRecorder)
+		expectPeer        func(m *mocks.MockEC2APIMockRecorder)
+		expectedStatus    []infrav1.VPCPeering
+		expectedConflicts []string
+		wantErr           bool
+	}{
+		{
+			name:   "no peering declared or recorded, does nothing",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "requests, accepts and routes a peering connection in the same account",
+			peerings: []infrav1.VPCPeeringSpec{
+				{
+					PeerVPCID:         "vpc-shared",
+					RouteCidrBlocks:   []string{"10.100.0.0/16"},
+					PeerRouteTableIDs: []string{"rtb-shared"},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcPeeringConnections(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVpcPeeringConnectionsOutput{}, nil)
+				m.CreateVpcPeeringConnection(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcPeeringConnectionInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateVpcPeeringConnectionInput, _ ...func(*ec2.Options)) (*ec2.CreateVpcPeeringConnectionOutput, error) {
+						if aws.ToString(input.VpcId) != "vpc-cluster" || aws.ToString(input.PeerVpcId) != "vpc-shared" ||
+							input.PeerOwnerId != nil || input.PeerRegion != nil {
+							t.Fatalf("unexpected create vpc peering connection input %+v", input)
+						}
+						if input.TagSpecifications[0].ResourceType != types.ResourceTypeVpcPeeringConnection {
+							t.Fatalf("unexpected tag specification %+v", input.TagSpecifications)
+						}
+						return &ec2.CreateVpcPeeringConnectionOutput{
+							VpcPeeringConnection: pcx("pcx-1", "vpc-shared", types.VpcPeeringConnectionStateReasonCodePendingAcceptance),
+						}, nil
+					})
+			},
+			expectPeer: func(m *mocks.MockEC2APIMockRecorder) {
+				m.AcceptVpcPeeringConnection(context.TODO(), gomock.Eq(&ec2.AcceptVpcPeeringConnectionInput{
+					VpcPeeringConnectionId: aws.String("pcx-1"),
+				})).Return(&ec2.AcceptVpcPeeringConnectionOutput{
+					VpcPeeringConnection: pcx("pcx-1", "vpc-shared", types.VpcPeeringConnectionStateReasonCodeActive),
+				}, nil)
+				m.DescribeRouteTables(context.TODO(), gomock.Eq(&ec2.DescribeRouteTablesInput{
+					RouteTableIds: []string{"rtb-shared"},
+				})).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: []types.RouteTable{
+						{
+							RouteTableId: aws.String("rtb-shared"),
+							Routes: []types.Route{
+								{DestinationCidrBlock: aws.String("10.100.0.0/16"), GatewayId: aws.String("local")},
+							},
+						},
+					},
+				}, nil)
+				m.CreateRoute(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+					RouteTableId:           aws.String("rtb-shared"),
+					DestinationCidrBlock:   aws.String("10.0.0.0/16"),
+					VpcPeeringConnectionId: aws.String("pcx-1"),
+				})).Return(&ec2.CreateRouteOutput{}, nil)
+			},
+			expectedStatus: []infrav1.VPCPeering{
+				{ID: "pcx-1", PeerVPCID: "vpc-shared", PeerRouteTableIDs: []string{"rtb-shared"}, State: string(types.VpcPeeringConnectionStateReasonCodeActive)},
+			},
+		},
+		{
+			name: "peering connection to another account without role waits for acceptance",
+			peerings: []infrav1.VPCPeeringSpec{
+				{
+					PeerVPCID:       "vpc-shared",
+					PeerOwnerID:     "123456789012",
+					PeerRegion:      "eu-west-1",
+					RouteCidrBlocks: []string{"10.100.0.0/16"},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcPeeringConnections(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVpcPeeringConnectionsOutput{}, nil)
+				m.CreateVpcPeeringConnection(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcPeeringConnectionInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateVpcPeeringConnectionInput, _ ...func(*ec2.Options)) (*ec2.CreateVpcPeeringConnectionOutput, error) {
+						if aws.ToString(input.PeerOwnerId) != "123456789012" || aws.ToString(input.PeerRegion) != "eu-west-1" {
+							t.Fatalf("unexpected create vpc peering connection input %+v", input)
+						}
+						return &ec2.CreateVpcPeeringConnectionOutput{
+							VpcPeeringConnection: pcx("pcx-1", "vpc-shared", types.VpcPeeringConnectionStateReasonCodePendingAcceptance),
+						}, nil
+					})
+			},
+			expectedStatus: []infrav1.VPCPeering{
+				{ID: "pcx-1", PeerVPCID: "vpc-shared", PeerOwnerID: "123456789012", PeerRegion: "eu-west-1", State: string(types.VpcPeeringConnectionStateReasonCodePendingAcceptance)},
+			},
+		},
+		{
+			name: "peering connections removed from the spec are deleted together with their peer routes",
+			status: []infrav1.VPCPeering{
+				{ID: "pcx-1", PeerVPCID: "vpc-shared", PeerRouteTableIDs: []string{"rtb-shared"}, State: string(types.VpcPeeringConnectionStateReasonCodeActive)},
+			},
+			expectPeer: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(context.TODO(), gomock.Eq(&ec2.DescribeRouteTablesInput{
+					RouteTableIds: []string{"rtb-shared"},
+				})).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: []types.RouteTable{
+						{
+							RouteTableId: aws.String("rtb-shared"),
+							Routes: []types.Route{
+								{DestinationCidrBlock: aws.String("10.100.0.0/16"), GatewayId: aws.String("local")},
+								{DestinationCidrBlock: aws.String("10.0.0.0/16"), VpcPeeringConnectionId: aws.String("pcx-1")},
+							},
+						},
+					},
+				}, nil)
+				m.DeleteRoute(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+					RouteTableId:         aws.String("rtb-shared"),
+					DestinationCidrBlock: aws.String("10.0.0.0/16"),
+				})).Return(&ec2.DeleteRouteOutput{}, nil)
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcPeeringConnections(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVpcPeeringConnectionsOutput{
+						VpcPeeringConnections: []types.VpcPeeringConnection{
+							*pcx("pcx-0", "vpc-shared", types.VpcPeeringConnectionStateReasonCodeDeleted),
+							*pcx("pcx-1", "vpc-shared", types.VpcPeeringConnectionStateReasonCodeActive),
+						},
+					}, nil)
+				m.DescribeRouteTables(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []types.RouteTable{
+							{
+								RouteTableId: aws.String("rtb-cluster"),
+								Routes: []types.Route{
+									{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local")},
+									{DestinationCidrBlock: aws.String("10.100.0.0/16"), VpcPeeringConnectionId: aws.String("pcx-1")},
+									{DestinationCidrBlock: aws.String("10.200.0.0/16"), VpcPeeringConnectionId: aws.String("pcx-other")},
+								},
+							},
+						},
+					}, nil)
+				m.DeleteRoute(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+					RouteTableId:         aws.String("rtb-cluster"),
+					DestinationCidrBlock: aws.String("10.100.0.0/16"),
+				})).Return(&ec2.DeleteRouteOutput{}, nil)
+				m.DeleteVpcPeeringConnection(context.TODO(), gomock.Eq(&ec2.DeleteVpcPeeringConnectionInput{
+					VpcPeeringConnectionId: aws.String("pcx-1"),
+				})).Return(&ec2.DeleteVpcPeeringConnectionOutput{}, nil)
+			},
+			expectedStatus: []infrav1.VPCPeering{},
+		},
+		{
+			name: "peer routes through another target are reported and left untouched",
+			peerings: []infrav1.VPCPeeringSpec{
+				{
+					PeerVPCID:         "vpc-shared",
+					RouteCidrBlocks:   []string{"10.100.0.0/16"},
+					PeerRouteTableIDs: []string{"rtb-shared"},
+				},
+			},
+			status: []infrav1.VPCPeering{
+				{ID: "pcx-1", PeerVPCID: "vpc-shared", PeerRouteTableIDs: []string{"rtb-shared"}, State: string(types.VpcPeeringConnectionStateReasonCodeActive)},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcPeeringConnections(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeVpcPeeringConnectionsOutput{
+						VpcPeeringConnections: []types.VpcPeeringConnection{
+							*pcx("pcx-1", "vpc-shared", types.VpcPeeringConnectionStateReasonCodeActive),
+						},
+					}, nil)
+			},
+			expectPeer: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(context.TODO(), gomock.Eq(&ec2.DescribeRouteTablesInput{
+					RouteTableIds: []string{"rtb-shared"},
+				})).Return(&ec2.DescribeRouteTablesOutput{
+					RouteTables: []types.RouteTable{
+						{
+							RouteTableId: aws.String("rtb-shared"),
+							Routes: []types.Route{
+								{DestinationCidrBlock: aws.String("10.0.0.0/16"), TransitGatewayId: aws.String("tgw-shared")},
+							},
+						},
+					},
+				}, nil)
+			},
+			expectedStatus: []infrav1.VPCPeering{
+				{ID: "pcx-1", PeerVPCID: "vpc-shared", PeerRouteTableIDs: []string{"rtb-shared"}, State: string(types.VpcPeeringConnectionStateReasonCodeActive)},
+			},
+			expectedConflicts: []string{"10.0.0.0/16 in rtb-shared"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			peerEC2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						Region: "us-east-1",
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								ID:        "vpc-cluster",
+								CidrBlock: "10.0.0.0/16",
+								Tags: infrav1.Tags{
+									infrav1.ClusterTagKey("test-cluster"): "owned",
+								},
+							},
+							VPCPeerings: tc.peerings,
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							VPCPeerings: tc.status,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+			if tc.expectPeer != nil {
+				tc.expectPeer(peerEC2Mock.EXPECT())
+			}
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+			s.PeerEC2Client = func(*infrav1.VPCPeeringSpec) common.EC2API {
+				return peerEC2Mock
+			}
+
+			conflicts, err := s.reconcileVPCPeerings()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scope.Network().VPCPeerings).To(Equal(tc.expectedStatus))
+			g.Expect(conflicts).To(Equal(tc.expectedConflicts))
+		})
+	}
+}
+
+func TestGetRoutesForSubnetWithVPCPeerings(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	err := infrav1.AddToScheme(scheme)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: infrav1.VPCSpec{
+						ID:                "vpc-cluster",
+						InternetGatewayID: aws.String("igw-cluster"),
+					},
+					VPCPeerings: []infrav1.VPCPeeringSpec{
+						{PeerVPCID: "vpc-shared", RouteCidrBlocks: []string{"10.100.0.0/16"}},
+						{PeerVPCID: "vpc-pending", RouteCidrBlocks: []string{"10.200.0.0/16"}},
+					},
+				},
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					VPCPeerings: []infrav1.VPCPeering{
+						{ID: "pcx-shared", PeerVPCID: "vpc-shared", State: string(types.VpcPeeringConnectionStateReasonCodeActive)},
+						{ID: "pcx-pending", PeerVPCID: "vpc-pending", State: string(types.VpcPeeringConnectionStateReasonCodePendingAcceptance)},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(scope)
+	routes, err := s.getRoutesForSubnet(&infrav1.SubnetSpec{
+		ID:               "subnet-public-a",
+		AvailabilityZone: "us-east-1a",
+		IsPublic:         true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(routes).To(Equal([]*ec2.CreateRouteInput{
+		{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-cluster")},
+		{DestinationCidrBlock: aws.String("10.100.0.0/16"), VpcPeeringConnectionId: aws.String("pcx-shared")},
+	}))
+}
+
+func TestPeerEC2ClientIsReused(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	err := infrav1.AddToScheme(scheme)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrav1.AWSClusterSpec{
+				Region: "us-east-1",
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(scope)
+	peering := &infrav1.VPCPeeringSpec{
+		PeerVPCID:   "vpc-shared",
+		PeerRegion:  "eu-west-1",
+		PeerRoleARN: "arn:aws:iam::123456789012:role/peering",
+	}
+	g.Expect(s.PeerEC2Client(peering)).To(BeIdenticalTo(s.PeerEC2Client(peering)))
+	g.Expect(s.PeerEC2Client(peering)).NotTo(BeIdenticalTo(s.PeerEC2Client(&infrav1.VPCPeeringSpec{PeerVPCID: "vpc-other"})))
+}
@@ -75,6 +75,11 @@ func (s *Service) reconcileRouteTables() error {
 			// For example, a gateway can be deleted and our controller will re-create it, then we replace the route
 			// for the subnet to allow traffic to flow.
 			for _, currentRoute := range rt.Routes {
+				// Routes through a transit gateway attachment that is being created or modified are kept as they are,
+				// the attachment doesn't report them until it is available again.
+				if s.isTransitGatewayRouteHeld(currentRoute) {
+					continue
+				}
 				for i := range routes {
 					// Routes destination cidr blocks must be unique within a routing table.
 					// If there is a mistmatch, we replace the routing association.
@@ -84,6 +89,12 @@ func (s *Service) reconcileRouteTables() error {
 				}
 			}
 
+			// Routes through the transit gateway and the peering connections follow the spec,
+			// so add the missing ones and drop the stale ones.
+			if err := s.reconcileAdditionalRoutes(routes, rt); err != nil {
+				return err
+			}
+
 			// Make sure tags are up-to-date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getRouteTableTagParams(aws.ToString(rt.RouteTableId), sn.IsPublic, sn.AvailabilityZone)
@@ -132,12 +143,16 @@ func (s *Service) fixMismatchedRouting(specRoute *ec2.CreateRouteInput, currentR
 		if (currentRoute.DestinationCidrBlock != nil &&
 			aws.ToString(currentRoute.DestinationCidrBlock) == aws.ToString(specRoute.DestinationCidrBlock)) &&
 			((currentRoute.GatewayId != nil && aws.ToString(currentRoute.GatewayId) != aws.ToString(specRoute.GatewayId)) ||
-				(currentRoute.NatGatewayId != nil && aws.ToString(currentRoute.NatGatewayId) != aws.ToString(specRoute.NatGatewayId))) {
+				(currentRoute.NatGatewayId != nil && aws.ToString(currentRoute.NatGatewayId) != aws.ToString(specRoute.NatGatewayId)) ||
+				(currentRoute.TransitGatewayId != nil && aws.ToString(currentRoute.TransitGatewayId) != aws.ToString(specRoute.TransitGatewayId)) ||
+				(currentRoute.VpcPeeringConnectionId != nil && aws.ToString(currentRoute.VpcPeeringConnectionId) != aws.ToString(specRoute.VpcPeeringConnectionId))) {
 			input = &ec2.ReplaceRouteInput{
-				RouteTableId:         rt.RouteTableId,
-				DestinationCidrBlock: specRoute.DestinationCidrBlock,
-				GatewayId:            specRoute.GatewayId,
-				NatGatewayId:         specRoute.NatGatewayId,
+				RouteTableId:           rt.RouteTableId,
+				DestinationCidrBlock:   specRoute.DestinationCidrBlock,
+				GatewayId:              specRoute.GatewayId,
+				NatGatewayId:           specRoute.NatGatewayId,
+				TransitGatewayId:       specRoute.TransitGatewayId,
+				VpcPeeringConnectionId: specRoute.VpcPeeringConnectionId,
 			}
 		}
 	}
@@ -171,6 +186,111 @@ func (s *Service) fixMismatchedRouting(specRoute *ec2.CreateRouteInput, currentR
 	return nil
 }
 
+// reconcileAdditionalRoutes creates the transit gateway and VPC peering routes missing from an existing route table
+// and deletes the ones whose destination is not expected anymore. Only routes through the transit gateway and the
+// peering connections recorded in the status are deleted, routes added out of band are left alone.
+func (s *Service) reconcileAdditionalRoutes(routes []*ec2.CreateRouteInput, rt types.RouteTable) error {
+	current := map[string]types.Route{}
+	for _, route := range rt.Routes {
+		if route.DestinationCidrBlock != nil {
+			current[aws.ToString(route.DestinationCidrBlock)] = route
+		}
+	}
+
+	wanted := map[string]struct{}{}
+	for _, route := range routes {
+		if route.DestinationCidrBlock == nil {
+			continue
+		}
+		destination := aws.ToString(route.DestinationCidrBlock)
+		wanted[destination] = struct{}{}
+		if _, ok := current[destination]; ok || !isAdditionalRoute(route.TransitGatewayId, route.VpcPeeringConnectionId) {
+			continue
+		}
+
+		input := *route
+		input.RouteTableId = rt.RouteTableId
+		if _, err := s.EC2Client.CreateRoute(context.TODO(), &input); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateRoute", "Failed to create route %s for RouteTable %q: %v", &input, aws.ToString(rt.RouteTableId), err)
+			return errors.Wrapf(err, "failed to create route in route table %q: %v", aws.ToString(rt.RouteTableId), &input)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateRoute", "Created route %s for RouteTable %q", &input, aws.ToString(rt.RouteTableId))
+	}
+
+	for destination, route := range current {
+		if _, ok := wanted[destination]; ok || !s.isManagedAdditionalRoute(route) {
+			continue
+		}
+		if s.isTransitGatewayRouteHeld(route) {
+			continue
+		}
+
+		if err := s.deleteRoute(rt, route); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteRoutesThrough removes the routes through a transit gateway or a VPC peering connection that is about to be
+// removed from the cluster route tables. Default routes are left to reconcileRouteTables, which points them back
+// to the NAT or internet gateway.
+func (s *Service) deleteRoutesThrough(transitGatewayID, vpcPeeringConnectionID string) error {
+	rts, err := s.describeVpcRouteTables()
+	if err != nil {
+		return err
+	}
+
+	for _, rt := range rts {
+		for _, route := range rt.Routes {
+			if route.DestinationCidrBlock == nil || aws.ToString(route.DestinationCidrBlock) == services.AnyIPv4CidrBlock {
+				continue
+			}
+			if (transitGatewayID == "" || aws.ToString(route.TransitGatewayId) != transitGatewayID) &&
+				(vpcPeeringConnectionID == "" || aws.ToString(route.VpcPeeringConnectionId) != vpcPeeringConnectionID) {
+				continue
+			}
+			if err := s.deleteRoute(rt, route); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Service) deleteRoute(rt types.RouteTable, route types.Route) error {
+	destination := aws.ToString(route.DestinationCidrBlock)
+	if _, err := s.EC2Client.DeleteRoute(context.TODO(), &ec2.DeleteRouteInput{
+		RouteTableId:         rt.RouteTableId,
+		DestinationCidrBlock: route.DestinationCidrBlock,
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteRoute", "Failed to delete route to %q from RouteTable %q: %v", destination, aws.ToString(rt.RouteTableId), err)
+		return errors.Wrapf(err, "failed to delete route to %q from route table %q", destination, aws.ToString(rt.RouteTableId))
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRoute", "Deleted route to %q from RouteTable %q", destination, aws.ToString(rt.RouteTableId))
+	return nil
+}
+
+// isAdditionalRoute returns true for the routes targeting a transit gateway or a VPC peering connection.
+func isAdditionalRoute(transitGatewayID, vpcPeeringConnectionID *string) bool {
+	return transitGatewayID != nil || vpcPeeringConnectionID != nil
+}
+
+// isManagedAdditionalRoute returns true for the routes through the transit gateway attachment or the VPC peering
+// connections created by CAPA, as recorded in the status.
+func (s *Service) isManagedAdditionalRoute(route types.Route) bool {
+	if attachment := s.scope.Network().TransitGatewayAttachment; attachment != nil && route.TransitGatewayId != nil &&
+		aws.ToString(route.TransitGatewayId) == attachment.TransitGatewayID {
+		return true
+	}
+	for _, peering := range s.scope.Network().VPCPeerings {
+		if route.VpcPeeringConnectionId != nil && aws.ToString(route.VpcPeeringConnectionId) == peering.ID {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Service) describeVpcRouteTablesBySubnet() (map[string]types.RouteTable, error) {
 	rts, err := s.describeVpcRouteTables()
 	if err != nil {
@@ -421,7 +541,18 @@ func (s *Service) getRoutesToPrivateSubnet(sn *infrav1.SubnetSpec) (routes []*ec
 		return routes, err
 	}
 
-	routes = append(routes, s.getNatGatewayPrivateRoute(natGatewayID))
+	// The transit gateway replaces the NAT gateway as default route when it is asked to carry all IPv4 traffic.
+	transitGatewayRoutes := s.getTransitGatewayRoutes()
+	defaultViaTransitGateway := false
+	for _, route := range transitGatewayRoutes {
+		if aws.ToString(route.DestinationCidrBlock) == services.AnyIPv4CidrBlock {
+			defaultViaTransitGateway = true
+		}
+	}
+	if !defaultViaTransitGateway {
+		routes = append(routes, s.getNatGatewayPrivateRoute(natGatewayID))
+	}
+	routes = append(routes, transitGatewayRoutes...)
 	if sn.IsIPv6 {
 		// We add the NAT64 route only if DNS64 is enabled for the subnet
 		// That is when the subnet is private and IPv6-only.
@@ -441,8 +572,15 @@ func (s *Service) getRoutesToPrivateSubnet(sn *infrav1.SubnetSpec) (routes []*ec
 }
 
 func (s *Service) getRoutesForSubnet(sn *infrav1.SubnetSpec) ([]*ec2.CreateRouteInput, error) {
+	var routes []*ec2.CreateRouteInput
+	var err error
 	if sn.IsPublic {
-		return s.getRoutesToPublicSubnet(sn)
+		routes, err = s.getRoutesToPublicSubnet(sn)
+	} else {
+		routes, err = s.getRoutesToPrivateSubnet(sn)
+	}
+	if err != nil {
+		return routes, err
 	}
-	return s.getRoutesToPrivateSubnet(sn)
+	return append(routes, s.getVPCPeeringRoutes()...), nil
 }
@@ -18,8 +18,10 @@ limitations under the License.
 package network
 
 import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth"
 )
 
 // Service holds a collection of interfaces.
@@ -28,12 +30,28 @@ import (
 type Service struct {
 	scope     scope.NetworkScope
 	EC2Client common.EC2API
+	// PeerEC2Client returns the ec2 api client used to manage the peer side of a VPC peering connection.
+	PeerEC2Client func(peering *infrav1.VPCPeeringSpec) common.EC2API
+	IAMClient     iamauth.IAMAPI
 }
 
 // NewService returns a new service given the ec2 api client.
 func NewService(networkScope scope.NetworkScope) *Service {
+	// The service lives for a single reconciliation, so the clients of the peer accounts are kept
+	// to assume each peer role only once.
+	peerEC2Clients := map[peerSessionKey]common.EC2API{}
 	return &Service{
 		scope:     networkScope,
 		EC2Client: scope.NewEC2Client(networkScope, networkScope, networkScope, networkScope.InfraCluster()),
+		PeerEC2Client: func(peering *infrav1.VPCPeeringSpec) common.EC2API {
+			key := peerSessionKey{region: peering.PeerRegion, roleARN: peering.PeerRoleARN}
+			if client, ok := peerEC2Clients[key]; ok {
+				return client
+			}
+			client := scope.NewEC2Client(networkScope, newPeerSession(networkScope, peering), networkScope, networkScope.InfraCluster())
+			peerEC2Clients[key] = client
+			return client
+		},
+		IAMClient: scope.NewIAMClient(networkScope, networkScope, networkScope, networkScope.InfraCluster()),
 	}
 }
@@ -800,3 +800,44 @@ func (s *Service) getSubnetTagParams(unmanagedVPC bool, id string, public bool,
 		Additional: additionalTags,
 	}
 }
+
+// resolveSubnetIDs translates subnet IDs referencing the cluster subnets spec into AWS subnet IDs.
+// IDs that are not part of the cluster subnets are returned as they are.
+func (s *Service) resolveSubnetIDs(ids []string) []string {
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if subnet := s.scope.Subnets().FindByID(id); subnet != nil {
+			res = append(res, subnet.GetResourceID())
+			continue
+		}
+		res = append(res, id)
+	}
+	return res
+}
+
+// getPrivateSubnetIDsPerZone returns the AWS ID of one private subnet per availability zone, ordered by zone.
+// It is used to place the network interfaces of resources that accept a single subnet per zone.
+func (s *Service) getPrivateSubnetIDsPerZone() []string {
+	byZone := map[string]string{}
+	for _, subnet := range s.scope.Subnets().FilterPrivate().FilterNonCni() {
+		if subnet.IsEdge() || subnet.GetResourceID() == "" {
+			continue
+		}
+		if _, ok := byZone[subnet.AvailabilityZone]; ok {
+			continue
+		}
+		byZone[subnet.AvailabilityZone] = subnet.GetResourceID()
+	}
+
+	zones := make([]string, 0, len(byZone))
+	for zone := range byZone {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+
+	ids := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		ids = append(ids, byZone[zone])
+	}
+	return ids
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// reconcileTransitGatewayAttachment attaches the managed VPC to the transit gateway declared in the VPC spec
+// and keeps the attachment subnets and options up to date. An attachment that is no longer declared is removed.
+// For more information, see: https://docs.aws.amazon.com/vpc/latest/tgw/tgw-vpc-attachments.html
+func (s *Service) reconcileTransitGatewayAttachment() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping transit gateway attachment reconcile in unmanaged mode")
+		return nil
+	}
+
+	spec := s.scope.VPC().TransitGateway
+	if spec == nil {
+		if attachment := s.scope.Network().TransitGatewayAttachment; attachment != nil {
+			if err := s.deleteRoutesThrough(attachment.TransitGatewayID, ""); err != nil {
+				return err
+			}
+		}
+		return s.deleteTransitGatewayAttachment()
+	}
+
+	s.scope.Debug("Reconciling transit gateway attachment", "transit-gateway-id", spec.ID)
+
+	attachment, err := s.describeTransitGatewayAttachment()
+	if err != nil {
+		return err
+	}
+
+	// The attachment of a VPC can't be moved to another transit gateway, replace it.
+	if attachment != nil && aws.ToString(attachment.TransitGatewayId) != spec.ID {
+		if err := s.deleteRoutesThrough(aws.ToString(attachment.TransitGatewayId), ""); err != nil {
+			return err
+		}
+		if err := s.deleteTransitGatewayAttachmentByID(aws.ToString(attachment.TransitGatewayAttachmentId)); err != nil {
+			return err
+		}
+		attachment = nil
+	}
+
+	subnetIDs := s.resolveSubnetIDs(spec.SubnetIDs)
+	if len(subnetIDs) == 0 {
+		subnetIDs = s.getPrivateSubnetIDsPerZone()
+	}
+	if len(subnetIDs) == 0 {
+		return errors.Errorf("no private subnets available to attach vpc %q to transit gateway %q", s.scope.VPC().ID, spec.ID)
+	}
+
+	if attachment == nil {
+		attachment, err = s.createTransitGatewayAttachment(spec, subnetIDs)
+		if err != nil {
+			return err
+		}
+	} else if err := s.updateTransitGatewayAttachment(attachment, spec, subnetIDs); err != nil {
+		return err
+	}
+
+	s.scope.Network().TransitGatewayAttachment = &infrav1.TransitGatewayAttachment{
+		ID:               aws.ToString(attachment.TransitGatewayAttachmentId),
+		TransitGatewayID: aws.ToString(attachment.TransitGatewayId),
+		State:            string(attachment.State),
+	}
+	return nil
+}
+
+// isTransitGatewayAttachmentAvailable returns true when the VPC attachment recorded in the status can route traffic.
+func (s *Service) isTransitGatewayAttachmentAvailable() bool {
+	attachment := s.scope.Network().TransitGatewayAttachment
+	return attachment != nil && attachment.State == string(types.TransitGatewayAttachmentStateAvailable)
+}
+
+// isTransitGatewayRouteHeld returns true when the route goes through the transit gateway of an attachment
+// that is being created or modified. Such routes must neither be replaced nor deleted until the attachment settles.
+func (s *Service) isTransitGatewayRouteHeld(route types.Route) bool {
+	attachment := s.scope.Network().TransitGatewayAttachment
+	return attachment.IsTransitional() && aws.ToString(route.TransitGatewayId) == attachment.TransitGatewayID
+}
+
+func (s *Service) describeTransitGatewayAttachment() (*types.TransitGatewayVpcAttachment, error) {
+	out, err := s.EC2Client.DescribeTransitGatewayVpcAttachments(context.TODO(), &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{s.scope.VPC().ID},
+			},
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	})
+	if err != nil {
+		record.Eventf(s.scope.InfraCluster(), "FailedDescribeTransitGatewayAttachment", "Failed to describe transit gateway attachments in vpc %q: %v", s.scope.VPC().ID, err)
+		return nil, errors.Wrapf(err, "failed to describe transit gateway attachments in vpc %q", s.scope.VPC().ID)
+	}
+
+	for i := range out.TransitGatewayVpcAttachments {
+		attachment := out.TransitGatewayVpcAttachments[i]
+		switch attachment.State {
+		case types.TransitGatewayAttachmentStateDeleting, types.TransitGatewayAttachmentStateDeleted,
+			types.TransitGatewayAttachmentStateFailed, types.TransitGatewayAttachmentStateFailing,
+			types.TransitGatewayAttachmentStateRejected, types.TransitGatewayAttachmentStateRejecting:
+			continue
+		}
+		return &attachment, nil
+	}
+	return nil, nil
+}
+
+func (s *Service) createTransitGatewayAttachment(spec *infrav1.TransitGatewaySpec, subnetIDs []string) (*types.TransitGatewayVpcAttachment, error) {
+	out, err := s.EC2Client.CreateTransitGatewayVpcAttachment(context.TODO(), &ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId: aws.String(spec.ID),
+		VpcId:            aws.String(s.scope.VPC().ID),
+		SubnetIds:        subnetIDs,
+		Options: &types.CreateTransitGatewayVpcAttachmentRequestOptions{
+			ApplianceModeSupport: applianceModeSupportValue(spec.ApplianceModeSupport),
+		},
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeTransitGatewayAttachment, s.getTransitGatewayAttachmentTagParams()),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateTransitGatewayAttachment", "Failed to attach VPC %q to transit gateway %q: %v", s.scope.VPC().ID, spec.ID, err)
+		return nil, errors.Wrapf(err, "failed to attach vpc %q to transit gateway %q", s.scope.VPC().ID, spec.ID)
+	}
+	if out.TransitGatewayVpcAttachment == nil {
+		return nil, errors.Errorf("failed to attach vpc %q to transit gateway %q: empty response", s.scope.VPC().ID, spec.ID)
+	}
+
+	attachmentID := aws.ToString(out.TransitGatewayVpcAttachment.TransitGatewayAttachmentId)
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateTransitGatewayAttachment", "Created transit gateway attachment %q to transit gateway %q", attachmentID, spec.ID)
+	s.scope.Info("Created transit gateway attachment", "transit-gateway-attachment-id", attachmentID, "transit-gateway-id", spec.ID)
+	return out.TransitGatewayVpcAttachment, nil
+}
+
+func (s *Service) updateTransitGatewayAttachment(attachment *types.TransitGatewayVpcAttachment, spec *infrav1.TransitGatewaySpec, subnetIDs []string) error {
+	// Only available attachments can be modified, the next reconciliation picks up the changes.
+	if attachment.State != types.TransitGatewayAttachmentStateAvailable {
+		return nil
+	}
+
+	modify := &ec2.ModifyTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId,
+	}
+	changed := false
+
+	currentSubnets := sets.New(attachment.SubnetIds...)
+	wantedSubnets := sets.New(subnetIDs...)
+	if additions := wantedSubnets.Difference(currentSubnets); additions.Len() > 0 {
+		modify.AddSubnetIds = sets.List(additions)
+		changed = true
+	}
+	if removals := currentSubnets.Difference(wantedSubnets); removals.Len() > 0 {
+		modify.RemoveSubnetIds = sets.List(removals)
+		changed = true
+	}
+
+	current := types.ApplianceModeSupportValueDisable
+	if attachment.Options != nil && attachment.Options.ApplianceModeSupport != "" {
+		current = attachment.Options.ApplianceModeSupport
+	}
+	if wanted := applianceModeSupportValue(spec.ApplianceModeSupport); current != wanted {
+		modify.Options = &types.ModifyTransitGatewayVpcAttachmentRequestOptions{
+			ApplianceModeSupport: wanted,
+		}
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	attachmentID := aws.ToString(attachment.TransitGatewayAttachmentId)
+	out, err := s.EC2Client.ModifyTransitGatewayVpcAttachment(context.TODO(), modify)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedModifyTransitGatewayAttachment", "Failed to modify transit gateway attachment %q: %v", attachmentID, err)
+		return errors.Wrapf(err, "failed to modify transit gateway attachment %q", attachmentID)
+	}
+	if out.TransitGatewayVpcAttachment != nil {
+		attachment.State = out.TransitGatewayVpcAttachment.State
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulModifyTransitGatewayAttachment", "Modified transit gateway attachment %q", attachmentID)
+	return nil
+}
+
+// deleteTransitGatewayAttachment removes the attachment recorded in the status, if any.
+func (s *Service) deleteTransitGatewayAttachment() error {
+	attachment := s.scope.Network().TransitGatewayAttachment
+	if attachment == nil {
+		return nil
+	}
+
+	if err := s.deleteTransitGatewayAttachmentByID(attachment.ID); err != nil {
+		return err
+	}
+	s.scope.Network().TransitGatewayAttachment = nil
+	return nil
+}
+
+func (s *Service) deleteTransitGatewayAttachmentByID(id string) error {
+	if _, err := s.EC2Client.DeleteTransitGatewayVpcAttachment(context.TODO(), &ec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(id),
+	}); err != nil {
+		if code, ok := awserrors.Code(err); ok && code == awserrors.TransitGatewayAttachmentNotFound {
+			return nil
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteTransitGatewayAttachment", "Failed to delete transit gateway attachment %q: %v", id, err)
+		return errors.Wrapf(err, "failed to delete transit gateway attachment %q", id)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteTransitGatewayAttachment", "Deleted transit gateway attachment %q", id)
+	s.scope.Info("Deleted transit gateway attachment", "transit-gateway-attachment-id", id)
+	return nil
+}
+
+// getTransitGatewayRoutes returns the routes of the private route tables that go through the transit gateway.
+// Routes are only returned once the attachment is available, as AWS rejects them before. Routes created earlier
+// are kept while the attachment is modified, see isTransitGatewayRouteHeld.
+func (s *Service) getTransitGatewayRoutes() []*ec2.CreateRouteInput {
+	spec := s.scope.VPC().TransitGateway
+	if spec == nil || !s.isTransitGatewayAttachmentAvailable() {
+		return nil
+	}
+
+	routes := make([]*ec2.CreateRouteInput, 0, len(spec.RouteCidrBlocks))
+	for _, cidrBlock := range spec.RouteCidrBlocks {
+		routes = append(routes, &ec2.CreateRouteInput{
+			DestinationCidrBlock: aws.String(cidrBlock),
+			TransitGatewayId:     aws.String(spec.ID),
+		})
+	}
+	return routes
+}
+
+func (s *Service) getTransitGatewayAttachmentTagParams() infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  services.TemporaryResourceID,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-tgw-attachment", s.scope.Name())),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+func applianceModeSupportValue(enabled bool) types.ApplianceModeSupportValue {
+	if enabled {
+		return types.ApplianceModeSupportValueEnable
+	}
+	return types.ApplianceModeSupportValueDisable
+}
@@ -0,0 +1,540 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileTransitGatewayAttachment(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	managedVPC := func(transitGateway *infrav1.TransitGatewaySpec) infrav1.NetworkSpec {
+		return infrav1.NetworkSpec{
+			VPC: infrav1.VPCSpec{
+				ID:        "vpc-tgw",
+				CidrBlock: "10.0.0.0/16",
+				Tags: infrav1.Tags{
+					infrav1.ClusterTagKey("test-cluster"): "owned",
+				},
+				TransitGateway: transitGateway,
+			},
+			Subnets: infrav1.Subnets{
+				{
+					ID:               "subnet-private-a",
+					AvailabilityZone: "us-east-1a",
+					IsPublic:         false,
+				},
+				{
+					ID:               "subnet-private-b",
+					AvailabilityZone: "us-east-1b",
+					IsPublic:         false,
+				},
+				{
+					ID:               "subnet-public-a",
+					AvailabilityZone: "us-east-1a",
+					IsPublic:         true,
+				},
+			},
+		}
+	}
+
+	describeInput := &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{"vpc-tgw"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: []string{"owned"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		input          infrav1.NetworkSpec
+		status         *infrav1.TransitGatewayAttachment
+		expect         func(m *mocks.MockEC2APIMockRecorder)
+		expectedStatus *infrav1.TransitGatewayAttachment
+		wantErr        bool
+	}{
+		{
+			name:   "no transit gateway declared or recorded, does nothing",
+			input:  managedVPC(nil),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:  "creates the attachment in one private subnet per zone",
+			input: managedVPC(&infrav1.TransitGatewaySpec{ID: "tgw-hub", ApplianceModeSupport: true}),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachments(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{}, nil)
+				m.CreateTransitGatewayVpcAttachment(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateTransitGatewayVpcAttachmentInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateTransitGatewayVpcAttachmentInput, _ ...func(*ec2.Options)) (*ec2.CreateTransitGatewayVpcAttachmentOutput, error) {
+						if aws.ToString(input.TransitGatewayId) != "tgw-hub" || aws.ToString(input.VpcId) != "vpc-tgw" ||
+							input.Options.ApplianceModeSupport != types.ApplianceModeSupportValueEnable {
+							t.Fatalf("unexpected create transit gateway attachment input %+v", input)
+						}
+						if len(input.SubnetIds) != 2 || input.SubnetIds[0] != "subnet-private-a" || input.SubnetIds[1] != "subnet-private-b" {
+							t.Fatalf("unexpected attachment subnets %v", input.SubnetIds)
+						}
+						if input.TagSpecifications[0].ResourceType != types.ResourceTypeTransitGatewayAttachment {
+							t.Fatalf("unexpected tag specification %+v", input.TagSpecifications)
+						}
+						return &ec2.CreateTransitGatewayVpcAttachmentOutput{
+							TransitGatewayVpcAttachment: &types.TransitGatewayVpcAttachment{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           input.TransitGatewayId,
+								State:                      types.TransitGatewayAttachmentStatePending,
+							},
+						}, nil
+					})
+			},
+			expectedStatus: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-hub",
+				State:            string(types.TransitGatewayAttachmentStatePending),
+			},
+		},
+		{
+			name:  "updates the subnets and options of a drifted attachment",
+			input: managedVPC(&infrav1.TransitGatewaySpec{ID: "tgw-hub", SubnetIDs: []string{"subnet-private-b"}}),
+			status: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-hub",
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachments(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+						TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+							{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-old"),
+								TransitGatewayId:           aws.String("tgw-hub"),
+								State:                      types.TransitGatewayAttachmentStateDeleted,
+							},
+							{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           aws.String("tgw-hub"),
+								State:                      types.TransitGatewayAttachmentStateAvailable,
+								SubnetIds:                  []string{"subnet-private-a"},
+								Options: &types.TransitGatewayVpcAttachmentOptions{
+									ApplianceModeSupport: types.ApplianceModeSupportValueEnable,
+								},
+							},
+						},
+					}, nil)
+				m.ModifyTransitGatewayVpcAttachment(context.TODO(), gomock.Eq(&ec2.ModifyTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+					AddSubnetIds:               []string{"subnet-private-b"},
+					RemoveSubnetIds:            []string{"subnet-private-a"},
+					Options: &types.ModifyTransitGatewayVpcAttachmentRequestOptions{
+						ApplianceModeSupport: types.ApplianceModeSupportValueDisable,
+					},
+				})).Return(&ec2.ModifyTransitGatewayVpcAttachmentOutput{
+					TransitGatewayVpcAttachment: &types.TransitGatewayVpcAttachment{
+						State: types.TransitGatewayAttachmentStateModifying,
+					},
+				}, nil)
+			},
+			expectedStatus: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-hub",
+				State:            string(types.TransitGatewayAttachmentStateModifying),
+			},
+		},
+		{
+			name:  "replaces the attachment when the transit gateway changes",
+			input: managedVPC(&infrav1.TransitGatewaySpec{ID: "tgw-new"}),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachments(context.TODO(), gomock.Eq(describeInput)).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+						TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+							{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           aws.String("tgw-hub"),
+								State:                      types.TransitGatewayAttachmentStateAvailable,
+							},
+						},
+					}, nil)
+				m.DescribeRouteTables(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []types.RouteTable{
+							{
+								RouteTableId: aws.String("rtb-private"),
+								Routes: []types.Route{
+									{DestinationCidrBlock: aws.String("10.100.0.0/16"), TransitGatewayId: aws.String("tgw-hub")},
+								},
+							},
+						},
+					}, nil)
+				m.DeleteRoute(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+					RouteTableId:         aws.String("rtb-private"),
+					DestinationCidrBlock: aws.String("10.100.0.0/16"),
+				})).Return(&ec2.DeleteRouteOutput{}, nil)
+				m.DeleteTransitGatewayVpcAttachment(context.TODO(), gomock.Eq(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+				})).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil)
+				m.CreateTransitGatewayVpcAttachment(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateTransitGatewayVpcAttachmentInput{})).
+					Return(&ec2.CreateTransitGatewayVpcAttachmentOutput{
+						TransitGatewayVpcAttachment: &types.TransitGatewayVpcAttachment{
+							TransitGatewayAttachmentId: aws.String("tgw-attach-2"),
+							TransitGatewayId:           aws.String("tgw-new"),
+							State:                      types.TransitGatewayAttachmentStatePendingAcceptance,
+						},
+					}, nil)
+			},
+			expectedStatus: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-2",
+				TransitGatewayID: "tgw-new",
+				State:            string(types.TransitGatewayAttachmentStatePendingAcceptance),
+			},
+		},
+		{
+			name:  "attachment removed from the spec is deleted together with the routes through the transit gateway",
+			input: managedVPC(nil),
+			status: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-hub",
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []types.RouteTable{
+							{
+								RouteTableId: aws.String("rtb-private"),
+								Routes: []types.Route{
+									// The default route is pointed back to the NAT gateway by the route tables reconciliation.
+									{DestinationCidrBlock: aws.String("0.0.0.0/0"), TransitGatewayId: aws.String("tgw-hub")},
+									{DestinationCidrBlock: aws.String("10.100.0.0/16"), TransitGatewayId: aws.String("tgw-hub")},
+									{DestinationCidrBlock: aws.String("10.200.0.0/16"), TransitGatewayId: aws.String("tgw-other")},
+								},
+							},
+						},
+					}, nil)
+				m.DeleteRoute(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+					RouteTableId:         aws.String("rtb-private"),
+					DestinationCidrBlock: aws.String("10.100.0.0/16"),
+				})).Return(&ec2.DeleteRouteOutput{}, nil)
+				m.DeleteTransitGatewayVpcAttachment(context.TODO(), gomock.Eq(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+				})).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						Region:      "us-east-1",
+						NetworkSpec: tc.input,
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							TransitGatewayAttachment: tc.status,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileTransitGatewayAttachment()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scope.Network().TransitGatewayAttachment).To(Equal(tc.expectedStatus))
+		})
+	}
+}
+
+func TestGetRoutesToPrivateSubnetWithTransitGateway(t *testing.T) {
+	testCases := []struct {
+		name           string
+		routeCidrs     []string
+		state          types.TransitGatewayAttachmentState
+		expectedRoutes []*ec2.CreateRouteInput
+	}{
+		{
+			name:       "routes are not added until the attachment is available",
+			routeCidrs: []string{"10.100.0.0/16"},
+			state:      types.TransitGatewayAttachmentStatePending,
+			expectedRoutes: []*ec2.CreateRouteInput{
+				{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-a")},
+			},
+		},
+		{
+			name:       "routes are added next to the nat gateway default route",
+			routeCidrs: []string{"10.100.0.0/16"},
+			state:      types.TransitGatewayAttachmentStateAvailable,
+			expectedRoutes: []*ec2.CreateRouteInput{
+				{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-a")},
+				{DestinationCidrBlock: aws.String("10.100.0.0/16"), TransitGatewayId: aws.String("tgw-hub")},
+			},
+		},
+		{
+			name:       "default route through the transit gateway replaces the nat gateway",
+			routeCidrs: []string{"0.0.0.0/0"},
+			state:      types.TransitGatewayAttachmentStateAvailable,
+			expectedRoutes: []*ec2.CreateRouteInput{
+				{DestinationCidrBlock: aws.String("0.0.0.0/0"), TransitGatewayId: aws.String("tgw-hub")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								ID: "vpc-tgw",
+								TransitGateway: &infrav1.TransitGatewaySpec{
+									ID:              "tgw-hub",
+									RouteCidrBlocks: tc.routeCidrs,
+								},
+							},
+							Subnets: infrav1.Subnets{
+								{
+									ID:               "subnet-public-a",
+									AvailabilityZone: "us-east-1a",
+									IsPublic:         true,
+									NatGatewayID:     aws.String("nat-a"),
+								},
+							},
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							TransitGatewayAttachment: &infrav1.TransitGatewayAttachment{
+								ID:               "tgw-attach-1",
+								TransitGatewayID: "tgw-hub",
+								State:            string(tc.state),
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(scope)
+			routes, err := s.getRoutesToPrivateSubnet(&infrav1.SubnetSpec{
+				ID:               "subnet-private-a",
+				AvailabilityZone: "us-east-1a",
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(routes).To(Equal(tc.expectedRoutes))
+		})
+	}
+}
+
+func TestReconcileRouteTablesWithTransitGateway(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	routeTableTags := func(name string) []types.Tag {
+		return []types.Tag{
+			{Key: aws.String("kubernetes.io/cluster/test-cluster"), Value: aws.String("owned")},
+			{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/role"), Value: aws.String("common")},
+			{Key: aws.String("Name"), Value: aws.String(name)},
+			{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+		}
+	}
+	describeRouteTables := func(m *mocks.MockEC2APIMockRecorder, privateRoutes ...types.Route) {
+		m.DescribeRouteTables(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+			Return(&ec2.DescribeRouteTablesOutput{
+				RouteTables: []types.RouteTable{
+					{
+						RouteTableId: aws.String("rtb-private"),
+						Associations: []types.RouteTableAssociation{{SubnetId: aws.String("subnet-private-a")}},
+						Routes:       privateRoutes,
+						Tags:         routeTableTags("test-cluster-rt-private-us-east-1a"),
+					},
+					{
+						RouteTableId: aws.String("rtb-public"),
+						Associations: []types.RouteTableAssociation{{SubnetId: aws.String("subnet-public-a")}},
+						Routes: []types.Route{
+							{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-1")},
+						},
+						Tags: routeTableTags("test-cluster-rt-public-us-east-1a"),
+					},
+				},
+			}, nil)
+	}
+
+	testCases := []struct {
+		name       string
+		routeCidrs []string
+		state      types.TransitGatewayAttachmentState
+		expect     func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name:       "routes are kept while the attachment is modified",
+			routeCidrs: []string{"0.0.0.0/0"},
+			state:      types.TransitGatewayAttachmentStateModifying,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeRouteTables(m,
+					types.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), TransitGatewayId: aws.String("tgw-hub")},
+					types.Route{DestinationCidrBlock: aws.String("10.200.0.0/16"), TransitGatewayId: aws.String("tgw-hub")},
+				)
+			},
+		},
+		{
+			name:       "routes through targets not created by CAPA are left alone",
+			routeCidrs: []string{"0.0.0.0/0"},
+			state:      types.TransitGatewayAttachmentStateAvailable,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeRouteTables(m,
+					types.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), TransitGatewayId: aws.String("tgw-hub")},
+					types.Route{DestinationCidrBlock: aws.String("10.50.0.0/16"), TransitGatewayId: aws.String("tgw-other")},
+					types.Route{DestinationCidrBlock: aws.String("10.60.0.0/16"), VpcPeeringConnectionId: aws.String("pcx-other")},
+				)
+			},
+		},
+		{
+			name:       "routes removed from the spec are deleted once the attachment is available",
+			routeCidrs: []string{"0.0.0.0/0"},
+			state:      types.TransitGatewayAttachmentStateAvailable,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				describeRouteTables(m,
+					types.Route{DestinationCidrBlock: aws.String("0.0.0.0/0"), TransitGatewayId: aws.String("tgw-hub")},
+					types.Route{DestinationCidrBlock: aws.String("10.200.0.0/16"), TransitGatewayId: aws.String("tgw-hub")},
+				)
+				m.DeleteRoute(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+					RouteTableId:         aws.String("rtb-private"),
+					DestinationCidrBlock: aws.String("10.200.0.0/16"),
+				})).Return(&ec2.DeleteRouteOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								ID:                "vpc-tgw",
+								InternetGatewayID: aws.String("igw-1"),
+								Tags: infrav1.Tags{
+									infrav1.ClusterTagKey("test-cluster"): "owned",
+								},
+								TransitGateway: &infrav1.TransitGatewaySpec{
+									ID:              "tgw-hub",
+									RouteCidrBlocks: tc.routeCidrs,
+								},
+							},
+							Subnets: infrav1.Subnets{
+								{
+									ID:               "subnet-private-a",
+									AvailabilityZone: "us-east-1a",
+								},
+								{
+									ID:               "subnet-public-a",
+									AvailabilityZone: "us-east-1a",
+									IsPublic:         true,
+									NatGatewayID:     aws.String("nat-a"),
+								},
+							},
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							TransitGatewayAttachment: &infrav1.TransitGatewayAttachment{
+								ID:               "tgw-attach-1",
+								TransitGatewayID: "tgw-hub",
+								State:            string(tc.state),
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+			g.Expect(s.reconcileRouteTables()).To(Succeed())
+		})
+	}
+}
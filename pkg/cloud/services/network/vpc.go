@@ -191,15 +191,25 @@ func (s *Service) describeVPCEndpoints(filters ...types.Filter) ([]types.VpcEndp
 	return endpoints, nil
 }
 
-// reconcileVPCEndpoints registers the AWS endpoints for the services that need to be enabled
-// in the VPC routing tables. If the VPC is unmanaged, this is a no-op.
-// For more information, see: https://docs.aws.amazon.com/vpc/latest/privatelink/gateway-endpoints.html
+// reconcileVPCEndpoints registers the AWS gateway and interface endpoints for the services that need
+// to be enabled in the VPC. If the VPC is unmanaged, this is a no-op.
 func (s *Service) reconcileVPCEndpoints() error {
 	// If the VPC is unmanaged or not yet populated, return early.
 	if s.scope.VPC().IsUnmanaged(s.scope.Name()) || s.scope.VPC().ID == "" {
 		return nil
 	}
 
+	if err := s.reconcileGatewayVPCEndpoints(); err != nil {
+		return err
+	}
+
+	return s.reconcileInterfaceVPCEndpoints()
+}
+
+// reconcileGatewayVPCEndpoints registers the AWS gateway endpoints for the services that need to be enabled
+// in the VPC routing tables.
+// For more information, see: https://docs.aws.amazon.com/vpc/latest/privatelink/gateway-endpoints.html
+func (s *Service) reconcileGatewayVPCEndpoints() error {
 	// Gather all services that need to be enabled.
 	services := sets.New[string]()
 	if s.scope.Bucket() != nil {
@@ -303,20 +313,25 @@ func (s *Service) deleteVPCEndpoints() error {
 		if ep.VpcEndpointId == nil || *ep.VpcEndpointId == "" {
 			continue
 		}
+		if ep.State == types.StateDeleting || ep.State == types.StateDeleted {
+			continue
+		}
 		ids = append(ids, *ep.VpcEndpointId)
 	}
 
-	if len(ids) == 0 {
-		return nil
+	if len(ids) > 0 {
+		// Iterate over all services and delete endpoints.
+		if _, err := s.EC2Client.DeleteVpcEndpoints(context.TODO(), &ec2.DeleteVpcEndpointsInput{
+			VpcEndpointIds: ids,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to delete vpc endpoints %+v", ids)
+		}
 	}
+	s.scope.Network().VPCEndpoints = nil
 
-	// Iterate over all services and delete endpoints.
-	if _, err := s.EC2Client.DeleteVpcEndpoints(context.TODO(), &ec2.DeleteVpcEndpointsInput{
-		VpcEndpointIds: ids,
-	}); err != nil {
-		return errors.Wrapf(err, "failed to delete vpc endpoints %+v", ids)
-	}
-	return nil
+	// The interface endpoints security group is not part of the cluster security groups deletion,
+	// as it stays in use until the endpoints are gone.
+	return s.deleteVPCEndpointSecurityGroup()
 }
 
 func (s *Service) ensureManagedVPCAttributes(vpc *infrav1.VPCSpec) error {
@@ -0,0 +1,436 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	// vpcEndpointHTTPSPort is the port interface endpoints serve the AWS APIs on.
+	vpcEndpointHTTPSPort = 443
+)
+
+// reconcileInterfaceVPCEndpoints creates, updates and removes the interface VPC endpoints (AWS PrivateLink)
+// declared in the VPC spec, together with the security group attached to them.
+// For more information, see: https://docs.aws.amazon.com/vpc/latest/privatelink/create-interface-endpoint.html
+func (s *Service) reconcileInterfaceVPCEndpoints() error {
+	var desired []infrav1.InterfaceVPCEndpointSpec
+	if s.scope.VPC().Endpoints != nil {
+		desired = s.scope.VPC().Endpoints.Interfaces
+	}
+
+	// Nothing was ever requested, avoid describing the endpoints on every reconciliation.
+	if len(desired) == 0 && len(s.scope.Network().VPCEndpoints) == 0 {
+		return nil
+	}
+
+	existing, err := s.describeVPCEndpoints(
+		filter.EC2.ClusterOwned(s.scope.Name()),
+		types.Filter{
+			Name:   aws.String("vpc-endpoint-type"),
+			Values: []string{string(types.VpcEndpointTypeInterface)},
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to describe interface vpc endpoints")
+	}
+
+	existingByService := make(map[string]types.VpcEndpoint, len(existing))
+	for _, ep := range existing {
+		switch ep.State {
+		case types.StateDeleting, types.StateDeleted, types.StateFailed, types.StateRejected:
+			continue
+		}
+		existingByService[aws.ToString(ep.ServiceName)] = ep
+	}
+
+	status := make([]infrav1.VPCEndpoint, 0, len(desired))
+	wanted := sets.New[string]()
+
+	if len(desired) > 0 {
+		sgID, err := s.reconcileVPCEndpointSecurityGroup()
+		if err != nil {
+			return err
+		}
+
+		for i := range desired {
+			spec := &desired[i]
+			serviceName := spec.ServiceName(s.scope.Region())
+			wanted.Insert(serviceName)
+
+			subnetIDs, err := s.getVPCEndpointSubnetIDs(spec)
+			if err != nil {
+				return err
+			}
+			securityGroupIDs := append([]string{sgID}, spec.AdditionalSecurityGroupIDs...)
+
+			var ep *types.VpcEndpoint
+			if current, ok := existingByService[serviceName]; ok {
+				if err := s.updateInterfaceVPCEndpoint(&current, spec, subnetIDs, securityGroupIDs); err != nil {
+					return err
+				}
+				ep = &current
+			} else {
+				ep, err = s.createInterfaceVPCEndpoint(spec, subnetIDs, securityGroupIDs)
+				if err != nil {
+					return err
+				}
+			}
+
+			status = append(status, infrav1.VPCEndpoint{
+				ID:               aws.ToString(ep.VpcEndpointId),
+				ServiceName:      serviceName,
+				State:            string(ep.State),
+				SubnetIDs:        subnetIDs,
+				SecurityGroupIDs: securityGroupIDs,
+			})
+		}
+	}
+
+	// Remove the endpoints that are no longer part of the spec.
+	removals := []string{}
+	for serviceName, ep := range existingByService {
+		if !wanted.Has(serviceName) {
+			removals = append(removals, aws.ToString(ep.VpcEndpointId))
+		}
+	}
+	if len(removals) > 0 {
+		sort.Strings(removals)
+		if _, err := s.EC2Client.DeleteVpcEndpoints(context.TODO(), &ec2.DeleteVpcEndpointsInput{
+			VpcEndpointIds: removals,
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteVPCEndpoint", "Failed to delete interface VPC endpoints %v: %v", removals, err)
+			return errors.Wrapf(err, "failed to delete vpc endpoints %+v", removals)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteVPCEndpoint", "Deleted interface VPC endpoints %v", removals)
+	}
+
+	s.scope.Network().VPCEndpoints = status
+	return nil
+}
+
+func (s *Service) createInterfaceVPCEndpoint(spec *infrav1.InterfaceVPCEndpointSpec, subnetIDs, securityGroupIDs []string) (*types.VpcEndpoint, error) {
+	serviceName := spec.ServiceName(s.scope.Region())
+	out, err := s.EC2Client.CreateVpcEndpoint(context.TODO(), &ec2.CreateVpcEndpointInput{
+		VpcId:             aws.String(s.scope.VPC().ID),
+		ServiceName:       aws.String(serviceName),
+		VpcEndpointType:   types.VpcEndpointTypeInterface,
+		PrivateDnsEnabled: aws.Bool(spec.IsPrivateDNSEnabled()),
+		SubnetIds:         subnetIDs,
+		SecurityGroupIds:  securityGroupIDs,
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeVpcEndpoint, s.getVPCEndpointTagParams()),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateVPCEndpoint", "Failed to create interface VPC endpoint for service %q: %v", serviceName, err)
+		return nil, errors.Wrapf(err, "failed to create vpc endpoint for service %q", serviceName)
+	}
+	if out.VpcEndpoint == nil {
+		return nil, errors.Errorf("failed to create vpc endpoint for service %q: empty response", serviceName)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateVPCEndpoint", "Created interface VPC endpoint %q for service %q", aws.ToString(out.VpcEndpoint.VpcEndpointId), serviceName)
+	s.scope.Info("Created interface VPC endpoint", "vpc-endpoint-id", aws.ToString(out.VpcEndpoint.VpcEndpointId), "service", serviceName)
+	return out.VpcEndpoint, nil
+}
+
+func (s *Service) updateInterfaceVPCEndpoint(ep *types.VpcEndpoint, spec *infrav1.InterfaceVPCEndpointSpec, subnetIDs, securityGroupIDs []string) error {
+	modify := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: ep.VpcEndpointId,
+	}
+	changed := false
+
+	currentSubnets := sets.New(ep.SubnetIds...)
+	wantedSubnets := sets.New(subnetIDs...)
+	if additions := wantedSubnets.Difference(currentSubnets); additions.Len() > 0 {
+		modify.AddSubnetIds = sets.List(additions)
+		changed = true
+	}
+	if removals := currentSubnets.Difference(wantedSubnets); removals.Len() > 0 {
+		modify.RemoveSubnetIds = sets.List(removals)
+		changed = true
+	}
+
+	currentGroups := sets.New[string]()
+	for _, group := range ep.Groups {
+		currentGroups.Insert(aws.ToString(group.GroupId))
+	}
+	wantedGroups := sets.New(securityGroupIDs...)
+	if additions := wantedGroups.Difference(currentGroups); additions.Len() > 0 {
+		modify.AddSecurityGroupIds = sets.List(additions)
+		changed = true
+	}
+	if removals := currentGroups.Difference(wantedGroups); removals.Len() > 0 {
+		modify.RemoveSecurityGroupIds = sets.List(removals)
+		changed = true
+	}
+
+	if aws.ToBool(ep.PrivateDnsEnabled) != spec.IsPrivateDNSEnabled() {
+		modify.PrivateDnsEnabled = aws.Bool(spec.IsPrivateDNSEnabled())
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if _, err := s.EC2Client.ModifyVpcEndpoint(context.TODO(), modify); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedModifyVPCEndpoint", "Failed to modify interface VPC endpoint %q: %v", aws.ToString(ep.VpcEndpointId), err)
+		return errors.Wrapf(err, "failed to modify vpc endpoint for service %q", aws.ToString(ep.ServiceName))
+	}
+	s.scope.Debug("Modified interface VPC endpoint", "vpc-endpoint-id", aws.ToString(ep.VpcEndpointId), "service", aws.ToString(ep.ServiceName))
+	return nil
+}
+
+// getVPCEndpointSubnetIDs returns the subnets an interface endpoint should be placed in. Subnets from the
+// endpoint spec are resolved against the cluster subnets, otherwise one private subnet per availability zone is used.
+func (s *Service) getVPCEndpointSubnetIDs(spec *infrav1.InterfaceVPCEndpointSpec) ([]string, error) {
+	if len(spec.SubnetIDs) > 0 {
+		return s.resolveSubnetIDs(spec.SubnetIDs), nil
+	}
+
+	ids := s.getPrivateSubnetIDsPerZone()
+	if len(ids) == 0 {
+		return nil, errors.Errorf("no private subnets available to place vpc endpoint for service %q", spec.Service)
+	}
+	return ids, nil
+}
+
+// reconcileVPCEndpointSecurityGroup makes sure the security group attached to the interface endpoints exists and
+// allows HTTPS from the VPC CIDR blocks. The group carries its own role tag so that it is left out of the cluster
+// security groups deletion, which runs before the network is deleted: the endpoint network interfaces keep using
+// the group until the endpoints are gone, so it is deleted together with them by deleteVPCEndpoints.
+func (s *Service) reconcileVPCEndpointSecurityGroup() (string, error) {
+	group, err := s.describeVPCEndpointSecurityGroup()
+	if err != nil {
+		return "", err
+	}
+	if group != nil {
+		groupID := aws.ToString(group.GroupId)
+		if err := s.reconcileVPCEndpointSecurityGroupIngress(groupID, group.IpPermissions); err != nil {
+			return "", err
+		}
+		return groupID, nil
+	}
+
+	name := s.getVPCEndpointSecurityGroupName()
+	params := s.getVPCEndpointSecurityGroupTagParams(name)
+	created, err := s.EC2Client.CreateSecurityGroup(context.TODO(), &ec2.CreateSecurityGroupInput{
+		VpcId:       aws.String(s.scope.VPC().ID),
+		GroupName:   aws.String(name),
+		Description: aws.String(fmt.Sprintf("Kubernetes cluster %s: vpc endpoints", s.scope.Name())),
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeSecurityGroup, params),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateSecurityGroup", "Failed to create VPC endpoint SecurityGroup: %v", err)
+		return "", errors.Wrapf(err, "failed to create security group %q in vpc %q", name, s.scope.VPC().ID)
+	}
+	groupID := aws.ToString(created.GroupId)
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateSecurityGroup", "Created VPC endpoint SecurityGroup %q", groupID)
+	s.scope.Info("Created VPC endpoint security group", "security-group", groupID)
+
+	if err := s.reconcileVPCEndpointSecurityGroupIngress(groupID, nil); err != nil {
+		return "", err
+	}
+	return groupID, nil
+}
+
+// reconcileVPCEndpointSecurityGroupIngress authorizes HTTPS from the current VPC CIDR blocks and revokes any other
+// ingress rule of the endpoint security group. New rules are authorized before stale ones are revoked, so that
+// the endpoints stay reachable while the VPC CIDR blocks change.
+func (s *Service) reconcileVPCEndpointSecurityGroupIngress(groupID string, current []types.IpPermission) error {
+	wantIPv4, wantIPv6 := s.getVPCEndpointIngressCidrs()
+
+	haveIPv4, haveIPv6 := sets.New[string](), sets.New[string]()
+	revoke := []types.IpPermission{}
+	for _, permission := range current {
+		if !isVPCEndpointHTTPSPermission(permission) {
+			revoke = append(revoke, permission)
+			continue
+		}
+		stale := types.IpPermission{
+			IpProtocol: permission.IpProtocol,
+			FromPort:   permission.FromPort,
+			ToPort:     permission.ToPort,
+		}
+		for _, r := range permission.IpRanges {
+			haveIPv4.Insert(aws.ToString(r.CidrIp))
+			if !wantIPv4.Has(aws.ToString(r.CidrIp)) {
+				stale.IpRanges = append(stale.IpRanges, types.IpRange{CidrIp: r.CidrIp})
+			}
+		}
+		for _, r := range permission.Ipv6Ranges {
+			haveIPv6.Insert(aws.ToString(r.CidrIpv6))
+			if !wantIPv6.Has(aws.ToString(r.CidrIpv6)) {
+				stale.Ipv6Ranges = append(stale.Ipv6Ranges, types.Ipv6Range{CidrIpv6: r.CidrIpv6})
+			}
+		}
+		// Rules referencing other security groups or prefix lists are never added by the controller.
+		if len(permission.UserIdGroupPairs) > 0 || len(permission.PrefixListIds) > 0 {
+			stale.UserIdGroupPairs = permission.UserIdGroupPairs
+			stale.PrefixListIds = permission.PrefixListIds
+		}
+		if len(stale.IpRanges) > 0 || len(stale.Ipv6Ranges) > 0 || len(stale.UserIdGroupPairs) > 0 || len(stale.PrefixListIds) > 0 {
+			revoke = append(revoke, stale)
+		}
+	}
+
+	missing := types.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int32(vpcEndpointHTTPSPort),
+		ToPort:     aws.Int32(vpcEndpointHTTPSPort),
+	}
+	for _, cidr := range sets.List(wantIPv4.Difference(haveIPv4)) {
+		missing.IpRanges = append(missing.IpRanges, types.IpRange{
+			CidrIp:      aws.String(cidr),
+			Description: aws.String("HTTPS from the VPC"),
+		})
+	}
+	for _, cidr := range sets.List(wantIPv6.Difference(haveIPv6)) {
+		missing.Ipv6Ranges = append(missing.Ipv6Ranges, types.Ipv6Range{
+			CidrIpv6:    aws.String(cidr),
+			Description: aws.String("HTTPS from the VPC"),
+		})
+	}
+
+	if len(missing.IpRanges) > 0 || len(missing.Ipv6Ranges) > 0 {
+		if _, err := s.EC2Client.AuthorizeSecurityGroupIngress(context.TODO(), &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: []types.IpPermission{missing},
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedAuthorizeSecurityGroupIngressRules", "Failed to authorize ingress rules for VPC endpoint SecurityGroup %q: %v", groupID, err)
+			return errors.Wrapf(err, "failed to authorize security group %q ingress rules", groupID)
+		}
+		s.scope.Debug("Authorized VPC endpoint security group ingress rules", "security-group", groupID)
+	}
+
+	if len(revoke) > 0 {
+		if _, err := s.EC2Client.RevokeSecurityGroupIngress(context.TODO(), &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: revoke,
+		}); err != nil && !awserrors.IsPermissionNotFoundError(errors.Cause(err)) {
+			record.Warnf(s.scope.InfraCluster(), "FailedRevokeSecurityGroupIngressRules", "Failed to revoke ingress rules for VPC endpoint SecurityGroup %q: %v", groupID, err)
+			return errors.Wrapf(err, "failed to revoke security group %q ingress rules", groupID)
+		}
+		s.scope.Debug("Revoked VPC endpoint security group ingress rules", "security-group", groupID)
+	}
+
+	return nil
+}
+
+// getVPCEndpointIngressCidrs returns the IPv4 and IPv6 CIDR blocks of the VPC that HTTPS is allowed from.
+func (s *Service) getVPCEndpointIngressCidrs() (sets.Set[string], sets.Set[string]) {
+	ipv4 := sets.New[string]()
+	if s.scope.VPC().CidrBlock != "" {
+		ipv4.Insert(s.scope.VPC().CidrBlock)
+	}
+	for _, block := range s.scope.AllSecondaryCidrBlocks() {
+		if block.IPv4CidrBlock != "" {
+			ipv4.Insert(block.IPv4CidrBlock)
+		}
+	}
+
+	ipv6 := sets.New[string]()
+	if s.scope.VPC().IsIPv6Enabled() && s.scope.VPC().IPv6.CidrBlock != "" {
+		ipv6.Insert(s.scope.VPC().IPv6.CidrBlock)
+	}
+	return ipv4, ipv6
+}
+
+func isVPCEndpointHTTPSPermission(permission types.IpPermission) bool {
+	return aws.ToString(permission.IpProtocol) == "tcp" &&
+		aws.ToInt32(permission.FromPort) == vpcEndpointHTTPSPort &&
+		aws.ToInt32(permission.ToPort) == vpcEndpointHTTPSPort
+}
+
+func (s *Service) describeVPCEndpointSecurityGroup() (*types.SecurityGroup, error) {
+	name := s.getVPCEndpointSecurityGroupName()
+	out, err := s.EC2Client.DescribeSecurityGroups(context.TODO(), &ec2.DescribeSecurityGroupsInput{
+		Filters: []types.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.SecurityGroupName(name),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe security group %q", name)
+	}
+	if len(out.SecurityGroups) == 0 {
+		return nil, nil
+	}
+	return &out.SecurityGroups[0], nil
+}
+
+// deleteVPCEndpointSecurityGroup deletes the security group of the interface endpoints. The network interfaces of
+// deleted endpoints are released asynchronously, until then the deletion fails and is retried.
+func (s *Service) deleteVPCEndpointSecurityGroup() error {
+	group, err := s.describeVPCEndpointSecurityGroup()
+	if err != nil || group == nil {
+		return err
+	}
+
+	groupID := aws.ToString(group.GroupId)
+	if _, err := s.EC2Client.DeleteSecurityGroup(context.TODO(), &ec2.DeleteSecurityGroupInput{
+		GroupId: aws.String(groupID),
+	}); err != nil {
+		if code, ok := awserrors.Code(err); ok && code == awserrors.DependencyViolation {
+			return errors.Wrapf(err, "waiting for the interface vpc endpoints to release security group %q", groupID)
+		}
+		if awserrors.IsIgnorableSecurityGroupError(err) == nil {
+			return nil
+		}
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteSecurityGroup", "Failed to delete VPC endpoint SecurityGroup %q: %v", groupID, err)
+		return errors.Wrapf(err, "failed to delete security group %q", groupID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteSecurityGroup", "Deleted VPC endpoint SecurityGroup %q", groupID)
+	s.scope.Info("Deleted VPC endpoint security group", "security-group", groupID)
+	return nil
+}
+
+func (s *Service) getVPCEndpointSecurityGroupName() string {
+	return fmt.Sprintf("%s-vpc-endpoint", s.scope.Name())
+}
+
+func (s *Service) getVPCEndpointSecurityGroupTagParams(name string) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		ResourceID:  services.TemporaryResourceID,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.VPCEndpointRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
@@ -0,0 +1,395 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileInterfaceVPCEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	managedVPC := func(endpoints *infrav1.VPCEndpointsSpec) infrav1.NetworkSpec {
+		return infrav1.NetworkSpec{
+			VPC: infrav1.VPCSpec{
+				ID:        "vpc-endpoints",
+				CidrBlock: "10.0.0.0/16",
+				Tags: infrav1.Tags{
+					infrav1.ClusterTagKey("test-cluster"): "owned",
+				},
+				Endpoints: endpoints,
+			},
+			Subnets: infrav1.Subnets{
+				{
+					ID:               "subnet-private-a",
+					AvailabilityZone: "us-east-1a",
+					IsPublic:         false,
+				},
+				{
+					ID:               "subnet-private-a-2",
+					AvailabilityZone: "us-east-1a",
+					IsPublic:         false,
+				},
+				{
+					ID:               "subnet-private-b",
+					AvailabilityZone: "us-east-1b",
+					IsPublic:         false,
+				},
+				{
+					ID:               "subnet-public-a",
+					AvailabilityZone: "us-east-1a",
+					IsPublic:         true,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		input          infrav1.NetworkSpec
+		status         []infrav1.VPCEndpoint
+		expect         func(m *mocks.MockEC2APIMockRecorder)
+		expectedStatus []infrav1.VPCEndpoint
+		wantErr        bool
+	}{
+		{
+			name:   "no endpoints declared or recorded, does nothing",
+			input:  managedVPC(nil),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "creates the security group and the missing endpoints",
+			input: managedVPC(&infrav1.VPCEndpointsSpec{
+				Interfaces: []infrav1.InterfaceVPCEndpointSpec{
+					{Service: "sts"},
+				},
+			}),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcEndpointsInput{}), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.DescribeSecurityGroups(context.TODO(), gomock.Eq(&ec2.DescribeSecurityGroupsInput{
+					Filters: []types.Filter{
+						{
+							Name:   aws.String("vpc-id"),
+							Values: []string{"vpc-endpoints"},
+						},
+						{
+							Name:   aws.String("group-name"),
+							Values: []string{"test-cluster-vpc-endpoint"},
+						},
+					},
+				})).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+				m.CreateSecurityGroup(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateSecurityGroupInput{})).
+					Return(&ec2.CreateSecurityGroupOutput{GroupId: aws.String("sg-endpoint")}, nil)
+				m.AuthorizeSecurityGroupIngress(context.TODO(), gomock.AssignableToTypeOf(&ec2.AuthorizeSecurityGroupIngressInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.AuthorizeSecurityGroupIngressInput, _ ...func(*ec2.Options)) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+						if aws.ToString(input.IpPermissions[0].IpRanges[0].CidrIp) != "10.0.0.0/16" {
+							t.Fatalf("unexpected ingress rule %+v", input.IpPermissions)
+						}
+						return &ec2.AuthorizeSecurityGroupIngressOutput{}, nil
+					})
+				m.CreateVpcEndpoint(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcEndpointInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateVpcEndpointInput, _ ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error) {
+						if aws.ToString(input.ServiceName) != "com.amazonaws.us-east-1.sts" || input.VpcEndpointType != types.VpcEndpointTypeInterface || !aws.ToBool(input.PrivateDnsEnabled) {
+							t.Fatalf("unexpected create vpc endpoint input %+v", input)
+						}
+						return &ec2.CreateVpcEndpointOutput{
+							VpcEndpoint: &types.VpcEndpoint{
+								VpcEndpointId: aws.String("vpce-sts"),
+								ServiceName:   input.ServiceName,
+								State:         types.StatePending,
+							},
+						}, nil
+					})
+			},
+			expectedStatus: []infrav1.VPCEndpoint{
+				{
+					ID:               "vpce-sts",
+					ServiceName:      "com.amazonaws.us-east-1.sts",
+					State:            string(types.StatePending),
+					SubnetIDs:        []string{"subnet-private-a", "subnet-private-b"},
+					SecurityGroupIDs: []string{"sg-endpoint"},
+				},
+			},
+		},
+		{
+			name: "updates drifted endpoints and removes undeclared ones",
+			input: managedVPC(&infrav1.VPCEndpointsSpec{
+				Interfaces: []infrav1.InterfaceVPCEndpointSpec{
+					{Service: "ec2", PrivateDNSEnabled: aws.Bool(false), SubnetIDs: []string{"subnet-private-b"}},
+				},
+			}),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcEndpointsInput{}), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []types.VpcEndpoint{
+							{
+								VpcEndpointId:     aws.String("vpce-ec2"),
+								ServiceName:       aws.String("com.amazonaws.us-east-1.ec2"),
+								State:             types.StateAvailable,
+								PrivateDnsEnabled: aws.Bool(true),
+								SubnetIds:         []string{"subnet-private-a"},
+								Groups:            []types.SecurityGroupIdentifier{{GroupId: aws.String("sg-endpoint")}},
+							},
+							{
+								VpcEndpointId: aws.String("vpce-logs"),
+								ServiceName:   aws.String("com.amazonaws.us-east-1.logs"),
+								State:         types.StateAvailable,
+							},
+						},
+					}, nil)
+				m.DescribeSecurityGroups(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+					Return(&ec2.DescribeSecurityGroupsOutput{
+						SecurityGroups: []types.SecurityGroup{{
+							GroupId: aws.String("sg-endpoint"),
+							IpPermissions: []types.IpPermission{{
+								IpProtocol: aws.String("tcp"),
+								FromPort:   aws.Int32(443),
+								ToPort:     aws.Int32(443),
+								IpRanges: []types.IpRange{
+									{CidrIp: aws.String("10.0.0.0/16")},
+									{CidrIp: aws.String("10.1.0.0/16")},
+								},
+							}},
+						}},
+					}, nil)
+				m.RevokeSecurityGroupIngress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupIngressInput{
+					GroupId: aws.String("sg-endpoint"),
+					IpPermissions: []types.IpPermission{{
+						IpProtocol: aws.String("tcp"),
+						FromPort:   aws.Int32(443),
+						ToPort:     aws.Int32(443),
+						IpRanges:   []types.IpRange{{CidrIp: aws.String("10.1.0.0/16")}},
+					}},
+				})).Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil)
+				m.ModifyVpcEndpoint(context.TODO(), gomock.Eq(&ec2.ModifyVpcEndpointInput{
+					VpcEndpointId:     aws.String("vpce-ec2"),
+					AddSubnetIds:      []string{"subnet-private-b"},
+					RemoveSubnetIds:   []string{"subnet-private-a"},
+					PrivateDnsEnabled: aws.Bool(false),
+				})).Return(&ec2.ModifyVpcEndpointOutput{}, nil)
+				m.DeleteVpcEndpoints(context.TODO(), gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+					VpcEndpointIds: []string{"vpce-logs"},
+				})).Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+			},
+			expectedStatus: []infrav1.VPCEndpoint{
+				{
+					ID:               "vpce-ec2",
+					ServiceName:      "com.amazonaws.us-east-1.ec2",
+					State:            string(types.StateAvailable),
+					SubnetIDs:        []string{"subnet-private-b"},
+					SecurityGroupIDs: []string{"sg-endpoint"},
+				},
+			},
+		},
+		{
+			name:  "endpoints removed from the spec are deleted",
+			input: managedVPC(nil),
+			status: []infrav1.VPCEndpoint{
+				{ID: "vpce-sts", ServiceName: "com.amazonaws.us-east-1.sts"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcEndpointsInput{}), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []types.VpcEndpoint{
+							{
+								VpcEndpointId: aws.String("vpce-sts"),
+								ServiceName:   aws.String("com.amazonaws.us-east-1.sts"),
+								State:         types.StateAvailable,
+							},
+						},
+					}, nil)
+				m.DeleteVpcEndpoints(context.TODO(), gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+					VpcEndpointIds: []string{"vpce-sts"},
+				})).Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+			},
+			expectedStatus: []infrav1.VPCEndpoint{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						Region:      "us-east-1",
+						NetworkSpec: tc.input,
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							VPCEndpoints: tc.status,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileInterfaceVPCEndpoints()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scope.Network().VPCEndpoints).To(Equal(tc.expectedStatus))
+		})
+	}
+}
+
+func TestDeleteVPCEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	describeEndpointSecurityGroup := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DescribeSecurityGroups(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+			Return(&ec2.DescribeSecurityGroupsOutput{
+				SecurityGroups: []types.SecurityGroup{{GroupId: aws.String("sg-endpoint")}},
+			}, nil)
+	}
+
+	testCases := []struct {
+		name    string
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "deletes the endpoints, then the endpoint security group",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcEndpointsInput{}), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []types.VpcEndpoint{
+							{VpcEndpointId: aws.String("vpce-sts"), State: types.StateAvailable},
+							{VpcEndpointId: aws.String("vpce-ec2"), State: types.StateDeleting},
+						},
+					}, nil)
+				m.DeleteVpcEndpoints(context.TODO(), gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+					VpcEndpointIds: []string{"vpce-sts"},
+				})).Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+				describeEndpointSecurityGroup(m)
+				m.DeleteSecurityGroup(context.TODO(), gomock.Eq(&ec2.DeleteSecurityGroupInput{
+					GroupId: aws.String("sg-endpoint"),
+				})).Return(&ec2.DeleteSecurityGroupOutput{}, nil)
+			},
+		},
+		{
+			name: "retries while the endpoint network interfaces still use the security group",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcEndpointsInput{}), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []types.VpcEndpoint{
+							{VpcEndpointId: aws.String("vpce-sts"), State: types.StateDeleting},
+						},
+					}, nil)
+				describeEndpointSecurityGroup(m)
+				m.DeleteSecurityGroup(context.TODO(), gomock.Any()).
+					Return(nil, &smithy.GenericAPIError{Code: awserrors.DependencyViolation})
+			},
+			wantErr: true,
+		},
+		{
+			name: "nothing left to delete",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeVpcEndpointsInput{}), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.DescribeSecurityGroups(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+					Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			err := infrav1.AddToScheme(scheme)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						Region: "us-east-1",
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{
+								ID: "vpc-endpoints",
+								Tags: infrav1.Tags{
+									infrav1.ClusterTagKey("test-cluster"): "owned",
+								},
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.deleteVPCEndpoints()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scope.Network().VPCEndpoints).To(BeNil())
+		})
+	}
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	stsv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	iam "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
+)
+
+// elbLogDeliveryAccountIDs are the Elastic Load Balancing accounts delivering the load balancer logs in the
+// regions available before August 2022. Newer regions deliver the logs from the log delivery service principal.
+// See https://docs.aws.amazon.com/elasticloadbalancing/latest/application/enable-access-logging.html.
+var elbLogDeliveryAccountIDs = map[string]string{
+	"us-east-1":      "127311923021",
+	"us-east-2":      "033677994240",
+	"us-west-1":      "027434742980",
+	"us-west-2":      "797873946194",
+	"af-south-1":     "098369216593",
+	"ap-east-1":      "754344448648",
+	"ap-southeast-3": "589379963580",
+	"ap-south-1":     "718504428378",
+	"ap-northeast-3": "383597477331",
+	"ap-northeast-2": "600734575887",
+	"ap-southeast-1": "114774131450",
+	"ap-southeast-2": "783225319266",
+	"ap-northeast-1": "582318560864",
+	"ca-central-1":   "985666609251",
+	"eu-central-1":   "054676820928",
+	"eu-west-1":      "156460612806",
+	"eu-west-2":      "652711504416",
+	"eu-south-1":     "635631232127",
+	"eu-west-3":      "009996457667",
+	"eu-north-1":     "897822967062",
+	"me-south-1":     "076674570225",
+	"sa-east-1":      "507241528517",
+	"us-gov-west-1":  "048591011584",
+	"us-gov-east-1":  "190560391635",
+}
+
+// ReconcileLoadBalancerLogsBucket creates the S3 bucket the load balancer logs are delivered to, if it does
+// not exist yet, and allows the Elastic Load Balancing log delivery to write to it. The log delivery
+// statements are merged into the existing bucket policy, so statements added by users are kept.
+func (s *Service) ReconcileLoadBalancerLogsBucket(ctx context.Context, bucketName string) error {
+	if s.bucketManagementEnabled() && bucketName == s.bucketName() {
+		return errors.Errorf("load balancer logs bucket %q cannot be the cluster's S3 bucket", bucketName)
+	}
+
+	if err := s.createBucketIfNotExist(ctx, bucketName); err != nil {
+		return errors.Wrap(err, "ensuring load balancer logs bucket exists")
+	}
+
+	// The bucket may be shared by several clusters and is kept when the cluster is deleted.
+	if err := s.tagBucket(ctx, bucketName, infrav1.APIServerRoleTagValue, infrav1.ResourceLifecycleShared); err != nil {
+		return errors.Wrap(err, "tagging load balancer logs bucket")
+	}
+
+	statements, err := s.loadBalancerLogsBucketPolicyStatements(ctx, bucketName)
+	if err != nil {
+		return errors.Wrap(err, "generating load balancer logs bucket policy")
+	}
+
+	currentPolicy, err := s.getBucketPolicy(ctx, bucketName)
+	if err != nil {
+		return errors.Wrap(err, "getting load balancer logs bucket policy")
+	}
+
+	bucketPolicy, changed, err := mergeBucketPolicy(currentPolicy, statements)
+	if err != nil {
+		return errors.Wrap(err, "merging load balancer logs bucket policy")
+	}
+
+	if !changed {
+		return nil
+	}
+
+	input := &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucketName),
+		Policy: aws.String(bucketPolicy),
+	}
+
+	if _, err := s.S3Client.PutBucketPolicy(ctx, input); err != nil {
+		return errors.Wrap(err, "creating load balancer logs bucket policy")
+	}
+
+	s.scope.Trace("Updated load balancer logs bucket policy", "bucket_name", bucketName)
+
+	return nil
+}
+
+// getBucketPolicy returns the policy of the bucket, or an empty string if it has none.
+func (s *Service) getBucketPolicy(ctx context.Context, bucketName string) (string, error) {
+	out, err := s.S3Client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{Bucket: aws.String(bucketName)})
+	if err != nil {
+		if code, ok := awserrors.Code(err); ok && code == awserrors.NoSuchBucketPolicy {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return aws.ToString(out.Policy), nil
+}
+
+// mergeBucketPolicy replaces the statements of the current bucket policy having the same Sid as one of
+// the given statements, and appends the others. The remaining statements are kept untouched. It returns
+// the merged policy and whether any of the given statements is missing from or differs in the current one.
+func mergeBucketPolicy(current string, statements []iam.StatementEntry) (string, bool, error) {
+	policy := map[string]interface{}{}
+	if current != "" {
+		if err := json.Unmarshal([]byte(current), &policy); err != nil {
+			return "", false, errors.Wrap(err, "parsing bucket policy")
+		}
+	}
+	if _, ok := policy["Version"]; !ok {
+		policy["Version"] = "2012-10-17"
+	}
+
+	// A policy with a single statement may hold it as an object rather than a list.
+	var currentStatements []interface{}
+	switch st := policy["Statement"].(type) {
+	case []interface{}:
+		currentStatements = st
+	case map[string]interface{}:
+		currentStatements = []interface{}{st}
+	}
+
+	sids := make(map[string]struct{}, len(statements))
+	for _, statement := range statements {
+		sids[statement.Sid] = struct{}{}
+	}
+
+	merged := make([]interface{}, 0, len(currentStatements)+len(statements))
+	for _, statement := range currentStatements {
+		if entry, ok := statement.(map[string]interface{}); ok {
+			if sid, ok := entry["Sid"].(string); ok {
+				if _, ours := sids[sid]; ours {
+					continue
+				}
+			}
+		}
+		merged = append(merged, statement)
+	}
+
+	// Round trip the statements through JSON, so they compare with the ones parsed from the current policy.
+	raw, err := json.Marshal(statements)
+	if err != nil {
+		return "", false, errors.Wrap(err, "building bucket policy statements")
+	}
+	var desired []interface{}
+	if err := json.Unmarshal(raw, &desired); err != nil {
+		return "", false, errors.Wrap(err, "building bucket policy statements")
+	}
+	merged = append(merged, desired...)
+
+	changed := !statementsMatch(currentStatements, desired)
+	policy["Statement"] = merged
+
+	policyRaw, err := json.Marshal(policy)
+	if err != nil {
+		return "", false, errors.Wrap(err, "building bucket policy")
+	}
+
+	return string(policyRaw), changed, nil
+}
+
+// statementsMatch reports whether each of the desired statements is found, by Sid, among the current statements.
+// AWS returns single element lists as strings and may reorder lists, so the statements are compared once their
+// actions, resources, principals and conditions are normalized to sorted sets.
+func statementsMatch(current, desired []interface{}) bool {
+	currentBySid := make(map[string]map[string]interface{}, len(current))
+	for _, statement := range current {
+		if entry, ok := statement.(map[string]interface{}); ok {
+			if sid, ok := entry["Sid"].(string); ok {
+				currentBySid[sid] = normalizeStatement(entry)
+			}
+		}
+	}
+
+	for _, statement := range desired {
+		entry, ok := statement.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		sid, _ := entry["Sid"].(string)
+		currentEntry, found := currentBySid[sid]
+		if !found || !reflect.DeepEqual(currentEntry, normalizeStatement(entry)) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeStatement returns a copy of a policy statement with its string lists turned into sorted sets.
+func normalizeStatement(statement map[string]interface{}) map[string]interface{} {
+	ret := make(map[string]interface{}, len(statement))
+	for key, value := range statement {
+		switch key {
+		case "Action", "NotAction", "Resource", "NotResource", "Principal", "NotPrincipal", "Condition":
+			ret[key] = normalizePolicyValue(value)
+		default:
+			ret[key] = value
+		}
+	}
+	return ret
+}
+
+// normalizePolicyValue turns a string or a list of strings into a sorted set, recursing into the
+// principal types and condition operators and keys.
+func normalizePolicyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		items := sets.New[string]()
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return value
+			}
+			items.Insert(s)
+		}
+		return sets.List(items)
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			ret[key] = normalizePolicyValue(item)
+		}
+		return ret
+	}
+	return value
+}
+
+func (s *Service) loadBalancerLogsBucketPolicyStatements(ctx context.Context, bucketName string) ([]iam.StatementEntry, error) {
+	accountID, err := s.STSClient.GetCallerIdentity(ctx, &stsv2.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting account ID")
+	}
+
+	partition := endpoints.GetPartitionFromRegion(s.scope.Region())
+	bucketARN := fmt.Sprintf("arn:%s:s3:::%s", partition, bucketName)
+	objectsARN := fmt.Sprintf("%s/*", bucketARN)
+
+	// Application load balancers deliver their logs from the Elastic Load Balancing account of the region,
+	// or from the log delivery service principal in the regions without such an account.
+	elbPrincipal := map[iam.PrincipalType]iam.PrincipalID{
+		iam.PrincipalService: []string{"logdelivery.elasticloadbalancing.amazonaws.com"},
+	}
+	if elbAccountID, ok := elbLogDeliveryAccountIDs[s.scope.Region()]; ok {
+		elbPrincipal = map[iam.PrincipalType]iam.PrincipalID{
+			iam.PrincipalAWS: []string{fmt.Sprintf("arn:%s:iam::%s:root", partition, elbAccountID)},
+		}
+	}
+
+	// Network load balancers deliver their logs through the AWS log delivery service.
+	logDeliveryPrincipal := map[iam.PrincipalType]iam.PrincipalID{
+		iam.PrincipalService: []string{"delivery.logs.amazonaws.com"},
+	}
+
+	return []iam.StatementEntry{
+		{
+			Sid:       "ELBLogDelivery",
+			Effect:    iam.EffectAllow,
+			Principal: elbPrincipal,
+			Action:    []string{"s3:PutObject"},
+			Resource:  []string{objectsARN},
+		},
+		{
+			Sid:       "LogDeliveryWrite",
+			Effect:    iam.EffectAllow,
+			Principal: logDeliveryPrincipal,
+			Action:    []string{"s3:PutObject"},
+			Resource:  []string{objectsARN},
+			Condition: iam.Conditions{
+				"StringEquals": map[string]interface{}{
+					"s3:x-amz-acl":      "bucket-owner-full-control",
+					"aws:SourceAccount": aws.ToString(accountID.Account),
+				},
+			},
+		},
+		{
+			Sid:       "LogDeliveryAclCheck",
+			Effect:    iam.EffectAllow,
+			Principal: logDeliveryPrincipal,
+			Action:    []string{"s3:GetBucketAcl"},
+			Resource:  []string{bucketARN},
+			Condition: iam.Conditions{
+				"StringEquals": map[string]interface{}{
+					"aws:SourceAccount": aws.ToString(accountID.Account),
+				},
+			},
+		},
+	}, nil
+}
@@ -46,6 +46,13 @@ import (
 // AWSDefaultRegion is the default AWS region.
 const AWSDefaultRegion string = "us-east-1"
 
+const (
+	// errServerSideEncryptionConfigurationNotFound is returned for buckets without default encryption configuration.
+	errServerSideEncryptionConfigurationNotFound = "ServerSideEncryptionConfigurationNotFoundError"
+	// errNoSuchPublicAccessBlockConfiguration is returned for buckets without public access block configuration.
+	errNoSuchPublicAccessBlockConfiguration = "NoSuchPublicAccessBlockConfiguration"
+)
+
 // Service holds a collection of interfaces.
 // The interfaces are broken down like this to group functions together.
 // One alternative is to have a large list of functions from the ec2 client.
@@ -61,12 +68,19 @@ type S3API interface {
 	CreateBucket(ctx context.Context, params *s3.CreateBucketInput, optFns ...func(*s3.Options)) (*s3.CreateBucketOutput, error)
 	DeleteBucket(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)
 	DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	GetBucketEncryption(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)
+	GetBucketLogging(ctx context.Context, params *s3.GetBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error)
+	GetBucketPolicy(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
+	GetPublicAccessBlock(ctx context.Context, params *s3.GetPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)
 	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
 	ListObjectsV2(ctx context.Context, input *s3.ListObjectsV2Input, optFns ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)
 	PutBucketPolicy(ctx context.Context, params *s3.PutBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error)
+	PutBucketEncryption(ctx context.Context, params *s3.PutBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)
 	PutBucketLifecycleConfiguration(ctx context.Context, input *s3.PutBucketLifecycleConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)
+	PutBucketLogging(ctx context.Context, params *s3.PutBucketLoggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error)
 	PutBucketTagging(ctx context.Context, params *s3.PutBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error)
 	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	PutPublicAccessBlock(ctx context.Context, params *s3.PutPublicAccessBlockInput, optFns ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error)
 }
 
 var _ S3API = &s3.Client{}
@@ -97,10 +111,18 @@ func (s *Service) ReconcileBucket(ctx context.Context) error {
 		return errors.Wrap(err, "ensuring bucket exists")
 	}
 
-	if err := s.tagBucket(ctx, bucketName); err != nil {
+	if err := s.tagBucket(ctx, bucketName, "node", infrav1.ResourceLifecycleOwned); err != nil {
 		return errors.Wrap(err, "tagging bucket")
 	}
 
+	if err := s.ensureBucketPublicAccessBlock(ctx, bucketName); err != nil {
+		return errors.Wrap(err, "ensuring bucket public access block")
+	}
+
+	if err := s.ensureBucketEncryption(ctx, bucketName); err != nil {
+		return errors.Wrap(err, "ensuring bucket encryption")
+	}
+
 	if err := s.ensureBucketPolicy(ctx, bucketName); err != nil {
 		return errors.Wrap(err, "ensuring bucket policy")
 	}
@@ -109,6 +131,10 @@ func (s *Service) ReconcileBucket(ctx context.Context) error {
 		return errors.Wrap(err, "ensuring bucket lifecycle configuration")
 	}
 
+	if err := s.ensureBucketAccessLogging(ctx, bucketName); err != nil {
+		return errors.Wrap(err, "ensuring bucket access logging")
+	}
+
 	return nil
 }
 
@@ -207,6 +233,7 @@ func (s *Service) Create(ctx context.Context, m *scope.MachineScope, data []byte
 		Bucket:               aws.String(bucket),
 		Key:                  aws.String(key),
 		ServerSideEncryption: s3types.ServerSideEncryptionAwsKms,
+		SSEKMSKeyId:          s.kmsKeyID(),
 	}); err != nil {
 		return "", errors.Wrap(err, "putting object")
 	}
@@ -258,6 +285,7 @@ func (s *Service) CreateForMachinePool(ctx context.Context, scope scope.LaunchTe
 		Bucket:               aws.String(bucket),
 		Key:                  aws.String(key),
 		ServerSideEncryption: s3types.ServerSideEncryptionAwsKms,
+		SSEKMSKeyId:          s.kmsKeyID(),
 	}); err != nil {
 		return "", errors.Wrap(err, "putting object for machine pool")
 	}
@@ -437,6 +465,131 @@ func (s *Service) ensureBucketPolicy(ctx context.Context, bucketName string) err
 	return nil
 }
 
+func (s *Service) ensureBucketEncryption(ctx context.Context, bucketName string) error {
+	bucket := s.scope.Bucket()
+	if bucket.KMSKeyARN == "" && bucket.BucketKeyEnabled == nil {
+		return nil
+	}
+
+	rule := s3types.ServerSideEncryptionRule{
+		ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{
+			SSEAlgorithm:   s3types.ServerSideEncryptionAwsKms,
+			KMSMasterKeyID: s.kmsKeyID(),
+		},
+		BucketKeyEnabled: aws.Bool(ptr.Deref(bucket.BucketKeyEnabled, true)),
+	}
+
+	out, err := s.S3Client.GetBucketEncryption(ctx, &s3.GetBucketEncryptionInput{Bucket: aws.String(bucketName)})
+	switch {
+	case err == nil:
+		if out.ServerSideEncryptionConfiguration != nil && encryptionRulesMatch(out.ServerSideEncryptionConfiguration.Rules, rule) {
+			return nil
+		}
+	case awserrors.ParseSmithyError(err).ErrorCode() != errServerSideEncryptionConfigurationNotFound:
+		return errors.Wrap(err, "getting S3 bucket encryption configuration")
+	}
+
+	input := &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucketName),
+		ServerSideEncryptionConfiguration: &s3types.ServerSideEncryptionConfiguration{
+			Rules: []s3types.ServerSideEncryptionRule{rule},
+		},
+	}
+
+	if _, err := s.S3Client.PutBucketEncryption(ctx, input); err != nil {
+		return errors.Wrap(err, "creating S3 bucket encryption configuration")
+	}
+
+	s.scope.Trace("Updated bucket encryption configuration", "bucket_name", bucketName)
+
+	return nil
+}
+
+// encryptionRulesMatch reports whether the current encryption rules of a bucket are the wanted rule.
+func encryptionRulesMatch(current []s3types.ServerSideEncryptionRule, want s3types.ServerSideEncryptionRule) bool {
+	if len(current) != 1 || current[0].ApplyServerSideEncryptionByDefault == nil {
+		return false
+	}
+	got := current[0]
+	return got.ApplyServerSideEncryptionByDefault.SSEAlgorithm == want.ApplyServerSideEncryptionByDefault.SSEAlgorithm &&
+		aws.ToString(got.ApplyServerSideEncryptionByDefault.KMSMasterKeyID) == aws.ToString(want.ApplyServerSideEncryptionByDefault.KMSMasterKeyID) &&
+		aws.ToBool(got.BucketKeyEnabled) == aws.ToBool(want.BucketKeyEnabled)
+}
+
+func (s *Service) ensureBucketPublicAccessBlock(ctx context.Context, bucketName string) error {
+	if !ptr.Deref(s.scope.Bucket().BlockPublicAccess, false) {
+		return nil
+	}
+
+	out, err := s.S3Client.GetPublicAccessBlock(ctx, &s3.GetPublicAccessBlockInput{Bucket: aws.String(bucketName)})
+	switch {
+	case err == nil:
+		if c := out.PublicAccessBlockConfiguration; c != nil && aws.ToBool(c.BlockPublicAcls) && aws.ToBool(c.BlockPublicPolicy) &&
+			aws.ToBool(c.IgnorePublicAcls) && aws.ToBool(c.RestrictPublicBuckets) {
+			return nil
+		}
+	case awserrors.ParseSmithyError(err).ErrorCode() != errNoSuchPublicAccessBlockConfiguration:
+		return errors.Wrap(err, "getting S3 bucket public access block")
+	}
+
+	input := &s3.PutPublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+		PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		},
+	}
+
+	if _, err := s.S3Client.PutPublicAccessBlock(ctx, input); err != nil {
+		return errors.Wrap(err, "creating S3 bucket public access block")
+	}
+
+	s.scope.Trace("Updated bucket public access block", "bucket_name", bucketName)
+
+	return nil
+}
+
+func (s *Service) ensureBucketAccessLogging(ctx context.Context, bucketName string) error {
+	logging := s.scope.Bucket().AccessLogging
+	if logging == nil {
+		return nil
+	}
+
+	targetPrefix := logging.TargetPrefix
+	if targetPrefix == "" {
+		targetPrefix = bucketName + "/"
+	}
+
+	out, err := s.S3Client.GetBucketLogging(ctx, &s3.GetBucketLoggingInput{Bucket: aws.String(bucketName)})
+	if err != nil {
+		return errors.Wrap(err, "getting S3 bucket access logging configuration")
+	}
+	if current := out.LoggingEnabled; current != nil && aws.ToString(current.TargetBucket) == logging.TargetBucket &&
+		aws.ToString(current.TargetPrefix) == targetPrefix {
+		return nil
+	}
+
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(bucketName),
+		BucketLoggingStatus: &s3types.BucketLoggingStatus{
+			LoggingEnabled: &s3types.LoggingEnabled{
+				TargetBucket: aws.String(logging.TargetBucket),
+				TargetPrefix: aws.String(targetPrefix),
+			},
+		},
+	}
+
+	if _, err := s.S3Client.PutBucketLogging(ctx, input); err != nil {
+		return errors.Wrap(err, "creating S3 bucket access logging configuration")
+	}
+
+	s.scope.Trace("Updated bucket access logging", "bucket_name", bucketName, "target_bucket", logging.TargetBucket)
+
+	return nil
+}
+
 func (s *Service) ensureBucketLifecycleConfiguration(ctx context.Context, bucketName string) error {
 	if !feature.Gates.Enabled(feature.MachinePool) {
 		return nil
@@ -474,7 +627,7 @@ func (s *Service) ensureBucketLifecycleConfiguration(ctx context.Context, bucket
 	return nil
 }
 
-func (s *Service) tagBucket(ctx context.Context, bucketName string) error {
+func (s *Service) tagBucket(ctx context.Context, bucketName, role string, lifecycle infrav1.ResourceLifecycle) error {
 	taggingInput := &s3.PutBucketTaggingInput{
 		Bucket: aws.String(bucketName),
 		Tagging: &s3types.Tagging{
@@ -484,9 +637,9 @@ func (s *Service) tagBucket(ctx context.Context, bucketName string) error {
 
 	tags := infrav1.Build(infrav1.BuildParams{
 		ClusterName: s.scope.Name(),
-		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Lifecycle:   lifecycle,
 		Name:        nil,
-		Role:        aws.String("node"),
+		Role:        aws.String(role),
 		Additional:  s.scope.AdditionalTags(),
 	})
 
@@ -537,6 +690,25 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 		},
 	}
 
+	if bucket.KMSKeyARN != "" {
+		// Objects must be encrypted with the configured key. The IAM roles reading the objects
+		// additionally need kms:Decrypt on the key, which can't be granted by a bucket policy.
+		statements = append(statements, iam.StatementEntry{
+			Sid:    "DenyIncorrectEncryptionKey",
+			Effect: iam.EffectDeny,
+			Principal: map[iam.PrincipalType]iam.PrincipalID{
+				iam.PrincipalAWS: []string{"*"},
+			},
+			Action:   []string{"s3:PutObject"},
+			Resource: []string{fmt.Sprintf("arn:%s:s3:::%s/*", partition, bucketName)},
+			Condition: iam.Conditions{
+				"StringNotEqualsIfExists": map[string]interface{}{
+					"s3:x-amz-server-side-encryption-aws-kms-key-id": bucket.KMSKeyARN,
+				},
+			},
+		})
+	}
+
 	if bucket.PresignedURLDuration == nil {
 		if bucket.ControlPlaneIAMInstanceProfile != "" {
 			statements = append(statements, iam.StatementEntry{
@@ -595,6 +767,14 @@ func (s *Service) bucketName() string {
 	return s.scope.Bucket().Name
 }
 
+// kmsKeyID returns the customer managed KMS key of the bucket, or nil to use the AWS managed key.
+func (s *Service) kmsKeyID() *string {
+	if s.scope.Bucket().KMSKeyARN == "" {
+		return nil
+	}
+	return aws.String(s.scope.Bucket().KMSKeyARN)
+}
+
 func (s *Service) bootstrapDataKey(m *scope.MachineScope) string {
 	// Use machine name as object key.
 	return path.Join(m.Role(), m.Name())
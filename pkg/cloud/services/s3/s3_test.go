@@ -18,11 +18,13 @@ package s3_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/url"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
@@ -30,10 +32,12 @@ import (
 	s3svc "github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
 	"github.com/golang/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -223,6 +227,156 @@ func TestReconcileBucket(t *testing.T) {
 		}
 	})
 
+	t.Run("creates_bucket_with_kms_encryption_and_access_logging", func(t *testing.T) {
+		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
+
+		bucketName := "bar"
+		kmsKeyARN := "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+		svc, s3Mock := testService(t, &testServiceInput{
+			Bucket: &infrav1.S3Bucket{
+				Name:              bucketName,
+				KMSKeyARN:         kmsKeyARN,
+				BucketKeyEnabled:  ptr.To(false),
+				BlockPublicAccess: ptr.To(true),
+				AccessLogging: &infrav1.S3BucketAccessLogging{
+					TargetBucket: "access-logs",
+				},
+			},
+		})
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetPublicAccessBlock(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "NoSuchPublicAccessBlockConfiguration"}).Times(1)
+		s3Mock.EXPECT().PutPublicAccessBlock(gomock.Any(), gomock.Eq(&s3svc.PutPublicAccessBlockInput{
+			Bucket: aws.String(bucketName),
+			PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+		})).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketEncryption(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "ServerSideEncryptionConfigurationNotFoundError"}).Times(1)
+		s3Mock.EXPECT().PutBucketEncryption(gomock.Any(), gomock.Eq(&s3svc.PutBucketEncryptionInput{
+			Bucket: aws.String(bucketName),
+			ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+				Rules: []types.ServerSideEncryptionRule{
+					{
+						ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+							SSEAlgorithm:   types.ServerSideEncryptionAwsKms,
+							KMSMasterKeyID: aws.String(kmsKeyARN),
+						},
+						BucketKeyEnabled: aws.Bool(false),
+					},
+				},
+			},
+		})).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, input *s3svc.PutBucketPolicyInput, optFns ...func(*s3svc.Options)) {
+			policy := aws.ToString(input.Policy)
+
+			if !strings.Contains(policy, "DenyIncorrectEncryptionKey") || !strings.Contains(policy, kmsKeyARN) {
+				t.Errorf("Expected deny when not using the configured KMS key; got: %v", policy)
+			}
+		}).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketLifecycleConfiguration(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLogging(gomock.Any(), gomock.Any()).Return(&s3svc.GetBucketLoggingOutput{}, nil).Times(1)
+		s3Mock.EXPECT().PutBucketLogging(gomock.Any(), gomock.Eq(&s3svc.PutBucketLoggingInput{
+			Bucket: aws.String(bucketName),
+			BucketLoggingStatus: &types.BucketLoggingStatus{
+				LoggingEnabled: &types.LoggingEnabled{
+					TargetBucket: aws.String("access-logs"),
+					TargetPrefix: aws.String("bar/"),
+				},
+			},
+		})).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileBucket(context.TODO()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("leaves_bucket_configuration_untouched_when_unset", func(t *testing.T) {
+		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
+
+		svc, s3Mock := testService(t, &testServiceInput{
+			Bucket: &infrav1.S3Bucket{
+				Name:              "bar",
+				BlockPublicAccess: ptr.To(false),
+			},
+		})
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetPublicAccessBlock(gomock.Any(), gomock.Any()).Times(0)
+		s3Mock.EXPECT().GetBucketEncryption(gomock.Any(), gomock.Any()).Times(0)
+		s3Mock.EXPECT().GetBucketLogging(gomock.Any(), gomock.Any()).Times(0)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketLifecycleConfiguration(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileBucket(context.TODO()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("does_not_rewrite_bucket_configuration_in_place", func(t *testing.T) {
+		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
+
+		bucketName := "bar"
+		kmsKeyARN := "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+		svc, s3Mock := testService(t, &testServiceInput{
+			Bucket: &infrav1.S3Bucket{
+				Name:              bucketName,
+				KMSKeyARN:         kmsKeyARN,
+				BlockPublicAccess: ptr.To(true),
+				AccessLogging: &infrav1.S3BucketAccessLogging{
+					TargetBucket: "access-logs",
+					TargetPrefix: "logs/",
+				},
+			},
+		})
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetPublicAccessBlock(gomock.Any(), gomock.Any()).Return(&s3svc.GetPublicAccessBlockOutput{
+			PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+				BlockPublicAcls:       aws.Bool(true),
+				BlockPublicPolicy:     aws.Bool(true),
+				IgnorePublicAcls:      aws.Bool(true),
+				RestrictPublicBuckets: aws.Bool(true),
+			},
+		}, nil).Times(1)
+		s3Mock.EXPECT().GetBucketEncryption(gomock.Any(), gomock.Any()).Return(&s3svc.GetBucketEncryptionOutput{
+			ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{
+				Rules: []types.ServerSideEncryptionRule{
+					{
+						ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+							SSEAlgorithm:   types.ServerSideEncryptionAwsKms,
+							KMSMasterKeyID: aws.String(kmsKeyARN),
+						},
+						BucketKeyEnabled: aws.Bool(true),
+					},
+				},
+			},
+		}, nil).Times(1)
+		s3Mock.EXPECT().GetBucketLogging(gomock.Any(), gomock.Any()).Return(&s3svc.GetBucketLoggingOutput{
+			LoggingEnabled: &types.LoggingEnabled{
+				TargetBucket: aws.String("access-logs"),
+				TargetPrefix: aws.String("logs/"),
+			},
+		}, nil).Times(1)
+		s3Mock.EXPECT().PutPublicAccessBlock(gomock.Any(), gomock.Any()).Times(0)
+		s3Mock.EXPECT().PutBucketEncryption(gomock.Any(), gomock.Any()).Times(0)
+		s3Mock.EXPECT().PutBucketLogging(gomock.Any(), gomock.Any()).Times(0)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketLifecycleConfiguration(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileBucket(context.TODO()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
 	t.Run("is_idempotent", func(t *testing.T) {
 		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
 
@@ -307,6 +461,21 @@ func TestReconcileBucket(t *testing.T) {
 			}
 		})
 
+		t.Run("creating_bucket_encryption_fails", func(t *testing.T) {
+			t.Parallel()
+
+			svc, s3Mock := testService(t, &testServiceInput{Bucket: &infrav1.S3Bucket{BucketKeyEnabled: ptr.To(true)}})
+
+			s3Mock.EXPECT().CreateBucket(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			s3Mock.EXPECT().PutBucketTagging(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			s3Mock.EXPECT().GetBucketEncryption(gomock.Any(), gomock.Any()).Return(&s3svc.GetBucketEncryptionOutput{}, nil).Times(1)
+			s3Mock.EXPECT().PutBucketEncryption(gomock.Any(), gomock.Any()).Return(nil, errors.New("error")).Times(1)
+
+			if err := svc.ReconcileBucket(context.TODO()); err == nil {
+				t.Fatalf("Expected error")
+			}
+		})
+
 		t.Run("creating_bucket_policy_fails", func(t *testing.T) {
 			t.Parallel()
 
@@ -345,6 +514,250 @@ func TestReconcileBucket(t *testing.T) {
 	})
 }
 
+func TestReconcileLoadBalancerLogsBucket(t *testing.T) {
+	t.Parallel()
+
+	t.Run("creates_bucket_with_policy_allowing_elb_log_delivery", func(t *testing.T) {
+		t.Parallel()
+
+		bucketName := "lb-logs"
+
+		svc, s3Mock := testService(t, nil)
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any(), gomock.Eq(&s3svc.CreateBucketInput{
+			Bucket: aws.String(bucketName),
+			CreateBucketConfiguration: &types.CreateBucketConfiguration{
+				LocationConstraint: types.BucketLocationConstraintUsWest2,
+			},
+		})).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any(), gomock.Eq(&s3svc.PutBucketTaggingInput{
+			Bucket: aws.String(bucketName),
+			Tagging: &types.Tagging{
+				TagSet: []types.Tag{
+					{
+						Key:   aws.String("additional"),
+						Value: aws.String("from-aws-cluster"),
+					},
+					{
+						Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+						Value: aws.String("shared"),
+					},
+					{
+						Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+						Value: aws.String("apiserver"),
+					},
+				},
+			},
+		})).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketPolicy(gomock.Any(), gomock.Eq(&s3svc.GetBucketPolicyInput{
+			Bucket: aws.String(bucketName),
+		})).Return(nil, &smithy.GenericAPIError{Code: "NoSuchBucketPolicy"}).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, input *s3svc.PutBucketPolicyInput, optFns ...func(*s3svc.Options)) {
+			if input.Policy == nil {
+				t.Fatalf("Policy must be defined")
+			}
+
+			policy := *input.Policy
+
+			if !strings.Contains(policy, "arn:aws:iam::797873946194:root") {
+				t.Errorf("Expected policy to allow the regional Elastic Load Balancing account; got: %v", policy)
+			}
+
+			if !strings.Contains(policy, "delivery.logs.amazonaws.com") {
+				t.Errorf("Expected policy to allow the log delivery service; got: %v", policy)
+			}
+
+			if !strings.Contains(policy, fmt.Sprintf("arn:aws:s3:::%s/*", bucketName)) {
+				t.Errorf("Expected policy to apply to all objects of the bucket; got: %v", policy)
+			}
+
+			if !strings.Contains(policy, `"aws:SourceAccount":"foo"`) {
+				t.Errorf("Expected policy to restrict the log delivery to the cluster account; got: %v", policy)
+			}
+		}).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileLoadBalancerLogsBucket(context.TODO(), bucketName); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("uses_log_delivery_service_principal_in_regions_without_elb_account", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, &testServiceInput{Region: "ap-southeast-4"})
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketPolicy(gomock.Any(), gomock.Any()).Return(&s3svc.GetBucketPolicyOutput{}, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, input *s3svc.PutBucketPolicyInput, optFns ...func(*s3svc.Options)) {
+			policy := aws.ToString(input.Policy)
+
+			if !strings.Contains(policy, "logdelivery.elasticloadbalancing.amazonaws.com") {
+				t.Errorf("Expected policy to allow the Elastic Load Balancing log delivery service; got: %v", policy)
+			}
+
+			if strings.Contains(policy, ":root") {
+				t.Errorf("Expected policy not to reference an Elastic Load Balancing account; got: %v", policy)
+			}
+		}).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileLoadBalancerLogsBucket(context.TODO(), "lb-logs"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("returns_error_when_bucket_policy_cannot_be_applied", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, nil)
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketPolicy(gomock.Any(), gomock.Any()).Return(&s3svc.GetBucketPolicyOutput{}, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Return(nil, errors.New("error")).Times(1)
+
+		if err := svc.ReconcileLoadBalancerLogsBucket(context.TODO(), "lb-logs"); err == nil {
+			t.Fatalf("Expected error")
+		}
+	})
+
+	t.Run("keeps_existing_bucket_policy_statements", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, nil)
+
+		currentPolicy := `{"Version":"2012-10-17","Statement":[` +
+			`{"Sid":"UserAccess","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::111111111111:root"},"Action":"s3:GetObject","NotResource":"arn:aws:s3:::lb-logs/private/*"},` +
+			`{"Sid":"ELBLogDelivery","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::000000000000:root"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::lb-logs/*"}]}`
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().GetBucketPolicy(gomock.Any(), gomock.Any()).Return(&s3svc.GetBucketPolicyOutput{Policy: aws.String(currentPolicy)}, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, input *s3svc.PutBucketPolicyInput, optFns ...func(*s3svc.Options)) {
+			policy := aws.ToString(input.Policy)
+
+			if !strings.Contains(policy, `"NotResource":"arn:aws:s3:::lb-logs/private/*"`) {
+				t.Errorf("Expected policy to keep the existing statements; got: %v", policy)
+			}
+
+			if strings.Contains(policy, "000000000000") {
+				t.Errorf("Expected policy to replace the outdated log delivery statement; got: %v", policy)
+			}
+
+			if n := strings.Count(policy, `"Sid":"ELBLogDelivery"`); n != 1 {
+				t.Errorf("Expected policy to hold a single log delivery statement, got %d: %v", n, policy)
+			}
+		}).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileLoadBalancerLogsBucket(context.TODO(), "lb-logs"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("does_not_update_bucket_policy_when_up_to_date", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, nil)
+
+		var appliedPolicy *string
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+		s3Mock.EXPECT().GetBucketPolicy(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *s3svc.GetBucketPolicyInput, optFns ...func(*s3svc.Options)) (*s3svc.GetBucketPolicyOutput, error) {
+			return &s3svc.GetBucketPolicyOutput{Policy: appliedPolicy}, nil
+		}).Times(2)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, input *s3svc.PutBucketPolicyInput, optFns ...func(*s3svc.Options)) {
+			appliedPolicy = input.Policy
+		}).Return(nil, nil).Times(1)
+
+		for range 2 {
+			if err := svc.ReconcileLoadBalancerLogsBucket(context.TODO(), "lb-logs"); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		}
+	})
+
+	t.Run("does_not_update_bucket_policy_rewritten_by_aws", func(t *testing.T) {
+		t.Parallel()
+
+		svc, s3Mock := testService(t, nil)
+
+		var appliedPolicy *string
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+		s3Mock.EXPECT().GetBucketPolicy(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *s3svc.GetBucketPolicyInput, optFns ...func(*s3svc.Options)) (*s3svc.GetBucketPolicyOutput, error) {
+			if appliedPolicy == nil {
+				return &s3svc.GetBucketPolicyOutput{}, nil
+			}
+			return &s3svc.GetBucketPolicyOutput{Policy: aws.String(rewriteBucketPolicyAsAWS(t, *appliedPolicy))}, nil
+		}).Times(2)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, input *s3svc.PutBucketPolicyInput, optFns ...func(*s3svc.Options)) {
+			appliedPolicy = input.Policy
+		}).Return(nil, nil).Times(1)
+
+		for range 2 {
+			if err := svc.ReconcileLoadBalancerLogsBucket(context.TODO(), "lb-logs"); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		}
+	})
+
+	t.Run("refuses_cluster_bucket", func(t *testing.T) {
+		t.Parallel()
+
+		svc, _ := testService(t, &testServiceInput{
+			Bucket: &infrav1.S3Bucket{
+				Name: "lb-logs",
+			},
+		})
+
+		if err := svc.ReconcileLoadBalancerLogsBucket(context.TODO(), "lb-logs"); err == nil {
+			t.Fatalf("Expected error")
+		}
+	})
+}
+
+// rewriteBucketPolicyAsAWS rewrites a bucket policy the way AWS returns it: single element lists become
+// strings and the statements are reordered.
+func rewriteBucketPolicyAsAWS(t *testing.T, policy string) string {
+	t.Helper()
+
+	var collapse func(value interface{}) interface{}
+	collapse = func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case []interface{}:
+			if len(v) == 1 {
+				return collapse(v[0])
+			}
+			for i := range v {
+				v[i] = collapse(v[i])
+			}
+		case map[string]interface{}:
+			for key, item := range v {
+				v[key] = collapse(item)
+			}
+		}
+		return value
+	}
+
+	parsed := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(policy), &parsed); err != nil {
+		t.Fatalf("Failed to parse policy: %v", err)
+	}
+	statements := parsed["Statement"].([]interface{})
+	slices.Reverse(statements)
+	for i := range statements {
+		statements[i] = collapse(statements[i])
+	}
+
+	raw, err := json.Marshal(parsed)
+	if err != nil {
+		t.Fatalf("Failed to marshal policy: %v", err)
+	}
+	return string(raw)
+}
+
 func TestDeleteBucket(t *testing.T) {
 	t.Parallel()
 
@@ -533,6 +946,40 @@ func TestCreateObject(t *testing.T) {
 		})
 	})
 
+	t.Run("uses_configured_kms_key", func(t *testing.T) {
+		t.Parallel()
+
+		kmsKeyARN := "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+		svc, s3Mock := testService(t, &testServiceInput{
+			Bucket: &infrav1.S3Bucket{
+				Name:      bucketName,
+				KMSKeyARN: kmsKeyARN,
+			},
+		})
+
+		machineScope := &scope.MachineScope{
+			Machine: &clusterv1.Machine{},
+			AWSMachine: &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			},
+		}
+
+		s3Mock.EXPECT().PutObject(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, putObjectInput *s3svc.PutObjectInput, optFns ...func(*s3svc.Options)) {
+			if putObjectInput.ServerSideEncryption != types.ServerSideEncryptionAwsKms {
+				t.Errorf("Expected SSE-KMS encryption, got %q", putObjectInput.ServerSideEncryption)
+			}
+			if aws.ToString(putObjectInput.SSEKMSKeyId) != kmsKeyARN {
+				t.Errorf("Expected KMS key %q, got %q", kmsKeyARN, aws.ToString(putObjectInput.SSEKMSKeyId))
+			}
+		}).Return(nil, nil).Times(1)
+
+		if _, err := svc.Create(context.TODO(), machineScope, []byte("foo")); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
 	t.Run("is_idempotent", func(t *testing.T) {
 		t.Parallel()
 
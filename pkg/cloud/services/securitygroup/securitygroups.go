@@ -118,6 +118,9 @@ func (s *Service) ReconcileSecurityGroups() error {
 			s.scope.SecurityGroups()[role] = infrav1.SecurityGroup{
 				ID:   *sg.GroupId,
 				Name: *sg.GroupName,
+				// New security groups come with a rule allowing all outbound IPv4 traffic, which is
+				// revoked below when the egress rules are managed.
+				EgressRules: egressRulesFromSDKType(defaultEgressPermission()),
 			}
 			continue
 		}
@@ -196,11 +199,116 @@ func (s *Service) ReconcileSecurityGroups() error {
 
 			s.scope.Debug("Authorized ingress rules in security group", "authorized-ingress-rules", toAuthorize, "security-group-id", sg.ID)
 		}
+
+		if err := s.reconcileEgressRules(role, sg); err != nil {
+			return err
+		}
 	}
 	v1beta1conditions.MarkTrue(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition)
 	return nil
 }
 
+// reconcileEgressRules creates or updates the outbound permissions of the security group to match the
+// specified egress rules. Security groups without declared egress rules are left untouched.
+func (s *Service) reconcileEgressRules(role infrav1.SecurityGroupRole, sg infrav1.SecurityGroup) error {
+	specRules, managed, err := s.getSecurityGroupEgressRules(role)
+	if err != nil {
+		return err
+	}
+	if !managed {
+		return nil
+	}
+
+	current := sg.EgressRules
+	want := expandEgressRules(specRules)
+
+	// Rules are authorized before the stale ones are revoked, so that the group keeps allowing the wanted
+	// traffic throughout. Rules only changing the description of a stale rule are the same permission
+	// for AWS, and can only be authorized once the stale rule is gone.
+	toRevoke := current.Difference(want)
+	var toAuthorize, toReplace infrav1.EgressRules
+	for _, rule := range want.Difference(current) {
+		if hasSameEgressPermission(toRevoke, rule) {
+			toReplace = append(toReplace, rule)
+		} else {
+			toAuthorize = append(toAuthorize, rule)
+		}
+	}
+
+	if err := s.authorizeEgressRules(sg.ID, toAuthorize); err != nil {
+		return err
+	}
+
+	if len(toRevoke) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := s.revokeSecurityGroupEgressRules(sg.ID, toRevoke); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return errors.Wrapf(err, "failed to revoke security group egress rules for %q", sg.ID)
+		}
+
+		s.scope.Debug("Revoked egress rules from security group", "revoked-egress-rules", toRevoke, "security-group-id", sg.ID)
+	}
+
+	return s.authorizeEgressRules(sg.ID, toReplace)
+}
+
+func (s *Service) authorizeEgressRules(id string, rules infrav1.EgressRules) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if err := s.authorizeSecurityGroupEgressRules(id, rules); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.GroupNotFound); err != nil {
+		return err
+	}
+
+	s.scope.Debug("Authorized egress rules in security group", "authorized-egress-rules", rules, "security-group-id", id)
+	return nil
+}
+
+// hasSameEgressPermission returns true if rules holds a rule matching rule on everything but the description.
+func hasSameEgressPermission(rules infrav1.EgressRules, rule infrav1.EgressRule) bool {
+	rule.Description = ""
+	for _, r := range rules {
+		r.Description = ""
+		if r.Equals(&rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultEgressPermission is the outbound rule AWS adds to every new security group.
+func defaultEgressPermission() types.IpPermission {
+	return types.IpPermission{
+		IpProtocol: aws.String(string(infrav1.SecurityGroupProtocolAll)),
+		IpRanges:   []types.IpRange{{CidrIp: aws.String(services.AnyIPv4CidrBlock)}},
+	}
+}
+
+// expandEgressRules expand the given egress rules so that it's compatible with the list generated by
+// egressRulesFromSDKType.
+func expandEgressRules(rules infrav1.EgressRules) infrav1.EgressRules {
+	ingressRules := make(infrav1.IngressRules, 0, len(rules))
+	for i := range rules {
+		ingressRules = append(ingressRules, egressRuleToIngressRule(&rules[i]))
+	}
+
+	expanded := expandIngressRules(ingressRules)
+	res := make(infrav1.EgressRules, 0, len(expanded))
+	for i := range expanded {
+		res = append(res, ingressRuleToEgressRule(&expanded[i]))
+	}
+	return res
+}
+
 // expandIngressRules expand the given ingress rules so that it's compatible with the list generated by
 // ingressRulesFromSDKType.
 // We assume that processIngressRulesSGs has been already called on the input, so the SourceSecurityGroupRoles have
@@ -216,7 +324,7 @@ func expandIngressRules(rules infrav1.IngressRules) infrav1.IngressRules {
 		}
 
 		// Nothing to expand
-		if len(rule.CidrBlocks) == 0 && len(rule.IPv6CidrBlocks) == 0 && len(rule.SourceSecurityGroupIDs) == 0 {
+		if len(rule.CidrBlocks) == 0 && len(rule.IPv6CidrBlocks) == 0 && len(rule.SourceSecurityGroupIDs) == 0 && len(rule.SourcePrefixListIDs) == 0 {
 			res = append(res, base)
 			continue
 		}
@@ -238,6 +346,12 @@ func expandIngressRules(rules infrav1.IngressRules) infrav1.IngressRules {
 			rcopy.SourceSecurityGroupIDs = []string{src}
 			res = append(res, rcopy)
 		}
+
+		for _, src := range rule.SourcePrefixListIDs {
+			rcopy := base
+			rcopy.SourcePrefixListIDs = []string{src}
+			res = append(res, rcopy)
+		}
 	}
 	return res
 }
@@ -301,6 +415,9 @@ func (s *Service) ec2SecurityGroupToSecurityGroup(ec2SecurityGroup types.Securit
 	for _, ec2rule := range ec2SecurityGroup.IpPermissions {
 		sg.IngressRules = append(sg.IngressRules, ingressRulesFromSDKType(ec2rule)...)
 	}
+	for _, ec2rule := range ec2SecurityGroup.IpPermissionsEgress {
+		sg.EgressRules = append(sg.EgressRules, egressRulesFromSDKType(ec2rule)...)
+	}
 	return sg
 }
 
@@ -385,7 +502,13 @@ func (s *Service) describeClusterOwnedSecurityGroups() ([]infrav1.SecurityGroup,
 		}
 
 		for _, group := range out.SecurityGroups {
-			groups = append(groups, makeInfraSecurityGroup(group))
+			sg := makeInfraSecurityGroup(group)
+			// The interface VPC endpoints security group is deleted by the network service, once the
+			// endpoints that use it are gone.
+			if sg.Tags.GetRole() == infrav1.VPCEndpointRoleTagValue {
+				continue
+			}
+			groups = append(groups, sg)
 		}
 	}
 
@@ -447,7 +570,7 @@ func (s *Service) revokeIngressAndEgressRulesFromVPCDefaultSecurityGroup() error
 		return errors.Wrapf(err, "failed to revoke ingress rules from vpc default security group %q in VPC %q", defaultSecurityGroupID, s.scope.VPC().ID)
 	}
 
-	egressRules := infrav1.IngressRules{
+	egressRules := infrav1.EgressRules{
 		{
 			Protocol:   infrav1.SecurityGroupProtocolAll,
 			FromPort:   -1,
@@ -526,11 +649,26 @@ func (s *Service) revokeSecurityGroupIngressRules(id string, rules infrav1.Ingre
 	return nil
 }
 
-func (s *Service) revokeSecurityGroupEgressRules(id string, rules infrav1.IngressRules) error {
+func (s *Service) authorizeSecurityGroupEgressRules(id string, rules infrav1.EgressRules) error {
+	input := &ec2.AuthorizeSecurityGroupEgressInput{GroupId: aws.String(id)}
+	for i := range rules {
+		rule := rules[i]
+		input.IpPermissions = append(input.IpPermissions, *egressRuleToSDKType(s.scope, &rule))
+	}
+	if _, err := s.EC2Client.AuthorizeSecurityGroupEgress(context.TODO(), input); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAuthorizeSecurityGroupEgressRules", "Failed to authorize security group egress rules %v for SecurityGroup %q: %v", rules, id, err)
+		return errors.Wrapf(err, "failed to authorize security group %q egress rules: %v", id, rules)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAuthorizeSecurityGroupEgressRules", "Authorized security group egress rules %v for SecurityGroup %q", rules, id)
+	return nil
+}
+
+func (s *Service) revokeSecurityGroupEgressRules(id string, rules infrav1.EgressRules) error {
 	input := &ec2.RevokeSecurityGroupEgressInput{GroupId: aws.String(id)}
 	for i := range rules {
 		rule := rules[i]
-		input.IpPermissions = append(input.IpPermissions, *ingressRuleToSDKType(s.scope, &rule))
+		input.IpPermissions = append(input.IpPermissions, *egressRuleToSDKType(s.scope, &rule))
 	}
 
 	if _, err := s.EC2Client.RevokeSecurityGroupEgress(context.TODO(), input); err != nil && !awserrors.IsPermissionNotFoundError(errors.Cause(err)) {
@@ -768,6 +906,47 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 	return nil, errors.Errorf("Cannot determine ingress rules for unknown security group role %q", role)
 }
 
+// getSecurityGroupEgressRules returns the egress rules of the security group with the given role, and
+// whether egress rules are declared for it at all.
+func (s *Service) getSecurityGroupEgressRules(role infrav1.SecurityGroupRole) (infrav1.EgressRules, bool, error) {
+	rules, managed := s.scope.SecurityGroupEgressRules()[role]
+
+	var additionalEgressRules []infrav1.EgressRule
+	switch role {
+	case infrav1.SecurityGroupControlPlane:
+		additionalEgressRules = s.scope.AdditionalControlPlaneEgressRules()
+	case infrav1.SecurityGroupNode, infrav1.SecurityGroupEKSNodeAdditional:
+		additionalEgressRules = s.scope.AdditionalNodeEgressRules()
+	}
+	if len(additionalEgressRules) > 0 {
+		managed = true
+		rules = append(rules, additionalEgressRules...)
+	}
+
+	if !managed {
+		return nil, false, nil
+	}
+
+	output := make(infrav1.EgressRules, 0, len(rules))
+	for _, rule := range rules {
+		if len(rule.DestinationSecurityGroupRoles) > 0 {
+			securityGroupIDs := sets.New(rule.DestinationSecurityGroupIDs...)
+			for _, destinationSGRole := range rule.DestinationSecurityGroupRoles {
+				sg, ok := s.scope.SecurityGroups()[destinationSGRole]
+				if !ok {
+					return nil, false, errors.Errorf("security group for role %q referenced by egress rule %q is not available", destinationSGRole, rule.Description)
+				}
+				securityGroupIDs.Insert(sg.ID)
+			}
+			rule.DestinationSecurityGroupIDs = sets.List(securityGroupIDs)
+			rule.DestinationSecurityGroupRoles = nil
+		}
+		output = append(output, rule)
+	}
+
+	return output, true, nil
+}
+
 func (s *Service) getSecurityGroupName(clusterName string, role infrav1.SecurityGroupRole) string {
 	groupPrefix := clusterName
 	if strings.HasPrefix(clusterName, "sg-") {
@@ -878,9 +1057,61 @@ func ingressRuleToSDKType(scope scope.SGScope, i *infrav1.IngressRule) (res *typ
 		res.UserIdGroupPairs = append(res.UserIdGroupPairs, userIDGroupPair)
 	}
 
+	for _, prefixListID := range i.SourcePrefixListIDs {
+		prefixList := types.PrefixListId{
+			PrefixListId: aws.String(prefixListID),
+		}
+
+		if i.Description != "" {
+			prefixList.Description = aws.String(i.Description)
+		}
+
+		res.PrefixListIds = append(res.PrefixListIds, prefixList)
+	}
+
+	return res
+}
+
+func egressRuleToSDKType(scope scope.SGScope, e *infrav1.EgressRule) *types.IpPermission {
+	// Outbound permissions have the same shape as inbound ones, the user id group pairs
+	// referencing the destination rather than the source security groups.
+	rule := egressRuleToIngressRule(e)
+	return ingressRuleToSDKType(scope, &rule)
+}
+
+func egressRulesFromSDKType(v types.IpPermission) (res infrav1.EgressRules) {
+	for _, rule := range ingressRulesFromSDKType(v) {
+		res = append(res, ingressRuleToEgressRule(&rule))
+	}
 	return res
 }
 
+func egressRuleToIngressRule(e *infrav1.EgressRule) infrav1.IngressRule {
+	return infrav1.IngressRule{
+		Description:            e.Description,
+		Protocol:               e.Protocol,
+		FromPort:               e.FromPort,
+		ToPort:                 e.ToPort,
+		CidrBlocks:             e.CidrBlocks,
+		IPv6CidrBlocks:         e.IPv6CidrBlocks,
+		SourceSecurityGroupIDs: e.DestinationSecurityGroupIDs,
+		SourcePrefixListIDs:    e.DestinationPrefixListIDs,
+	}
+}
+
+func ingressRuleToEgressRule(i *infrav1.IngressRule) infrav1.EgressRule {
+	return infrav1.EgressRule{
+		Description:                 i.Description,
+		Protocol:                    i.Protocol,
+		FromPort:                    i.FromPort,
+		ToPort:                      i.ToPort,
+		CidrBlocks:                  i.CidrBlocks,
+		IPv6CidrBlocks:              i.IPv6CidrBlocks,
+		DestinationSecurityGroupIDs: i.SourceSecurityGroupIDs,
+		DestinationPrefixListIDs:    i.SourcePrefixListIDs,
+	}
+}
+
 func ingressRulesFromSDKType(v types.IpPermission) (res infrav1.IngressRules) {
 	for _, ec2range := range v.IpRanges {
 		rule := ingressRuleFromSDKProtocol(v)
@@ -916,6 +1147,20 @@ func ingressRulesFromSDKType(v types.IpPermission) (res infrav1.IngressRules) {
 		res = append(res, rule)
 	}
 
+	for _, prefixList := range v.PrefixListIds {
+		rule := ingressRuleFromSDKProtocol(v)
+		if prefixList.PrefixListId == nil {
+			continue
+		}
+
+		if prefixList.Description != nil && *prefixList.Description != "" {
+			rule.Description = *prefixList.Description
+		}
+
+		rule.SourcePrefixListIDs = []string{*prefixList.PrefixListId}
+		res = append(res, rule)
+	}
+
 	return res
 }
 
@@ -1056,7 +1301,7 @@ func (s *Service) processIngressRulesSGs(ingressRules []infrav1.IngressRule) (in
 			return nil, errors.New("NAT Gateway IPs are not available yet")
 		}
 
-		if len(rule.CidrBlocks) != 0 || len(rule.IPv6CidrBlocks) != 0 { // don't set source security group if cidr blocks are set
+		if len(rule.CidrBlocks) != 0 || len(rule.IPv6CidrBlocks) != 0 || len(rule.SourcePrefixListIDs) != 0 { // don't set source security group if cidr blocks or prefix lists are set
 			output = append(output, rule)
 			continue
 		}
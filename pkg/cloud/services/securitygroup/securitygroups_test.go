@@ -279,6 +279,48 @@ func TestReconcileSecurityGroups(t *testing.T) {
 					After(securityGroupNode)
 			},
 		},
+		{
+			name: "new security groups lose the default egress rule in the same pass",
+			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
+				return acl
+			},
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID:                "vpc-securitygroups",
+					InternetGatewayID: aws.String("igw-01"),
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-securitygroups-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+				SecurityGroupEgressRules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+					infrav1.SecurityGroupBastion: {},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroups(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+					Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+				m.CreateSecurityGroup(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateSecurityGroupInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateSecurityGroupInput, _ ...func(*ec2.Options)) (*ec2.CreateSecurityGroupOutput, error) {
+						return &ec2.CreateSecurityGroupOutput{GroupId: aws.String("sg-" + strings.TrimPrefix(aws.ToString(input.GroupName), "test-cluster-"))}, nil
+					}).Times(5)
+				m.AuthorizeSecurityGroupIngress(context.TODO(), gomock.AssignableToTypeOf(&ec2.AuthorizeSecurityGroupIngressInput{})).
+					Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil).Times(4)
+				m.RevokeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-bastion"),
+					IpPermissions: []types.IpPermission{{
+						IpProtocol: aws.String("-1"),
+						IpRanges:   []types.IpRange{{CidrIp: aws.String(services.AnyIPv4CidrBlock)}},
+					}},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+			},
+		},
 		{
 			name: "NLB is defined with preserve client IP disabled",
 			awsCluster: func(acl infrav1.AWSCluster) infrav1.AWSCluster {
@@ -2034,6 +2076,28 @@ func TestDeleteSecurityGroups(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should leave the VPC endpoint SG to the network service",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{ID: "vpc-id"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroups(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{}), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []types.SecurityGroup{
+						{
+							GroupId:   aws.String("group-id"),
+							GroupName: aws.String("test-cluster-vpc-endpoint"),
+							Tags: []types.Tag{
+								{
+									Key:   aws.String(infrav1.NameAWSClusterAPIRole),
+									Value: aws.String(infrav1.VPCEndpointRoleTagValue),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+		},
 		{
 			name: "Should delete SG successfully",
 			input: &infrav1.NetworkSpec{
@@ -2214,6 +2278,53 @@ func TestIngressRulesFromSDKType(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Mix of prefix lists and cidr blocks",
+			input: types.IpPermission{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int32(6443),
+				ToPort:     aws.Int32(6443),
+				IpRanges: []types.IpRange{
+					{
+						CidrIp:      aws.String("192.168.1.1/32"),
+						Description: aws.String("My VPN"),
+					},
+				},
+				PrefixListIds: []types.PrefixListId{
+					{
+						PrefixListId: aws.String("pl-12345"),
+						Description:  aws.String("Office"),
+					},
+					{
+						PrefixListId: aws.String("pl-67890"),
+						Description:  aws.String("Office"),
+					},
+				},
+			},
+			expected: infrav1.IngressRules{
+				{
+					Description: "My VPN",
+					Protocol:    "tcp",
+					FromPort:    6443,
+					ToPort:      6443,
+					CidrBlocks:  []string{"192.168.1.1/32"},
+				},
+				{
+					Description:         "Office",
+					Protocol:            "tcp",
+					FromPort:            6443,
+					ToPort:              6443,
+					SourcePrefixListIDs: []string{"pl-12345"},
+				},
+				{
+					Description:         "Office",
+					Protocol:            "tcp",
+					FromPort:            6443,
+					ToPort:              6443,
+					SourcePrefixListIDs: []string{"pl-67890"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2348,6 +2459,42 @@ func TestExpandIngressRules(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "prefix lists expand",
+			input: infrav1.IngressRules{
+				{
+					Description:         "SSH",
+					Protocol:            infrav1.SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					CidrBlocks:          []string{"0.0.0.0/0"},
+					SourcePrefixListIDs: []string{"pl-1", "pl-2"},
+				},
+			},
+			expected: infrav1.IngressRules{
+				{
+					Description: "SSH",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    22,
+					ToPort:      22,
+					CidrBlocks:  []string{"0.0.0.0/0"},
+				},
+				{
+					Description:         "SSH",
+					Protocol:            infrav1.SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-1"},
+				},
+				{
+					Description:         "SSH",
+					Protocol:            infrav1.SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-2"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2541,3 +2688,266 @@ func TestNodePortServicesIngressRules(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileEgressRules(t *testing.T) {
+	allowAll := infrav1.EgressRule{
+		Protocol:   infrav1.SecurityGroupProtocolAll,
+		CidrBlocks: []string{services.AnyIPv4CidrBlock},
+	}
+	allowAllPermission := types.IpPermission{
+		IpProtocol: aws.String("-1"),
+		IpRanges: []types.IpRange{
+			{CidrIp: aws.String(services.AnyIPv4CidrBlock)},
+		},
+	}
+	https := infrav1.EgressRule{
+		Description: "HTTPS",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    443,
+		ToPort:      443,
+		CidrBlocks:  []string{"10.0.0.0/16"},
+	}
+	httpsPermission := types.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int32(443),
+		ToPort:     aws.Int32(443),
+		IpRanges: []types.IpRange{
+			{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("HTTPS")},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		role    infrav1.SecurityGroupRole
+		network infrav1.NetworkSpec
+		current infrav1.EgressRules
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		err     string
+	}{
+		{
+			name:    "no egress rules declared, default rule is kept",
+			role:    infrav1.SecurityGroupNode,
+			current: infrav1.EgressRules{allowAll},
+			expect:  func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "additional node egress rules replace the default rule",
+			role: infrav1.SecurityGroupNode,
+			network: infrav1.NetworkSpec{
+				AdditionalNodeEgressRules: []infrav1.EgressRule{
+					https,
+					{
+						Description:                   "Kubernetes API",
+						Protocol:                      infrav1.SecurityGroupProtocolTCP,
+						FromPort:                      6443,
+						ToPort:                        6443,
+						DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupControlPlane},
+					},
+				},
+			},
+			current: infrav1.EgressRules{allowAll},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					m.AuthorizeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+						GroupId: aws.String("sg-node"),
+						IpPermissions: []types.IpPermission{
+							httpsPermission,
+							{
+								IpProtocol: aws.String("tcp"),
+								FromPort:   aws.Int32(6443),
+								ToPort:     aws.Int32(6443),
+								UserIdGroupPairs: []types.UserIdGroupPair{
+									{GroupId: aws.String("sg-control"), Description: aws.String("Kubernetes API")},
+								},
+							},
+						},
+					})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil),
+					m.RevokeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+						GroupId:       aws.String("sg-node"),
+						IpPermissions: []types.IpPermission{allowAllPermission},
+					})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil),
+				)
+			},
+		},
+		{
+			name: "rules only changing the description are authorized after the stale rule is revoked",
+			role: infrav1.SecurityGroupControlPlane,
+			network: infrav1.NetworkSpec{
+				AdditionalControlPlaneEgressRules: []infrav1.EgressRule{https},
+			},
+			current: infrav1.EgressRules{
+				allowAll,
+				{
+					Description: "HTTPS to the VPC",
+					Protocol:    infrav1.SecurityGroupProtocolTCP,
+					FromPort:    443,
+					ToPort:      443,
+					CidrBlocks:  []string{"10.0.0.0/16"},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				gomock.InOrder(
+					m.RevokeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+						GroupId: aws.String("sg-control"),
+						IpPermissions: []types.IpPermission{
+							allowAllPermission,
+							{
+								IpProtocol: aws.String("tcp"),
+								FromPort:   aws.Int32(443),
+								ToPort:     aws.Int32(443),
+								IpRanges: []types.IpRange{
+									{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("HTTPS to the VPC")},
+								},
+							},
+						},
+					})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil),
+					m.AuthorizeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+						GroupId:       aws.String("sg-control"),
+						IpPermissions: []types.IpPermission{httpsPermission},
+					})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil),
+				)
+			},
+		},
+		{
+			name: "egress rules up to date",
+			role: infrav1.SecurityGroupControlPlane,
+			network: infrav1.NetworkSpec{
+				AdditionalControlPlaneEgressRules: []infrav1.EgressRule{https},
+			},
+			current: infrav1.EgressRules{https},
+			expect:  func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "role listed without rules denies all outbound traffic",
+			role: infrav1.SecurityGroupBastion,
+			network: infrav1.NetworkSpec{
+				SecurityGroupEgressRules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+					infrav1.SecurityGroupBastion: {},
+				},
+			},
+			current: infrav1.EgressRules{allowAll},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.RevokeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId:       aws.String("sg-bastion"),
+					IpPermissions: []types.IpPermission{allowAllPermission},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+		{
+			name: "per role egress rules are combined with the additional rules",
+			role: infrav1.SecurityGroupControlPlane,
+			network: infrav1.NetworkSpec{
+				AdditionalControlPlaneEgressRules: []infrav1.EgressRule{https},
+				SecurityGroupEgressRules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+					infrav1.SecurityGroupControlPlane: {allowAll},
+				},
+			},
+			current: infrav1.EgressRules{allowAll},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.AuthorizeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId:       aws.String("sg-control"),
+					IpPermissions: []types.IpPermission{httpsPermission},
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+		{
+			name: "unknown destination role",
+			role: infrav1.SecurityGroupNode,
+			network: infrav1.NetworkSpec{
+				AdditionalNodeEgressRules: []infrav1.EgressRule{
+					{
+						Description:                   "Load balancer",
+						Protocol:                      infrav1.SecurityGroupProtocolTCP,
+						FromPort:                      443,
+						ToPort:                        443,
+						DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupLB},
+					},
+				},
+			},
+			current: infrav1.EgressRules{allowAll},
+			expect:  func(m *mocks.MockEC2APIMockRecorder) {},
+			err:     `security group for role "lb" referenced by egress rule "Load balancer" is not available`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: tc.network,
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+								infrav1.SecurityGroupBastion:      {ID: "sg-bastion"},
+								infrav1.SecurityGroupControlPlane: {ID: "sg-control"},
+								infrav1.SecurityGroupNode:         {ID: "sg-node"},
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			sg := cs.SecurityGroups()[tc.role]
+			sg.EgressRules = tc.current
+			err = s.reconcileEgressRules(tc.role, sg)
+			if tc.err != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.err)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestEgressRulesFromSDKType(t *testing.T) {
+	g := NewWithT(t)
+
+	output := egressRulesFromSDKType(types.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int32(443),
+		ToPort:     aws.Int32(443),
+		IpRanges: []types.IpRange{
+			{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("HTTPS")},
+		},
+		UserIdGroupPairs: []types.UserIdGroupPair{
+			{GroupId: aws.String("sg-12345"), Description: aws.String("HTTPS")},
+		},
+	})
+
+	g.Expect(output).To(Equal(infrav1.EgressRules{
+		{
+			Description: "HTTPS",
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    443,
+			ToPort:      443,
+			CidrBlocks:  []string{"10.0.0.0/16"},
+		},
+		{
+			Description:                 "HTTPS",
+			Protocol:                    infrav1.SecurityGroupProtocolTCP,
+			FromPort:                    443,
+			ToPort:                      443,
+			DestinationSecurityGroupIDs: []string{"sg-12345"},
+		},
+	}))
+}
@@ -72,6 +72,26 @@ func (mr *MockSTSClientMockRecorder) AssumeRole(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssumeRole", reflect.TypeOf((*MockSTSClient)(nil).AssumeRole), varargs...)
 }
 
+// AssumeRoleWithWebIdentity mocks base method.
+func (m *MockSTSClient) AssumeRoleWithWebIdentity(arg0 context.Context, arg1 *sts.AssumeRoleWithWebIdentityInput, arg2 ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssumeRoleWithWebIdentity", varargs...)
+	ret0, _ := ret[0].(*sts.AssumeRoleWithWebIdentityOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssumeRoleWithWebIdentity indicates an expected call of AssumeRoleWithWebIdentity.
+func (mr *MockSTSClientMockRecorder) AssumeRoleWithWebIdentity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssumeRoleWithWebIdentity", reflect.TypeOf((*MockSTSClient)(nil).AssumeRoleWithWebIdentity), varargs...)
+}
+
 // GetCallerIdentity mocks base method.
 func (m *MockSTSClient) GetCallerIdentity(arg0 context.Context, arg1 *sts.GetCallerIdentityInput, arg2 ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
 	m.ctrl.T.Helper()
@@ -29,6 +29,7 @@ type STSClient interface {
 	GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
 	PresignGetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.PresignOptions)) (*signerv4.PresignedHTTPRequest, error)
 	AssumeRole(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error)
+	AssumeRoleWithWebIdentity(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error)
 }
 
 // ClientWrapper wraps both the regular STS client and presign client to implement STSClient interface.
@@ -60,5 +61,10 @@ func (c *ClientWrapper) AssumeRole(ctx context.Context, params *sts.AssumeRoleIn
 	return c.client.AssumeRole(ctx, params, optFns...)
 }
 
+// AssumeRoleWithWebIdentity calls the STS AssumeRoleWithWebIdentity operation.
+func (c *ClientWrapper) AssumeRoleWithWebIdentity(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	return c.client.AssumeRoleWithWebIdentity(ctx, params, optFns...)
+}
+
 // Ensure our wrapper implements the STSClient interface.
 var _ STSClient = (*ClientWrapper)(nil)
@@ -44,6 +44,8 @@ func TestEKSAddonPlan(t *testing.T) {
 	addonPreserve := false
 	created := time.Now()
 	maxActiveUpdateDeleteWait := 30 * time.Minute
+	podIdentityServiceAccount := "addon1-sa"
+	podIdentityRoleARN := "arn:aws:iam::123456789012:role/addon1"
 
 	testCases := []struct {
 		name              string
@@ -242,6 +244,61 @@ func TestEKSAddonPlan(t *testing.T) {
 			expectCreateError: false,
 			expectDoError:     false,
 		},
+		{
+			name: "1 installed and 1 desired - pod identity association added",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					UpdateAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdateAddonInput{
+						AddonName:        aws.String(addon1Name),
+						AddonVersion:     aws.String(addon1version),
+						ClusterName:      aws.String(clusterName),
+						ResolveConflicts: ekstypes.ResolveConflictsOverwrite,
+						PodIdentityAssociations: []ekstypes.AddonPodIdentityAssociations{
+							{RoleArn: aws.String(podIdentityRoleARN), ServiceAccount: aws.String(podIdentityServiceAccount)},
+						},
+					})).
+					Return(&eks.UpdateAddonOutput{
+						Update: &ekstypes.Update{
+							CreatedAt: &created,
+							Id:        aws.String("someid"),
+							Status:    ekstypes.UpdateStatus(ekstypes.AddonStatusUpdating),
+							Type:      ekstypes.UpdateTypeAddonUpdate,
+						},
+					}, nil)
+
+				out := &eks.DescribeAddonOutput{
+					Addon: &ekstypes.Addon{
+						Status: ekstypes.AddonStatusActive,
+					},
+				}
+				m.DescribeAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.DescribeAddonInput{
+					AddonName:   aws.String(addon1Name),
+					ClusterName: aws.String(clusterName),
+				})).Return(out, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddonWithPodIdentity(addon1Name, addon1version, podIdentityServiceAccount, podIdentityRoleARN),
+			},
+			installedAddons: []*EKSAddon{
+				createInstalledAddon(addon1Name, addon1version, addonARN, addonStatusActive, addonPreserve),
+			},
+			expectCreateError: false,
+			expectDoError:     false,
+		},
+		{
+			name: "1 installed and 1 desired - same pod identity associations",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				// No Action expected
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddonWithPodIdentity(addon1Name, addon1version, podIdentityServiceAccount, podIdentityRoleARN),
+			},
+			installedAddons: []*EKSAddon{
+				createInstalledAddonWithPodIdentity(addon1Name, addon1version, addonARN, addonStatusActive, podIdentityServiceAccount, podIdentityRoleARN),
+			},
+			expectCreateError: false,
+			expectDoError:     false,
+		},
 		{
 			name: "1 installed and 1 desired - version upgrade in progress",
 			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
@@ -484,3 +541,21 @@ func createInstalledAddon(name, version, arn, status string, preserve bool) *EKS
 
 	return desired
 }
+
+func createDesiredAddonWithPodIdentity(name, version, serviceAccount, roleARN string) *EKSAddon {
+	desired := createDesiredAddon(name, version)
+	desired.PodIdentityAssociations = []ekstypes.AddonPodIdentityAssociations{
+		{RoleArn: aws.String(roleARN), ServiceAccount: aws.String(serviceAccount)},
+	}
+
+	return desired
+}
+
+func createInstalledAddonWithPodIdentity(name, version, arn, status, serviceAccount, roleARN string) *EKSAddon {
+	installed := createInstalledAddon(name, version, arn, status, false)
+	installed.PodIdentityAssociations = []ekstypes.AddonPodIdentityAssociations{
+		{RoleArn: aws.String(roleARN), ServiceAccount: aws.String(serviceAccount)},
+	}
+
+	return installed
+}
@@ -80,12 +80,13 @@ func (p *UpdateAddonProcedure) Do(ctx context.Context) error {
 	}
 
 	input := &eks.UpdateAddonInput{
-		AddonName:             desired.Name,
-		AddonVersion:          desired.Version,
-		ClusterName:           &p.plan.clusterName,
-		ConfigurationValues:   desired.Configuration,
-		ResolveConflicts:      converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
-		ServiceAccountRoleArn: desired.ServiceAccountRoleARN,
+		AddonName:               desired.Name,
+		AddonVersion:            desired.Version,
+		ClusterName:             &p.plan.clusterName,
+		ConfigurationValues:     desired.Configuration,
+		ResolveConflicts:        converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
+		ServiceAccountRoleArn:   desired.ServiceAccountRoleARN,
+		PodIdentityAssociations: desired.PodIdentityAssociations,
 	}
 
 	if _, err := p.plan.eksClient.UpdateAddon(ctx, input); err != nil {
@@ -149,13 +150,14 @@ func (p *CreateAddonProcedure) Do(ctx context.Context) error {
 	}
 
 	input := &eks.CreateAddonInput{
-		AddonName:             desired.Name,
-		AddonVersion:          desired.Version,
-		ClusterName:           &p.plan.clusterName,
-		ConfigurationValues:   desired.Configuration,
-		ServiceAccountRoleArn: desired.ServiceAccountRoleARN,
-		ResolveConflicts:      converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
-		Tags:                  desired.Tags,
+		AddonName:               desired.Name,
+		AddonVersion:            desired.Version,
+		ClusterName:             &p.plan.clusterName,
+		ConfigurationValues:     desired.Configuration,
+		ServiceAccountRoleArn:   desired.ServiceAccountRoleARN,
+		ResolveConflicts:        converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
+		Tags:                    desired.Tags,
+		PodIdentityAssociations: desired.PodIdentityAssociations,
 	}
 
 	output, err := p.plan.eksClient.CreateAddon(ctx, input)
@@ -17,6 +17,8 @@ limitations under the License.
 package addons
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/google/go-cmp/cmp"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -33,6 +35,8 @@ type EKSAddon struct {
 	Preserve              bool
 	ARN                   *string
 	Status                *string
+	// PodIdentityAssociations are the service accounts of the addon and the roles associated with them.
+	PodIdentityAssociations []ekstypes.AddonPodIdentityAssociations
 }
 
 // IsEqual determines if 2 EKSAddon are equal.
@@ -50,6 +54,11 @@ func (e *EKSAddon) IsEqual(other *EKSAddon, includeTags bool) bool {
 	if !cmp.Equal(e.Configuration, other.Configuration) {
 		return false
 	}
+	// Pod identity associations are only compared when desired, so the associations of addons
+	// that do not reference any are left untouched.
+	if len(e.PodIdentityAssociations) > 0 && !podIdentityAssociationsEqual(e.PodIdentityAssociations, other.PodIdentityAssociations) {
+		return false
+	}
 
 	if includeTags {
 		diffTags := e.Tags.Difference(other.Tags)
@@ -60,3 +69,23 @@ func (e *EKSAddon) IsEqual(other *EKSAddon, includeTags bool) bool {
 
 	return true
 }
+
+func podIdentityAssociationsEqual(a, b []ekstypes.AddonPodIdentityAssociations) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	roles := map[string]string{}
+	for _, association := range a {
+		roles[aws.ToString(association.ServiceAccount)] = aws.ToString(association.RoleArn)
+	}
+	for _, association := range b {
+		role, ok := roles[aws.ToString(association.ServiceAccount)]
+		if !ok || role != aws.ToString(association.RoleArn) {
+			return false
+		}
+		delete(roles, aws.ToString(association.ServiceAccount))
+	}
+
+	return len(roles) == 0
+}
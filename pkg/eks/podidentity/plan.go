@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package podidentity provides a plan to manage EKS Pod Identity associations.
+package podidentity
+
+import (
+	"context"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/planner"
+)
+
+// NewPlan creates a new Plan to manage EKS Pod Identity associations.
+func NewPlan(clusterName string, desiredAssociations, installedAssociations []*EKSPodIdentityAssociation, client eks.Client) planner.Plan {
+	return &plan{
+		installedAssociations: installedAssociations,
+		desiredAssociations:   desiredAssociations,
+		eksClient:             client,
+		clusterName:           clusterName,
+	}
+}
+
+// Plan is a plan that will manage EKS Pod Identity associations.
+type plan struct {
+	installedAssociations []*EKSPodIdentityAssociation
+	desiredAssociations   []*EKSPodIdentityAssociation
+	eksClient             eks.Client
+	clusterName           string
+}
+
+// Create will create the plan (i.e. list of procedures) for managing EKS Pod Identity associations.
+func (p *plan) Create(_ context.Context) ([]planner.Procedure, error) {
+	procedures := []planner.Procedure{}
+
+	// Handle create and update
+	for i := range p.desiredAssociations {
+		desired := p.desiredAssociations[i]
+		installed := p.getInstalled(desired.Key())
+		if installed == nil {
+			procedures = append(procedures, &CreateAssociationProcedure{plan: p, key: desired.Key()})
+			continue
+		}
+
+		diffTags := desired.Tags.Difference(installed.Tags)
+		if len(diffTags) > 0 {
+			procedures = append(procedures, &UpdateAssociationTagsProcedure{plan: p, key: installed.Key()})
+		}
+		if !desired.IsEqual(installed, false) {
+			procedures = append(procedures, &UpdateAssociationProcedure{plan: p, key: installed.Key()})
+		}
+	}
+
+	// Look for deletions
+	for i := range p.installedAssociations {
+		installed := p.installedAssociations[i]
+		if p.getDesired(installed.Key()) == nil {
+			procedures = append(procedures, &DeleteAssociationProcedure{plan: p, key: installed.Key()})
+		}
+	}
+
+	return procedures, nil
+}
+
+func (p *plan) getInstalled(key string) *EKSPodIdentityAssociation {
+	for i := range p.installedAssociations {
+		installed := p.installedAssociations[i]
+		if installed.Key() == key {
+			return installed
+		}
+	}
+
+	return nil
+}
+
+func (p *plan) getDesired(key string) *EKSPodIdentityAssociation {
+	for i := range p.desiredAssociations {
+		desired := p.desiredAssociations[i]
+		if desired.Key() == key {
+			return desired
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podidentity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+)
+
+func TestEKSPodIdentityAssociationPlan(t *testing.T) {
+	clusterName := "default.cluster"
+	namespace := "kube-system"
+	serviceAccount := "ebs-csi-controller-sa"
+	roleARN := "arn:aws:iam::123456789012:role/ebs-csi"
+	otherRoleARN := "arn:aws:iam::123456789012:role/ebs-csi-v2"
+	associationID := "a-abcdefghijklmnop1"
+	associationARN := "arn:aws:eks:us-east-1:123456789012:podidentityassociation/default.cluster/a-abcdefghijklmnop1"
+
+	testCases := []struct {
+		name                  string
+		desiredAssociations   []*EKSPodIdentityAssociation
+		installedAssociations []*EKSPodIdentityAssociation
+		expect                func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectDoError         bool
+	}{
+		{
+			name: "no desired and no installed",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				// Do nothing
+			},
+		},
+		{
+			name: "no installed and 1 desired",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					CreatePodIdentityAssociation(gomock.Eq(context.TODO()), gomock.Eq(&eks.CreatePodIdentityAssociationInput{
+						ClusterName:    aws.String(clusterName),
+						Namespace:      aws.String(namespace),
+						ServiceAccount: aws.String(serviceAccount),
+						RoleArn:        aws.String(roleARN),
+						Tags:           createTags(),
+					})).
+					Return(&eks.CreatePodIdentityAssociationOutput{
+						Association: &ekstypes.PodIdentityAssociation{
+							AssociationId:  aws.String(associationID),
+							AssociationArn: aws.String(associationARN),
+						},
+					}, nil)
+			},
+			desiredAssociations: []*EKSPodIdentityAssociation{
+				createDesiredAssociation(namespace, serviceAccount, roleARN),
+			},
+		},
+		{
+			name: "1 installed and 1 desired with the same role",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				// Do nothing
+			},
+			desiredAssociations: []*EKSPodIdentityAssociation{
+				createDesiredAssociation(namespace, serviceAccount, roleARN),
+			},
+			installedAssociations: []*EKSPodIdentityAssociation{
+				createInstalledAssociation(namespace, serviceAccount, roleARN, associationID, associationARN),
+			},
+		},
+		{
+			name: "1 installed and 1 desired with a different role",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					UpdatePodIdentityAssociation(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdatePodIdentityAssociationInput{
+						AssociationId: aws.String(associationID),
+						ClusterName:   aws.String(clusterName),
+						RoleArn:       aws.String(otherRoleARN),
+					})).
+					Return(&eks.UpdatePodIdentityAssociationOutput{}, nil)
+			},
+			desiredAssociations: []*EKSPodIdentityAssociation{
+				createDesiredAssociation(namespace, serviceAccount, otherRoleARN),
+			},
+			installedAssociations: []*EKSPodIdentityAssociation{
+				createInstalledAssociation(namespace, serviceAccount, roleARN, associationID, associationARN),
+			},
+		},
+		{
+			name: "1 installed and 1 desired with additional tags",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					TagResource(gomock.Eq(context.TODO()), gomock.Eq(&eks.TagResourceInput{
+						ResourceArn: aws.String(associationARN),
+						Tags:        createTagsAdditional(),
+					})).
+					Return(&eks.TagResourceOutput{}, nil)
+			},
+			desiredAssociations: []*EKSPodIdentityAssociation{
+				createDesiredAssociationExtraTag(namespace, serviceAccount, roleARN),
+			},
+			installedAssociations: []*EKSPodIdentityAssociation{
+				createInstalledAssociation(namespace, serviceAccount, roleARN, associationID, associationARN),
+			},
+		},
+		{
+			name: "1 installed and 0 desired",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					DeletePodIdentityAssociation(gomock.Eq(context.TODO()), gomock.Eq(&eks.DeletePodIdentityAssociationInput{
+						AssociationId: aws.String(associationID),
+						ClusterName:   aws.String(clusterName),
+					})).
+					Return(&eks.DeletePodIdentityAssociationOutput{}, nil)
+			},
+			installedAssociations: []*EKSPodIdentityAssociation{
+				createInstalledAssociation(namespace, serviceAccount, roleARN, associationID, associationARN),
+			},
+		},
+		{
+			name: "create fails",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					CreatePodIdentityAssociation(gomock.Eq(context.TODO()), gomock.Any()).
+					Return(nil, errors.New("role not found"))
+			},
+			desiredAssociations: []*EKSPodIdentityAssociation{
+				createDesiredAssociation(namespace, serviceAccount, roleARN),
+			},
+			expectDoError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			tc.expect(eksMock.EXPECT())
+
+			ctx := context.TODO()
+
+			planner := NewPlan(clusterName, tc.desiredAssociations, tc.installedAssociations, eksMock)
+			procedures, err := planner.Create(ctx)
+			g.Expect(err).To(BeNil())
+			g.Expect(procedures).NotTo(BeNil())
+
+			for _, proc := range procedures {
+				procErr := proc.Do(ctx)
+				if tc.expectDoError {
+					g.Expect(procErr).To(HaveOccurred())
+					return
+				}
+				g.Expect(procErr).To(BeNil())
+			}
+		})
+	}
+}
+
+func createTags() infrav1.Tags {
+	tags := infrav1.Tags{}
+	tags["tag1"] = "val1"
+
+	return tags
+}
+
+func createTagsAdditional() infrav1.Tags {
+	tags := createTags()
+	tags["tag2"] = "val2"
+
+	return tags
+}
+
+func createDesiredAssociation(namespace, serviceAccount, roleARN string) *EKSPodIdentityAssociation {
+	return &EKSPodIdentityAssociation{
+		Namespace:      namespace,
+		ServiceAccount: serviceAccount,
+		RoleARN:        roleARN,
+		Tags:           createTags(),
+	}
+}
+
+func createDesiredAssociationExtraTag(namespace, serviceAccount, roleARN string) *EKSPodIdentityAssociation {
+	desired := createDesiredAssociation(namespace, serviceAccount, roleARN)
+	desired.Tags = createTagsAdditional()
+
+	return desired
+}
+
+func createInstalledAssociation(namespace, serviceAccount, roleARN, associationID, associationARN string) *EKSPodIdentityAssociation {
+	installed := createDesiredAssociation(namespace, serviceAccount, roleARN)
+	installed.AssociationID = associationID
+	installed.AssociationARN = associationARN
+
+	return installed
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podidentity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+)
+
+var (
+	// ErrNilAssociation defines an error for when a nil association is returned.
+	ErrNilAssociation = errors.New("nil pod identity association returned from create")
+	// ErrAssociationNotFound defines an error for when an association is not found.
+	ErrAssociationNotFound = errors.New("pod identity association not found")
+)
+
+// CreateAssociationProcedure is a procedure that will create an EKS Pod Identity association.
+type CreateAssociationProcedure struct {
+	plan *plan
+	key  string
+}
+
+// Do implements the logic for the procedure.
+func (p *CreateAssociationProcedure) Do(ctx context.Context) error {
+	desired := p.plan.getDesired(p.key)
+	if desired == nil {
+		return fmt.Errorf("getting desired pod identity association %s: %w", p.key, ErrAssociationNotFound)
+	}
+
+	input := &eks.CreatePodIdentityAssociationInput{
+		ClusterName:    aws.String(p.plan.clusterName),
+		Namespace:      aws.String(desired.Namespace),
+		ServiceAccount: aws.String(desired.ServiceAccount),
+		RoleArn:        aws.String(desired.RoleARN),
+		Tags:           desired.Tags,
+	}
+
+	output, err := p.plan.eksClient.CreatePodIdentityAssociation(ctx, input)
+	if err != nil {
+		return fmt.Errorf("creating pod identity association %s: %w", p.key, err)
+	}
+
+	if output.Association == nil {
+		return ErrNilAssociation
+	}
+
+	return nil
+}
+
+// Name is the name of the procedure.
+func (p *CreateAssociationProcedure) Name() string {
+	return "pod_identity_association_create"
+}
+
+// UpdateAssociationProcedure is a procedure that will update the role of an EKS Pod Identity association.
+type UpdateAssociationProcedure struct {
+	plan *plan
+	key  string
+}
+
+// Do implements the logic for the procedure.
+func (p *UpdateAssociationProcedure) Do(ctx context.Context) error {
+	desired := p.plan.getDesired(p.key)
+	installed := p.plan.getInstalled(p.key)
+
+	if desired == nil {
+		return fmt.Errorf("getting desired pod identity association %s: %w", p.key, ErrAssociationNotFound)
+	}
+	if installed == nil {
+		return fmt.Errorf("getting installed pod identity association %s: %w", p.key, ErrAssociationNotFound)
+	}
+
+	input := &eks.UpdatePodIdentityAssociationInput{
+		AssociationId: aws.String(installed.AssociationID),
+		ClusterName:   aws.String(p.plan.clusterName),
+		RoleArn:       aws.String(desired.RoleARN),
+	}
+
+	if _, err := p.plan.eksClient.UpdatePodIdentityAssociation(ctx, input); err != nil {
+		return fmt.Errorf("updating pod identity association %s: %w", p.key, err)
+	}
+
+	return nil
+}
+
+// Name is the name of the procedure.
+func (p *UpdateAssociationProcedure) Name() string {
+	return "pod_identity_association_update"
+}
+
+// UpdateAssociationTagsProcedure is a procedure that will update the tags of an EKS Pod Identity association.
+type UpdateAssociationTagsProcedure struct {
+	plan *plan
+	key  string
+}
+
+// Do implements the logic for the procedure.
+func (p *UpdateAssociationTagsProcedure) Do(ctx context.Context) error {
+	desired := p.plan.getDesired(p.key)
+	installed := p.plan.getInstalled(p.key)
+
+	if desired == nil {
+		return fmt.Errorf("getting desired pod identity association %s: %w", p.key, ErrAssociationNotFound)
+	}
+	if installed == nil {
+		return fmt.Errorf("getting installed pod identity association %s: %w", p.key, ErrAssociationNotFound)
+	}
+
+	input := &eks.TagResourceInput{
+		ResourceArn: aws.String(installed.AssociationARN),
+		Tags:        desired.Tags,
+	}
+
+	if _, err := p.plan.eksClient.TagResource(ctx, input); err != nil {
+		return fmt.Errorf("updating pod identity association tags %s: %w", p.key, err)
+	}
+
+	return nil
+}
+
+// Name is the name of the procedure.
+func (p *UpdateAssociationTagsProcedure) Name() string {
+	return "pod_identity_association_tags_update"
+}
+
+// DeleteAssociationProcedure is a procedure that will delete an EKS Pod Identity association.
+type DeleteAssociationProcedure struct {
+	plan *plan
+	key  string
+}
+
+// Do implements the logic for the procedure.
+func (p *DeleteAssociationProcedure) Do(ctx context.Context) error {
+	installed := p.plan.getInstalled(p.key)
+	if installed == nil {
+		return fmt.Errorf("getting installed pod identity association %s: %w", p.key, ErrAssociationNotFound)
+	}
+
+	input := &eks.DeletePodIdentityAssociationInput{
+		AssociationId: aws.String(installed.AssociationID),
+		ClusterName:   aws.String(p.plan.clusterName),
+	}
+
+	if _, err := p.plan.eksClient.DeletePodIdentityAssociation(ctx, input); err != nil {
+		return fmt.Errorf("deleting pod identity association %s: %w", p.key, err)
+	}
+
+	return nil
+}
+
+// Name is the name of the procedure.
+func (p *DeleteAssociationProcedure) Name() string {
+	return "pod_identity_association_delete"
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podidentity
+
+import (
+	"fmt"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// EKSPodIdentityAssociation represents an EKS Pod Identity association.
+type EKSPodIdentityAssociation struct {
+	Namespace      string
+	ServiceAccount string
+	RoleARN        string
+	Tags           infrav1.Tags
+	AssociationID  string
+	AssociationARN string
+}
+
+// Key returns the namespace and service account identifying the association in a cluster.
+func (e *EKSPodIdentityAssociation) Key() string {
+	return fmt.Sprintf("%s/%s", e.Namespace, e.ServiceAccount)
+}
+
+// IsEqual determines if 2 EKSPodIdentityAssociation are equal.
+func (e *EKSPodIdentityAssociation) IsEqual(other *EKSPodIdentityAssociation, includeTags bool) bool {
+	// NOTE: we do not compare the ID and ARN as they are only for existing associations
+	if e == other {
+		return true
+	}
+	if e.RoleARN != other.RoleARN {
+		return false
+	}
+
+	if includeTags {
+		diffTags := e.Tags.Difference(other.Tags)
+		if len(diffTags) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -52,6 +52,26 @@ func (m *MockELBV2API) EXPECT() *MockELBV2APIMockRecorder {
 	return m.recorder
 }
 
+// AddListenerCertificates mocks base method.
+func (m *MockELBV2API) AddListenerCertificates(arg0 context.Context, arg1 *elasticloadbalancingv2.AddListenerCertificatesInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.AddListenerCertificatesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddListenerCertificates", varargs...)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.AddListenerCertificatesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddListenerCertificates indicates an expected call of AddListenerCertificates.
+func (mr *MockELBV2APIMockRecorder) AddListenerCertificates(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddListenerCertificates", reflect.TypeOf((*MockELBV2API)(nil).AddListenerCertificates), varargs...)
+}
+
 // AddTags mocks base method.
 func (m *MockELBV2API) AddTags(arg0 context.Context, arg1 *elasticloadbalancingv2.AddTagsInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.AddTagsOutput, error) {
 	m.ctrl.T.Helper()
@@ -212,6 +232,26 @@ func (mr *MockELBV2APIMockRecorder) DeregisterTargets(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTargets", reflect.TypeOf((*MockELBV2API)(nil).DeregisterTargets), varargs...)
 }
 
+// DescribeListenerCertificates mocks base method.
+func (m *MockELBV2API) DescribeListenerCertificates(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeListenerCertificatesInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeListenerCertificatesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeListenerCertificates", varargs...)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.DescribeListenerCertificatesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeListenerCertificates indicates an expected call of DescribeListenerCertificates.
+func (mr *MockELBV2APIMockRecorder) DescribeListenerCertificates(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeListenerCertificates", reflect.TypeOf((*MockELBV2API)(nil).DescribeListenerCertificates), varargs...)
+}
+
 // DescribeListeners mocks base method.
 func (m *MockELBV2API) DescribeListeners(arg0 context.Context, arg1 *elasticloadbalancingv2.DescribeListenersInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.DescribeListenersOutput, error) {
 	m.ctrl.T.Helper()
@@ -426,6 +466,26 @@ func (mr *MockELBV2APIMockRecorder) RegisterTargets(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTargets", reflect.TypeOf((*MockELBV2API)(nil).RegisterTargets), varargs...)
 }
 
+// RemoveListenerCertificates mocks base method.
+func (m *MockELBV2API) RemoveListenerCertificates(arg0 context.Context, arg1 *elasticloadbalancingv2.RemoveListenerCertificatesInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.RemoveListenerCertificatesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveListenerCertificates", varargs...)
+	ret0, _ := ret[0].(*elasticloadbalancingv2.RemoveListenerCertificatesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveListenerCertificates indicates an expected call of RemoveListenerCertificates.
+func (mr *MockELBV2APIMockRecorder) RemoveListenerCertificates(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveListenerCertificates", reflect.TypeOf((*MockELBV2API)(nil).RemoveListenerCertificates), varargs...)
+}
+
 // RemoveTags mocks base method.
 func (m *MockELBV2API) RemoveTags(arg0 context.Context, arg1 *elasticloadbalancingv2.RemoveTagsInput, arg2 ...func(*elasticloadbalancingv2.Options)) (*elasticloadbalancingv2.RemoveTagsOutput, error) {
 	m.ctrl.T.Helper()
@@ -22,6 +22,7 @@ import (
 	"net"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -197,6 +198,13 @@ func (w *AWSCluster) validateControlPlaneLoadBalancerUpdate(oldlb, newlb *infrav
 					newlb.Scheme, "field is immutable, default value was set to internet-facing"),
 			)
 		}
+		// The DNS record backs the control plane endpoint, which cannot change once set.
+		if newlb.DNS != nil {
+			allErrs = append(allErrs,
+				field.Forbidden(field.NewPath("spec", "controlPlaneLoadBalancer", "dns"),
+					"field is immutable and can only be set at creation time"),
+			)
+		}
 	} else {
 		// A disabled Load Balancer has many implications that must be treated as immutable/
 		// this is mostly used by externally managed Control Plane, and there's no need to support type changes.
@@ -244,6 +252,14 @@ func (w *AWSCluster) validateControlPlaneLoadBalancerUpdate(oldlb, newlb *infrav
 					"field is immutable and cannot be changed after target group creation"),
 			)
 		}
+
+		// The DNS record backs the control plane endpoint, which cannot change once set.
+		if !cmp.Equal(oldlb.DNS, newlb.DNS) {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "dns"),
+					newlb.DNS, "field is immutable"),
+			)
+		}
 	}
 
 	return allErrs
@@ -345,6 +361,11 @@ func (w *AWSCluster) validateNetwork(r *infrav1.AWSCluster) field.ErrorList {
 
 	allErrs = append(allErrs, w.validateIngressRules(field.NewPath("spec", "network", "additionalControlPlaneIngressRules"), r.Spec.NetworkSpec.AdditionalControlPlaneIngressRules)...)
 	allErrs = append(allErrs, w.validateIngressRules(field.NewPath("spec", "network", "additionalNodeIngressRules"), r.Spec.NetworkSpec.AdditionalNodeIngressRules)...)
+	allErrs = append(allErrs, w.validateEgressRules(field.NewPath("spec", "network", "additionalControlPlaneEgressRules"), r.Spec.NetworkSpec.AdditionalControlPlaneEgressRules)...)
+	allErrs = append(allErrs, w.validateEgressRules(field.NewPath("spec", "network", "additionalNodeEgressRules"), r.Spec.NetworkSpec.AdditionalNodeEgressRules)...)
+	for role, rules := range r.Spec.NetworkSpec.SecurityGroupEgressRules {
+		allErrs = append(allErrs, w.validateEgressRules(field.NewPath("spec", "network", "securityGroupEgressRules").Key(string(role)), rules)...)
+	}
 
 	for cidrBlockIndex, cidrBlock := range r.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks {
 		if _, _, err := net.ParseCIDR(cidrBlock); err != nil {
@@ -412,6 +433,8 @@ func (w *AWSCluster) validateControlPlaneLBs(r *infrav1.AWSCluster) (admission.W
 			}
 		}
 		allErrs = append(allErrs, w.validateIngressRules(basePath.Child("ingressRules"), r.Spec.ControlPlaneLoadBalancer.IngressRules)...)
+		allErrs = append(allErrs, w.validateLoadBalancerLogsAndProtection(basePath, r.Spec.ControlPlaneLoadBalancer)...)
+		allErrs = append(allErrs, w.validateAdditionalListenersTLS(basePath, r.Spec.ControlPlaneLoadBalancer)...)
 
 		if r.Spec.ControlPlaneLoadBalancer.LoadBalancerType == infrav1.LoadBalancerTypeDisabled {
 			if r.Spec.ControlPlaneLoadBalancer.Name != nil {
@@ -453,6 +476,10 @@ func (w *AWSCluster) validateControlPlaneLBs(r *infrav1.AWSCluster) (admission.W
 			if r.Spec.ControlPlaneLoadBalancer.TargetGroupIPType != nil {
 				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "targetGroupIPType"), r.Spec.ControlPlaneLoadBalancer.TargetGroupIPType, "cannot set target group IP type if the LoadBalancer reconciliation is disabled"))
 			}
+
+			if r.Spec.ControlPlaneLoadBalancer.DNS != nil {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "dns"), r.Spec.ControlPlaneLoadBalancer.DNS, "cannot configure a DNS record if the LoadBalancer reconciliation is disabled"))
+			}
 		}
 	}
 
@@ -478,6 +505,10 @@ func (w *AWSCluster) validateControlPlaneLBs(r *infrav1.AWSCluster) (admission.W
 			allWarnings = append(allWarnings, fmt.Sprintf(warningClassicELB, "secondary control plane"))
 		}
 
+		if r.Spec.SecondaryControlPlaneLoadBalancer.DNS != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "dns"), r.Spec.SecondaryControlPlaneLoadBalancer.DNS, "DNS records are only supported on the primary control plane load balancer"))
+		}
+
 		// Validate the control plane load balancers settings (e.g. SG ingress rules, target groups)
 		basePath := field.NewPath("spec", "secondaryControlPlaneLoadBalancer")
 		if r.Spec.SecondaryControlPlaneLoadBalancer.TargetGroupIPType != nil {
@@ -490,6 +521,8 @@ func (w *AWSCluster) validateControlPlaneLBs(r *infrav1.AWSCluster) (admission.W
 			}
 		}
 		allErrs = append(allErrs, w.validateIngressRules(basePath.Child("ingressRules"), r.Spec.SecondaryControlPlaneLoadBalancer.IngressRules)...)
+		allErrs = append(allErrs, w.validateLoadBalancerLogsAndProtection(basePath, r.Spec.SecondaryControlPlaneLoadBalancer)...)
+		allErrs = append(allErrs, w.validateAdditionalListenersTLS(basePath, r.Spec.SecondaryControlPlaneLoadBalancer)...)
 	}
 
 	return allWarnings, allErrs
@@ -500,18 +533,124 @@ func (w *AWSCluster) validateIngressRules(path *field.Path, rules []infrav1.Ingr
 	for ruleIndex, rule := range rules {
 		rulePath := path.Index(ruleIndex)
 		if rule.NatGatewaysIPsSource {
-			if rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil || rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil {
-				allErrs = append(allErrs, field.Invalid(rulePath, rules, "natGatewaysIPsSource cannot be used together with CIDR blocks, security group IDs or security group roles"))
+			if rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil || rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil || rule.SourcePrefixListIDs != nil {
+				allErrs = append(allErrs, field.Invalid(rulePath, rules, "natGatewaysIPsSource cannot be used together with CIDR blocks, prefix lists, security group IDs or security group roles"))
 			}
 		} else {
 			if (rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil) && (rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil) {
 				allErrs = append(allErrs, field.Invalid(rulePath, rules, "CIDR blocks and security group IDs or security group roles cannot be used together"))
 			}
+			if rule.SourcePrefixListIDs != nil && (rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil) {
+				allErrs = append(allErrs, field.Invalid(rulePath, rules, "prefix lists and security group IDs or security group roles cannot be used together"))
+			}
+		}
+		for i, prefixListID := range rule.SourcePrefixListIDs {
+			if !strings.HasPrefix(prefixListID, "pl-") {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("sourcePrefixListIds").Index(i), prefixListID, "prefix list ID must start with 'pl-'"))
+			}
 		}
 	}
 	return allErrs
 }
 
+func (w *AWSCluster) validateEgressRules(path *field.Path, rules []infrav1.EgressRule) field.ErrorList {
+	var allErrs field.ErrorList
+	for ruleIndex, rule := range rules {
+		rulePath := path.Index(ruleIndex)
+		hasCidrBlocks := rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil || rule.DestinationPrefixListIDs != nil
+		hasSecurityGroups := rule.DestinationSecurityGroupIDs != nil || rule.DestinationSecurityGroupRoles != nil
+		if hasCidrBlocks && hasSecurityGroups {
+			allErrs = append(allErrs, field.Invalid(rulePath, rules, "CIDR blocks or prefix lists and security group IDs or security group roles cannot be used together"))
+		}
+		if !hasCidrBlocks && !hasSecurityGroups {
+			allErrs = append(allErrs, field.Invalid(rulePath, rules, "one of CIDR blocks, prefix lists, security group IDs or security group roles must be specified"))
+		}
+		for i, prefixListID := range rule.DestinationPrefixListIDs {
+			if !strings.HasPrefix(prefixListID, "pl-") {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("destinationPrefixListIds").Index(i), prefixListID, "prefix list ID must start with 'pl-'"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateLoadBalancerLogsAndProtection validates that the logs and deletion protection settings are supported
+// by the load balancer type.
+func (w *AWSCluster) validateLoadBalancerLogsAndProtection(path *field.Path, lbSpec *infrav1.AWSLoadBalancerSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	isV2LB := lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeALB || lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeNLB || lbSpec.LoadBalancerType == infrav1.LoadBalancerTypeELB
+	if lbSpec.DeletionProtection && !isV2LB {
+		allErrs = append(allErrs, field.Invalid(path.Child("deletionProtection"), lbSpec.DeletionProtection, "deletion protection is only supported on alb, nlb and elb load balancer types"))
+	}
+
+	if lbSpec.AccessLogs != nil {
+		if lbSpec.LoadBalancerType != infrav1.LoadBalancerTypeALB && lbSpec.LoadBalancerType != infrav1.LoadBalancerTypeNLB {
+			allErrs = append(allErrs, field.Invalid(path.Child("accessLogs"), lbSpec.AccessLogs, "access logs are only supported on alb and nlb load balancer types"))
+		}
+		allErrs = append(allErrs, validateLoadBalancerLogsPrefix(path.Child("accessLogs", "prefix"), lbSpec.AccessLogs.Prefix)...)
+	}
+
+	if lbSpec.ConnectionLogs != nil {
+		if lbSpec.LoadBalancerType != infrav1.LoadBalancerTypeALB {
+			allErrs = append(allErrs, field.Invalid(path.Child("connectionLogs"), lbSpec.ConnectionLogs, "connection logs are only supported on the alb load balancer type"))
+		}
+		allErrs = append(allErrs, validateLoadBalancerLogsPrefix(path.Child("connectionLogs", "prefix"), lbSpec.ConnectionLogs.Prefix)...)
+	}
+
+	return allErrs
+}
+
+func validateLoadBalancerLogsPrefix(path *field.Path, prefix string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if strings.HasPrefix(prefix, "/") || strings.HasSuffix(prefix, "/") {
+		allErrs = append(allErrs, field.Invalid(path, prefix, "prefix must not start or end with a slash"))
+	}
+	if strings.Contains(prefix, "AWSLogs") {
+		allErrs = append(allErrs, field.Invalid(path, prefix, "prefix must not contain AWSLogs"))
+	}
+
+	return allErrs
+}
+
+// validateAdditionalListenersTLS validates that TLS additional listeners are only used with network load balancers
+// and have a certificate, and that the TLS settings are only set on TLS listeners.
+func (w *AWSCluster) validateAdditionalListenersTLS(path *field.Path, lbSpec *infrav1.AWSLoadBalancerSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, listener := range lbSpec.AdditionalListeners {
+		listenerPath := path.Child("additionalListeners").Index(i)
+
+		if listener.Protocol != infrav1.ELBProtocolTLS {
+			if len(listener.CertificateARNs) > 0 {
+				allErrs = append(allErrs, field.Forbidden(listenerPath.Child("certificateARNs"), "certificates can only be set on TLS listeners"))
+			}
+			if listener.SSLPolicy != nil {
+				allErrs = append(allErrs, field.Forbidden(listenerPath.Child("sslPolicy"), "SSL policy can only be set on TLS listeners"))
+			}
+			if listener.ALPNPolicy != nil {
+				allErrs = append(allErrs, field.Forbidden(listenerPath.Child("alpnPolicy"), "ALPN policy can only be set on TLS listeners"))
+			}
+			continue
+		}
+
+		if lbSpec.LoadBalancerType != infrav1.LoadBalancerTypeNLB {
+			allErrs = append(allErrs, field.Invalid(listenerPath.Child("protocol"), listener.Protocol, "TLS listeners are only supported on the nlb load balancer type"))
+		}
+		if len(listener.CertificateARNs) == 0 {
+			allErrs = append(allErrs, field.Required(listenerPath.Child("certificateARNs"), "at least one certificate is required for TLS listeners"))
+		}
+		for j, certificateARN := range listener.CertificateARNs {
+			if !arn.IsARN(certificateARN) {
+				allErrs = append(allErrs, field.Invalid(listenerPath.Child("certificateARNs").Index(j), certificateARN, "must be a valid certificate ARN"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 // validateTargetGroupIPType validates that the target group IP type is compatible
 // with the load balancer type and VPC configuration.
 func (w *AWSCluster) validateTargetGroupIPType(r *infrav1.AWSCluster, path *field.Path, targetGroupIPType *infrav1.TargetGroupIPType, lbSpec *infrav1.AWSLoadBalancerSpec) field.ErrorList {
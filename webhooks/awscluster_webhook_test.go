@@ -174,6 +174,72 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "No options are allowed when LoadBalancer is disabled (dns)",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						DNS: &infrav1.LoadBalancerDNSSpec{
+							HostedZoneID: "Z0123456789",
+							RecordName:   "api.example.com",
+						},
+						LoadBalancerType: infrav1.LoadBalancerTypeDisabled,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects dns on the secondary control plane load balancer",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						Name:             aws.String("primary-lb"),
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+					},
+					SecondaryControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						Name:             aws.String("secondary-lb"),
+						Scheme:           &infrav1.ELBSchemeInternal,
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+						DNS: &infrav1.LoadBalancerDNSSpec{
+							HostedZoneID: "Z0123456789",
+							RecordName:   "api.example.com",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects dns with an invalid record name",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+						DNS: &infrav1.LoadBalancerDNSSpec{
+							HostedZoneID: "Z0123456789",
+							RecordName:   "api_server",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts dns on the primary control plane load balancer",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+						DNS: &infrav1.LoadBalancerDNSSpec{
+							HostedZoneID: "Z0123456789",
+							RecordName:   "api.example.com.",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		// The SSHKeyName tests were moved to sshkeyname_test.go
 		{
 			name: "Supported schemes are 'internet-facing, Internet-facing, internal, or nil', rest will be rejected",
@@ -324,6 +390,22 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "rejects access logging to the bucket itself",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					S3Bucket: &infrav1.S3Bucket{
+						Name:                           "foo",
+						ControlPlaneIAMInstanceProfile: "foo",
+						NodesIAMInstanceProfiles:       []string{"bar"},
+						AccessLogging: &infrav1.S3BucketAccessLogging{
+							TargetBucket: "foo",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "accepts vpc cidr",
 			cluster: &infrav1.AWSCluster{
@@ -801,6 +883,276 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "accepts node egress rules with destination security group role",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						AdditionalNodeEgressRules: []infrav1.EgressRule{
+							{
+								Protocol:                      infrav1.SecurityGroupProtocolTCP,
+								FromPort:                      6443,
+								ToPort:                        6443,
+								DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupControlPlane},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects CP egress rules with cidr block and destination security group id",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						AdditionalControlPlaneEgressRules: []infrav1.EgressRule{
+							{
+								Protocol:                    infrav1.SecurityGroupProtocolTCP,
+								CidrBlocks:                  []string{"10.0.0.0/16"},
+								DestinationSecurityGroupIDs: []string{"sg-12345"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects per role egress rules without a destination",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						SecurityGroupEgressRules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+							infrav1.SecurityGroupBastion: {
+								{
+									Protocol: infrav1.SecurityGroupProtocolTCP,
+									FromPort: 443,
+									ToPort:   443,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts per role egress rules without any rules",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						SecurityGroupEgressRules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+							infrav1.SecurityGroupBastion: {},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "accepts CP ingress rules with cidr block and prefix list",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						AdditionalControlPlaneIngressRules: []infrav1.IngressRule{
+							{
+								Protocol:            infrav1.SecurityGroupProtocolTCP,
+								CidrBlocks:          []string{"10.0.0.0/16"},
+								SourcePrefixListIDs: []string{"pl-12345"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects CP ingress rules with prefix list and source security group role",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						AdditionalControlPlaneIngressRules: []infrav1.IngressRule{
+							{
+								Protocol:                 infrav1.SecurityGroupProtocolTCP,
+								SourcePrefixListIDs:      []string{"pl-12345"},
+								SourceSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupBastion},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects node ingress rules with invalid prefix list ID",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						AdditionalNodeIngressRules: []infrav1.IngressRule{
+							{
+								Protocol:            infrav1.SecurityGroupProtocolTCP,
+								SourcePrefixListIDs: []string{"sg-12345"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts node egress rules with destination prefix list",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						AdditionalNodeEgressRules: []infrav1.EgressRule{
+							{
+								Protocol:                 infrav1.SecurityGroupProtocolTCP,
+								FromPort:                 443,
+								ToPort:                   443,
+								DestinationPrefixListIDs: []string{"pl-63a5400a"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "accepts access logs, connection logs and deletion protection for ALB",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType:   infrav1.LoadBalancerTypeALB,
+						DeletionProtection: true,
+						AccessLogs: &infrav1.LoadBalancerLogsSpec{
+							Bucket: "lb-logs",
+							Prefix: "access",
+						},
+						ConnectionLogs: &infrav1.LoadBalancerLogsSpec{
+							Bucket: "lb-logs",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "accepts TLS additional listener with certificate for NLB",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+						AdditionalListeners: []infrav1.AdditionalListenerSpec{
+							{
+								Port:            8443,
+								Protocol:        infrav1.ELBProtocolTLS,
+								CertificateARNs: []string{"arn:aws:acm:us-east-1:123456789012:certificate/abcd"},
+								SSLPolicy:       aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+								ALPNPolicy:      ptr.To(infrav1.ALPNPolicyHTTP2Preferred),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects TLS additional listener for ALB",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeALB,
+						AdditionalListeners: []infrav1.AdditionalListenerSpec{
+							{
+								Port:            8443,
+								Protocol:        infrav1.ELBProtocolTLS,
+								CertificateARNs: []string{"arn:aws:acm:us-east-1:123456789012:certificate/abcd"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects TLS additional listener without certificate",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+						AdditionalListeners: []infrav1.AdditionalListenerSpec{
+							{
+								Port:     8443,
+								Protocol: infrav1.ELBProtocolTLS,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects certificate on TCP additional listener",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+						AdditionalListeners: []infrav1.AdditionalListenerSpec{
+							{
+								Port:            8443,
+								Protocol:        infrav1.ELBProtocolTCP,
+								CertificateARNs: []string{"arn:aws:acm:us-east-1:123456789012:certificate/abcd"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects access logs for classic ELB",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeClassic,
+						AccessLogs: &infrav1.LoadBalancerLogsSpec{
+							Bucket: "lb-logs",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects connection logs for NLB",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+						ConnectionLogs: &infrav1.LoadBalancerLogsSpec{
+							Bucket: "lb-logs",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects access logs prefix containing AWSLogs",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+						AccessLogs: &infrav1.LoadBalancerLogsSpec{
+							Bucket: "lb-logs",
+							Prefix: "cluster/AWSLogs",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "rejects node ingress rules with cidr block and source security group id",
 			cluster: &infrav1.AWSCluster{
@@ -1127,6 +1479,49 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "controlPlaneLoadBalancer dns is immutable",
+			oldCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						DNS: &infrav1.LoadBalancerDNSSpec{
+							HostedZoneID: "Z0123456789",
+							RecordName:   "api.example.com",
+						},
+					},
+				},
+			},
+			newCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						DNS: &infrav1.LoadBalancerDNSSpec{
+							HostedZoneID: "Z0123456789",
+							RecordName:   "kube.example.com",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "controlPlaneLoadBalancer dns cannot be added after creation",
+			oldCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{},
+				},
+			},
+			newCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+						DNS: &infrav1.LoadBalancerDNSSpec{
+							HostedZoneID: "Z0123456789",
+							RecordName:   "api.example.com",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "controlPlaneLoadBalancer scheme is immutable",
 			oldCluster: &infrav1.AWSCluster{
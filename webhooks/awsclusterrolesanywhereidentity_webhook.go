@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-awsclusterrolesanywhereidentity,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=awsclusterrolesanywhereidentities,versions=v1beta2,name=validation.awsclusterrolesanywhereidentity.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta2-awsclusterrolesanywhereidentity,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=awsclusterrolesanywhereidentities,versions=v1beta2,name=default.awsclusterrolesanywhereidentity.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// AWSClusterRolesAnywhereIdentity implements a validating and defaulting webhook for AWSClusterRolesAnywhereIdentity.
+type AWSClusterRolesAnywhereIdentity struct{}
+
+var (
+	_ webhook.CustomValidator = &AWSClusterRolesAnywhereIdentity{}
+	_ webhook.CustomDefaulter = &AWSClusterRolesAnywhereIdentity{}
+)
+
+func (w *AWSClusterRolesAnywhereIdentity) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&infrav1.AWSClusterRolesAnywhereIdentity{}).
+		WithValidator(w).
+		WithDefaulter(w).
+		Complete()
+}
+
+// ValidateCreate will do any extra validation when creating an AWSClusterRolesAnywhereIdentity.
+func (*AWSClusterRolesAnywhereIdentity) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	r, ok := obj.(*infrav1.AWSClusterRolesAnywhereIdentity)
+	if !ok {
+		return nil, fmt.Errorf("expected an AWSClusterRolesAnywhereIdentity object but got %T", r)
+	}
+
+	return nil, validateAWSClusterRolesAnywhereIdentitySpec(&r.Spec)
+}
+
+// ValidateDelete allows you to add any extra validation when deleting an AWSClusterRolesAnywhereIdentity.
+func (*AWSClusterRolesAnywhereIdentity) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate will do any extra validation when updating an AWSClusterRolesAnywhereIdentity.
+func (*AWSClusterRolesAnywhereIdentity) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	r, ok := newObj.(*infrav1.AWSClusterRolesAnywhereIdentity)
+	if !ok {
+		return nil, fmt.Errorf("expected an AWSClusterRolesAnywhereIdentity object but got %T", r)
+	}
+
+	if _, ok := oldObj.(*infrav1.AWSClusterRolesAnywhereIdentity); !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an AWSClusterRolesAnywhereIdentity but got a %T", oldObj))
+	}
+
+	return nil, validateAWSClusterRolesAnywhereIdentitySpec(&r.Spec)
+}
+
+// Default will set default values for the AWSClusterRolesAnywhereIdentity.
+func (*AWSClusterRolesAnywhereIdentity) Default(_ context.Context, obj runtime.Object) error {
+	r, ok := obj.(*infrav1.AWSClusterRolesAnywhereIdentity)
+	if !ok {
+		return fmt.Errorf("expected an AWSClusterRolesAnywhereIdentity object but got %T", r)
+	}
+	infrav1.SetDefaults_Labels(&r.ObjectMeta)
+	return nil
+}
+
+func validateAWSClusterRolesAnywhereIdentitySpec(spec *infrav1.AWSClusterRolesAnywhereIdentitySpec) error {
+	if spec.SecretRef == "" {
+		return field.Required(field.NewPath("spec", "secretRef"), "must reference the secret containing the certificate and private key")
+	}
+
+	for _, f := range []struct {
+		name     string
+		value    string
+		service  string
+		resource string
+	}{
+		{name: "trustAnchorARN", value: spec.TrustAnchorARN, service: "rolesanywhere", resource: "trust-anchor/"},
+		{name: "profileARN", value: spec.ProfileARN, service: "rolesanywhere", resource: "profile/"},
+		{name: "roleARN", value: spec.RoleARN, service: "iam", resource: "role/"},
+	} {
+		parsed, err := arn.Parse(f.value)
+		if err != nil || parsed.Service != f.service || !strings.HasPrefix(parsed.Resource, f.resource) {
+			return field.Invalid(field.NewPath("spec", f.name), f.value, fmt.Sprintf("must be a valid %s %s ARN", f.service, strings.TrimSuffix(f.resource, "/")))
+		}
+	}
+
+	// Validate selector parses as Selector
+	if spec.AllowedNamespaces != nil {
+		_, err := metav1.LabelSelectorAsSelector(&spec.AllowedNamespaces.Selector)
+		if err != nil {
+			return field.Invalid(field.NewPath("spec", "allowedNamespaces", "selector"), spec.AllowedNamespaces.Selector, err.Error())
+		}
+	}
+
+	return nil
+}
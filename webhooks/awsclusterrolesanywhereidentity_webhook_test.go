@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+func TestCreateAWSClusterRolesAnywhereIdentityValidation(t *testing.T) {
+	validSpec := func() infrav1.AWSClusterRolesAnywhereIdentitySpec {
+		return infrav1.AWSClusterRolesAnywhereIdentitySpec{
+			SecretRef:      "roles-anywhere-certificate",
+			TrustAnchorARN: "arn:aws:rolesanywhere:us-east-1:123456789012:trust-anchor/4579702c-8d1d-4dbc-a4ce-9a5b2c4c5d4e",
+			ProfileARN:     "arn:aws:rolesanywhere:us-east-1:123456789012:profile/6f4a2c6e-1f5a-4e0e-9f3c-2a7f9b8f1c2d",
+			RoleARN:        "arn:aws:iam::123456789012:role/capa",
+		}
+	}
+
+	tests := []struct {
+		name      string
+		spec      func() infrav1.AWSClusterRolesAnywhereIdentitySpec
+		wantError bool
+	}{
+		{
+			name:      "should accept a valid identity",
+			spec:      validSpec,
+			wantError: false,
+		},
+		{
+			name: "should return error without a secret",
+			spec: func() infrav1.AWSClusterRolesAnywhereIdentitySpec {
+				spec := validSpec()
+				spec.SecretRef = ""
+				return spec
+			},
+			wantError: true,
+		},
+		{
+			name: "should return error for an invalid trust anchor ARN",
+			spec: func() infrav1.AWSClusterRolesAnywhereIdentitySpec {
+				spec := validSpec()
+				spec.TrustAnchorARN = "arn:aws:rolesanywhere:us-east-1:123456789012:profile/6f4a2c6e-1f5a-4e0e-9f3c-2a7f9b8f1c2d"
+				return spec
+			},
+			wantError: true,
+		},
+		{
+			name: "should return error for an invalid profile ARN",
+			spec: func() infrav1.AWSClusterRolesAnywhereIdentitySpec {
+				spec := validSpec()
+				spec.ProfileARN = "profile"
+				return spec
+			},
+			wantError: true,
+		},
+		{
+			name: "should return error for an invalid role ARN",
+			spec: func() infrav1.AWSClusterRolesAnywhereIdentitySpec {
+				spec := validSpec()
+				spec.RoleARN = "arn:aws:iam::123456789012:user/capa"
+				return spec
+			},
+			wantError: true,
+		},
+		{
+			name: "should return error for invalid selector",
+			spec: func() infrav1.AWSClusterRolesAnywhereIdentitySpec {
+				spec := validSpec()
+				spec.AllowedNamespaces = &infrav1.AllowedNamespaces{
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"-123-foo": "bar"},
+					},
+				}
+				return spec
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity := &infrav1.AWSClusterRolesAnywhereIdentity{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       string(infrav1.ClusterRolesAnywhereIdentityKind),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "roles-anywhere",
+				},
+				Spec: tt.spec(),
+			}
+
+			ctx := context.TODO()
+			if err := testEnv.Create(ctx, identity); (err != nil) != tt.wantError {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantError)
+			}
+			testEnv.Delete(ctx, identity)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-awsclusterwebidentity,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=awsclusterwebidentities,versions=v1beta2,name=validation.awsclusterwebidentity.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta2-awsclusterwebidentity,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=awsclusterwebidentities,versions=v1beta2,name=default.awsclusterwebidentity.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// AWSClusterWebIdentity implements a validating and defaulting webhook for AWSClusterWebIdentity.
+type AWSClusterWebIdentity struct{}
+
+var (
+	_ webhook.CustomValidator = &AWSClusterWebIdentity{}
+	_ webhook.CustomDefaulter = &AWSClusterWebIdentity{}
+)
+
+func (w *AWSClusterWebIdentity) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&infrav1.AWSClusterWebIdentity{}).
+		WithValidator(w).
+		WithDefaulter(w).
+		Complete()
+}
+
+// ValidateCreate will do any extra validation when creating an AWSClusterWebIdentity.
+func (*AWSClusterWebIdentity) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	r, ok := obj.(*infrav1.AWSClusterWebIdentity)
+	if !ok {
+		return nil, fmt.Errorf("expected an AWSClusterWebIdentity object but got %T", r)
+	}
+
+	return nil, validateAWSClusterWebIdentitySpec(&r.Spec)
+}
+
+// ValidateDelete allows you to add any extra validation when deleting an AWSClusterWebIdentity.
+func (*AWSClusterWebIdentity) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate will do any extra validation when updating an AWSClusterWebIdentity.
+func (*AWSClusterWebIdentity) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	r, ok := newObj.(*infrav1.AWSClusterWebIdentity)
+	if !ok {
+		return nil, fmt.Errorf("expected an AWSClusterWebIdentity object but got %T", r)
+	}
+
+	if _, ok := oldObj.(*infrav1.AWSClusterWebIdentity); !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an AWSClusterWebIdentity but got a %T", oldObj))
+	}
+
+	return nil, validateAWSClusterWebIdentitySpec(&r.Spec)
+}
+
+// Default will set default values for the AWSClusterWebIdentity.
+func (*AWSClusterWebIdentity) Default(_ context.Context, obj runtime.Object) error {
+	r, ok := obj.(*infrav1.AWSClusterWebIdentity)
+	if !ok {
+		return fmt.Errorf("expected an AWSClusterWebIdentity object but got %T", r)
+	}
+	infrav1.SetDefaults_Labels(&r.ObjectMeta)
+	return nil
+}
+
+func validateAWSClusterWebIdentitySpec(spec *infrav1.AWSClusterWebIdentitySpec) error {
+	if _, err := arn.Parse(spec.RoleArn); err != nil {
+		return field.Invalid(field.NewPath("spec", "roleARN"), spec.RoleArn, "must be a valid IAM role ARN")
+	}
+
+	if spec.TokenFile != "" && !filepath.IsAbs(spec.TokenFile) {
+		return field.Invalid(field.NewPath("spec", "tokenFile"), spec.TokenFile, "must be an absolute path")
+	}
+
+	// Validate selector parses as Selector
+	if spec.AllowedNamespaces != nil {
+		_, err := metav1.LabelSelectorAsSelector(&spec.AllowedNamespaces.Selector)
+		if err != nil {
+			return field.Invalid(field.NewPath("spec", "allowedNamespaces", "selector"), spec.AllowedNamespaces.Selector, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+func TestCreateAWSClusterWebIdentityValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.AWSClusterWebIdentitySpec
+		wantError bool
+	}{
+		{
+			name: "should accept a token file",
+			spec: infrav1.AWSClusterWebIdentitySpec{
+				AWSRoleSpec: infrav1.AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+				TokenFile:   "/var/run/secrets/sts.amazonaws.com/serviceaccount/token",
+			},
+			wantError: false,
+		},
+		{
+			name: "should accept a token secret",
+			spec: infrav1.AWSClusterWebIdentitySpec{
+				AWSRoleSpec:    infrav1.AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+				TokenSecretRef: "web-identity-token",
+			},
+			wantError: false,
+		},
+		{
+			name: "should return error without a token source",
+			spec: infrav1.AWSClusterWebIdentitySpec{
+				AWSRoleSpec: infrav1.AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+			},
+			wantError: true,
+		},
+		{
+			name: "should return error with both token sources",
+			spec: infrav1.AWSClusterWebIdentitySpec{
+				AWSRoleSpec:    infrav1.AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+				TokenFile:      "/var/run/secrets/sts.amazonaws.com/serviceaccount/token",
+				TokenSecretRef: "web-identity-token",
+			},
+			wantError: true,
+		},
+		{
+			name: "should return error for a relative token file",
+			spec: infrav1.AWSClusterWebIdentitySpec{
+				AWSRoleSpec: infrav1.AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+				TokenFile:   "token",
+			},
+			wantError: true,
+		},
+		{
+			name: "should return error for an invalid role ARN",
+			spec: infrav1.AWSClusterWebIdentitySpec{
+				AWSRoleSpec: infrav1.AWSRoleSpec{RoleArn: "capa"},
+				TokenFile:   "/var/run/secrets/sts.amazonaws.com/serviceaccount/token",
+			},
+			wantError: true,
+		},
+		{
+			name: "should return error for invalid selector",
+			spec: infrav1.AWSClusterWebIdentitySpec{
+				AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+					AllowedNamespaces: &infrav1.AllowedNamespaces{
+						Selector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"-123-foo": "bar"},
+						},
+					},
+				},
+				AWSRoleSpec: infrav1.AWSRoleSpec{RoleArn: "arn:aws:iam::123456789012:role/capa"},
+				TokenFile:   "/var/run/secrets/sts.amazonaws.com/serviceaccount/token",
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity := &infrav1.AWSClusterWebIdentity{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       string(infrav1.ClusterWebIdentityKind),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "web",
+				},
+				Spec: tt.spec,
+			}
+
+			ctx := context.TODO()
+			if err := testEnv.Create(ctx, identity); (err != nil) != tt.wantError {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantError)
+			}
+			testEnv.Delete(ctx, identity)
+		})
+	}
+}
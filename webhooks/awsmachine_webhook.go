@@ -86,6 +86,7 @@ func (w *AWSMachine) ValidateCreate(_ context.Context, obj runtime.Object) (admi
 	allErrs = append(allErrs, w.validateInstanceMarketType(r)...)
 	allErrs = append(allErrs, w.validateCapacityReservation(r)...)
 	allErrs = append(allErrs, w.validateHostAllocation(r)...)
+	allErrs = append(allErrs, w.validateHibernation(r)...)
 
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -141,6 +142,10 @@ func (w *AWSMachine) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.
 	delete(oldAWSMachineSpec, "additionalSecurityGroups")
 	delete(newAWSMachineSpec, "additionalSecurityGroups")
 
+	// allow changes to powerState
+	delete(oldAWSMachineSpec, "powerState")
+	delete(newAWSMachineSpec, "powerState")
+
 	// allow changes to secretPrefix, secretCount, and secureSecretsBackend
 	if cloudInit, ok := oldAWSMachineSpec["cloudInit"].(map[string]interface{}); ok {
 		delete(cloudInit, "secretPrefix")
@@ -361,6 +366,10 @@ func (w *AWSMachine) validateRootVolume(r *infrav1.AWSMachine) field.ErrorList {
 		}
 	}
 
+	if r.Spec.RootVolume.SnapshotID != "" {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.rootVolume.snapshotId"), "root volume is created from the snapshot of the AMI and cannot set a snapshot"))
+	}
+
 	if r.Spec.RootVolume.DeviceName != "" {
 		log.Info("root volume shouldn't have a device name (this can be ignored if performing a `clusterctl move`)")
 	}
@@ -421,6 +430,20 @@ func (w *AWSMachine) validateInstanceMarketType(r *infrav1.AWSMachine) field.Err
 	return allErrs
 }
 
+func (w *AWSMachine) validateHibernation(r *infrav1.AWSMachine) field.ErrorList {
+	var allErrs field.ErrorList
+	if !r.Spec.HibernationEnabled {
+		return allErrs
+	}
+	if r.Spec.RootVolume != nil && r.Spec.RootVolume.Encrypted != nil && !*r.Spec.RootVolume.Encrypted {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "rootVolume", "encrypted"), "root volume must be encrypted when hibernationEnabled is true"))
+	}
+	if r.Spec.MarketType == infrav1.MarketTypeSpot || r.Spec.SpotMarketOptions != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "hibernationEnabled"), "hibernation cannot be enabled for Spot instances"))
+	}
+	return allErrs
+}
+
 func (w *AWSMachine) validateNonRootVolumes(r *infrav1.AWSMachine) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -441,6 +464,10 @@ func (w *AWSMachine) validateNonRootVolumes(r *infrav1.AWSMachine) field.ErrorLi
 		if volume.DeviceName == "" {
 			allErrs = append(allErrs, field.Required(field.NewPath("spec.nonRootVolumes.deviceName"), "non root volume should have device name"))
 		}
+
+		if volume.VolumeInitializationRate != nil && volume.SnapshotID == "" {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec.nonRootVolumes.snapshotId"), "snapshotId required if volumeInitializationRate is set"))
+		}
 	}
 
 	return allErrs
@@ -153,6 +153,52 @@ func TestAWSMachineCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "ensure root volume cannot set a snapshot",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					RootVolume: &infrav1.Volume{
+						Size:       *aws.Int64(8),
+						SnapshotID: "snap-0123456789abcdef0",
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ensure non root volume can be created from a snapshot",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					NonRootVolumes: []infrav1.Volume{
+						{
+							DeviceName:               "name",
+							Size:                     *aws.Int64(100),
+							SnapshotID:               "snap-0123456789abcdef0",
+							VolumeInitializationRate: aws.Int64(300),
+						},
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ensure non root volume initialization rate requires a snapshot",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					NonRootVolumes: []infrav1.Volume{
+						{
+							DeviceName:               "name",
+							Size:                     *aws.Int64(100),
+							VolumeInitializationRate: aws.Int64(300),
+						},
+					},
+					InstanceType: "test",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "additional security groups may have id",
 			machine: &infrav1.AWSMachine{
@@ -722,6 +768,56 @@ func TestAWSMachineCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "hibernated power state with hibernation enabled is accepted",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType:       "type",
+					HibernationEnabled: true,
+					PowerState:         infrav1.InstancePowerStateHibernated,
+					RootVolume: &infrav1.Volume{
+						Size:      32,
+						Encrypted: aws.Bool(true),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error when power state is hibernated without hibernation enabled",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType: "type",
+					PowerState:   infrav1.InstancePowerStateHibernated,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error when hibernation is enabled with an unencrypted root volume",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType:       "type",
+					HibernationEnabled: true,
+					RootVolume: &infrav1.Volume{
+						Size:      32,
+						Encrypted: aws.Bool(false),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error when hibernation is enabled for a Spot instance",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType:       "type",
+					HibernationEnabled: true,
+					SpotMarketOptions:  &infrav1.SpotMarketOptions{},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -836,6 +932,36 @@ func TestAWSMachineUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "change in power state",
+			oldMachine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType: "test",
+				},
+			},
+			newMachine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType: "test",
+					PowerState:   infrav1.InstancePowerStateStopped,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "change in hibernation enabled",
+			oldMachine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType: "test",
+				},
+			},
+			newMachine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType:       "test",
+					HibernationEnabled: true,
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		ctx := context.TODO()
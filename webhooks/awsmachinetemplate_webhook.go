@@ -70,6 +70,10 @@ func (w *AWSMachineTemplate) validateRootVolume(r *infrav1.AWSMachineTemplate) f
 		}
 	}
 
+	if spec.RootVolume.SnapshotID != "" {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.template.spec.rootVolume.snapshotId"), "root volume is created from the snapshot of the AMI and cannot set a snapshot"))
+	}
+
 	if spec.RootVolume.DeviceName != "" {
 		log.Info("root volume shouldn't have a device name (this can be ignored if performing a `clusterctl move`)")
 	}
@@ -99,6 +103,10 @@ func (w *AWSMachineTemplate) validateNonRootVolumes(r *infrav1.AWSMachineTemplat
 		if volume.DeviceName == "" {
 			allErrs = append(allErrs, field.Required(field.NewPath("spec.template.spec.nonRootVolumes.deviceName"), "non root volume should have device name"))
 		}
+
+		if volume.VolumeInitializationRate != nil && volume.SnapshotID == "" {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec.template.spec.nonRootVolumes.snapshotId"), "snapshotId required if volumeInitializationRate is set"))
+		}
 	}
 
 	return allErrs
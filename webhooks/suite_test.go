@@ -77,6 +77,12 @@ func setup() {
 	if err := (&AWSClusterStaticIdentity{}).SetupWebhookWithManager(testEnv); err != nil {
 		panic(fmt.Sprintf("Unable to setup AWSClusterStaticIdentity webhook: %v", err))
 	}
+	if err := (&AWSClusterWebIdentity{}).SetupWebhookWithManager(testEnv); err != nil {
+		panic(fmt.Sprintf("Unable to setup AWSClusterWebIdentity webhook: %v", err))
+	}
+	if err := (&AWSClusterRolesAnywhereIdentity{}).SetupWebhookWithManager(testEnv); err != nil {
+		panic(fmt.Sprintf("Unable to setup AWSClusterRolesAnywhereIdentity webhook: %v", err))
+	}
 
 	go func() {
 		fmt.Println("Starting the manager")